@@ -597,6 +597,23 @@ func (chain *BlockChain) RecordFaultPeer(pid string, height int64, hash []byte,
 	faultnode.ErrInfo = err
 	faultnode.ReqFlag = false
 	chain.AddFaultPeer(&faultnode)
+	//只有peer发送了错误的区块才扣分
+	if !IsPeerFaultErr(err) {
+		synlog.Debug("RecordFaultPeer not peer fault", "pid", pid, "height", height, "err", err)
+		return
+	}
+	chain.reportPeer(pid, types.PeerBehaviorSyncFail, err.Error())
+}
+
+//reportPeer 通知p2p模块对出错的节点扣分
+func (chain *BlockChain) reportPeer(pid string, behavior int32, reason string) {
+	if chain.client == nil {
+		return
+	}
+	msg := chain.client.NewMessage("p2p", types.EventReportPeer, &types.ReportPeer{Pid: pid, Behavior: behavior, Reason: reason})
+	if err := chain.client.Send(msg, false); err != nil {
+		synlog.Error("reportPeer", "pid", pid, "err", err)
+	}
 }
 
 //SynBlocksFromPeers blockSynSeconds时间检测一次本节点的height是否有增长，没有增长就需要通过对端peerlist获取最新高度，发起同步
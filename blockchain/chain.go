@@ -139,6 +139,11 @@ type BlockChain struct {
 	processingDeleteChunk int32
 	deleteChunkCount      int64
 
+	//轻节点模式下的区块头链
+	light *lightChain
+	//并行流水线同步
+	pipeline *syncPipeline
+
 	// TODO
 	lastHeight             int64
 	heightNotIncreaseTimes int32
@@ -191,6 +196,9 @@ func New(cfg *types.Chain33Config) *BlockChain {
 	}
 	blockchain.initConfig(cfg)
 	blockchain.blockCache = newBlockCache(cfg, defaultBlockHashCacheSize)
+	if mcfg.EnablePipelineSync {
+		blockchain.pipeline = newSyncPipeline(blockchain)
+	}
 	return blockchain
 }
 
@@ -329,7 +337,11 @@ func (chain *BlockChain) InitBlockChain() {
 	}
 	cfg := chain.client.GetConfig()
 	cfg.S("dbversion", curdbver)
-	if !chain.cfg.IsParaChain && chain.cfg.RollbackBlock <= 0 {
+	if chain.cfg.LightMode {
+		// 轻节点只同步区块头
+		chain.light = newLightChain(chain)
+		go chain.light.synRoutine()
+	} else if !chain.cfg.IsParaChain && chain.cfg.RollbackBlock <= 0 {
 		// 定时检测/同步block
 		go chain.SynRoutine()
 
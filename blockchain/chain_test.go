@@ -854,6 +854,12 @@ func testIsRecordFaultErr(t *testing.T) {
 	if isok {
 		t.Error("testIsRecordFaultErr  IsRecordFaultErr", "isok", isok)
 	}
+	//本节点自身的错误不对peer扣分
+	assert.False(t, blockchain.IsPeerFaultErr(nil))
+	assert.False(t, blockchain.IsPeerFaultErr(types.ErrFutureBlock))
+	assert.False(t, blockchain.IsPeerFaultErr(queue.ErrQueueTimeout))
+	assert.False(t, blockchain.IsPeerFaultErr(types.ErrDisableWrite))
+	assert.True(t, blockchain.IsPeerFaultErr(types.ErrCheckStateHash))
 	chainlog.Debug("testIsRecordFaultErr end ---------------------")
 }
 
@@ -31,6 +31,9 @@ const (
 	normalDownLoadMode = 0
 	fastDownLoadMode   = 1
 	chunkDownLoadMode  = 2
+
+	//并行流水线下载模式, 见pipeline.go
+	pipelineDownLoadMode = 3
 )
 
 //DownLoadInfo blockchain模块下载block处理结构体
@@ -400,7 +403,9 @@ func (chain *BlockChain) DownLoadBlocks() {
 		}
 	} else {
 		// 2.其次尝试开启快速下载模式,目前默认开启
-		if chain.GetDownloadSyncStatus() == fastDownLoadMode {
+		if chain.cfg.EnablePipelineSync {
+			chain.PipelineDownLoadBlocks()
+		} else if chain.GetDownloadSyncStatus() == fastDownLoadMode {
 			chain.FastDownLoadBlocks()
 		}
 	}
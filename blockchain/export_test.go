@@ -0,0 +1,16 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import "time"
+
+//SetPipelineTimeout 测试中缩短流水线同步的超时时间, 返回恢复原值的函数
+func SetPipelineTimeout(stall, header time.Duration) func() {
+	oldStall, oldHeader := pipelineStallTimeout, pipelineHeaderTimeout
+	pipelineStallTimeout, pipelineHeaderTimeout = stall, header
+	return func() {
+		pipelineStallTimeout, pipelineHeaderTimeout = oldStall, oldHeader
+	}
+}
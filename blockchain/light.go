@@ -0,0 +1,613 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/difficulty"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	mavl "github.com/33cn/chain33/system/store/mavl/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+//轻节点模式:
+//1. 只从peer同步区块头, 校验区块头哈希,签名以及父哈希的连接关系, 并由共识模块按共识规则校验后保存到本地
+//2. 分叉时按累计工作量选择区块头链
+//3. 查询交易时向全节点请求交易的merkle证明, 并使用本地区块头的TxHash校验
+//4. 查询状态(余额等)时向全节点请求mavl证明, 并使用本地区块头的StateHash校验
+//5. 创世区块由本地共识模块生成并执行, 作为轻节点区块头链的锚点
+var (
+	lightTipKey         = []byte("LightTipHeight")
+	lightHeaderPrefix   = "LightHeader:"
+	lightStateHashPrefx = "LightStateHash:"
+)
+
+const (
+	//一次向peer请求的区块头个数
+	lightFetchHeaderNum int64 = 256
+)
+
+func calcLightHeaderKey(height int64) []byte {
+	return []byte(fmt.Sprintf("%s%012d", lightHeaderPrefix, height))
+}
+
+func calcLightStateHashKey(stateHash []byte) []byte {
+	return append([]byte(lightStateHashPrefx), stateHash...)
+}
+
+//lightChain 轻节点的区块头链
+type lightChain struct {
+	chain    *BlockChain
+	mu       sync.RWMutex
+	tip      *types.Header
+	hasPeer  int32
+	caughtUp int32
+}
+
+func newLightChain(chain *BlockChain) *lightChain {
+	return &lightChain{chain: chain}
+}
+
+//getTip 获取轻节点当前最新的区块头, 首次使用时从本地创世区块初始化
+func (l *lightChain) getTip() (*types.Header, error) {
+	l.mu.RLock()
+	tip := l.tip
+	l.mu.RUnlock()
+	if tip != nil {
+		return tip, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.tip != nil {
+		return l.tip, nil
+	}
+	db := l.chain.blockStore.db
+	height, err := l.getHeight(lightTipKey)
+	if err == nil {
+		header, err := l.getHeader(height)
+		if err != nil {
+			return nil, err
+		}
+		l.tip = header
+		return l.tip, nil
+	}
+	genesis, err := l.chain.blockStore.GetBlockHeaderByHeight(0)
+	if err != nil {
+		return nil, err
+	}
+	batch := db.NewBatch(true)
+	l.saveHeader(batch, genesis)
+	batch.Set(lightTipKey, types.Encode(&types.Int64{Data: 0}))
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+	l.tip = genesis
+	chainlog.Info("lightChain init from genesis", "hash", common.ToHex(genesis.Hash))
+	return l.tip, nil
+}
+
+func (l *lightChain) getHeight(key []byte) (int64, error) {
+	value, err := l.chain.blockStore.db.Get(key)
+	if err != nil {
+		return -1, err
+	}
+	return decodeHeight(value)
+}
+
+func (l *lightChain) getHeader(height int64) (*types.Header, error) {
+	value, err := l.chain.blockStore.db.Get(calcLightHeaderKey(height))
+	if err != nil {
+		return nil, types.ErrHeightNotExist
+	}
+	var header types.Header
+	err = types.Decode(value, &header)
+	if err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+func (l *lightChain) getHeightByStateHash(stateHash []byte) (int64, error) {
+	return l.getHeight(calcLightStateHashKey(stateHash))
+}
+
+func (l *lightChain) saveHeader(batch interface{ Set(key, value []byte) }, header *types.Header) {
+	batch.Set(calcLightHeaderKey(header.Height), types.Encode(header))
+	batch.Set(calcLightStateHashKey(header.StateHash), types.Encode(&types.Int64{Data: header.Height}))
+}
+
+//checkHeader 校验区块头自身的合法性: 哈希以及区块签名
+func checkHeader(cfg *types.Chain33Config, header *types.Header) error {
+	hash := header.CalcHash(cfg)
+	if !bytes.Equal(hash, header.Hash) {
+		return types.ErrBlockHashNoMatch
+	}
+	if header.Signature != nil && !types.CheckSign(hash, "", header.Signature, header.Height) {
+		return types.ErrSign
+	}
+	return nil
+}
+
+//addHeaders 添加从peer获取的连续区块头
+//区块头和本地链不连续时, 只有在peer的链从分叉点开始的累计工作量大于本地链时才回滚到分叉点并切换到peer的链
+func (l *lightChain) addHeaders(headers []*types.Header, pid string) error {
+	if len(headers) == 0 {
+		return nil
+	}
+	tip, err := l.getTip()
+	if err != nil {
+		return err
+	}
+	for i, header := range headers {
+		err = checkHeader(l.chain.client.GetConfig(), header)
+		if err != nil {
+			chainlog.Error("lightChain addHeaders", "height", header.Height, "pid", pid, "err", err)
+			return err
+		}
+		if i > 0 && (header.Height != headers[i-1].Height+1 || !bytes.Equal(header.ParentHash, headers[i-1].Hash)) {
+			return types.ErrParentHash
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	tip = l.tip
+
+	//跳过本地已经存在的区块头, 找到第一个不同的区块头
+	start := 0
+	for ; start < len(headers); start++ {
+		local, err := l.getHeader(headers[start].Height)
+		if err != nil || !bytes.Equal(local.Hash, headers[start].Hash) {
+			break
+		}
+	}
+	if start == len(headers) {
+		return nil
+	}
+	first := headers[start]
+	if first.Height == 0 {
+		return types.ErrBlockHashNoMatch
+	}
+	if first.Height > tip.Height+1 {
+		//超前的区块头由同步协程按顺序获取
+		return types.ErrParentBlockNoExist
+	}
+	parent, err := l.getHeader(first.Height - 1)
+	if err != nil || !bytes.Equal(parent.Hash, first.ParentHash) {
+		//找不到分叉点, 向前获取更多的区块头
+		backStart := first.Height - BackBlockNum
+		if backStart < 1 {
+			backStart = 1
+		}
+		chainlog.Debug("lightChain addHeaders not link", "height", first.Height, "pid", pid)
+		go l.chain.FetchBlockHeaders(backStart, first.Height, pid)
+		return types.ErrParentHash
+	}
+	//从分叉点开始按共识规则校验, 比如出块人是否有出块权限, 难度是否正确
+	err = util.CheckHeaders(l.chain.client, append([]*types.Header{parent}, headers[start:]...))
+	if err != nil {
+		chainlog.Error("lightChain addHeaders CheckHeaders", "height", first.Height, "pid", pid, "err", err)
+		return err
+	}
+	last := headers[len(headers)-1]
+	if first.Height <= tip.Height {
+		localWork, err := l.calcWork(first.Height, tip.Height)
+		if err != nil {
+			return err
+		}
+		//分叉的链累计工作量没有超过本地链, 不切换
+		if calcHeadersWork(headers[start:]).Cmp(localWork) <= 0 {
+			return nil
+		}
+	}
+
+	batch := l.chain.blockStore.db.NewBatch(true)
+	for h := first.Height; h <= tip.Height; h++ {
+		old, err := l.getHeader(h)
+		if err == nil {
+			batch.Delete(calcLightStateHashKey(old.StateHash))
+		}
+		batch.Delete(calcLightHeaderKey(h))
+	}
+	for _, header := range headers[start:] {
+		l.saveHeader(batch, header)
+	}
+	batch.Set(lightTipKey, types.Encode(&types.Int64{Data: last.Height}))
+	err = batch.Write()
+	if err != nil {
+		return err
+	}
+	if first.Height <= tip.Height {
+		chainlog.Info("lightChain switch fork", "forkHeight", first.Height, "oldTip", tip.Height, "newTip", last.Height, "pid", pid)
+	}
+	l.tip = last
+	return nil
+}
+
+//calcWork 本地[start, end]高度区块头的累计工作量
+func (l *lightChain) calcWork(start, end int64) (*big.Int, error) {
+	work := big.NewInt(0)
+	for h := start; h <= end; h++ {
+		header, err := l.getHeader(h)
+		if err != nil {
+			return nil, err
+		}
+		work.Add(work, difficulty.CalcWork(header.Difficulty))
+	}
+	return work, nil
+}
+
+func calcHeadersWork(headers []*types.Header) *big.Int {
+	work := big.NewInt(0)
+	for _, header := range headers {
+		work.Add(work, difficulty.CalcWork(header.Difficulty))
+	}
+	return work
+}
+
+//synRoutine 定时从最高的peer同步区块头
+func (l *lightChain) synRoutine() {
+	ticker := time.NewTicker(l.chain.blockSynInterVal * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.chain.quit:
+			return
+		case <-ticker.C:
+			err := l.synHeaders()
+			if err != nil {
+				synlog.Debug("lightChain synHeaders", "err", err)
+			}
+		}
+	}
+}
+
+func (l *lightChain) synHeaders() error {
+	tip, err := l.getTip()
+	if err != nil {
+		return err
+	}
+	peer, err := l.getMaxPeer()
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt32(&l.hasPeer, 1)
+	//高度相同的分叉不切换, 等待peer的链更长之后再通过addHeaders寻找分叉点
+	if peer.Header.Height <= tip.Height {
+		atomic.StoreInt32(&l.caughtUp, 1)
+		return nil
+	}
+	atomic.StoreInt32(&l.caughtUp, 0)
+	end := tip.Height + lightFetchHeaderNum
+	if end > peer.Header.Height {
+		end = peer.Header.Height
+	}
+	return l.chain.FetchBlockHeaders(tip.Height+1, end, peer.Name)
+}
+
+//getMaxPeer 获取高度最高的peer
+func (l *lightChain) getMaxPeer() (*types.Peer, error) {
+	msg := l.chain.client.NewMessage("p2p", types.EventPeerInfo, nil)
+	err := l.chain.client.SendTimeout(msg, true, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := l.chain.client.WaitTimeout(msg, 60*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	peerlist, ok := resp.GetData().(*types.PeerList)
+	if !ok {
+		return nil, types.ErrNoPeer
+	}
+	var maxPeer *types.Peer
+	for _, peer := range peerlist.GetPeers() {
+		if peer == nil || peer.Self || peer.Header == nil {
+			continue
+		}
+		if maxPeer == nil || peer.Header.Height > maxPeer.Header.Height {
+			maxPeer = peer
+		}
+	}
+	if maxPeer == nil {
+		return nil, types.ErrNoPeer
+	}
+	return maxPeer, nil
+}
+
+//queryTx 向全节点请求交易详情及merkle证明, 使用本地区块头校验交易确实被打包
+//区块头中没有交易回执的承诺, 轻节点无法校验全节点返回的回执, 所以不返回回执, 区块时间取自本地区块头
+func (l *lightChain) queryTx(hash []byte) (*types.TransactionDetail, error) {
+	var header *types.Header
+	resp, err := l.fetchProof(types.EventFetchTxProof, &types.ReqHash{Hash: hash}, func(resp types.Message) error {
+		detail, ok := resp.(*types.TransactionDetail)
+		if !ok || detail.GetTx() == nil {
+			return types.ErrTypeAsset
+		}
+		var err error
+		header, err = l.verifyTxProof(hash, detail)
+		return err
+	})
+	if err != nil {
+		chainlog.Error("lightChain queryTx", "hash", common.ToHex(hash), "err", err)
+		return nil, err
+	}
+	detail := resp.(*types.TransactionDetail)
+	detail.Receipt = nil
+	detail.Blocktime = header.BlockTime
+	return detail, nil
+}
+
+func (l *lightChain) verifyTxProof(hash []byte, detail *types.TransactionDetail) (*types.Header, error) {
+	tx := detail.GetTx()
+	if !bytes.Equal(tx.Hash(), hash) {
+		return nil, types.ErrCheckTxHash
+	}
+	header, err := l.getHeader(detail.Height)
+	if err != nil {
+		return nil, err
+	}
+	index := uint32(detail.Index)
+	var root []byte
+	if !l.chain.client.GetConfig().IsFork(detail.Height, "ForkRootHash") {
+		root = merkle.GetMerkleRootFromBranch(detail.GetProofs(), tx.Hash(), index)
+	} else {
+		if len(detail.GetTxProofs()) == 0 {
+			return nil, types.ErrCheckTxHash
+		}
+		root = tx.FullHash()
+		for i, txproof := range detail.GetTxProofs() {
+			root = merkle.GetMerkleRootFromBranch(txproof.GetProofs(), root, txproof.GetIndex())
+			if i == 0 && txproof.GetRootHash() == nil && txproof.GetIndex() != index {
+				return nil, types.ErrCheckTxHash
+			}
+		}
+	}
+	if !bytes.Equal(root, header.TxHash) {
+		return nil, types.ErrCheckTxHash
+	}
+	return header, nil
+}
+
+//storeGet 向全节点请求状态的mavl证明, 使用本地区块头的StateHash校验
+//本地不认识的状态哈希以及创世状态直接从本地store读取
+//不存在的key通过左右相邻叶子节点的证明校验, 返回空值, 全节点没有返回证明时无法校验, 返回ErrNoStateProof
+func (l *lightChain) storeGet(req *types.StoreGet) (*types.StoreReplyValue, error) {
+	height, err := l.getHeightByStateHash(req.StateHash)
+	if err != nil || height == 0 {
+		return l.localStoreGet(req)
+	}
+	resp, err := l.fetchProof(types.EventFetchStateProof, req, func(resp types.Message) error {
+		reply, ok := resp.(*types.StoreReplyProof)
+		if !ok {
+			return types.ErrTypeAsset
+		}
+		return verifyStateProof(req, reply)
+	})
+	if err != nil {
+		return nil, err
+	}
+	reply := resp.(*types.StoreReplyProof)
+	values := make([][]byte, len(req.Keys))
+	for i, proof := range reply.Proofs {
+		values[i] = proof.Value
+	}
+	return &types.StoreReplyValue{Values: values}, nil
+}
+
+func verifyStateProof(req *types.StoreGet, reply *types.StoreReplyProof) error {
+	if len(reply.Proofs) != len(req.Keys) {
+		return types.ErrCheckStateHash
+	}
+	for i, proof := range reply.Proofs {
+		if !bytes.Equal(proof.Key, req.Keys[i]) {
+			return types.ErrCheckStateHash
+		}
+		if proof.Left != nil || proof.Right != nil {
+			if len(proof.Value) != 0 || !mavl.VerifyAbsence(proof.Key, req.StateHash, proof.Left, proof.Right) {
+				chainlog.Error("lightChain storeGet verify absence", "key", string(proof.Key))
+				return types.ErrCheckStateHash
+			}
+			continue
+		}
+		if len(proof.Proof) == 0 && len(proof.Value) == 0 {
+			return types.ErrNoStateProof
+		}
+		leaf := types.LeafNode{Key: proof.Key, Value: proof.Value, Height: 0, Size: 1}
+		p, err := mavl.ReadProof(req.StateHash, leaf.Hash(), proof.Proof)
+		if err != nil || !p.Verify(proof.Key, proof.Value, req.StateHash) {
+			chainlog.Error("lightChain storeGet verify", "key", string(proof.Key), "err", err)
+			return types.ErrCheckStateHash
+		}
+	}
+	return nil
+}
+
+func (l *lightChain) localStoreGet(req *types.StoreGet) (*types.StoreReplyValue, error) {
+	msg := l.chain.client.NewMessage("store", types.EventStoreGet, req)
+	err := l.chain.client.Send(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := l.chain.client.Wait(msg)
+	if err != nil {
+		return nil, err
+	}
+	reply, ok := resp.GetData().(*types.StoreReplyValue)
+	if !ok {
+		return nil, types.ErrTypeAsset
+	}
+	return reply, nil
+}
+
+//fetchProof 向全节点请求证明, p2p依次向peer请求直到verify校验通过
+func (l *lightChain) fetchProof(ty int64, req types.Message, verify func(types.Message) error) (types.Message, error) {
+	msg := l.chain.client.NewMessage("p2p", ty, &types.FetchProof{Req: req, Verify: verify})
+	err := l.chain.client.SendTimeout(msg, true, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := l.chain.client.WaitTimeout(msg, 60*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	if err, ok := resp.GetData().(error); ok {
+		return nil, err
+	}
+	return resp.GetData().(types.Message), nil
+}
+
+func (l *lightChain) getHeaders(req *types.ReqBlocks) (*types.Headers, error) {
+	if req.Start > req.End {
+		return nil, types.ErrEndLessThanStartHeight
+	}
+	if req.End-req.Start >= types.MaxHeaderCountPerTime {
+		return nil, types.ErrMaxCountPerTime
+	}
+	tip, err := l.getTip()
+	if err != nil {
+		return nil, err
+	}
+	if req.Start > tip.Height {
+		return nil, types.ErrStartHeight
+	}
+	end := req.End
+	if end > tip.Height {
+		end = tip.Height
+	}
+	var headers types.Headers
+	for i := req.Start; i <= end; i++ {
+		header, err := l.getHeader(i)
+		if err != nil {
+			return nil, err
+		}
+		headers.Items = append(headers.Items, header)
+	}
+	return &headers, nil
+}
+
+//isCaughtUp 和全节点一致, 没有peer时只有单节点模式认为已经同步
+func (l *lightChain) isCaughtUp() bool {
+	if atomic.LoadInt32(&l.hasPeer) == 0 {
+		return l.chain.cfg.SingleMode
+	}
+	return atomic.LoadInt32(&l.caughtUp) == 1
+}
+
+//procLight 轻节点模式下接管区块头相关以及交易,状态查询的消息
+func (chain *BlockChain) procLight(msgtype int64, msg *queue.Message, reqnum chan struct{}) bool {
+	switch msgtype {
+	case types.EventQueryTx:
+		go chain.processMsg(msg, reqnum, chain.lightQueryTx)
+	case types.EventStoreGet:
+		go chain.processMsg(msg, reqnum, chain.lightStoreGet)
+	case types.EventGetLastHeader:
+		go chain.processMsg(msg, reqnum, chain.lightGetLastHeader)
+	case types.EventGetBlockHeight:
+		go chain.processMsg(msg, reqnum, chain.lightGetBlockHeight)
+	case types.EventGetHeaders:
+		go chain.processMsg(msg, reqnum, chain.lightGetHeaders)
+	case types.EventAddBlockHeaders:
+		go chain.processMsg(msg, reqnum, chain.lightAddBlockHeaders)
+	case types.EventBroadcastAddBlock:
+		go chain.processMsg(msg, reqnum, chain.lightBroadcastAddBlock)
+	case types.EventIsSync:
+		go chain.processMsg(msg, reqnum, chain.lightIsSync)
+	default:
+		return false
+	}
+	return true
+}
+
+func (chain *BlockChain) lightQueryTx(msg *queue.Message) {
+	txhash := (msg.Data).(*types.ReqHash)
+	detail, err := chain.light.queryTx(txhash.Hash)
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("rpc", types.EventTransactionDetail, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventTransactionDetail, detail))
+}
+
+func (chain *BlockChain) lightStoreGet(msg *queue.Message) {
+	req := (msg.Data).(*types.StoreGet)
+	reply, err := chain.light.storeGet(req)
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("", types.EventStoreGetReply, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventStoreGetReply, reply))
+}
+
+func (chain *BlockChain) lightGetLastHeader(msg *queue.Message) {
+	header, err := chain.light.getTip()
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("account", types.EventHeader, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("account", types.EventHeader, header))
+}
+
+func (chain *BlockChain) lightGetBlockHeight(msg *queue.Message) {
+	var reply types.ReplyBlockHeight
+	reply.Height = -1
+	header, err := chain.light.getTip()
+	if err == nil {
+		reply.Height = header.Height
+	}
+	msg.Reply(chain.client.NewMessage("consensus", types.EventReplyBlockHeight, &reply))
+}
+
+func (chain *BlockChain) lightGetHeaders(msg *queue.Message) {
+	req := (msg.Data).(*types.ReqBlocks)
+	headers, err := chain.light.getHeaders(req)
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("rpc", types.EventHeaders, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventHeaders, headers))
+}
+
+func (chain *BlockChain) lightAddBlockHeaders(msg *queue.Message) {
+	var reply types.Reply
+	reply.IsOk = true
+	headerspid := msg.Data.(*types.HeadersPid)
+	err := chain.light.addHeaders(headerspid.Headers.GetItems(), headerspid.Pid)
+	if err != nil {
+		reply.IsOk = false
+		reply.Msg = []byte(err.Error())
+	}
+	msg.Reply(chain.client.NewMessage("p2p", types.EventReply, &reply))
+}
+
+func (chain *BlockChain) lightBroadcastAddBlock(msg *queue.Message) {
+	var reply types.Reply
+	reply.IsOk = true
+	blockwithpid := msg.Data.(*types.BlockPid)
+	header := blockwithpid.Block.GetHeader(chain.client.GetConfig())
+	header.Signature = blockwithpid.Block.Signature
+	err := chain.light.addHeaders([]*types.Header{header}, blockwithpid.Pid)
+	if err != nil {
+		chainlog.Debug("lightBroadcastAddBlock", "height", header.Height, "err", err)
+		reply.IsOk = false
+		reply.Msg = []byte(err.Error())
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventReply, &reply))
+}
+
+func (chain *BlockChain) lightIsSync(msg *queue.Message) {
+	ok := chain.light.isCaughtUp()
+	msg.Reply(chain.client.NewMessage("", types.EventReplyIsSync, &types.IsCaughtUp{Iscaughtup: ok}))
+}
@@ -0,0 +1,149 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLightNetwork() *testnode.Network {
+	full := testnode.GetDefaultConfig()
+	light := testnode.GetDefaultConfig()
+	light.GetModuleConfig().BlockChain.LightMode = true
+	light.GetModuleConfig().Consensus.Minerstart = false
+	return testnode.NewNetwork([]*types.Chain33Config{full, light})
+}
+
+func waitTxDetail(t *testing.T, node *testnode.Chain33Mock, hash []byte) *types.TransactionDetail {
+	for i := 0; i < 200; i++ {
+		detail, err := node.GetAPI().QueryTx(&types.ReqHash{Hash: hash})
+		if err == nil {
+			return detail
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatal("wait tx timeout")
+	return nil
+}
+
+func waitLightHeight(t *testing.T, node *testnode.Chain33Mock, height int64) {
+	for i := 0; i < 200; i++ {
+		header, err := node.GetAPI().GetLastHeader()
+		require.Nil(t, err)
+		if header.Height >= height {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatal("wait light height timeout")
+}
+
+func TestLightMode(t *testing.T) {
+	network := newLightNetwork()
+	defer network.Close()
+	full, light := network.Node(0), network.Node(1)
+	cfg := full.GetClient().GetConfig()
+
+	addr, _ := util.Genaddress()
+	tx := util.CreateCoinsTx(cfg, full.GetGenesisKey(), addr, 10*types.Coin)
+	hash := full.SendTx(tx)
+	waitTxDetail(t, full, hash)
+
+	fullHeader, err := full.GetAPI().GetLastHeader()
+	require.Nil(t, err)
+	waitLightHeight(t, light, fullHeader.Height)
+
+	//区块头和全节点一致, 但本地没有执行区块
+	lightHeader, err := light.GetAPI().GetLastHeader()
+	require.Nil(t, err)
+	assert.Equal(t, fullHeader.Hash, lightHeader.Hash)
+	assert.Equal(t, fullHeader.StateHash, lightHeader.StateHash)
+	assert.Equal(t, int64(0), light.GetBlockChain().GetBlockHeight())
+
+	//交易通过merkle证明校验, 区块头没有回执的承诺, 不返回无法校验的回执
+	detail, err := light.GetAPI().QueryTx(&types.ReqHash{Hash: hash})
+	require.Nil(t, err)
+	assert.Equal(t, tx.Hash(), detail.Tx.Hash())
+	assert.Nil(t, detail.Receipt)
+	fullDetail := waitTxDetail(t, full, hash)
+	assert.Equal(t, fullDetail.Blocktime, detail.Blocktime)
+
+	//余额通过mavl证明校验
+	accs, err := account.NewCoinsAccount(cfg).LoadAccounts(light.GetAPI(), []string{addr})
+	require.Nil(t, err)
+	assert.Equal(t, 10*types.Coin, accs[0].Balance)
+	//不存在的key通过相邻叶子节点的mavl证明校验, 返回空账户
+	addr2, _ := util.Genaddress()
+	accs, err = account.NewCoinsAccount(cfg).LoadAccounts(light.GetAPI(), []string{addr2})
+	require.Nil(t, err)
+	assert.Equal(t, addr2, accs[0].Addr)
+	assert.Equal(t, int64(0), accs[0].Balance)
+
+	//篡改的区块头被拒绝
+	headers, err := full.GetAPI().GetHeaders(&types.ReqBlocks{Start: fullHeader.Height, End: fullHeader.Height})
+	require.Nil(t, err)
+	bad := types.Clone(headers.Items[0]).(*types.Header)
+	bad.TxHash = []byte("bad txhash")
+	client := light.GetClient()
+	msg := client.NewMessage("blockchain", types.EventAddBlockHeaders, &types.HeadersPid{Pid: "bad", Headers: &types.Headers{Items: []*types.Header{bad}}})
+	require.Nil(t, client.Send(msg, true))
+	resp, err := client.Wait(msg)
+	require.Nil(t, err)
+	assert.False(t, resp.GetData().(*types.Reply).IsOk)
+	assert.Equal(t, types.ErrBlockHashNoMatch.Error(), string(resp.GetData().(*types.Reply).Msg))
+
+	//不连续的区块头被拒绝
+	parent := types.Clone(headers.Items[0]).(*types.Header)
+	parent.Height += 2
+	parent.Hash = parent.CalcHash(cfg)
+	msg = client.NewMessage("blockchain", types.EventAddBlockHeaders, &types.HeadersPid{Pid: "bad", Headers: &types.Headers{Items: []*types.Header{parent}}})
+	require.Nil(t, client.Send(msg, true))
+	resp, err = client.Wait(msg)
+	require.Nil(t, err)
+	assert.False(t, resp.GetData().(*types.Reply).IsOk)
+	lightHeader2, err := light.GetAPI().GetLastHeader()
+	require.Nil(t, err)
+	assert.Equal(t, lightHeader.Hash, lightHeader2.Hash)
+
+	addHeader := func(header *types.Header) *types.Reply {
+		header.Hash = header.CalcHash(cfg)
+		msg := client.NewMessage("blockchain", types.EventAddBlockHeaders, &types.HeadersPid{Pid: "bad", Headers: &types.Headers{Items: []*types.Header{header}}})
+		require.Nil(t, client.Send(msg, true))
+		resp, err := client.Wait(msg)
+		require.Nil(t, err)
+		return resp.GetData().(*types.Reply)
+	}
+	//不符合共识规则的区块头被拒绝
+	child := types.Clone(lightHeader).(*types.Header)
+	child.Height++
+	child.ParentHash = lightHeader.Hash
+	child.BlockTime++
+	child.Difficulty++
+	reply := addHeader(child)
+	assert.False(t, reply.IsOk)
+	assert.Equal(t, types.ErrBlockHeaderDifficulty.Error(), string(reply.Msg))
+	child.Difficulty--
+	child.TxCount = 0
+	reply = addHeader(child)
+	assert.False(t, reply.IsOk)
+	assert.Equal(t, types.ErrEmptyTx.Error(), string(reply.Msg))
+
+	//累计工作量相同的分叉不切换
+	fork := types.Clone(lightHeader).(*types.Header)
+	fork.BlockTime++
+	reply = addHeader(fork)
+	assert.True(t, reply.IsOk)
+	lightHeader2, err = light.GetAPI().GetLastHeader()
+	require.Nil(t, err)
+	assert.Equal(t, lightHeader.Hash, lightHeader2.Hash)
+}
@@ -0,0 +1,73 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkPartitionReorg(t *testing.T) {
+	network := testnode.Launch(&testnode.NetworkConfig{Nodes: 3, Miners: []int{0, 2}, Accounts: 1})
+	defer network.Close()
+	keys := network.Keys()
+	cfg := network.Node(0).GetClient().GetConfig()
+
+	//分区后两边各自出块, 产生分叉
+	network.Partition([]int{0, 1}, []int{2})
+	assert.False(t, network.Connected(1, 2))
+	for i := 0; i < 3; i++ {
+		network.Node(0).SendTx(util.CreateCoinsTx(cfg, keys.Genesis, keys.Address(0), types.Coin))
+		require.Nil(t, network.Node(0).WaitHeight(int64(2+i)))
+	}
+	network.Node(2).SendTx(util.CreateCoinsTx(cfg, keys.Genesis, keys.Address(0), 2*types.Coin))
+	require.Nil(t, network.Node(2).WaitHeight(2))
+	header0, err := network.Node(0).GetAPI().GetLastHeader()
+	require.Nil(t, err)
+	header2, err := network.Node(2).GetAPI().GetLastHeader()
+	require.Nil(t, err)
+	assert.NotEqual(t, header0.Hash, header2.Hash)
+
+	//恢复网络后短链回滚到长链
+	network.Heal()
+	require.Nil(t, network.WaitSync(2*time.Minute))
+	header2, err = network.Node(2).GetAPI().GetLastHeader()
+	require.Nil(t, err)
+	assert.True(t, header2.Height >= 4)
+}
+
+func TestNetworkRestart(t *testing.T) {
+	network := testnode.Launch(&testnode.NetworkConfig{Nodes: 2, Accounts: 1})
+	defer network.Close()
+	require.Nil(t, network.Fund(0, 10*types.Coin, time.Minute))
+
+	require.Nil(t, network.StopNode(1))
+	assert.Nil(t, network.Node(1))
+	assert.NotNil(t, network.StopNode(2))
+	assert.Nil(t, network.Node(-1))
+	cfg := network.Node(0).GetClient().GetConfig()
+	keys := network.Keys()
+	for i := 0; i < 2; i++ {
+		addr, _ := util.Genaddress()
+		network.Node(0).SendTx(util.CreateCoinsTx(cfg, keys.Accounts[0], addr, types.Coin))
+	}
+	header, err := network.Node(0).GetAPI().GetLastHeader()
+	require.Nil(t, err)
+
+	//重启的节点保留原来的数据, 并同步停止期间产生的区块
+	_, err = network.RestartNode(2)
+	assert.NotNil(t, err)
+	node, err := network.RestartNode(1)
+	require.Nil(t, err)
+	require.NotNil(t, node)
+	require.Nil(t, network.WaitHeight(header.Height, time.Minute))
+	require.Nil(t, network.WaitSync(time.Minute))
+}
@@ -0,0 +1,626 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+//并行流水线同步, 用于节点落后较多区块时的初始同步:
+//1. 先从最优链节点批量获取区块头, 校验区块头哈希以及父哈希的链接关系, 并由共识模块按共识规则校验,
+//   共识没有实现区块头校验时退出流水线, 使用普通同步模式
+//2. 区块按窗口分配给多个节点并行下载, 优先分配给吞吐量高的节点, 长时间没有进展的窗口重新分配给其他节点
+//3. 下载的区块和已校验的区块头比对后在内存中缓存, 按高度顺序执行, 下载和执行互不阻塞
+
+const (
+	defaultPipelineWindowSize = 128
+	defaultPipelineMaxWindows = 16
+	//每个节点同时下载的最大窗口数
+	pipelinePeerWindows = 2
+	//一次请求的区块头数量
+	pipelineHeaderBatch = 2000
+	//已校验的区块头最多领先执行高度的数量, 控制内存占用
+	pipelineHeaderAhead = 20000
+	//执行高度超过该时间没有增长, 退出流水线同步
+	pipelineIdleTimeout = waitTimeDownLoad * time.Second
+)
+
+var (
+	//窗口超过该时间没有收到新的区块, 重新分配给其他节点
+	pipelineStallTimeout = 30 * time.Second
+	//等待区块头的超时时间
+	pipelineHeaderTimeout = 30 * time.Second
+)
+
+//pipelinePeer 下载节点的吞吐量统计
+type pipelinePeer struct {
+	pid     string
+	windows int           //正在下载的窗口数
+	bytes   int64         //已完成窗口的区块大小
+	cost    time.Duration //已完成窗口的耗时
+	stalls  int           //窗口超时次数
+}
+
+//rate 节点的下载速率 bytes/s, 还没有统计数据的节点优先尝试
+func (p *pipelinePeer) rate() float64 {
+	if p.cost <= 0 {
+		if p.stalls > 0 {
+			return 0
+		}
+		return math.MaxFloat64
+	}
+	return float64(p.bytes) / p.cost.Seconds() / float64(1+p.stalls)
+}
+
+//pipelineWindow 分配给某个节点下载的一段连续区块
+type pipelineWindow struct {
+	start  int64
+	end    int64
+	pid    string
+	begin  time.Time //分配的时间
+	last   time.Time //最近收到区块的时间
+	bytes  int64
+	remain int64 //还未收到的区块数
+}
+
+//headerRequest 正在等待的区块头请求
+type headerRequest struct {
+	pid   string
+	start int64
+}
+
+type syncPipeline struct {
+	chain      *BlockChain
+	windowSize int64
+	maxWindows int
+
+	active   int32
+	headerCh chan *types.Headers
+	execCh   chan struct{}
+	schedCh  chan struct{}
+
+	lock      sync.Mutex
+	target    int64
+	next      int64 //下一个待执行的高度
+	headerTip int64 //已校验区块头的最大高度
+	tipHeader *types.Header
+	assigned  int64 //已分配下载窗口的最大高度
+	lastExec  time.Time
+	hashes    map[int64][]byte
+	blocks    map[int64]*types.BlockPid
+	windows   map[int64]*pipelineWindow
+	retry     []*pipelineWindow
+	peers     map[string]*pipelinePeer
+	waitHead  *headerRequest
+}
+
+func newSyncPipeline(chain *BlockChain) *syncPipeline {
+	p := &syncPipeline{
+		chain:      chain,
+		windowSize: chain.cfg.PipelineWindowSize,
+		maxWindows: int(chain.cfg.PipelineMaxWindows),
+		headerCh:   make(chan *types.Headers, 1),
+		execCh:     make(chan struct{}, 1),
+		schedCh:    make(chan struct{}, 1),
+	}
+	if p.windowSize <= 0 {
+		p.windowSize = defaultPipelineWindowSize
+	}
+	if p.maxWindows <= 0 {
+		p.maxWindows = defaultPipelineMaxWindows
+	}
+	return p
+}
+
+func notify(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+//PipelineDownLoadBlocks 开启并行流水线下载区块的模式
+func (chain *BlockChain) PipelineDownLoadBlocks() {
+	chain.UpdateDownloadSyncStatus(pipelineDownLoadMode)
+	curHeight := chain.GetBlockHeight()
+	lastTempHight := chain.GetLastTempBlockHeight()
+
+	synlog.Info("PipelineDownLoadBlocks", "curHeight", curHeight, "lastTempHight", lastTempHight)
+
+	//需要执行完上次快速下载临时存贮在db中的blocks
+	if lastTempHight != -1 && lastTempHight > curHeight {
+		chain.ReadBlockToExec(lastTempHight, false)
+	}
+
+	startTime := types.Now()
+	for {
+		select {
+		case <-chain.quit:
+			return
+		default:
+		}
+		curheight := chain.GetBlockHeight()
+		peerMaxBlkHeight := chain.GetPeerMaxBlkHeight()
+		pids := chain.GetBestChainPids()
+		//落后不超过一次请求的区块数时使用普通同步模式
+		if pids != nil && peerMaxBlkHeight != -1 && curheight+chain.MaxFetchBlockNum >= peerMaxBlkHeight {
+			synlog.Info("PipelineDownLoadBlocks:quit!", "curheight", curheight, "peerMaxBlkHeight", peerMaxBlkHeight)
+			break
+		} else if pids != nil && curheight+chain.MaxFetchBlockNum < peerMaxBlkHeight {
+			synlog.Info("start download blocks!PipelineDownLoadBlocks", "curheight", curheight, "peerMaxBlkHeight", peerMaxBlkHeight, "pids", len(pids))
+			beg := types.Now()
+			err := chain.pipeline.run(curheight+1, peerMaxBlkHeight)
+			synlog.Info("PipelineDownLoadBlocks:complete!", "start", curheight+1, "height", chain.GetBlockHeight(), "target", peerMaxBlkHeight, "cost", types.Since(beg), "err", err)
+			break
+		} else if types.Since(startTime) > waitTimeDownLoad*time.Second || chain.cfg.SingleMode {
+			synlog.Info("PipelineDownLoadBlocks:waitTimeDownLoad:quit!", "curheight", curheight, "peerMaxBlkHeight", peerMaxBlkHeight, "pids", pids)
+			break
+		} else {
+			synlog.Info("PipelineDownLoadBlocks task sleep 1 second !")
+			time.Sleep(time.Second)
+		}
+	}
+	chain.UpdateDownloadSyncStatus(normalDownLoadMode)
+}
+
+//run 同步[start, target]区间的区块, 全部执行完成或者出错时返回
+func (p *syncPipeline) run(start, target int64) error {
+	parent, err := p.chain.blockStore.GetBlockHeaderByHeight(start - 1)
+	if err != nil {
+		return err
+	}
+	p.lock.Lock()
+	p.target = target
+	p.next = start
+	p.headerTip = start - 1
+	p.tipHeader = parent
+	p.assigned = start - 1
+	p.lastExec = types.Now()
+	p.hashes = map[int64][]byte{start - 1: parent.Hash}
+	p.blocks = make(map[int64]*types.BlockPid)
+	p.windows = make(map[int64]*pipelineWindow)
+	p.retry = nil
+	p.peers = make(map[string]*pipelinePeer)
+	p.waitHead = nil
+	p.lock.Unlock()
+
+	atomic.StoreInt32(&p.active, 1)
+	stop := make(chan struct{})
+	defer func() {
+		atomic.StoreInt32(&p.active, 0)
+		close(stop)
+		atomic.CompareAndSwapInt32(&p.chain.isbatchsync, 0, 1)
+		p.lock.Lock()
+		p.hashes, p.blocks, p.windows, p.retry = nil, nil, nil, nil
+		p.lock.Unlock()
+	}()
+
+	errCh := make(chan error, 2)
+	go func() {
+		if err := p.syncHeaders(stop); err != nil {
+			errCh <- err
+		}
+	}()
+	go func() {
+		errCh <- p.execBlocks(stop)
+	}()
+
+	ticker := time.NewTicker(time.Second / 5)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.chain.quit:
+			return types.ErrIsClosed
+		case err := <-errCh:
+			return err
+		case <-ticker.C:
+		case <-p.schedCh:
+		}
+		p.lock.Lock()
+		idle := types.Since(p.lastExec)
+		p.lock.Unlock()
+		if idle > pipelineIdleTimeout {
+			return types.ErrTimeout
+		}
+		p.schedule()
+	}
+}
+
+//syncHeaders 获取并校验区块头, 最多领先执行高度pipelineHeaderAhead个区块
+func (p *syncPipeline) syncHeaders(stop chan struct{}) error {
+	for {
+		p.lock.Lock()
+		tip, next := p.headerTip, p.next
+		parent := p.tipHeader
+		p.lock.Unlock()
+		if tip >= p.target {
+			return nil
+		}
+		if tip >= next+pipelineHeaderAhead {
+			select {
+			case <-stop:
+				return nil
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		end := tip + pipelineHeaderBatch
+		if end > p.target {
+			end = p.target
+		}
+		headers, err := p.fetchHeaders(parent, end, stop)
+		if err != nil {
+			return err
+		}
+		p.lock.Lock()
+		if p.hashes == nil {
+			p.lock.Unlock()
+			return nil
+		}
+		for _, header := range headers {
+			p.hashes[header.Height] = header.Hash
+		}
+		p.headerTip = headers[len(headers)-1].Height
+		p.tipHeader = headers[len(headers)-1]
+		p.lock.Unlock()
+		notify(p.schedCh)
+	}
+}
+
+//fetchHeaders 依次向最优链节点请求parent之后的区块头, 返回第一个校验通过的结果
+func (p *syncPipeline) fetchHeaders(parent *types.Header, end int64, stop chan struct{}) ([]*types.Header, error) {
+	cfg := p.chain.client.GetConfig()
+	start := parent.Height + 1
+	for retry := 0; retry < 3; retry++ {
+		for _, pid := range p.chain.GetBestChainPids() {
+			info := p.chain.GetPeerInfo(pid)
+			if info == nil || info.Height < end {
+				continue
+			}
+			headers, err := p.requestHeaders(start, end, pid, stop)
+			if err != nil {
+				synlog.Debug("syncPipeline fetchHeaders", "start", start, "end", end, "pid", pid, "err", err)
+				if err == types.ErrIsClosed {
+					return nil, err
+				}
+				continue
+			}
+			err = verifyHeaders(cfg, parent, headers)
+			if err == nil {
+				err = util.CheckHeaders(p.chain.client, append([]*types.Header{parent}, headers...))
+				if err == types.ErrNotSupport {
+					return nil, err
+				}
+			}
+			if err != nil {
+				synlog.Error("syncPipeline verifyHeaders", "start", start, "pid", pid, "err", err)
+				p.chain.reportPeer(pid, types.PeerBehaviorInvalidBlock, "invalid headers:"+err.Error())
+				continue
+			}
+			return headers, nil
+		}
+		time.Sleep(time.Second)
+	}
+	return nil, types.ErrNoPeer
+}
+
+func (p *syncPipeline) requestHeaders(start, end int64, pid string, stop chan struct{}) ([]*types.Header, error) {
+	//丢弃之前超时请求的结果
+	select {
+	case <-p.headerCh:
+	default:
+	}
+	p.lock.Lock()
+	p.waitHead = &headerRequest{pid: pid, start: start}
+	p.lock.Unlock()
+	defer func() {
+		p.lock.Lock()
+		p.waitHead = nil
+		p.lock.Unlock()
+	}()
+
+	err := p.chain.FetchBlockHeaders(start, end, pid)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case <-stop:
+		return nil, types.ErrIsClosed
+	case headers := <-p.headerCh:
+		return headers.Items, nil
+	case <-time.After(pipelineHeaderTimeout):
+		return nil, types.ErrTimeout
+	}
+}
+
+//verifyHeaders 校验区块头的哈希和签名, 并且从parent开始高度连续, 哈希链接
+func verifyHeaders(cfg *types.Chain33Config, parent *types.Header, headers []*types.Header) error {
+	if len(headers) == 0 {
+		return types.ErrHeaderNotSet
+	}
+	for _, header := range headers {
+		if header.Height != parent.Height+1 {
+			return types.ErrBlockHeightNoMatch
+		}
+		if !bytes.Equal(header.ParentHash, parent.Hash) {
+			return types.ErrParentHash
+		}
+		err := checkHeader(cfg, header)
+		if err != nil {
+			return err
+		}
+		parent = header
+	}
+	return nil
+}
+
+//deliverHeaders 流水线正在等待该节点的区块头时接收, 否则按普通流程处理
+func (p *syncPipeline) deliverHeaders(headers *types.HeadersPid) bool {
+	if p == nil || atomic.LoadInt32(&p.active) == 0 || len(headers.GetHeaders().GetItems()) == 0 {
+		return false
+	}
+	p.lock.Lock()
+	wait := p.waitHead
+	p.lock.Unlock()
+	if wait == nil || wait.pid != headers.Pid || wait.start != headers.Headers.Items[0].Height {
+		return false
+	}
+	select {
+	case p.headerCh <- headers.Headers:
+	default:
+	}
+	return true
+}
+
+//deliverBlock 接收下载的区块, 和已校验的区块头比对后缓存等待执行
+func (p *syncPipeline) deliverBlock(blockpid *types.BlockPid) bool {
+	if p == nil || atomic.LoadInt32(&p.active) == 0 || blockpid.GetBlock() == nil {
+		return false
+	}
+	block := blockpid.Block
+	height := block.Height
+	p.lock.Lock()
+	hash, ok := p.hashes[height]
+	_, exist := p.blocks[height]
+	next := p.next
+	p.lock.Unlock()
+	//不在同步范围内或者重复的区块直接丢弃
+	if !ok || exist || height < next {
+		return true
+	}
+	cfg := p.chain.client.GetConfig()
+	if !bytes.Equal(hash, block.Hash(cfg)) || !bytes.Equal(block.TxHash, merkle.CalcMerkleRoot(cfg, height, block.Txs)) {
+		synlog.Error("syncPipeline deliverBlock", "height", height, "pid", blockpid.Pid, "err", types.ErrBlockHashNoMatch)
+		p.chain.reportPeer(blockpid.Pid, types.PeerBehaviorInvalidBlock, "block not match header")
+		return true
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, exist = p.blocks[height]; exist || height < p.next || p.blocks == nil {
+		return true
+	}
+	p.blocks[height] = blockpid
+	for _, w := range p.windows {
+		if height < w.start || height > w.end {
+			continue
+		}
+		w.remain--
+		w.bytes += int64(block.Size())
+		w.last = types.Now()
+		if w.remain <= 0 {
+			p.finishWindow(w)
+		}
+		break
+	}
+	notify(p.execCh)
+	return true
+}
+
+func (p *syncPipeline) finishWindow(w *pipelineWindow) {
+	delete(p.windows, w.start)
+	peer := p.peers[w.pid]
+	if peer == nil {
+		return
+	}
+	peer.windows--
+	peer.bytes += w.bytes
+	peer.cost += types.Since(w.begin)
+	notify(p.schedCh)
+}
+
+//missing [start, end]区间还未收到的区块数, 返回第一个未收到的高度
+func (p *syncPipeline) missing(start, end int64) (int64, int64) {
+	if start < p.next {
+		start = p.next
+	}
+	first, count := int64(-1), int64(0)
+	for h := start; h <= end; h++ {
+		if _, ok := p.blocks[h]; !ok {
+			if first == -1 {
+				first = h
+			}
+			count++
+		}
+	}
+	return first, count
+}
+
+//schedule 重新分配超时的窗口, 并在不超过缓存上限的前提下分配新的下载窗口
+func (p *syncPipeline) schedule() {
+	pids := p.chain.GetBestChainPids()
+	var reqs []pipelineWindow
+
+	p.lock.Lock()
+	now := types.Now()
+	for _, w := range p.windows {
+		if now.Sub(w.last) < pipelineStallTimeout {
+			continue
+		}
+		delete(p.windows, w.start)
+		if peer := p.peers[w.pid]; peer != nil {
+			peer.windows--
+			peer.stalls++
+		}
+		synlog.Info("syncPipeline window stalled", "start", w.start, "end", w.end, "pid", w.pid, "remain", w.remain)
+		p.chain.reportPeer(w.pid, types.PeerBehaviorTimeout, "download blocks stalled")
+		if first, _ := p.missing(w.start, w.end); first != -1 {
+			p.retry = append(p.retry, &pipelineWindow{start: first, end: w.end, pid: w.pid})
+		}
+	}
+
+	for len(p.windows) < p.maxWindows {
+		var w *pipelineWindow
+		isRetry := len(p.retry) > 0
+		if isRetry {
+			w = p.retry[0]
+		} else {
+			start := p.assigned + 1
+			//执行落后时暂停分配, 控制缓存的区块数量
+			if start > p.headerTip || start > p.next+int64(p.maxWindows)*p.windowSize*2 {
+				break
+			}
+			end := start + p.windowSize - 1
+			if end > p.headerTip {
+				end = p.headerTip
+			}
+			w = &pipelineWindow{start: start, end: end}
+		}
+		first, remain := p.missing(w.start, w.end)
+		if remain == 0 {
+			if isRetry {
+				p.retry = p.retry[1:]
+			} else {
+				p.assigned = w.end
+			}
+			continue
+		}
+		peer := p.selectPeer(pids, w)
+		if peer == nil {
+			break
+		}
+		if isRetry {
+			p.retry = p.retry[1:]
+		} else {
+			p.assigned = w.end
+		}
+		w.start, w.pid, w.remain = first, peer.pid, remain
+		w.begin, w.last, w.bytes = now, now, 0
+		peer.windows++
+		p.windows[w.start] = w
+		reqs = append(reqs, *w)
+	}
+	p.lock.Unlock()
+
+	for _, w := range reqs {
+		err := p.chain.fetchPipelineBlocks(w.start, w.end, w.pid)
+		if err != nil {
+			//请求失败的窗口由超时检测重新分配
+			synlog.Error("syncPipeline fetchPipelineBlocks", "start", w.start, "end", w.end, "pid", w.pid, "err", err)
+		}
+	}
+}
+
+//selectPeer 选择高度足够并且吞吐量最高的空闲节点, 重新分配的窗口优先选择其他节点
+func (p *syncPipeline) selectPeer(pids []string, w *pipelineWindow) *pipelinePeer {
+	var best *pipelinePeer
+	for _, pid := range pids {
+		if pid == w.pid && len(pids) > 1 {
+			continue
+		}
+		info := p.chain.GetPeerInfo(pid)
+		if info == nil || info.Height < w.end {
+			continue
+		}
+		peer, ok := p.peers[pid]
+		if !ok {
+			peer = &pipelinePeer{pid: pid}
+			p.peers[pid] = peer
+		}
+		if peer.windows >= pipelinePeerWindows {
+			continue
+		}
+		if best == nil || peer.rate() > best.rate() {
+			best = peer
+		}
+	}
+	return best
+}
+
+//execBlocks 按高度顺序执行缓存的区块
+func (p *syncPipeline) execBlocks(stop chan struct{}) error {
+	for {
+		select {
+		case <-stop:
+			return nil
+		default:
+		}
+		p.lock.Lock()
+		next := p.next
+		blockpid := p.blocks[next]
+		p.lock.Unlock()
+		if next > p.target {
+			return nil
+		}
+		if blockpid == nil {
+			select {
+			case <-stop:
+				return nil
+			case <-p.execCh:
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+
+		// 节点同步阶段自己高度小于最大高度batchsyncblocknum时存储block到db批量处理时不刷盘
+		if p.target > next+batchsyncblocknum && !p.chain.cfgBatchSync {
+			atomic.CompareAndSwapInt32(&p.chain.isbatchsync, 1, 0)
+		} else {
+			atomic.CompareAndSwapInt32(&p.chain.isbatchsync, 0, 1)
+		}
+		_, _, _, err := p.chain.ProcessBlock(false, &types.BlockDetail{Block: blockpid.Block}, blockpid.Pid, true, -1)
+		if err != nil && err != types.ErrBlockExist {
+			synlog.Error("syncPipeline execBlocks", "height", next, "pid", blockpid.Pid, "err", err)
+			return err
+		}
+
+		p.lock.Lock()
+		delete(p.blocks, next)
+		delete(p.hashes, next-1)
+		p.next++
+		p.lastExec = types.Now()
+		p.lock.Unlock()
+		notify(p.schedCh)
+	}
+}
+
+//fetchPipelineBlocks 向指定节点请求一个窗口的区块, 区块通过EventSyncBlock异步返回
+func (chain *BlockChain) fetchPipelineBlocks(start, end int64, pid string) error {
+	if chain.client == nil {
+		return types.ErrClientNotBindQueue
+	}
+	req := &types.ReqBlocks{Start: start, End: end, Pid: []string{pid}}
+	msg := chain.client.NewMessage("p2p", types.EventFetchBlocks, req)
+	err := chain.client.Send(msg, true)
+	if err != nil {
+		return err
+	}
+	resp, err := chain.client.WaitTimeout(msg, 10*time.Second)
+	if err != nil {
+		return err
+	}
+	if reply, ok := resp.GetData().(*types.Reply); ok && !reply.IsOk {
+		return types.ErrNoPeer
+	}
+	return nil
+}
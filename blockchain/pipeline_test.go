@@ -0,0 +1,124 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineSync(t *testing.T) {
+	network := testnode.NewNetwork([]*types.Chain33Config{testnode.GetDefaultConfig()})
+	defer network.Close()
+	full := network.Node(0)
+	cfg := full.GetClient().GetConfig()
+
+	//全节点先产生一批区块
+	for i := 0; i < 24; i++ {
+		addr, _ := util.Genaddress()
+		hash := full.SendTx(util.CreateCoinsTx(cfg, full.GetGenesisKey(), addr, types.Coin))
+		waitTxDetail(t, full, hash)
+	}
+	fullHeader, err := full.GetAPI().GetLastHeader()
+	require.Nil(t, err)
+
+	//落后的节点加入网络, 通过流水线并行下载区块
+	node := network.AddNode(pipelineConfig())
+	waitSynced(t, node, fullHeader)
+}
+
+//多个节点提供数据, 其中一个节点不响应区块请求, 一个节点返回共识无效的区块头
+func TestPipelineSyncFaultyPeers(t *testing.T) {
+	defer blockchain.SetPipelineTimeout(time.Second, time.Second)()
+	network, fullHeader := newSyncedNetwork(t, 3)
+	defer network.Close()
+	network.SetFault(1, testnode.FaultStall)
+	network.SetFault(2, testnode.FaultBadHeaders)
+
+	node := network.AddNode(pipelineConfig())
+	waitSynced(t, node, fullHeader)
+	//超时的窗口重新分配给其他节点, 并上报不响应的节点
+	assert.True(t, hasReport(network.Reports(3), "node1", types.PeerBehaviorTimeout))
+	for _, report := range network.Reports(3) {
+		assert.NotEqual(t, "node0", report.Pid)
+	}
+}
+
+//只有返回无效区块头的节点时, 流水线上报该节点并退出, 使用普通同步模式逐个执行区块
+func TestPipelineSyncBadHeaders(t *testing.T) {
+	network, fullHeader := newSyncedNetwork(t, 2)
+	defer network.Close()
+	network.SetFault(1, testnode.FaultBadHeaders)
+	network.Disconnect(0, 2)
+
+	node := network.AddNode(pipelineConfig())
+	waitSynced(t, node, fullHeader)
+	assert.True(t, hasReport(network.Reports(2), "node1", types.PeerBehaviorInvalidBlock))
+}
+
+//newSyncedNetwork 第一个节点产生区块, 其余节点同步到相同高度后返回
+func newSyncedNetwork(t *testing.T, count int) (*testnode.Network, *types.Header) {
+	network := testnode.NewNetwork([]*types.Chain33Config{testnode.GetDefaultConfig()})
+	full := network.Node(0)
+	cfg := full.GetClient().GetConfig()
+	//其余节点接收广播的区块
+	for i := 1; i < count; i++ {
+		follower := testnode.GetDefaultConfig()
+		follower.GetModuleConfig().Consensus.Minerstart = false
+		network.AddNode(follower)
+	}
+	for i := 0; i < 24; i++ {
+		addr, _ := util.Genaddress()
+		hash := full.SendTx(util.CreateCoinsTx(cfg, full.GetGenesisKey(), addr, types.Coin))
+		waitTxDetail(t, full, hash)
+	}
+	fullHeader, err := full.GetAPI().GetLastHeader()
+	require.Nil(t, err)
+	require.Nil(t, network.WaitHeight(fullHeader.Height, 30*time.Second))
+	return network, fullHeader
+}
+
+//pipelineConfig 落后节点的配置, 通过流水线并行下载区块
+func pipelineConfig() *types.Chain33Config {
+	cfg := testnode.GetDefaultConfig()
+	mcfg := cfg.GetModuleConfig()
+	mcfg.BlockChain.SingleMode = false
+	mcfg.BlockChain.MaxFetchBlockNum = 4
+	mcfg.BlockChain.EnablePipelineSync = true
+	mcfg.BlockChain.PipelineWindowSize = 3
+	mcfg.BlockChain.PipelineMaxWindows = 2
+	mcfg.Consensus.Minerstart = false
+	return cfg
+}
+
+func waitSynced(t *testing.T, node *testnode.Chain33Mock, fullHeader *types.Header) {
+	for i := 0; i < 300; i++ {
+		if node.GetBlockChain().GetBlockHeight() >= fullHeader.Height {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.Equal(t, fullHeader.Height, node.GetBlockChain().GetBlockHeight())
+	header, err := node.GetAPI().GetLastHeader()
+	require.Nil(t, err)
+	assert.Equal(t, fullHeader.Hash, header.Hash)
+	assert.Equal(t, fullHeader.StateHash, header.StateHash)
+}
+
+func hasReport(reports []*types.ReportPeer, pid string, behavior int32) bool {
+	for _, report := range reports {
+		if report.Pid == pid && report.Behavior == behavior {
+			return true
+		}
+	}
+	return false
+}
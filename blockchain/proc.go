@@ -26,6 +26,9 @@ func (chain *BlockChain) ProcRecvMsg() {
 		if chain.procLocalDB(msgtype, msg, reqnum) {
 			continue
 		}
+		if chain.light != nil && chain.procLight(msgtype, msg, reqnum) {
+			continue
+		}
 		switch msgtype {
 		case types.EventQueryTx:
 			go chain.processMsg(msg, reqnum, chain.queryTx)
@@ -116,6 +119,8 @@ func (chain *BlockChain) ProcRecvMsg() {
 			// 用于chunk同步区块
 		case types.EventAddChunkBlock:
 			go chain.processMsg(msg, reqnum, chain.addChunkBlock)
+		case types.EventConfigReloaded:
+			go chain.processMsg(msg, reqnum, chain.configReloaded)
 		default:
 			go chain.processMsg(msg, reqnum, chain.unknowMsg)
 		}
@@ -126,6 +131,16 @@ func (chain *BlockChain) unknowMsg(msg *queue.Message) {
 	chainlog.Warn("ProcRecvMsg unknow msg", "msgtype", msg.Ty)
 }
 
+//configReloaded 配置热加载, 更新推送订阅者的数量限制
+func (chain *BlockChain) configReloaded(msg *queue.Message) {
+	reload, ok := msg.GetData().(*types.ReloadConfig)
+	if !ok || reload.Config.BlockChain == nil || chain.push == nil {
+		return
+	}
+	chain.push.setMaxSubscriber(reload.Config.BlockChain.MaxPushSubscriber)
+	chainlog.Info("blockchain config reloaded", "maxPushSubscriber", reload.Config.BlockChain.MaxPushSubscriber)
+}
+
 func (chain *BlockChain) listPush(msg *queue.Message) {
 	cbs, err := chain.ProcListPush()
 	if err != nil {
@@ -171,6 +186,11 @@ func (chain *BlockChain) addBlock(msg *queue.Message) {
 	reply.IsOk = true
 	blockpid := msg.Data.(*types.BlockPid)
 	//chainlog.Error("addBlock", "height", blockpid.Block.Height, "pid", blockpid.Pid)
+	//流水线同步的区块由流水线校验后按顺序执行
+	if chain.pipeline.deliverBlock(blockpid) {
+		msg.Reply(chain.client.NewMessage("p2p", types.EventReply, &reply))
+		return
+	}
 	if chain.GetDownloadSyncStatus() == fastDownLoadMode {
 		err := chain.WriteBlockToDbTemp(blockpid.Block, true)
 		if err != nil {
@@ -360,6 +380,10 @@ func (chain *BlockChain) addBlockHeaders(msg *queue.Message) {
 	var reply types.Reply
 	reply.IsOk = true
 	headerspid := msg.Data.(*types.HeadersPid)
+	if chain.pipeline.deliverHeaders(headerspid) {
+		msg.Reply(chain.client.NewMessage("p2p", types.EventReply, &reply))
+		return
+	}
 	err := chain.ProcAddBlockHeadersMsg(headerspid.Headers, headerspid.Pid)
 	if err != nil {
 		chainlog.Error("addBlockHeaders", "err", err.Error())
@@ -624,3 +624,11 @@ func (chain *BlockChain) ProcessDelParaChainBlock(broadcast bool, blockdetail *t
 func IsRecordFaultErr(err error) bool {
 	return err != types.ErrFutureBlock && !api.IsGrpcError(err) && !api.IsQueueError(err)
 }
+
+// IsPeerFaultErr 检测此错误是否说明peer发送了错误的区块, 本节点自身或者临时性的错误不能对peer扣分
+func IsPeerFaultErr(err error) bool {
+	if err == nil || !IsRecordFaultErr(err) {
+		return false
+	}
+	return err != types.ErrDisableWrite && err != types.ErrTimeout
+}
@@ -93,6 +93,7 @@ type Push struct {
 	cfg            *types.Chain33Config
 	postFail2Sleep int32
 	postwg         *sync.WaitGroup
+	maxSubscriber  int
 }
 
 //PushClient ...
@@ -243,12 +244,26 @@ func newpush(commonStore CommonStore, seqStore SequenceStore, cfg *types.Chain33
 		cfg:            cfg,
 		postFail2Sleep: postFail2Sleep,
 		postwg:         &sync.WaitGroup{},
+		maxSubscriber:  maxPushSubscriber,
+	}
+	if n := cfg.GetModuleConfig().BlockChain.MaxPushSubscriber; n > 0 {
+		service.maxSubscriber = n
 	}
 	service.init()
 
 	return service
 }
 
+//setMaxSubscriber 配置热加载时更新推送订阅者的最大数量, 已经注册的订阅者不受影响
+func (push *Push) setMaxSubscriber(n int) {
+	if n <= 0 {
+		n = maxPushSubscriber
+	}
+	push.mu.Lock()
+	push.maxSubscriber = n
+	push.mu.Unlock()
+}
+
 //初始化: 从数据库读出seq的数目
 func (push *Push) init() {
 	var subscribes []*types.PushSubscribeReq
@@ -316,7 +331,7 @@ func (push *Push) addSubscriber(subscribe *types.PushSubscribeReq) error {
 	}
 
 	push.mu.Lock()
-	if len(push.tasks) >= maxPushSubscriber {
+	if len(push.tasks) >= push.maxSubscriber {
 		chainlog.Error("addSubscriber too many push subscriber")
 		push.mu.Unlock()
 		return types.ErrTooManySeqCB
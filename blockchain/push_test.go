@@ -996,6 +996,11 @@ func Test_AddPush_reachMaxNum(t *testing.T) {
 	subscribe.Name = "push-test-lastOne"
 	err := chain.push.addSubscriber(subscribe)
 	assert.Equal(t, err, types.ErrTooManySeqCB)
+
+	//配置热加载之后调整订阅者的数量限制
+	chain.push.setMaxSubscriber(maxPushSubscriber + 1)
+	err = chain.push.addSubscriber(subscribe)
+	assert.Equal(t, err, nil)
 	defer mock33.Close()
 }
 
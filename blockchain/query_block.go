@@ -5,7 +5,10 @@
 package blockchain
 
 import (
+	"time"
+
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/record"
 	"github.com/33cn/chain33/types"
 )
 
@@ -253,6 +256,9 @@ func (chain *BlockChain) ProcAddBlockMsg(broadcast bool, blockdetail *types.Bloc
 		return nil, types.ErrInvalidParam
 	}
 	b, ismain, isorphan, err := chain.ProcessBlock(broadcast, blockdetail, pid, true, -1)
+	if record.Enabled(record.KindBlock) {
+		recordBlock(broadcast, block, pid, beg, err)
+	}
 	if b != nil {
 		blockdetail = b
 	}
@@ -285,6 +291,24 @@ func (chain *BlockChain) ProcAddBlockMsg(broadcast bool, blockdetail *types.Bloc
 	return blockdetail, err
 }
 
+//recordBlock 记录收到的区块以及处理结果, 用于在测试节点上重放
+func recordBlock(broadcast bool, block *types.Block, pid string, recv time.Time, err error) {
+	entry := &record.Entry{
+		Time:   recv.UnixNano(),
+		Kind:   record.KindBlock,
+		Source: pid,
+		Method: record.MethodSync,
+		Data:   types.Encode(block),
+	}
+	if broadcast {
+		entry.Method = record.MethodBroadcast
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	record.Record(entry)
+}
+
 //getBlockHashes 获取指定height区间对应的blockhashes
 func (chain *BlockChain) getBlockHashes(startheight, endheight int64) types.ReqHashes {
 	var reqHashes types.ReqHashes
@@ -0,0 +1,61 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/33cn/chain33/common/record"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "record")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "record.gz")
+	require.Nil(t, record.Init(&record.Config{Enable: true, File: file}))
+
+	mock := testnode.New("", nil)
+	mock.Listen()
+	cfg := mock.GetClient().GetConfig()
+	for i := 0; i < 3; i++ {
+		mock.SendTxRPC(util.CreateCoinsTx(cfg, mock.GetGenesisKey(), mock.GetHotAddress(), types.Coin))
+		require.Nil(t, mock.Wait())
+	}
+	//重复的交易被mempool拒绝
+	tx := util.CreateCoinsTx(cfg, mock.GetGenesisKey(), mock.GetHotAddress(), types.Coin)
+	mock.SendTx(tx)
+	_, err = mock.GetAPI().SendTx(tx)
+	assert.Equal(t, types.ErrTxExist, err)
+	require.Nil(t, mock.Wait())
+	var header rpctypes.Header
+	require.Nil(t, mock.GetJSONC().Call("Chain33.GetLastHeader", nil, &header))
+	record.Close()
+	height := mock.GetLastBlock().Height
+	hash := mock.GetLastBlock().Hash(cfg)
+	mock.Close()
+
+	entries, err := record.ReadFile(file)
+	require.Nil(t, err)
+	replay := testnode.NewReplayNode(testnode.GetDefaultConfig(), "")
+	defer replay.Close()
+	report := replay.Replay(entries, &testnode.ReplayConfig{})
+	assert.Equal(t, 0, len(report.Diffs), "%v", report.Diffs)
+	assert.Equal(t, height, report.Height)
+	assert.Equal(t, hash, replay.GetLastBlock().Hash(cfg))
+	assert.Equal(t, int(height), report.Replayed[record.KindBlock])
+	assert.Equal(t, 5, report.Replayed[record.KindTx])
+	//创世区块已经存在, 发送交易的rpc请求通过mempool的记录重放
+	assert.Equal(t, 4, report.Skipped)
+}
@@ -151,6 +151,7 @@ func IsQueueError(err error) bool {
 	}
 	if err == queue.ErrQueueTimeout ||
 		err == queue.ErrQueueChannelFull ||
+		err == queue.ErrQueueOverload ||
 		err == queue.ErrIsQueueClosed {
 		return true
 	}
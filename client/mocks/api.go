@@ -37,6 +37,29 @@ func (_m *QueueProtocolAPI) AddPushSubscribe(param *types.PushSubscribeReq) (*ty
 	return r0, r1
 }
 
+// BanPeer provides a mock function with given fields: _a0
+func (_m *QueueProtocolAPI) BanPeer(_a0 *types.ReqBanPeer) (*types.Reply, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqBanPeer) *types.Reply); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqBanPeer) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *QueueProtocolAPI) Close() {
 	_m.Called()
@@ -465,6 +488,29 @@ func (_m *QueueProtocolAPI) GetMempool(req *types.ReqGetMempool) (*types.ReplyTx
 	return r0, r1
 }
 
+// GetMempoolSize provides a mock function with given fields:
+func (_m *QueueProtocolAPI) GetMempoolSize() (*types.MempoolSize, error) {
+	ret := _m.Called()
+
+	var r0 *types.MempoolSize
+	if rf, ok := ret.Get(0).(func() *types.MempoolSize); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MempoolSize)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNetInfo provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetNetInfo(param *types.P2PGetNetInfoReq) (*types.NodeNetInfo, error) {
 	ret := _m.Called(param)
@@ -534,6 +580,29 @@ func (_m *QueueProtocolAPI) GetParaTxByTitle(param *types.ReqParaTxByTitle) (*ty
 	return r0, r1
 }
 
+// GetPeerScores provides a mock function with given fields: _a0
+func (_m *QueueProtocolAPI) GetPeerScores(_a0 *types.ReqNil) (*types.PeerScoreList, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *types.PeerScoreList
+	if rf, ok := ret.Get(0).(func(*types.ReqNil) *types.PeerScoreList); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PeerScoreList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqNil) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProperFee provides a mock function with given fields: req
 func (_m *QueueProtocolAPI) GetProperFee(req *types.ReqProperFee) (*types.ReplyProperFee, error) {
 	ret := _m.Called(req)
@@ -580,6 +649,29 @@ func (_m *QueueProtocolAPI) GetPushSeqLastNum(param *types.ReqString) (*types.In
 	return r0, r1
 }
 
+// GetQueueStats provides a mock function with given fields:
+func (_m *QueueProtocolAPI) GetQueueStats() (*types.QueueStats, error) {
+	ret := _m.Called()
+
+	var r0 *types.QueueStats
+	if rf, ok := ret.Get(0).(func() *types.QueueStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueueStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSequenceByHash provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetSequenceByHash(param *types.ReqHash) (*types.Int64, error) {
 	ret := _m.Called(param)
@@ -1103,6 +1195,29 @@ func (_m *QueueProtocolAPI) QueryTx(param *types.ReqHash) (*types.TransactionDet
 	return r0, r1
 }
 
+// ReloadConfig provides a mock function with given fields: _a0
+func (_m *QueueProtocolAPI) ReloadConfig(_a0 *types.ReqNil) (*types.ReplyReloadConfig, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *types.ReplyReloadConfig
+	if rf, ok := ret.Get(0).(func(*types.ReqNil) *types.ReplyReloadConfig); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyReloadConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqNil) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendTx provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) SendTx(param *types.Transaction) (*types.Reply, error) {
 	ret := _m.Called(param)
@@ -1310,6 +1425,29 @@ func (_m *QueueProtocolAPI) StoreSet(param *types.StoreSetWithSync) (*types.Repl
 	return r0, r1
 }
 
+// UnbanPeer provides a mock function with given fields: _a0
+func (_m *QueueProtocolAPI) UnbanPeer(_a0 *types.ReqString) (*types.Reply, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqString) *types.Reply); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqString) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Version provides a mock function with given fields:
 func (_m *QueueProtocolAPI) Version() (*types.VersionInfo, error) {
 	ret := _m.Called()
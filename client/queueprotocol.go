@@ -12,6 +12,7 @@ import (
 	"github.com/33cn/chain33/common/crypto"
 
 	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/trace"
 
 	"github.com/33cn/chain33/common/version"
 	"github.com/33cn/chain33/queue"
@@ -28,8 +29,12 @@ const (
 	walletKey     = "wallet"     // 钱包
 	blockchainKey = "blockchain" // 区块
 	storeKey      = "store"
+	configKey     = "config" // 配置热加载
 )
 
+// reloadConfigTimeout 节点没有启动配置热加载时, 请求在超时之后返回
+const reloadConfigTimeout = 30 * time.Second
+
 var log = log15.New("module", "client")
 
 // QueueProtocolOption queue protocol option
@@ -38,6 +43,34 @@ type QueueProtocolOption struct {
 	SendTimeout time.Duration
 	// 接收应答超时时间
 	WaitTimeout time.Duration
+	// 查询类请求以后台优先级发送, 消息队列繁忙时优先被拒绝
+	BackgroundQuery bool
+}
+
+// backgroundEvents 以后台优先级发送的查询事件
+var backgroundEvents = map[int64]bool{
+	types.EventBlockChainQuery:      true,
+	types.EventConsensusQuery:       true,
+	types.EventGetBlocks:            true,
+	types.EventGetBlockByHashes:     true,
+	types.EventGetBlockSequences:    true,
+	types.EventGetBlockOverview:     true,
+	types.EventGetBlockHash:         true,
+	types.EventGetSeqByHash:         true,
+	types.EventGetHeaders:           true,
+	types.EventQueryTx:              true,
+	types.EventGetTransactionByAddr: true,
+	types.EventGetTransactionByHash: true,
+	types.EventGetAddrOverview:      true,
+	types.EventGetMempool:           true,
+	types.EventGetLastMempool:       true,
+	types.EventGetProperFee:         true,
+	types.EventLocalGet:             true,
+	types.EventLocalList:            true,
+	types.EventLocalPrefixCount:     true,
+	types.EventStoreGet:             true,
+	types.EventStoreList:            true,
+	types.EventGetValueByKey:        true,
 }
 
 // QueueProtocol 消息通道协议实现
@@ -63,9 +96,23 @@ func New(client queue.Client, option *QueueProtocolOption) (QueueProtocolAPI, er
 	return q, nil
 }
 
+// WithTrace 返回发出的消息都延续sc追踪的接口, 用于被采样的rpc请求, sc无效或者api不是QueueProtocol时原样返回
+func WithTrace(api QueueProtocolAPI, sc trace.SpanContext) QueueProtocolAPI {
+	q, ok := api.(*QueueProtocol)
+	if !ok || !sc.IsValid() {
+		return api
+	}
+	traced := *q
+	traced.client = queue.WithTrace(q.client, sc)
+	return &traced
+}
+
 func (q *QueueProtocol) send(topic string, ty int64, data interface{}) (*queue.Message, error) {
 	client := q.client
 	msg := client.NewMessage(topic, ty, data)
+	if q.option.BackgroundQuery && backgroundEvents[ty] {
+		msg.SetPriority(queue.PriorityBackground)
+	}
 	err := client.SendTimeout(msg, true, q.option.SendTimeout)
 	if err != nil {
 		return &queue.Message{}, err
@@ -271,6 +318,51 @@ func (q *QueueProtocol) NetProtocols(req *types.ReqNil) (*types.NetProtocolInfos
 	return nil, types.ErrTypeAsset
 }
 
+//GetPeerScores 查询节点评分
+func (q *QueueProtocol) GetPeerScores(req *types.ReqNil) (*types.PeerScoreList, error) {
+	msg, err := q.send(p2pKey, types.EventGetPeerScores, req)
+	if err != nil {
+		log.Error("GetPeerScores", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.PeerScoreList); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+//BanPeer 手动封禁节点
+func (q *QueueProtocol) BanPeer(req *types.ReqBanPeer) (*types.Reply, error) {
+	if req == nil || req.Pid == "" || req.Seconds < 0 {
+		return nil, types.ErrInvalidParam
+	}
+	msg, err := q.send(p2pKey, types.EventBanPeer, req)
+	if err != nil {
+		log.Error("BanPeer", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+//UnbanPeer 解除节点封禁
+func (q *QueueProtocol) UnbanPeer(req *types.ReqString) (*types.Reply, error) {
+	if req == nil || req.Data == "" {
+		return nil, types.ErrInvalidParam
+	}
+	msg, err := q.send(p2pKey, types.EventUnbanPeer, req)
+	if err != nil {
+		log.Error("UnbanPeer", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // GetHeaders get block headers by height
 func (q *QueueProtocol) GetHeaders(param *types.ReqBlocks) (*types.Headers, error) {
 	if param == nil {
@@ -302,6 +394,28 @@ func (q *QueueProtocol) GetLastMempool() (*types.ReplyTxList, error) {
 	return nil, types.ErrTypeAsset
 }
 
+// GetMempoolSize get mempool size
+func (q *QueueProtocol) GetMempoolSize() (*types.MempoolSize, error) {
+	msg, err := q.send(mempoolKey, types.EventGetMempoolSize, &types.ReqNil{})
+	if err != nil {
+		log.Error("GetMempoolSize", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.MempoolSize); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetQueueStats get queue lane stats
+func (q *QueueProtocol) GetQueueStats() (*types.QueueStats, error) {
+	stats := queue.Stats(q.client)
+	if stats == nil {
+		return nil, types.ErrNotSupport
+	}
+	return stats, nil
+}
+
 // GetProperFee get proper fee from mempool
 func (q *QueueProtocol) GetProperFee(req *types.ReqProperFee) (*types.ReplyProperFee, error) {
 	msg, err := q.send(mempoolKey, types.EventGetProperFee, req)
@@ -655,7 +769,12 @@ func (q *QueueProtocol) StoreGet(param *types.StoreGet) (*types.StoreReplyValue,
 		return nil, err
 	}
 
-	msg, err := q.send(storeKey, types.EventStoreGet, param)
+	//轻节点模式下由blockchain模块向全节点请求状态证明并校验
+	topic := storeKey
+	if q.client.GetConfig().GetModuleConfig().BlockChain.LightMode {
+		topic = blockchainKey
+	}
+	msg, err := q.send(topic, types.EventStoreGet, param)
 	if err != nil {
 		log.Error("StoreGet", "Error", err.Error())
 		return nil, err
@@ -796,6 +915,25 @@ func (q *QueueProtocol) CloseQueue() (*types.Reply, error) {
 	return q.client.CloseQueue()
 }
 
+// ReloadConfig 重新加载配置文件, 返回生效以及需要重启才能生效的配置项
+func (q *QueueProtocol) ReloadConfig(req *types.ReqNil) (*types.ReplyReloadConfig, error) {
+	client := q.client
+	msg := client.NewMessage(configKey, types.EventReloadConfig, req)
+	err := client.SendTimeout(msg, true, q.option.SendTimeout)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, reloadConfigTimeout)
+	if err != nil {
+		log.Error("ReloadConfig", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := resp.GetData().(*types.ReplyReloadConfig); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // GetLastBlockSequence 获取最新的block执行序列号
 func (q *QueueProtocol) GetLastBlockSequence() (*types.Int64, error) {
 	msg, err := q.send(blockchainKey, types.EventGetLastBlockSequence, &types.ReqNil{})
@@ -24,6 +24,10 @@ type QueueProtocolAPI interface {
 	GetMempool(req *types.ReqGetMempool) (*types.ReplyTxList, error)
 	// types.EventGetLastMempool
 	GetLastMempool() (*types.ReplyTxList, error)
+	// types.EventGetMempoolSize
+	GetMempoolSize() (*types.MempoolSize, error)
+	// 消息队列各通道的积压和等待时间
+	GetQueueStats() (*types.QueueStats, error)
 	// types.EventGetProperFee
 	GetProperFee(req *types.ReqProperFee) (*types.ReplyProperFee, error)
 	// +++++++++++++++ execs interfaces begin
@@ -43,6 +47,12 @@ type QueueProtocolAPI interface {
 	GetNetInfo(param *types.P2PGetNetInfoReq) (*types.NodeNetInfo, error)
 	//type.EventNetProtocols
 	NetProtocols(*types.ReqNil) (*types.NetProtocolInfos, error)
+	// types.EventGetPeerScores
+	GetPeerScores(*types.ReqNil) (*types.PeerScoreList, error)
+	// types.EventBanPeer
+	BanPeer(*types.ReqBanPeer) (*types.Reply, error)
+	// types.EventUnbanPeer
+	UnbanPeer(*types.ReqString) (*types.Reply, error)
 	// --------------- p2p interfaces end
 	// +++++++++++++++ wallet interfaces begin
 	// types.EventLocalGet
@@ -117,6 +127,8 @@ type QueueProtocolAPI interface {
 	// +++++++++++++++ other interfaces begin
 	// close chain33
 	CloseQueue() (*types.Reply, error)
+	// types.EventReloadConfig 重新加载配置文件
+	ReloadConfig(*types.ReqNil) (*types.ReplyReloadConfig, error)
 	// --------------- other interfaces end
 	// types.EventAddBlockSeqCB
 	AddPushSubscribe(param *types.PushSubscribeReq) (*types.ReplySubscribePush, error)
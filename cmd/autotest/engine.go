@@ -0,0 +1,118 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/33cn/chain33/cmd/autotest/scenario"
+	"github.com/33cn/chain33/cmd/autotest/testflow"
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/BurntSushi/toml"
+)
+
+var stdLog = log15.New("module", "autotest")
+
+//engineConfig 场景测试引擎的配置, 和原来的autotest共用一个配置文件
+type engineConfig struct {
+	//chain33的jsonrpc地址
+	JSONRPC string `toml:"jsonrpc"`
+	//链配置文件, 用于构造交易, 为空时使用默认配置
+	ChainConfig string `toml:"chainConfig"`
+	//发送一笔交易后等待回执的超时时间，单位秒
+	CheckTimeout int `toml:"checkTimeout"`
+	//并行执行的用例数量
+	Parallel        int                     `toml:"parallel"`
+	TestCaseFileArr []testflow.TestCaseFile `toml:"TestCaseFile"`
+}
+
+func runScenario() bool {
+
+	log15.Root().SetHandler(log15.Must.FileHandler(logFile, log15.LogfmtFormat()))
+	conf := &engineConfig{JSONRPC: "http://localhost:8801", CheckTimeout: 60, Parallel: 4}
+	if _, err := toml.DecodeFile(configFile, conf); err != nil {
+		fmt.Println("TomlDecodeAutoTestConfig", configFile, err)
+		return false
+	}
+	if rpcAddr != "" {
+		conf.JSONRPC = rpcAddr
+	}
+	if parallel > 0 {
+		conf.Parallel = parallel
+	}
+
+	var list []*scenario.Scenario
+	for _, caseFile := range conf.TestCaseFileArr {
+		s, err := scenario.LoadFile(caseFile.Filename)
+		if err != nil {
+			fmt.Println("LoadTestCaseFile", caseFile.Filename, err)
+			return false
+		}
+		if caseFile.Dapp != "" {
+			s.Name = caseFile.Dapp
+		}
+		list = append(list, s)
+	}
+
+	var backend scenario.Backend
+	interval := time.Second
+	if mock {
+		//进程内的节点出块快, 缩短查询回执的间隔
+		interval = time.Second / 10
+		node := testnode.New(conf.ChainConfig, nil)
+		defer node.Close()
+		backend = scenario.NewAPIBackend(node.GetAPI())
+	} else {
+		var cfg *types.Chain33Config
+		if conf.ChainConfig != "" {
+			cfg = types.NewChain33Config(types.ReadFile(conf.ChainConfig))
+		} else {
+			cfg = types.NewChain33Config(types.GetDefaultCfgstring())
+		}
+		var err error
+		backend, err = scenario.NewRPCBackend(cfg, conf.JSONRPC)
+		if err != nil {
+			fmt.Println("NewRPCBackend", conf.JSONRPC, err)
+			return false
+		}
+	}
+
+	runner := scenario.NewRunner(backend, &scenario.Config{
+		Parallel: conf.Parallel,
+		Timeout:  time.Duration(conf.CheckTimeout) * time.Second,
+		Interval: interval,
+	})
+	results := runner.RunAll(list)
+
+	bSuccess := true
+	fmt.Println("==================================AutoTestResultSummary======================================")
+	for _, res := range results {
+		var failID []string
+		for _, c := range res.Cases {
+			if c.Status != scenario.StatusPassed {
+				failID = append(failID, c.ID)
+				fmt.Printf("%s %s %s: %s\n", res.Name, c.ID, c.Status, c.Message)
+			}
+		}
+		if len(failID) > 0 {
+			bSuccess = false
+			stdLog.Error("TestFailed", "dapp", res.Name, "TotalCase", len(res.Cases), "TotalFail", len(failID), "FailID", failID)
+		} else {
+			stdLog.Info("TestSuccess", "dapp", res.Name, "TotalCase", len(res.Cases), "TotalFail", 0)
+		}
+		fmt.Printf("dapp %s total %d failed %d skipped %d time %v\n", res.Name, len(res.Cases),
+			res.Count(scenario.StatusFailed), res.Count(scenario.StatusSkipped), res.Time)
+	}
+	if junitFile != "" {
+		if err := scenario.WriteJUnitFile(junitFile, results); err != nil {
+			fmt.Println("WriteJUnitFile", junitFile, err)
+			return false
+		}
+	}
+	return bSuccess
+}
@@ -5,6 +5,8 @@
 // Package 自动化系统回归测试工具，外部支持输入测试用例配置文件，
 // 输出测试用例执行结果并记录详细执行日志。
 // 内部代码支持用例扩展开发，继承并实现通用接口，即可自定义实现用例类型。
+//
+// 默认调用chain33-cli执行用例, -scenario 使用进程内的场景测试引擎
 package main
 
 import (
@@ -20,20 +22,35 @@ import (
 var (
 	configFile string
 	logFile    string
+	useEngine  bool
+	rpcAddr    string
+	mock       bool
+	junitFile  string
+	parallel   int
 )
 
 func init() {
 
 	flag.StringVar(&configFile, "f", "autotest.toml", "-f configFile")
 	flag.StringVar(&logFile, "l", "autotest.log", "-l logFile")
+	flag.BoolVar(&useEngine, "scenario", false, "run test cases by the in-process scenario engine instead of chain33-cli")
+	flag.StringVar(&rpcAddr, "rpc", "", "jsonrpc address of chain33, overwrite jsonrpc in config file")
+	flag.BoolVar(&mock, "mock", false, "run test cases against an in-process chain33 node")
+	flag.StringVar(&junitFile, "junit", "", "write junit report to file")
+	flag.IntVar(&parallel, "parallel", 0, "number of test cases running in parallel, overwrite parallel in config file")
 	flag.Parse()
 }
 
 func main() {
 
-	testflow.InitFlowConfig(configFile, logFile)
-
-	if testflow.StartAutoTest() {
+	var bSuccess bool
+	if useEngine {
+		bSuccess = runScenario()
+	} else {
+		testflow.InitFlowConfig(configFile, logFile)
+		bSuccess = testflow.StartAutoTest()
+	}
+	if bSuccess {
 
 		fmt.Println("========================================Succeed!============================================")
 		os.Exit(0)
@@ -0,0 +1,266 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scenario
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+)
+
+//signExpire 钱包签名的交易过期时间, 和chain33-cli wallet sign的默认值一致
+const signExpire = "120s"
+
+//Backend 执行用例的节点, 可以是jsonrpc服务, 也可以是进程内的节点
+type Backend interface {
+	//构造交易使用的链配置
+	GetConfig() *types.Chain33Config
+	//导入私钥到钱包, 返回地址
+	ImportKey(key, label string) (string, error)
+	//使用私钥或者钱包中地址对应的私钥签名
+	SignTx(tx *types.Transaction, key string) (*types.Transaction, error)
+	//发送交易, 返回交易哈希
+	SendTx(tx *types.Transaction) (string, error)
+	//查询交易回执, 交易还没有打包时返回错误
+	QueryTx(hash string) (*TxResult, error)
+	//查询账户余额, execer为空时查询coins余额
+	GetBalance(addr, execer string) (*types.Account, error)
+}
+
+//TxResult 交易执行结果, 不同的Backend统一转换为这个结构做断言
+type TxResult struct {
+	Hash    string
+	Height  int64
+	Execer  string
+	From    string
+	Fee     int64
+	Receipt *types.ReceiptData
+}
+
+//TyName 回执类型名
+func (r *TxResult) TyName() string {
+	switch r.Receipt.GetTy() {
+	case types.ExecErr:
+		return "ExecErr"
+	case types.ExecPack:
+		return "ExecPack"
+	case types.ExecOk:
+		return "ExecOk"
+	}
+	return "Unknown"
+}
+
+//LogNames 回执中每条日志的类型名
+func (r *TxResult) LogNames() []string {
+	var names []string
+	for _, l := range r.Receipt.GetLogs() {
+		name := "unkownType"
+		if logType := types.LoadLog([]byte(r.Execer), int64(l.Ty)); logType != nil {
+			name = logType.Name()
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+//DecodeLog 解析第i条日志, 返回日志对应的结构体, 如 *types.ReceiptAccountTransfer
+func (r *TxResult) DecodeLog(i int) (interface{}, error) {
+	logs := r.Receipt.GetLogs()
+	if i < 0 || i >= len(logs) {
+		return nil, fmt.Errorf("log index %d out of range, total %d", i, len(logs))
+	}
+	return types.DecodeLog([]byte(r.Execer), int64(logs[i].Ty), logs[i].Log)
+}
+
+//rpcBackend 通过jsonrpc访问节点
+type rpcBackend struct {
+	cfg  *types.Chain33Config
+	json *jsonclient.JSONClient
+}
+
+//NewRPCBackend 创建jsonrpc的Backend, cfg需要和节点的链配置一致
+func NewRPCBackend(cfg *types.Chain33Config, addr string) (Backend, error) {
+	json, err := jsonclient.NewJSONClient(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcBackend{cfg: cfg, json: json}, nil
+}
+
+func (b *rpcBackend) GetConfig() *types.Chain33Config {
+	return b.cfg
+}
+
+func (b *rpcBackend) ImportKey(key, label string) (string, error) {
+	var acc rpctypes.WalletAccount
+	err := b.json.Call("Chain33.ImportPrivkey", &types.ReqWalletImportPrivkey{Privkey: key, Label: label}, &acc)
+	if err != nil {
+		return "", err
+	}
+	return acc.Acc.Addr, nil
+}
+
+func (b *rpcBackend) SignTx(tx *types.Transaction, key string) (*types.Transaction, error) {
+	var signed string
+	err := b.json.Call("Chain33.SignRawTx", signRequest(tx, key), &signed)
+	if err != nil {
+		return nil, err
+	}
+	return decodeTx(signed)
+}
+
+func (b *rpcBackend) SendTx(tx *types.Transaction) (string, error) {
+	var hash string
+	err := b.json.Call("Chain33.SendTransaction", &rpctypes.RawParm{Data: common.ToHex(types.Encode(tx))}, &hash)
+	return hash, err
+}
+
+func (b *rpcBackend) QueryTx(hash string) (*TxResult, error) {
+	var detail rpctypes.TransactionDetail
+	err := b.json.Call("Chain33.QueryTransaction", &rpctypes.QueryParm{Hash: hash}, &detail)
+	if err != nil {
+		return nil, err
+	}
+	if detail.Tx == nil || detail.Receipt == nil {
+		return nil, types.ErrTxNotExist
+	}
+	receipt := &types.ReceiptData{Ty: detail.Receipt.Ty}
+	for _, l := range detail.Receipt.Logs {
+		data, err := common.FromHex(l.RawLog)
+		if err != nil {
+			return nil, err
+		}
+		receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: l.Ty, Log: data})
+	}
+	return &TxResult{
+		Hash:    hash,
+		Height:  detail.Height,
+		Execer:  detail.Tx.Execer,
+		From:    detail.Tx.From,
+		Fee:     detail.Tx.Fee,
+		Receipt: receipt,
+	}, nil
+}
+
+func (b *rpcBackend) GetBalance(addr, execer string) (*types.Account, error) {
+	var accs []*rpctypes.Account
+	err := b.json.Call("Chain33.GetBalance", balanceRequest(b.cfg, addr, execer), &accs)
+	if err != nil {
+		return nil, err
+	}
+	if len(accs) == 0 {
+		return nil, types.ErrAccountNotExist
+	}
+	return &types.Account{Currency: accs[0].Currency, Balance: accs[0].Balance, Frozen: accs[0].Frozen, Addr: accs[0].Addr}, nil
+}
+
+//apiBackend 通过消息队列直接访问进程内的节点, 如 testnode.Chain33Mock
+type apiBackend struct {
+	api client.QueueProtocolAPI
+}
+
+//NewAPIBackend 创建进程内节点的Backend, 如 NewAPIBackend(mock.GetAPI())
+func NewAPIBackend(api client.QueueProtocolAPI) Backend {
+	return &apiBackend{api: api}
+}
+
+func (b *apiBackend) GetConfig() *types.Chain33Config {
+	return b.api.GetConfig()
+}
+
+func (b *apiBackend) ImportKey(key, label string) (string, error) {
+	reply, err := b.api.ExecWalletFunc("wallet", "WalletImportPrivkey", &types.ReqWalletImportPrivkey{Privkey: key, Label: label})
+	if err != nil {
+		return "", err
+	}
+	return reply.(*types.WalletAccount).GetAcc().GetAddr(), nil
+}
+
+func (b *apiBackend) SignTx(tx *types.Transaction, key string) (*types.Transaction, error) {
+	reply, err := b.api.ExecWalletFunc("wallet", "SignRawTx", signRequest(tx, key))
+	if err != nil {
+		return nil, err
+	}
+	return decodeTx(reply.(*types.ReplySignRawTx).TxHex)
+}
+
+func (b *apiBackend) SendTx(tx *types.Transaction) (string, error) {
+	reply, err := b.api.SendTx(tx)
+	if err != nil {
+		return "", err
+	}
+	return common.ToHex(reply.GetMsg()), nil
+}
+
+func (b *apiBackend) QueryTx(hash string) (*TxResult, error) {
+	data, err := common.FromHex(hash)
+	if err != nil {
+		return nil, err
+	}
+	detail, err := b.api.QueryTx(&types.ReqHash{Hash: data})
+	if err != nil {
+		return nil, err
+	}
+	tx := detail.GetTx()
+	return &TxResult{
+		Hash:    hash,
+		Height:  detail.GetHeight(),
+		Execer:  string(tx.GetExecer()),
+		From:    tx.From(),
+		Fee:     tx.GetFee(),
+		Receipt: detail.GetReceipt(),
+	}, nil
+}
+
+func (b *apiBackend) GetBalance(addr, execer string) (*types.Account, error) {
+	cfg := b.api.GetConfig()
+	acc := account.NewCoinsAccount(cfg)
+	accs, err := acc.GetBalance(b.api, balanceRequest(cfg, addr, execer))
+	if err != nil {
+		return nil, err
+	}
+	if len(accs) == 0 {
+		return nil, types.ErrAccountNotExist
+	}
+	return accs[0], nil
+}
+
+//balanceRequest 查询coins资产在execer合约中的余额, execer为空时查询coins余额
+func balanceRequest(cfg *types.Chain33Config, addr, execer string) *types.ReqBalance {
+	if execer == "" {
+		execer = "coins"
+	}
+	return &types.ReqBalance{Addresses: []string{addr}, Execer: execer, AssetExec: "coins", AssetSymbol: cfg.GetCoinSymbol()}
+}
+
+//signRequest key是地址时使用钱包中的私钥签名, 否则当作私钥
+func signRequest(tx *types.Transaction, key string) *types.ReqSignRawTx {
+	req := &types.ReqSignRawTx{TxHex: common.ToHex(types.Encode(tx)), Expire: signExpire, Fee: tx.Fee}
+	if address.CheckAddress(key) == nil {
+		req.Addr = key
+	} else {
+		req.Privkey = key
+	}
+	return req
+}
+
+func decodeTx(hexTx string) (*types.Transaction, error) {
+	data, err := common.FromHex(hexTx)
+	if err != nil {
+		return nil, err
+	}
+	tx := &types.Transaction{}
+	err = types.Decode(data, tx)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
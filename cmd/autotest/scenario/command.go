@@ -0,0 +1,115 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scenario
+
+import (
+	"strings"
+
+	"github.com/33cn/chain33/types"
+)
+
+func init() {
+	RegisterCommand("account import_key", importKey)
+}
+
+//Flags 命令行参数, 短参数和长参数分别保存, 取值时两者都会查找
+type Flags map[string]string
+
+//ParseFlags 解析 -a 1 --note=x 形式的参数, 不带值的参数值为"true"
+func ParseFlags(args []string) Flags {
+	flags := make(Flags)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+		name := strings.TrimLeft(arg, "-")
+		if idx := strings.Index(name, "="); idx >= 0 {
+			flags[name[:idx]] = name[idx+1:]
+			continue
+		}
+		if i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+			flags[name] = args[i+1]
+			i++
+			continue
+		}
+		flags[name] = "true"
+	}
+	return flags
+}
+
+//Get 获取参数值, 依次查找给出的参数名
+func (f Flags) Get(names ...string) string {
+	for _, name := range names {
+		if v, ok := f[name]; ok {
+			return v
+		}
+	}
+	return ""
+}
+
+//Amount 获取金额参数, 转换为最小单位
+func (f Flags) Amount(names ...string) (int64, error) {
+	v := f.Get(names...)
+	if v == "" {
+		return 0, types.ErrAmount
+	}
+	return ParseAmount(v)
+}
+
+//Exec 在进程内执行一条用例命令, 交易命令返回交易哈希
+//
+//交易命令和chain33-cli一样以send开头, -k 参数为签名的私钥或者钱包中的地址
+func Exec(b Backend, command string) (output string, isTx bool, err error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return "", false, ErrUnknownCommand
+	}
+	if args[0] != "send" {
+		cmd, _, rest := lookup(args)
+		if cmd == nil {
+			return "", false, ErrUnknownCommand
+		}
+		output, err = cmd(b, ParseFlags(rest))
+		return output, false, err
+	}
+	var key string
+	var createArgs []string
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		if strings.HasPrefix(arg, "-k=") || strings.HasPrefix(arg, "--key=") {
+			key = arg[strings.Index(arg, "=")+1:]
+			continue
+		}
+		if (arg == "-k" || arg == "--key") && i+1 < len(args) {
+			key = args[i+1]
+			i++
+			continue
+		}
+		createArgs = append(createArgs, arg)
+	}
+	if key == "" {
+		return "", true, types.ErrNoPrivKeyOrAddr
+	}
+	_, create, rest := lookup(createArgs)
+	if create == nil {
+		return "", true, ErrUnknownCommand
+	}
+	tx, err := create(b, ParseFlags(rest))
+	if err != nil {
+		return "", true, err
+	}
+	tx, err = b.SignTx(tx, key)
+	if err != nil {
+		return "", true, err
+	}
+	output, err = b.SendTx(tx)
+	return output, true, err
+}
+
+//importKey 导入私钥到钱包, 参数 -k 私钥 -l 标签
+func importKey(b Backend, flags Flags) (string, error) {
+	return b.ImportKey(flags.Get("k", "key"), flags.Get("l", "label"))
+}
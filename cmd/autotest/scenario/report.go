@@ -0,0 +1,103 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scenario
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+type junitSuites struct {
+	XMLName  xml.Name     `xml:"testsuites"`
+	Tests    int          `xml:"tests,attr"`
+	Failures int          `xml:"failures,attr"`
+	Skipped  int          `xml:"skipped,attr"`
+	Time     string       `xml:"time,attr"`
+	Suites   []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Time     string      `xml:"time,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+//WriteJUnit 输出JUnit格式的测试报告, 每个场景是一个testsuite
+func WriteJUnit(w io.Writer, results []*Result) error {
+	report := &junitSuites{}
+	var total time.Duration
+	for _, r := range results {
+		suite := junitSuite{
+			Name:     r.Name,
+			Tests:    len(r.Cases),
+			Failures: r.Count(StatusFailed),
+			Skipped:  r.Count(StatusSkipped),
+			Time:     seconds(r.Time),
+		}
+		for _, c := range r.Cases {
+			jc := junitCase{Name: c.ID, ClassName: r.Name + "." + c.Kind, Time: seconds(c.Time)}
+			switch c.Status {
+			case StatusFailed:
+				jc.Failure = &junitMessage{Message: c.Message, Body: c.Command}
+			case StatusSkipped:
+				jc.Skipped = &junitMessage{Message: c.Message}
+			}
+			if c.TxHash != "" {
+				jc.SystemOut = "tx " + c.TxHash
+			}
+			suite.Cases = append(suite.Cases, jc)
+		}
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Skipped += suite.Skipped
+		total += r.Time
+		report.Suites = append(report.Suites, suite)
+	}
+	report.Time = seconds(total)
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+//WriteJUnitFile 输出JUnit报告到文件
+func WriteJUnitFile(filename string, results []*Result) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return WriteJUnit(f, results)
+}
@@ -0,0 +1,380 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scenario
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/log/log15"
+)
+
+var log = log15.New("module", "autotest.scenario")
+
+//用例执行状态
+const (
+	StatusPassed  = "passed"
+	StatusFailed  = "failed"
+	StatusSkipped = "skipped"
+)
+
+//Config 场景执行参数
+type Config struct {
+	//同时执行的用例数量, 没有依赖关系的用例并行执行
+	Parallel int
+	//等待交易打包的超时时间
+	Timeout time.Duration
+	//查询交易回执的间隔
+	Interval time.Duration
+}
+
+//CaseResult 一次用例执行的结果, 重复执行的用例每次执行一个结果, id为 用例id_序号
+type CaseResult struct {
+	ID      string
+	Kind    string
+	Command string
+	TxHash  string
+	Status  string
+	Message string
+	Time    time.Duration
+}
+
+//Result 一个场景的执行结果
+type Result struct {
+	Name  string
+	Cases []*CaseResult
+	Time  time.Duration
+}
+
+//Count 统计某个状态的用例数量
+func (r *Result) Count(status string) int {
+	n := 0
+	for _, c := range r.Cases {
+		if c.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+//Passed 所有用例都执行通过
+func (r *Result) Passed() bool {
+	return r.Count(StatusPassed) == len(r.Cases)
+}
+
+//Runner 场景执行器
+type Runner struct {
+	backend Backend
+	cfg     Config
+}
+
+//NewRunner 创建场景执行器, cfg为nil时使用默认参数
+func NewRunner(backend Backend, cfg *Config) *Runner {
+	r := &Runner{backend: backend}
+	if cfg != nil {
+		r.cfg = *cfg
+	}
+	if r.cfg.Parallel <= 0 {
+		r.cfg.Parallel = 1
+	}
+	if r.cfg.Timeout <= 0 {
+		r.cfg.Timeout = time.Minute
+	}
+	if r.cfg.Interval <= 0 {
+		r.cfg.Interval = time.Second
+	}
+	return r
+}
+
+//RunAll 并行执行多个场景, 结果和场景的顺序一致
+func (r *Runner) RunAll(list []*Scenario) []*Result {
+	results := make([]*Result, len(list))
+	var wg sync.WaitGroup
+	for i, s := range list {
+		wg.Add(1)
+		go func(i int, s *Scenario) {
+			defer wg.Done()
+			results[i] = r.Run(s)
+		}(i, s)
+	}
+	wg.Wait()
+	return results
+}
+
+type caseDone struct {
+	c       *Case
+	results []*CaseResult
+	ok      bool
+}
+
+//Run 按照依赖关系执行场景中的用例, 依赖的用例全部通过后才会执行, 依赖失败的用例跳过
+func (r *Runner) Run(s *Scenario) *Result {
+	start := time.Now()
+	index := make(map[string]int)
+	for i, c := range s.Cases {
+		index[c.ID] = i
+	}
+	caseResults := make([][]*CaseResult, len(s.Cases))
+	finished := make(map[string]bool)
+	pending := make(map[string]int)
+	dependents := make(map[string][]*Case)
+	var ready []*Case
+	for _, c := range s.Cases {
+		var missing []string
+		for _, dep := range c.Dep {
+			if _, ok := index[dep]; !ok {
+				missing = append(missing, dep)
+			}
+			dependents[dep] = append(dependents[dep], c)
+		}
+		if len(missing) > 0 {
+			finished[c.ID] = true
+			caseResults[index[c.ID]] = []*CaseResult{newResult(c, c.ID, StatusFailed,
+				fmt.Sprintf("%v: %s", ErrDependNotExist, strings.Join(missing, ",")))}
+			continue
+		}
+		pending[c.ID] = len(c.Dep)
+		if len(c.Dep) == 0 {
+			ready = append(ready, c)
+		}
+	}
+
+	var skip func(c *Case, reason string)
+	skip = func(c *Case, reason string) {
+		if finished[c.ID] {
+			return
+		}
+		finished[c.ID] = true
+		caseResults[index[c.ID]] = []*CaseResult{newResult(c, c.ID, StatusSkipped, reason)}
+		for _, d := range dependents[c.ID] {
+			skip(d, fmt.Sprintf("%v: %s", ErrDependFailed, c.ID))
+		}
+	}
+	//依赖不存在的用例也要跳过它的后续用例
+	for _, c := range s.Cases {
+		if finished[c.ID] {
+			for _, d := range dependents[c.ID] {
+				skip(d, fmt.Sprintf("%v: %s", ErrDependFailed, c.ID))
+			}
+		}
+	}
+
+	done := make(chan *caseDone)
+	sem := make(chan struct{}, r.cfg.Parallel)
+	running := 0
+	launch := func(c *Case) {
+		running++
+		go func() {
+			sem <- struct{}{}
+			results, ok := r.runCase(c)
+			<-sem
+			done <- &caseDone{c: c, results: results, ok: ok}
+		}()
+	}
+	for _, c := range ready {
+		launch(c)
+	}
+	for running > 0 {
+		d := <-done
+		running--
+		finished[d.c.ID] = true
+		caseResults[index[d.c.ID]] = d.results
+		for _, c := range dependents[d.c.ID] {
+			if finished[c.ID] {
+				continue
+			}
+			if !d.ok {
+				skip(c, fmt.Sprintf("%v: %s", ErrDependFailed, d.c.ID))
+				continue
+			}
+			pending[c.ID]--
+			if pending[c.ID] == 0 {
+				launch(c)
+			}
+		}
+	}
+	//剩下的用例之间存在循环依赖
+	for _, c := range s.Cases {
+		if !finished[c.ID] {
+			caseResults[index[c.ID]] = []*CaseResult{newResult(c, c.ID, StatusFailed, ErrDependCycle.Error())}
+		}
+	}
+
+	result := &Result{Name: s.Name, Time: time.Since(start)}
+	for _, list := range caseResults {
+		result.Cases = append(result.Cases, list...)
+	}
+	return result
+}
+
+func newResult(c *Case, id, status, msg string) *CaseResult {
+	return &CaseResult{ID: id, Kind: c.Kind, Command: c.Command, Status: status, Message: msg}
+}
+
+//runCase 执行用例, 重复执行的用例按顺序执行
+func (r *Runner) runCase(c *Case) ([]*CaseResult, bool) {
+	repeat := c.Repeat
+	if repeat <= 0 {
+		repeat = 1
+	}
+	var results []*CaseResult
+	ok := true
+	for i := 0; i < repeat; i++ {
+		id := c.ID
+		if i > 0 {
+			id = fmt.Sprintf("%s_%d", c.ID, i)
+		}
+		start := time.Now()
+		res := newResult(c, id, StatusPassed, "")
+		err := r.execute(c, res)
+		if c.Fail {
+			if err == nil {
+				err = ErrExpectFailed
+			} else {
+				res.Message = err.Error()
+				err = nil
+			}
+		}
+		if err != nil {
+			ok = false
+			res.Status = StatusFailed
+			res.Message = err.Error()
+			log.Error("TestCaseResult", "TestID", id, "Command", c.Command, "TxHash", res.TxHash, "err", err)
+		} else {
+			log.Info("TestCaseResult", "TestID", id, "Result", "Succeed")
+		}
+		res.Time = time.Since(start)
+		results = append(results, res)
+	}
+	return results, ok
+}
+
+//execute 执行命令, 交易命令等待打包后检查回执
+func (r *Runner) execute(c *Case, res *CaseResult) error {
+	output, isTx, err := Exec(r.backend, c.Command)
+	if c.Expect != nil && c.Expect.Error != "" {
+		if err == nil {
+			return fmt.Errorf("expect error %q, but succeed", c.Expect.Error)
+		}
+		if !strings.Contains(err.Error(), c.Expect.Error) {
+			return fmt.Errorf("expect error %q, got %v", c.Expect.Error, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !isTx || c.Kind == SimpleKind {
+		return nil
+	}
+	res.TxHash = output
+	tx, err := r.waitTx(output)
+	if err != nil {
+		return err
+	}
+	return r.check(c, tx)
+}
+
+func (r *Runner) waitTx(hash string) (*TxResult, error) {
+	deadline := time.Now().Add(r.cfg.Timeout)
+	for {
+		tx, err := r.backend.QueryTx(hash)
+		if err == nil {
+			return tx, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("wait tx %s timeout: %v", hash, err)
+		}
+		time.Sleep(r.cfg.Interval)
+	}
+}
+
+//check 检查回执类型, 日志, 注册的检查项和余额
+func (r *Runner) check(c *Case, tx *TxResult) error {
+	want := "ExecOk"
+	if c.Expect != nil && c.Expect.Receipt != "" {
+		want = c.Expect.Receipt
+	}
+	if tx.TyName() != want {
+		return fmt.Errorf("receipt %s, expect %s, logs %v%s", tx.TyName(), want, tx.LogNames(), logErr(tx))
+	}
+	if c.Expect != nil && len(c.Expect.Logs) > 0 {
+		if err := matchLogs(tx.LogNames(), c.Expect.Logs); err != nil {
+			return err
+		}
+	}
+	for _, item := range c.CheckItem {
+		check := getCheck(c.Kind, item)
+		if check == nil {
+			return fmt.Errorf("check item %s.%s not registered", c.Kind, item)
+		}
+		if err := check(c, tx); err != nil {
+			return fmt.Errorf("check %s: %v", item, err)
+		}
+	}
+	if c.Expect == nil {
+		return nil
+	}
+	for _, b := range c.Expect.Balance {
+		if err := r.checkBalance(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Runner) checkBalance(b *BalanceExpect) error {
+	acc, err := r.backend.GetBalance(b.Addr, b.Exec)
+	if err != nil {
+		return err
+	}
+	amount, err := ParseAmount(b.Amount)
+	if err != nil {
+		return err
+	}
+	if acc.Balance != amount {
+		return fmt.Errorf("balance of %s in %q is %d, expect %d", b.Addr, b.Exec, acc.Balance, amount)
+	}
+	if b.Frozen == "" {
+		return nil
+	}
+	frozen, err := ParseAmount(b.Frozen)
+	if err != nil {
+		return err
+	}
+	if acc.Frozen != frozen {
+		return fmt.Errorf("frozen of %s in %q is %d, expect %d", b.Addr, b.Exec, acc.Frozen, frozen)
+	}
+	return nil
+}
+
+//matchLogs expect中的日志按顺序出现在回执中, 中间可以有其他日志
+func matchLogs(names, expect []string) error {
+	i := 0
+	for _, name := range names {
+		if i < len(expect) && name == expect[i] {
+			i++
+		}
+	}
+	if i < len(expect) {
+		return fmt.Errorf("logs %v, expect %v in order", names, expect)
+	}
+	return nil
+}
+
+//logErr 执行失败的交易回执中LogErr的错误信息
+func logErr(tx *TxResult) string {
+	for i, name := range tx.LogNames() {
+		if name != "LogErr" {
+			continue
+		}
+		if msg, err := tx.DecodeLog(i); err == nil {
+			return fmt.Sprintf(", err %v", msg)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,262 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package scenario autotest的场景测试引擎, 在进程内执行用例文件, 不再调用chain33-cli解析输出
+//
+// 用例文件兼容autotest原有的toml格式, 也支持相同结构的yaml格式, 每个表名是用例类型, 如:
+//
+//	[[TransferCase]]
+//	id = "btyTrans1"
+//	command = "send coins transfer -a 10 -t 1Ka7EPFRqs3v9yreXG6qA4RQbNmbPJCZPj -k 12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv"
+//	from = "12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv"
+//	to = "1Ka7EPFRqs3v9yreXG6qA4RQbNmbPJCZPj"
+//	amount = "10"
+//	checkItem = ["balance"]
+//	dep = ["import1"]
+//	[TransferCase.expect]
+//	receipt = "ExecOk"
+//	logs = ["LogFee", "LogTransfer", "LogTransfer"]
+//
+// command 由 RegisterCommand 和 RegisterTxCommand 注册的命令在进程内执行,
+// checkItem 由 RegisterCheck 注册的检查函数对交易回执做类型化的断言, expect 是所有用例通用的声明式断言
+package scenario
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/33cn/chain33/types"
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	//ErrUnknownCommand 用例命令没有注册
+	ErrUnknownCommand = errors.New("ErrUnknownCommand")
+	//ErrDependNotExist 依赖的用例不存在
+	ErrDependNotExist = errors.New("ErrDependNotExist")
+	//ErrDependCycle 用例之间循环依赖
+	ErrDependCycle = errors.New("ErrDependCycle")
+	//ErrDependFailed 依赖的用例执行失败
+	ErrDependFailed = errors.New("ErrDependFailed")
+	//ErrExpectFailed 用例预期失败但是执行成功
+	ErrExpectFailed = errors.New("ErrExpectFailed")
+)
+
+//SimpleKind 只执行命令不检查回执的用例类型, 对应autotest的SimpleCase
+const SimpleKind = "SimpleCase"
+
+//Case 一个测试用例
+type Case struct {
+	//用例类型, 即用例文件中的表名
+	Kind      string   `json:"-"`
+	ID        string   `json:"id"`
+	Command   string   `json:"command"`
+	Dep       []string `json:"dep,omitempty"`
+	CheckItem []string `json:"checkItem,omitempty"`
+	Repeat    int      `json:"repeat,omitempty"`
+	//预期执行失败, 发送失败, 回执不是ExecOk或者检查不通过都算作预期的失败
+	Fail   bool    `json:"fail,omitempty"`
+	Expect *Expect `json:"expect,omitempty"`
+	//用例的所有字段, 包括不同用例类型自定义的字段
+	Fields map[string]interface{} `json:"-"`
+}
+
+//Expect 声明式的回执断言
+type Expect struct {
+	//回执类型名, ExecOk或者ExecPack, 为空时默认ExecOk
+	Receipt string `json:"receipt,omitempty"`
+	//回执中按顺序出现的日志类型名
+	Logs []string `json:"logs,omitempty"`
+	//发送失败时错误信息包含的内容, 设置后要求发送失败
+	Error string `json:"error,omitempty"`
+	//交易执行后的账户余额
+	Balance []*BalanceExpect `json:"balance,omitempty"`
+}
+
+//BalanceExpect 账户余额断言, 金额的单位和命令行一致
+type BalanceExpect struct {
+	Addr string `json:"addr"`
+	//合约名, 为空时检查coins余额
+	Exec   string `json:"exec,omitempty"`
+	Amount string `json:"amount"`
+	Frozen string `json:"frozen,omitempty"`
+}
+
+//String 获取用例的字符串字段
+func (c *Case) String(key string) string {
+	v, ok := c.Fields[key]
+	if !ok || v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprint(v)
+}
+
+//Amount 获取用例的金额字段, 转换为最小单位
+func (c *Case) Amount(key string) (int64, error) {
+	return ParseAmount(c.String(key))
+}
+
+//Scenario 一个用例文件中的所有用例
+type Scenario struct {
+	Name  string
+	Cases []*Case
+}
+
+//LoadFile 加载toml或者yaml格式的用例文件, 场景名为文件名
+func LoadFile(filename string) (*Scenario, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	switch filepath.Ext(filename) {
+	case ".yaml", ".yml":
+		return LoadYAML(name, data)
+	default:
+		return LoadTOML(name, data)
+	}
+}
+
+//LoadTOML 解析toml格式的用例
+func LoadTOML(name string, data []byte) (*Scenario, error) {
+	tables := make(map[string][]map[string]interface{})
+	if _, err := toml.Decode(string(data), &tables); err != nil {
+		return nil, err
+	}
+	return newScenario(name, tables)
+}
+
+//LoadYAML 解析yaml格式的用例
+func LoadYAML(name string, data []byte) (*Scenario, error) {
+	tables := make(map[string][]map[string]interface{})
+	if err := yaml.Unmarshal(data, &tables); err != nil {
+		return nil, err
+	}
+	return newScenario(name, tables)
+}
+
+func newScenario(name string, tables map[string][]map[string]interface{}) (*Scenario, error) {
+	s := &Scenario{Name: name}
+	kinds := make([]string, 0, len(tables))
+	for kind := range tables {
+		kinds = append(kinds, kind)
+	}
+	//map无序, 按类型名排序保证每次加载的顺序相同
+	sort.Strings(kinds)
+	ids := make(map[string]bool)
+	for _, kind := range kinds {
+		for _, fields := range tables[kind] {
+			data, err := json.Marshal(fields)
+			if err != nil {
+				return nil, err
+			}
+			c := &Case{}
+			if err := json.Unmarshal(data, c); err != nil {
+				return nil, fmt.Errorf("%s %s: %v", kind, fields["id"], err)
+			}
+			if c.ID == "" || ids[c.ID] {
+				return nil, fmt.Errorf("%s: empty or duplicate case id %q", kind, c.ID)
+			}
+			ids[c.ID] = true
+			c.Kind = kind
+			c.Fields = fields
+			s.Cases = append(s.Cases, c)
+		}
+	}
+	return s, nil
+}
+
+//ParseAmount 把命令行格式的金额转换为最小单位, 精度和chain33-cli一致
+func ParseAmount(s string) (int64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	if f < 0 {
+		return -int64((-f+0.0000001)*1e4) * 1e4, nil
+	}
+	return int64((f+0.0000001)*1e4) * 1e4, nil
+}
+
+//Command 非交易命令, 如导入私钥, 返回命令的输出
+type Command func(b Backend, flags Flags) (string, error)
+
+//TxCommand 构造交易的命令, 通过send命令签名发送
+type TxCommand func(b Backend, flags Flags) (*types.Transaction, error)
+
+//CheckFunc 对交易回执的检查
+type CheckFunc func(c *Case, r *TxResult) error
+
+var (
+	mu         sync.RWMutex
+	commands   = make(map[string]Command)
+	txCommands = make(map[string]TxCommand)
+	checks     = make(map[string]map[string]CheckFunc)
+)
+
+//RegisterCommand 注册非交易命令, name为命令路径, 如 "account import_key"
+func RegisterCommand(name string, cmd Command) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := commands[name]; ok {
+		panic("scenario: Register Duplicate Command, name = " + name)
+	}
+	commands[name] = cmd
+}
+
+//RegisterTxCommand 注册构造交易的命令, name为命令路径, 如 "coins transfer"
+func RegisterTxCommand(name string, cmd TxCommand) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := txCommands[name]; ok {
+		panic("scenario: Register Duplicate TxCommand, name = " + name)
+	}
+	txCommands[name] = cmd
+}
+
+//RegisterCheck 注册用例类型的检查项, 对应用例的checkItem
+func RegisterCheck(kind, item string, check CheckFunc) {
+	mu.Lock()
+	defer mu.Unlock()
+	if checks[kind] == nil {
+		checks[kind] = make(map[string]CheckFunc)
+	}
+	if _, ok := checks[kind][item]; ok {
+		panic("scenario: Register Duplicate Check, item = " + kind + "." + item)
+	}
+	checks[kind][item] = check
+}
+
+func getCheck(kind, item string) CheckFunc {
+	mu.RLock()
+	defer mu.RUnlock()
+	return checks[kind][item]
+}
+
+//lookup 按最长前缀匹配命令, 返回命令后面的参数
+func lookup(args []string) (Command, TxCommand, []string) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for i := len(args); i > 0; i-- {
+		name := strings.Join(args[:i], " ")
+		if cmd, ok := commands[name]; ok {
+			return cmd, nil, args[i:]
+		}
+		if cmd, ok := txCommands[name]; ok {
+			return nil, cmd, args[i:]
+		}
+	}
+	return nil, nil, nil
+}
@@ -0,0 +1,227 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scenario
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//fakeBackend 交易的payload决定执行结果: ok, pack 或者 senderr
+type fakeBackend struct {
+	mu  sync.Mutex
+	txs map[string]*TxResult
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{txs: make(map[string]*TxResult)}
+}
+
+func (b *fakeBackend) GetConfig() *types.Chain33Config { return nil }
+
+func (b *fakeBackend) ImportKey(key, label string) (string, error) { return label, nil }
+
+func (b *fakeBackend) SignTx(tx *types.Transaction, key string) (*types.Transaction, error) {
+	return tx, nil
+}
+
+func (b *fakeBackend) SendTx(tx *types.Transaction) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	receipt := &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{{Ty: types.TyLogFee}}}
+	switch string(tx.Payload) {
+	case "senderr":
+		return "", types.ErrBalanceLessThanTenTimesFee
+	case "pack":
+		receipt = &types.ReceiptData{Ty: types.ExecPack, Logs: []*types.ReceiptLog{{Ty: types.TyLogErr, Log: []byte("ErrNoBalance")}}}
+	}
+	hash := fmt.Sprintf("0x%02x", len(b.txs))
+	b.txs[hash] = &TxResult{Hash: hash, Execer: "coins", Fee: 100000, Receipt: receipt}
+	return hash, nil
+}
+
+func (b *fakeBackend) QueryTx(hash string) (*TxResult, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if tx, ok := b.txs[hash]; ok {
+		return tx, nil
+	}
+	return nil, types.ErrTxNotExist
+}
+
+func (b *fakeBackend) GetBalance(addr, execer string) (*types.Account, error) {
+	return &types.Account{Addr: addr, Balance: 10 * types.Coin}, nil
+}
+
+func init() {
+	RegisterTxCommand("fake tx", func(b Backend, flags Flags) (*types.Transaction, error) {
+		return &types.Transaction{Execer: []byte("coins"), Payload: []byte(flags.Get("r", "result"))}, nil
+	})
+	RegisterCheck("FakeCase", "fee", func(c *Case, r *TxResult) error {
+		if r.Fee != 100000 {
+			return errors.New("fee")
+		}
+		return nil
+	})
+}
+
+const fakeCases = `
+[[SimpleCase]]
+id = "import"
+command = "account import_key -k 0x01 -l fake"
+
+[[FakeCase]]
+id = "ok"
+command = "send fake tx -r ok -k 12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv"
+checkItem = ["fee"]
+repeat = 3
+dep = ["import"]
+[FakeCase.expect]
+logs = ["LogFee"]
+[[FakeCase.expect.balance]]
+addr = "12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv"
+amount = "10"
+
+[[FakeCase]]
+id = "failPack"
+command = "send fake tx -r pack -k 0x01"
+fail = true
+dep = ["ok"]
+
+[[FakeCase]]
+id = "sendErr"
+command = "send fake tx -r senderr -k 0x01"
+[FakeCase.expect]
+error = "ErrBalanceLessThanTenTimesFee"
+
+[[FakeCase]]
+id = "wrongReceipt"
+command = "send fake tx -r pack -k 0x01"
+
+[[FakeCase]]
+id = "skipped"
+command = "send fake tx -r ok -k 0x01"
+dep = ["wrongReceipt", "ok"]
+
+[[FakeCase]]
+id = "missing"
+command = "send fake tx -r ok -k 0x01"
+dep = ["notExist"]
+
+[[FakeCase]]
+id = "cycle1"
+command = "send fake tx -r ok -k 0x01"
+dep = ["cycle2"]
+
+[[FakeCase]]
+id = "cycle2"
+command = "send fake tx -r ok -k 0x01"
+dep = ["cycle1"]
+
+[[FakeCase]]
+id = "unknown"
+command = "send fake unknown -k 0x01"
+`
+
+func TestLoadCases(t *testing.T) {
+	s, err := LoadFile("../../../system/dapp/coins/autotest/coins.toml")
+	require.Nil(t, err)
+	assert.Equal(t, "coins", s.Name)
+	assert.Equal(t, 7, len(s.Cases))
+	assert.Equal(t, SimpleKind, s.Cases[0].Kind)
+	var trans *Case
+	for _, c := range s.Cases {
+		if c.ID == "btyTrans2" {
+			trans = c
+		}
+	}
+	require.NotNil(t, trans)
+	assert.Equal(t, "TransferCase", trans.Kind)
+	assert.Equal(t, 5, trans.Repeat)
+	assert.Equal(t, []string{"btyTrans1"}, trans.Dep)
+	assert.Equal(t, "12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv", trans.String("to"))
+	amount, err := trans.Amount("amount")
+	assert.Nil(t, err)
+	assert.Equal(t, types.Coin, amount)
+
+	yamlCases := `
+FakeCase:
+  - id: y1
+    command: send fake tx -r ok -k 0x01
+    dep: [y0]
+    expect:
+      receipt: ExecOk
+      logs: [LogFee]
+  - id: y0
+    command: send fake tx -r ok -k 0x01
+`
+	s, err = LoadYAML("yaml", []byte(yamlCases))
+	require.Nil(t, err)
+	require.Equal(t, 2, len(s.Cases))
+	assert.Equal(t, []string{"y0"}, s.Cases[0].Dep)
+	assert.Equal(t, []string{"LogFee"}, s.Cases[0].Expect.Logs)
+
+	_, err = LoadTOML("dup", []byte("[[A]]\nid=\"a\"\n[[B]]\nid=\"a\"\n"))
+	assert.NotNil(t, err)
+}
+
+func TestParse(t *testing.T) {
+	flags := ParseFlags([]string{"-a", "1.5", "--note=x y", "-v", "--to", "addr"})
+	assert.Equal(t, "1.5", flags.Get("a", "amount"))
+	assert.Equal(t, "x y", flags.Get("n", "note"))
+	assert.Equal(t, "true", flags.Get("v"))
+	assert.Equal(t, "addr", flags.Get("t", "to"))
+	amount, err := flags.Amount("a")
+	assert.Nil(t, err)
+	assert.Equal(t, types.Coin*3/2, amount)
+	amount, err = ParseAmount("-0.1")
+	assert.Nil(t, err)
+	assert.Equal(t, -types.Coin/10, amount)
+
+	assert.Nil(t, matchLogs([]string{"LogFee", "LogTransfer", "LogTransfer"}, []string{"LogFee", "LogTransfer"}))
+	assert.NotNil(t, matchLogs([]string{"LogTransfer", "LogFee"}, []string{"LogFee", "LogTransfer"}))
+}
+
+func TestRunner(t *testing.T) {
+	s, err := LoadTOML("fake", []byte(fakeCases))
+	require.Nil(t, err)
+	runner := NewRunner(newFakeBackend(), &Config{Parallel: 4})
+	results := runner.RunAll([]*Scenario{s})
+	require.Equal(t, 1, len(results))
+	status := make(map[string]string)
+	for _, c := range results[0].Cases {
+		status[c.ID] = c.Status
+	}
+	assert.Equal(t, map[string]string{
+		"import":       StatusPassed,
+		"ok":           StatusPassed,
+		"ok_1":         StatusPassed,
+		"ok_2":         StatusPassed,
+		"failPack":     StatusPassed,
+		"sendErr":      StatusPassed,
+		"wrongReceipt": StatusFailed,
+		"skipped":      StatusSkipped,
+		"missing":      StatusFailed,
+		"cycle1":       StatusFailed,
+		"cycle2":       StatusFailed,
+		"unknown":      StatusFailed,
+	}, status)
+	assert.False(t, results[0].Passed())
+	assert.Equal(t, 5, results[0].Count(StatusFailed))
+
+	var buf bytes.Buffer
+	require.Nil(t, WriteJUnit(&buf, results))
+	report := buf.String()
+	assert.Contains(t, report, `<testsuites tests="12" failures="5" skipped="1"`)
+	assert.Contains(t, report, `<testcase name="wrongReceipt" classname="fake.FakeCase"`)
+	assert.Contains(t, report, "ErrDependFailed: wrongReceipt")
+}
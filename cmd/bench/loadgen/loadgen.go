@@ -0,0 +1,347 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package loadgen 交易压测工具, 使用大量账户按照目标速率发送签名交易,
+// 统计交易接受和拒绝的数量, 交易从发送到打包的延迟以及出块速度
+//
+// 账户私钥由seed确定性生成, 和 cmd/localnet 使用相同的seed时可以直接使用本地网络中已经充值的账户,
+// 交易的收款地址, 交易类型的选择都由seed决定, 相同的配置多次压测得到可以对比的结果
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+)
+
+var log = log15.New("module", "bench.loadgen")
+
+var (
+	//ErrNoWorkload 没有配置交易类型
+	ErrNoWorkload = errors.New("ErrNoWorkload")
+	//ErrUnknownWorkload 不支持的交易类型
+	ErrUnknownWorkload = errors.New("ErrUnknownWorkload")
+	//ErrFundTimeout 充值交易没有在超时时间内打包
+	ErrFundTimeout = errors.New("ErrFundTimeout")
+)
+
+//交易类型
+const (
+	//WorkloadTransfer 账户之间的coins转账
+	WorkloadTransfer = "transfer"
+	//WorkloadGroup 同一个账户签名的交易组, 每笔交易都是coins转账
+	WorkloadGroup = "group"
+	//WorkloadExec coins转账到合约
+	WorkloadExec = "exec"
+	//WorkloadCustom 通过执行器的 ExecutorType.CreateTx 构造任意合约交易
+	WorkloadCustom = "custom"
+)
+
+//Workload 一种交易类型, 多种类型按照权重混合发送
+type Workload struct {
+	Type string `toml:"type" json:"type"`
+	//权重, 默认为1
+	Weight int `toml:"weight" json:"weight"`
+	//转账金额, 最小单位, 默认为1
+	Amount int64 `toml:"amount" json:"amount"`
+	//交易组中交易的数量, 默认为2
+	GroupSize int `toml:"groupSize" json:"groupSize"`
+	//exec和custom类型的执行器名
+	Exec string `toml:"exec" json:"exec"`
+	//custom类型的action名, 如 Transfer
+	Action string `toml:"action" json:"action"`
+	//custom类型action的json参数, 其中的 $from 和 $to 替换为发送和接收地址
+	Payload string `toml:"payload" json:"payload"`
+}
+
+//Config 压测参数
+type Config struct {
+	//节点的jsonrpc地址, 发送交易和查询区块
+	JSONRPC string `toml:"jsonrpc" json:"jsonrpc"`
+	//节点的grpc地址, 配置后通过grpc发送交易和查询区块
+	GRPC string `toml:"grpc" json:"grpc"`
+	//链配置文件, 为空时使用默认配置
+	ChainConfig string `toml:"chainConfig" json:"chainConfig"`
+	//充值账户的私钥, 为空时不充值
+	FunderKey string `toml:"funderKey" json:"-"`
+	//每个账户的充值金额, 单位为币
+	Fund int64 `toml:"fund" json:"fund"`
+	//生成账户私钥的seed
+	Seed string `toml:"seed" json:"seed"`
+	//发送交易的账户数量
+	Accounts int `toml:"accounts" json:"accounts"`
+	//每秒发送的交易数量, 0表示不限速
+	Rate int `toml:"rate" json:"rate"`
+	//发送交易的时间, 单位秒, 和Total都为0时发送60秒
+	Duration int `toml:"duration" json:"duration"`
+	//发送交易的总数, 0表示不限制
+	Total int64 `toml:"total" json:"total"`
+	//并发发送交易的协程数量
+	Workers int `toml:"workers" json:"workers"`
+	//发送结束后等待交易打包的时间, 单位秒
+	ConfirmTimeout int `toml:"confirmTimeout" json:"confirmTimeout"`
+	//查询新区块的间隔, 单位毫秒
+	PollInterval int `toml:"pollInterval" json:"pollInterval"`
+	//交易类型, 为空时只发送转账交易
+	Workloads []*Workload `toml:"workload" json:"workload"`
+}
+
+//setDefault 填充默认参数
+func (c *Config) setDefault() {
+	if c.Seed == "" {
+		c.Seed = "chain33"
+	}
+	if c.Accounts <= 0 {
+		c.Accounts = 100
+	}
+	if c.Duration <= 0 && c.Total <= 0 {
+		c.Duration = 60
+	}
+	if c.Workers <= 0 {
+		c.Workers = 8
+	}
+	if c.ConfirmTimeout <= 0 {
+		c.ConfirmTimeout = 60
+	}
+	if c.PollInterval <= 0 {
+		c.PollInterval = 200
+	}
+	if len(c.Workloads) == 0 {
+		c.Workloads = []*Workload{{Type: WorkloadTransfer}}
+	}
+	for _, w := range c.Workloads {
+		if w.Weight <= 0 {
+			w.Weight = 1
+		}
+		if w.Amount <= 0 {
+			w.Amount = 1
+		}
+		if w.GroupSize <= 1 {
+			w.GroupSize = 2
+		}
+	}
+}
+
+func (c *Config) pollInterval() time.Duration {
+	return time.Duration(c.PollInterval) * time.Millisecond
+}
+
+//Generator 交易压测
+type Generator struct {
+	cfg       *Config
+	chain     *types.Chain33Config
+	transport Transport
+	accounts  []crypto.PrivKey
+	addrs     []string
+	//按照权重展开的交易类型, 随机选择时每个元素的概率相同
+	workloads []*Workload
+}
+
+//New 创建压测, chain需要和节点的链配置一致
+func New(cfg *Config, chain *types.Chain33Config, transport Transport) (*Generator, error) {
+	cfg.setDefault()
+	g := &Generator{cfg: cfg, chain: chain, transport: transport}
+	for _, w := range cfg.Workloads {
+		if err := checkWorkload(w); err != nil {
+			return nil, err
+		}
+		for i := 0; i < w.Weight; i++ {
+			g.workloads = append(g.workloads, w)
+		}
+	}
+	for i := 0; i < cfg.Accounts; i++ {
+		key, err := AccountKey(cfg.Seed, i)
+		if err != nil {
+			return nil, err
+		}
+		g.accounts = append(g.accounts, key)
+		g.addrs = append(g.addrs, address.PubKeyToAddr(key.PubKey().Bytes()))
+	}
+	return g, nil
+}
+
+//Addresses 压测账户的地址
+func (g *Generator) Addresses() []string {
+	return g.addrs
+}
+
+//Fund 使用FunderKey给每个压测账户充值, 分批发送并等待打包, 避免超过mempool中单个账户的交易数量限制
+func (g *Generator) Fund(ctx context.Context) error {
+	if g.cfg.FunderKey == "" || g.cfg.Fund <= 0 {
+		return nil
+	}
+	funder, err := privKeyFromHex(g.cfg.FunderKey)
+	if err != nil {
+		return err
+	}
+	const batch = 50
+	stats := newStats()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	height, err := g.transport.LastHeight(ctx)
+	if err != nil {
+		return err
+	}
+	go g.watch(ctx, stats, height)
+	for start := 0; start < len(g.addrs); start += batch {
+		end := start + batch
+		if end > len(g.addrs) {
+			end = len(g.addrs)
+		}
+		for _, addr := range g.addrs[start:end] {
+			tx, err := g.transfer(addr, g.cfg.Fund*types.Coin)
+			if err != nil {
+				return err
+			}
+			tx.Sign(types.SECP256K1, funder)
+			if err := g.send(ctx, stats, tx); err != nil {
+				return err
+			}
+		}
+		if !stats.waitConfirm(ctx, time.Duration(g.cfg.ConfirmTimeout)*time.Second) {
+			return ErrFundTimeout
+		}
+		log.Info("Fund", "accounts", end)
+	}
+	return nil
+}
+
+//Run 按照配置发送交易, 发送结束后等待交易打包, 返回压测报告
+func (g *Generator) Run(ctx context.Context) (*Report, error) {
+	height, err := g.transport.LastHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stats := newStats()
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	defer stopWatch()
+	go g.watch(watchCtx, stats, height)
+
+	sendCtx := ctx
+	if g.cfg.Duration > 0 {
+		var cancel context.CancelFunc
+		sendCtx, cancel = context.WithTimeout(ctx, time.Duration(g.cfg.Duration)*time.Second)
+		defer cancel()
+	}
+	tokens := make(chan int64, g.cfg.Workers)
+	go g.limit(sendCtx, tokens)
+	var wg sync.WaitGroup
+	for i := 0; i < g.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for seq := range tokens {
+				if err := g.sendOne(ctx, stats, seq); err != nil && err != ctx.Err() {
+					log.Debug("sendOne", "seq", seq, "err", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	stats.endSend()
+	stats.waitConfirm(ctx, time.Duration(g.cfg.ConfirmTimeout)*time.Second)
+	return stats.report(), nil
+}
+
+//limit 按照速率产生发送交易的序号, 达到总数或者发送时间结束后关闭
+func (g *Generator) limit(ctx context.Context, tokens chan<- int64) {
+	defer close(tokens)
+	const tick = 10 * time.Millisecond
+	var seq int64
+	start := time.Now()
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	for {
+		//不限速时一直发送, 由worker的数量决定速度
+		expect := int64(math.MaxInt64)
+		if g.cfg.Rate > 0 {
+			expect = int64(time.Since(start).Seconds() * float64(g.cfg.Rate))
+		}
+		for ; seq < expect; seq++ {
+			if g.cfg.Total > 0 && seq >= g.cfg.Total {
+				return
+			}
+			select {
+			case tokens <- seq:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if g.cfg.Total > 0 && seq >= g.cfg.Total {
+			return
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+//sendOne 构造并发送第seq笔交易, 交易类型和账户由seq和seed决定
+func (g *Generator) sendOne(ctx context.Context, stats *Stats, seq int64) error {
+	rnd := rand.New(rand.NewSource(seedInt(g.cfg.Seed) + seq))
+	w := g.workloads[rnd.Intn(len(g.workloads))]
+	n := len(g.accounts)
+	from := int(seq % int64(n))
+	//收款账户不能是发送账户本身
+	to := from
+	if n > 1 {
+		to = (from + 1 + rnd.Intn(n-1)) % n
+	}
+	tx, err := g.build(w, from, to)
+	if err != nil {
+		stats.reject(err)
+		return err
+	}
+	return g.send(ctx, stats, tx)
+}
+
+//send 发送前记录交易哈希, 避免交易在返回之前已经打包
+func (g *Generator) send(ctx context.Context, stats *Stats, tx *types.Transaction) error {
+	hash := common.ToHex(tx.Hash())
+	stats.submit(hash, time.Now())
+	err := g.transport.SendTx(ctx, tx)
+	if err != nil {
+		stats.fail(hash, err)
+		return err
+	}
+	stats.accept()
+	return nil
+}
+
+//watch 依次查询新区块, 统计出块时间和交易的打包延迟
+func (g *Generator) watch(ctx context.Context, stats *Stats, height int64) {
+	ticker := time.NewTicker(g.cfg.pollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		last, err := g.transport.LastHeight(ctx)
+		if err != nil {
+			log.Debug("watch LastHeight", "err", err)
+			continue
+		}
+		for ; height < last; height++ {
+			hashes, err := g.transport.BlockTxHashes(ctx, height+1)
+			if err != nil {
+				log.Debug("watch BlockTxHashes", "height", height+1, "err", err)
+				break
+			}
+			stats.block(hashes, time.Now())
+		}
+	}
+}
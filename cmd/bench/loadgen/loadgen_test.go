@@ -0,0 +1,179 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package loadgen
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	_ "github.com/33cn/chain33/system/dapp/init"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//fakeTransport 每次查询高度时把已经接受的交易打包成一个区块, 每 rejectEvery 笔交易拒绝一笔
+type fakeTransport struct {
+	mu          sync.Mutex
+	rejectEvery int
+	txs         []*types.Transaction
+	mempool     []string
+	blocks      [][]string
+}
+
+func (t *fakeTransport) SendTx(ctx context.Context, tx *types.Transaction) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.txs = append(t.txs, tx)
+	if t.rejectEvery > 0 && len(t.txs)%t.rejectEvery == 0 {
+		return types.ErrTxExist
+	}
+	t.mempool = append(t.mempool, common.ToHex(tx.Hash()))
+	return nil
+}
+
+func (t *fakeTransport) LastHeight(ctx context.Context) (int64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.mempool) > 0 {
+		t.blocks = append(t.blocks, t.mempool)
+		t.mempool = nil
+	}
+	return int64(len(t.blocks)), nil
+}
+
+func (t *fakeTransport) BlockTxHashes(ctx context.Context, height int64) ([]string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.blocks[height-1], nil
+}
+
+func (t *fakeTransport) Close() error { return nil }
+
+func newChainConfig() *types.Chain33Config {
+	return types.NewChain33Config(types.GetDefaultCfgstring())
+}
+
+func TestGenerator(t *testing.T) {
+	transport := &fakeTransport{rejectEvery: 10}
+	cfg := &Config{
+		Accounts:     10,
+		Total:        100,
+		Workers:      4,
+		PollInterval: 10,
+		Workloads: []*Workload{
+			{Type: WorkloadTransfer, Weight: 2},
+			{Type: WorkloadGroup, GroupSize: 3},
+			{Type: WorkloadExec, Exec: "ticket"},
+			{Type: WorkloadCustom, Exec: "coins", Action: "Transfer", Payload: `{"to":"$to","amount":1}`},
+		},
+	}
+	gen, err := New(cfg, newChainConfig(), transport)
+	require.Nil(t, err)
+	report, err := gen.Run(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, int64(100), report.Submitted)
+	assert.Equal(t, int64(90), report.Accepted)
+	assert.Equal(t, int64(10), report.Rejected)
+	assert.Equal(t, map[string]int64{"ErrTxExist": 10}, report.Errors)
+	assert.Equal(t, int64(90), report.Confirmed)
+	assert.Equal(t, int64(0), report.Unconfirmed)
+	assert.True(t, report.Blocks > 0)
+	assert.True(t, report.Latency.Max >= report.Latency.P50)
+
+	groups := 0
+	chain := newChainConfig()
+	for _, tx := range transport.txs {
+		assert.Nil(t, tx.Check(chain, 0, chain.GetMinTxFeeRate(), chain.GetMaxTxFee()))
+		assert.NotEqual(t, tx.From(), tx.GetRealToAddr())
+		if tx.GroupCount > 0 {
+			groups++
+			assert.Equal(t, int32(3), tx.GroupCount)
+			group, err := tx.GetTxGroup()
+			require.Nil(t, err)
+			for _, gtx := range group.GetTxs() {
+				assert.NotEqual(t, gtx.From(), gtx.GetRealToAddr())
+			}
+		}
+	}
+	assert.True(t, groups > 0)
+
+	var buf bytes.Buffer
+	require.Nil(t, report.WriteJSON(&buf))
+	assert.Contains(t, buf.String(), `"confirmed": 90`)
+	assert.Contains(t, report.String(), "ErrTxExist")
+}
+
+//TestRepeatable 相同的seed发送的交易类型和收款地址相同
+func TestRepeatable(t *testing.T) {
+	run := func() []string {
+		transport := &fakeTransport{}
+		cfg := &Config{
+			Accounts:     5,
+			Total:        20,
+			Workers:      1,
+			PollInterval: 10,
+			Workloads:    []*Workload{{Type: WorkloadTransfer}, {Type: WorkloadExec, Exec: "ticket"}},
+		}
+		gen, err := New(cfg, newChainConfig(), transport)
+		require.Nil(t, err)
+		_, err = gen.Run(context.Background())
+		require.Nil(t, err)
+		var list []string
+		for _, tx := range transport.txs {
+			list = append(list, tx.From()+"->"+tx.To)
+		}
+		return list
+	}
+	assert.Equal(t, run(), run())
+}
+
+func TestRate(t *testing.T) {
+	transport := &fakeTransport{}
+	cfg := &Config{Accounts: 5, Rate: 100, Duration: 1, PollInterval: 10}
+	gen, err := New(cfg, newChainConfig(), transport)
+	require.Nil(t, err)
+	start := time.Now()
+	report, err := gen.Run(context.Background())
+	require.Nil(t, err)
+	assert.True(t, time.Since(start) >= time.Second)
+	assert.InDelta(t, 100, report.Submitted, 10)
+	assert.Equal(t, report.Submitted, report.Confirmed)
+}
+
+func TestFund(t *testing.T) {
+	transport := &fakeTransport{}
+	cfg := &Config{Accounts: 60, Fund: 10, PollInterval: 10,
+		FunderKey: "CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944"}
+	gen, err := New(cfg, newChainConfig(), transport)
+	require.Nil(t, err)
+	require.Nil(t, gen.Fund(context.Background()))
+	require.Equal(t, 60, len(transport.txs))
+	for i, tx := range transport.txs {
+		assert.Equal(t, "14KEKbYtKKQm4wMthSK9J4La4nAiidGozt", tx.From())
+		assert.Equal(t, gen.Addresses()[i], tx.To)
+	}
+	//分两批充值, 第二批在第一批打包之后发送
+	assert.True(t, len(transport.blocks) >= 2)
+
+	_, err = New(&Config{Workloads: []*Workload{{Type: "unknown"}}}, newChainConfig(), transport)
+	assert.NotNil(t, err)
+	_, err = New(&Config{Workloads: []*Workload{{Type: WorkloadCustom, Exec: "notexist"}}}, newChainConfig(), transport)
+	assert.NotNil(t, err)
+}
+
+func TestPercentile(t *testing.T) {
+	var list []time.Duration
+	for i := 100; i > 0; i-- {
+		list = append(list, time.Duration(i)*time.Millisecond)
+	}
+	l := latency(list)
+	assert.Equal(t, Latency{Min: 1, Mean: 50.5, P50: 50, P90: 90, P99: 99, Max: 100}, l)
+	assert.Equal(t, Latency{}, latency(nil))
+}
@@ -0,0 +1,252 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package loadgen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+//Stats 压测过程中的统计数据
+type Stats struct {
+	mu        sync.Mutex
+	start     time.Time
+	sendEnd   time.Time
+	submitted int64
+	accepted  int64
+	rejected  map[string]int64
+	//已发送还没有打包的交易, 哈希对应发送时间
+	pending   map[string]time.Time
+	latencies []time.Duration
+	blocks    int64
+	blockTxs  int64
+	lastBlock time.Time
+}
+
+func newStats() *Stats {
+	return &Stats{start: time.Now(), rejected: make(map[string]int64), pending: make(map[string]time.Time)}
+}
+
+func (s *Stats) submit(hash string, t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.submitted++
+	s.pending[hash] = t
+}
+
+func (s *Stats) accept() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accepted++
+}
+
+//fail 发送失败, 交易不会被打包
+func (s *Stats) fail(hash string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pending, hash)
+	s.rejected[err.Error()]++
+}
+
+//reject 构造交易失败, 也计入发送数量
+func (s *Stats) reject(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.submitted++
+	s.rejected[err.Error()]++
+}
+
+//block 新区块中的交易如果是压测发送的, 记录打包延迟
+func (s *Stats) block(hashes []string, t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blocks++
+	s.blockTxs += int64(len(hashes))
+	s.lastBlock = t
+	for _, hash := range hashes {
+		if sent, ok := s.pending[hash]; ok {
+			s.latencies = append(s.latencies, t.Sub(sent))
+			delete(s.pending, hash)
+		}
+	}
+}
+
+func (s *Stats) pendingCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.pending)
+}
+
+func (s *Stats) endSend() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sendEnd = time.Now()
+}
+
+//waitConfirm 等待已发送的交易全部打包, 超时返回false
+func (s *Stats) waitConfirm(ctx context.Context, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for s.pendingCount() > 0 {
+		if time.Now().After(deadline) {
+			return false
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	return true
+}
+
+//Latency 交易从发送到所在区块被查询到的时间, 单位毫秒
+type Latency struct {
+	Min  float64 `json:"min"`
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P90  float64 `json:"p90"`
+	P99  float64 `json:"p99"`
+	Max  float64 `json:"max"`
+}
+
+//Report 压测报告
+type Report struct {
+	//发送交易的时间, 秒
+	SendTime float64 `json:"sendTime"`
+	//从开始发送到最后一个区块的时间, 秒
+	TotalTime   float64          `json:"totalTime"`
+	Submitted   int64            `json:"submitted"`
+	Accepted    int64            `json:"accepted"`
+	Rejected    int64            `json:"rejected"`
+	Errors      map[string]int64 `json:"errors,omitempty"`
+	Confirmed   int64            `json:"confirmed"`
+	Unconfirmed int64            `json:"unconfirmed"`
+	//节点接受交易的速度
+	SendTPS float64 `json:"sendTPS"`
+	//压测交易的打包速度
+	ConfirmTPS   float64 `json:"confirmTPS"`
+	Blocks       int64   `json:"blocks"`
+	BlocksPerSec float64 `json:"blocksPerSec"`
+	//区块中平均交易数, 包括其他来源的交易
+	TxsPerBlock float64 `json:"txsPerBlock"`
+	Latency     Latency `json:"latencyMs"`
+}
+
+func (s *Stats) report() *Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := &Report{
+		Submitted:   s.submitted,
+		Accepted:    s.accepted,
+		Errors:      make(map[string]int64),
+		Confirmed:   int64(len(s.latencies)),
+		Unconfirmed: int64(len(s.pending)),
+		Blocks:      s.blocks,
+	}
+	for msg, n := range s.rejected {
+		r.Errors[msg] = n
+		r.Rejected += n
+	}
+	end := s.sendEnd
+	if end.IsZero() {
+		end = time.Now()
+	}
+	r.SendTime = end.Sub(s.start).Seconds()
+	if r.SendTime > 0 {
+		r.SendTPS = float64(r.Accepted) / r.SendTime
+	}
+	if s.blocks > 0 {
+		r.TotalTime = s.lastBlock.Sub(s.start).Seconds()
+		r.TxsPerBlock = float64(s.blockTxs) / float64(s.blocks)
+	}
+	if r.TotalTime > 0 {
+		r.ConfirmTPS = float64(r.Confirmed) / r.TotalTime
+		r.BlocksPerSec = float64(r.Blocks) / r.TotalTime
+	}
+	r.Latency = latency(s.latencies)
+	return r
+}
+
+func latency(list []time.Duration) Latency {
+	if len(list) == 0 {
+		return Latency{}
+	}
+	sorted := make([]time.Duration, len(list))
+	copy(sorted, list)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	return Latency{
+		Min:  ms(sorted[0]),
+		Mean: ms(sum / time.Duration(len(sorted))),
+		P50:  ms(percentile(sorted, 50)),
+		P90:  ms(percentile(sorted, 90)),
+		P99:  ms(percentile(sorted, 99)),
+		Max:  ms(sorted[len(sorted)-1]),
+	}
+}
+
+//percentile 最近秩法计算百分位数, sorted已经从小到大排序
+func percentile(sorted []time.Duration, p int) time.Duration {
+	i := (len(sorted)*p + 99) / 100
+	if i < 1 {
+		i = 1
+	}
+	return sorted[i-1]
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+//String 文本格式的报告
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "send time:     %.2fs\n", r.SendTime)
+	fmt.Fprintf(&b, "submitted:     %d\n", r.Submitted)
+	fmt.Fprintf(&b, "accepted:      %d (%.2f tx/s)\n", r.Accepted, r.SendTPS)
+	fmt.Fprintf(&b, "rejected:      %d\n", r.Rejected)
+	msgs := make([]string, 0, len(r.Errors))
+	for msg := range r.Errors {
+		msgs = append(msgs, msg)
+	}
+	sort.Strings(msgs)
+	for _, msg := range msgs {
+		fmt.Fprintf(&b, "  %-40s %d\n", msg, r.Errors[msg])
+	}
+	fmt.Fprintf(&b, "confirmed:     %d (%.2f tx/s)\n", r.Confirmed, r.ConfirmTPS)
+	fmt.Fprintf(&b, "unconfirmed:   %d\n", r.Unconfirmed)
+	fmt.Fprintf(&b, "blocks:        %d (%.2f blocks/s, %.1f txs/block)\n", r.Blocks, r.BlocksPerSec, r.TxsPerBlock)
+	l := r.Latency
+	fmt.Fprintf(&b, "latency(ms):   min %.0f  mean %.0f  p50 %.0f  p90 %.0f  p99 %.0f  max %.0f\n",
+		l.Min, l.Mean, l.P50, l.P90, l.P99, l.Max)
+	return b.String()
+}
+
+//WriteJSON 输出json格式的报告, 方便多次压测结果对比
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+//WriteJSONFile 输出json格式的报告到文件
+func (r *Report) WriteJSONFile(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return r.WriteJSON(f)
+}
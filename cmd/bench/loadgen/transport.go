@@ -0,0 +1,120 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package loadgen
+
+import (
+	"context"
+	"errors"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/rpc/sdk"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"google.golang.org/grpc/status"
+)
+
+//Transport 发送交易和查询区块的方式
+type Transport interface {
+	//发送已签名的交易, 节点拒绝时返回错误
+	SendTx(ctx context.Context, tx *types.Transaction) error
+	//最新区块高度
+	LastHeight(ctx context.Context) (int64, error)
+	//区块中所有交易的哈希, 16进制带0x前缀
+	BlockTxHashes(ctx context.Context, height int64) ([]string, error)
+	Close() error
+}
+
+//NewTransport grpcAddr不为空时使用grpc, 否则使用jsonrpc
+func NewTransport(jsonrpcAddr, grpcAddr string) (Transport, error) {
+	cli, err := sdk.New(&sdk.Config{JSONRPCAddr: jsonrpcAddr, GRPCAddr: grpcAddr, RetryTimes: 3})
+	if err != nil {
+		return nil, err
+	}
+	if grpcAddr != "" {
+		return &grpcTransport{cli: cli, grpc: cli.GRPC()}, nil
+	}
+	return &jsonTransport{cli: cli}, nil
+}
+
+type jsonTransport struct {
+	cli *sdk.Client
+}
+
+func (t *jsonTransport) SendTx(ctx context.Context, tx *types.Transaction) error {
+	_, err := t.cli.SendTx(ctx, tx)
+	return err
+}
+
+func (t *jsonTransport) LastHeight(ctx context.Context) (int64, error) {
+	header, err := t.cli.GetLastHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return header.Height, nil
+}
+
+func (t *jsonTransport) BlockTxHashes(ctx context.Context, height int64) ([]string, error) {
+	hash, err := t.cli.GetBlockHash(ctx, &types.ReqInt{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	block, err := t.cli.GetBlockOverview(ctx, &rpctypes.QueryParm{Hash: hash.Hash})
+	if err != nil {
+		return nil, err
+	}
+	return block.TxHashes, nil
+}
+
+func (t *jsonTransport) Close() error {
+	return t.cli.Close()
+}
+
+type grpcTransport struct {
+	cli  *sdk.Client
+	grpc types.Chain33Client
+}
+
+func (t *grpcTransport) SendTx(ctx context.Context, tx *types.Transaction) error {
+	reply, err := t.grpc.SendTransaction(ctx, tx)
+	if err != nil {
+		//统计拒绝原因时只使用服务端返回的错误信息
+		if s, ok := status.FromError(err); ok {
+			return errors.New(s.Message())
+		}
+		return err
+	}
+	if !reply.GetIsOk() {
+		return errors.New(string(reply.GetMsg()))
+	}
+	return nil
+}
+
+func (t *grpcTransport) LastHeight(ctx context.Context) (int64, error) {
+	header, err := t.grpc.GetLastHeader(ctx, &types.ReqNil{})
+	if err != nil {
+		return 0, err
+	}
+	return header.GetHeight(), nil
+}
+
+func (t *grpcTransport) BlockTxHashes(ctx context.Context, height int64) ([]string, error) {
+	hash, err := t.grpc.GetBlockHash(ctx, &types.ReqInt{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	block, err := t.grpc.GetBlockOverview(ctx, &types.ReqHash{Hash: hash.GetHash()})
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, 0, len(block.GetTxHashes()))
+	for _, h := range block.GetTxHashes() {
+		hashes = append(hashes, common.ToHex(h))
+	}
+	return hashes, nil
+}
+
+func (t *grpcTransport) Close() error {
+	return t.cli.Close()
+}
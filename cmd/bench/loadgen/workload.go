@@ -0,0 +1,134 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package loadgen
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/rpc/sdk"
+	"github.com/33cn/chain33/types"
+)
+
+//AccountKey 第i个压测账户的私钥, 和 testnode.GenKeys 的生成规则一致
+func AccountKey(seed string, i int) (crypto.PrivKey, error) {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", seed, i)))
+	return privKeyFromBytes(hash[:])
+}
+
+func privKeyFromHex(key string) (crypto.PrivKey, error) {
+	data, err := common.FromHex(key)
+	if err != nil {
+		return nil, err
+	}
+	return privKeyFromBytes(data)
+}
+
+func privKeyFromBytes(data []byte) (crypto.PrivKey, error) {
+	c, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	return c.PrivKeyFromBytes(data)
+}
+
+//seedInt 随机数种子, 相同的seed选择相同的交易类型和收款地址
+func seedInt(seed string) int64 {
+	hash := sha256.Sum256([]byte(seed))
+	return int64(binary.BigEndian.Uint64(hash[:8]) >> 1)
+}
+
+func checkWorkload(w *Workload) error {
+	switch w.Type {
+	case WorkloadTransfer, WorkloadGroup:
+	case WorkloadExec:
+		if w.Exec == "" {
+			return fmt.Errorf("%v: workload exec need exec name", types.ErrInvalidParam)
+		}
+	case WorkloadCustom:
+		if types.LoadExecutorType(w.Exec) == nil {
+			return fmt.Errorf("%v: %s", types.ErrExecNotFound, w.Exec)
+		}
+		if w.Action == "" {
+			return fmt.Errorf("%v: workload custom need action", types.ErrInvalidParam)
+		}
+	default:
+		return fmt.Errorf("%v: %s", ErrUnknownWorkload, w.Type)
+	}
+	return nil
+}
+
+//transfer 构造coins转账
+func (g *Generator) transfer(to string, amount int64) (*types.Transaction, error) {
+	return sdk.NewTxBuilder(g.chain).Transfer(to, amount, "")
+}
+
+//build 构造第from个账户签名的交易, to为收款账户序号
+func (g *Generator) build(w *Workload, from, to int) (*types.Transaction, error) {
+	key := g.accounts[from]
+	var tx *types.Transaction
+	var err error
+	switch w.Type {
+	case WorkloadTransfer:
+		tx, err = g.transfer(g.addrs[to], w.Amount)
+	case WorkloadExec:
+		tx, err = sdk.NewTxBuilder(g.chain).TransferToExec(g.chain.ExecName(w.Exec), w.Amount, "")
+	case WorkloadGroup:
+		return g.buildGroup(w, key, from, to)
+	case WorkloadCustom:
+		tx, err = g.buildCustom(w, g.addrs[from], g.addrs[to])
+	default:
+		return nil, ErrUnknownWorkload
+	}
+	if err != nil {
+		return nil, err
+	}
+	tx.Sign(types.SECP256K1, key)
+	return tx, nil
+}
+
+//buildGroup 交易组中的转账依次转给从to开始的账户, 跳过发送账户from
+func (g *Generator) buildGroup(w *Workload, key crypto.PrivKey, from, to int) (*types.Transaction, error) {
+	n := len(g.addrs)
+	txs := make([]*types.Transaction, 0, w.GroupSize)
+	for i := to; len(txs) < w.GroupSize; i++ {
+		if n > 1 && i%n == from {
+			continue
+		}
+		tx, err := g.transfer(g.addrs[i%n], w.Amount)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	group, err := types.CreateTxGroup(txs, g.chain.GetMinTxFeeRate())
+	if err != nil {
+		return nil, err
+	}
+	for i := range group.Txs {
+		if err := group.SignN(i, types.SECP256K1, key); err != nil {
+			return nil, err
+		}
+	}
+	return group.Tx(), nil
+}
+
+//buildCustom 通过执行器注册的action构造交易, payload中的 $from 和 $to 替换为账户地址
+func (g *Generator) buildCustom(w *Workload, from, to string) (*types.Transaction, error) {
+	payload := strings.NewReplacer("$from", from, "$to", to).Replace(w.Payload)
+	if payload == "" {
+		payload = "{}"
+	}
+	tx, err := types.LoadExecutorType(w.Exec).CreateTx(w.Action, json.RawMessage(payload))
+	if err != nil {
+		return nil, err
+	}
+	return types.FormatTx(g.chain, g.chain.ExecName(w.Exec), tx)
+}
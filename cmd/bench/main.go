@@ -0,0 +1,118 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package main 交易压测工具, 按照配置的速率和交易类型向节点发送交易, 输出延迟和吞吐量报告
+//
+//	bench -f bench.toml -rate 500 -duration 60 -out report.json
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/33cn/chain33/cmd/bench/loadgen"
+	"github.com/33cn/chain33/common/log"
+	_ "github.com/33cn/chain33/system/dapp/init"
+	"github.com/33cn/chain33/types"
+	"github.com/BurntSushi/toml"
+)
+
+var (
+	configFile = flag.String("f", "bench.toml", "config file")
+	rpcAddr    = flag.String("rpc", "", "jsonrpc address, override config")
+	grpcAddr   = flag.String("grpc", "", "grpc address, send tx by grpc if set")
+	rate       = flag.Int("rate", -1, "tx per second, 0 means unlimited, override config")
+	duration   = flag.Int("duration", -1, "send duration in seconds, override config")
+	total      = flag.Int64("total", -1, "total tx count, override config")
+	accounts   = flag.Int("accounts", 0, "number of accounts, override config")
+	workers    = flag.Int("workers", 0, "number of send workers, override config")
+	fund       = flag.Bool("fund", true, "fund accounts by funderKey before sending")
+	out        = flag.String("out", "", "write json report to file")
+	logLevel   = flag.String("log", "error", "log level")
+)
+
+func main() {
+	flag.Parse()
+	log.SetLogLevel(*logLevel)
+	cfg := &loadgen.Config{JSONRPC: "http://localhost:8801"}
+	if _, err := toml.DecodeFile(*configFile, cfg); err != nil {
+		fmt.Fprintln(os.Stderr, "DecodeConfig", *configFile, err)
+		os.Exit(1)
+	}
+	override(cfg)
+	chain := types.NewChain33Config(types.GetDefaultCfgstring())
+	if cfg.ChainConfig != "" {
+		chain = types.NewChain33Config(types.ReadFile(cfg.ChainConfig))
+	}
+	transport, err := loadgen.NewTransport(cfg.JSONRPC, cfg.GRPC)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "NewTransport", err)
+		os.Exit(1)
+	}
+	defer transport.Close()
+	gen, err := loadgen.New(cfg, chain, transport)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "NewGenerator", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sig
+		cancel()
+	}()
+
+	if *fund {
+		fmt.Println("funding", len(gen.Addresses()), "accounts")
+		if err := gen.Fund(ctx); err != nil {
+			fmt.Fprintln(os.Stderr, "Fund", err)
+			os.Exit(1)
+		}
+	}
+	fmt.Println("sending, rate", cfg.Rate, "duration", cfg.Duration, "total", cfg.Total)
+	report, err := gen.Run(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Run", err)
+		os.Exit(1)
+	}
+	fmt.Print(report)
+	if *out != "" {
+		if err := report.WriteJSONFile(*out); err != nil {
+			fmt.Fprintln(os.Stderr, "WriteReport", err)
+			os.Exit(1)
+		}
+	}
+}
+
+//override 命令行参数覆盖配置文件
+func override(cfg *loadgen.Config) {
+	if *rpcAddr != "" {
+		cfg.JSONRPC = *rpcAddr
+	}
+	if *grpcAddr != "" {
+		cfg.GRPC = *grpcAddr
+	}
+	if *rate >= 0 {
+		cfg.Rate = *rate
+	}
+	if *duration >= 0 {
+		cfg.Duration = *duration
+	}
+	if *total >= 0 {
+		cfg.Total = *total
+	}
+	if *accounts > 0 {
+		cfg.Accounts = *accounts
+	}
+	if *workers > 0 {
+		cfg.Workers = *workers
+	}
+}
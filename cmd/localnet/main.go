@@ -0,0 +1,289 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package main 在一个进程内启动多节点的本地测试网络, 节点之间通过内存p2p连接
+//
+// 启动后从脚本文件或者标准输入逐行读取命令控制网络:
+//
+//	status                      打印每个节点的高度和最新区块哈希
+//	send <node> <count>         通过节点发送count笔交易
+//	partition 0,1 2             把网络分成几组, 组之间的链路断开
+//	disconnect <i> <j>          断开两个节点之间的链路
+//	connect <i> <j>             恢复两个节点之间的链路
+//	heal                        恢复所有链路
+//	stop <node>                 关闭节点, 保留数据
+//	restart <node>              使用原来的数据重启节点
+//	wait-height <height> [30s]  等待所有节点达到指定高度
+//	wait-sync [30s]             等待所有节点最新区块相同
+//	sleep <duration>            等待一段时间
+//	quit                        关闭网络并退出
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+)
+
+var (
+	nodes    = flag.Int("n", 3, "number of nodes")
+	miners   = flag.String("miners", "0", "comma separated index of mining nodes")
+	seed     = flag.String("seed", "chain33", "seed of genesis and account keys")
+	accounts = flag.Int("accounts", 4, "number of generated accounts")
+	fund     = flag.Int64("fund", 1000, "coins transferred to each account from genesis, 0 disables")
+	script   = flag.String("script", "", "command script file, read from stdin if empty")
+)
+
+func main() {
+	flag.Parse()
+	minerList, err := parseIndexes(*miners)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	for _, i := range minerList {
+		if i < 0 || i >= *nodes {
+			fmt.Fprintf(os.Stderr, "miner index %d out of range [0, %d)\n", i, *nodes)
+			os.Exit(1)
+		}
+	}
+	network := testnode.Launch(&testnode.NetworkConfig{
+		Nodes:    *nodes,
+		Miners:   minerList,
+		Seed:     *seed,
+		Accounts: *accounts,
+		RPC:      true,
+	})
+	defer network.Close()
+	printNetwork(network)
+	if *fund > 0 && *accounts > 0 {
+		if err := network.Fund(minerList[0], *fund*types.Coin, time.Minute); err != nil {
+			fmt.Fprintln(os.Stderr, "fund:", err)
+		}
+	}
+
+	input := io.Reader(os.Stdin)
+	if *script != "" {
+		f, err := os.Open(*script)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		defer f.Close()
+		input = f
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scanner := bufio.NewScanner(input)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			fmt.Println(">", line)
+			quit, err := execute(network, strings.Fields(line))
+			if err != nil {
+				fmt.Println("error:", err)
+			}
+			if quit {
+				return
+			}
+		}
+		//脚本执行完后继续运行, 直到收到退出信号
+		if *script != "" {
+			select {}
+		}
+	}()
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case <-sig:
+	case <-done:
+	}
+}
+
+func printNetwork(network *testnode.Network) {
+	keys := network.Keys()
+	fmt.Printf("genesis %s key %s\n", keys.GenesisAddress(), common.ToHex(keys.Genesis.Bytes()))
+	for i := range keys.Accounts {
+		fmt.Printf("account %d %s key %s\n", i, keys.Address(i), common.ToHex(keys.Accounts[i].Bytes()))
+	}
+	for i := 0; i < network.Len(); i++ {
+		jrpc, grpc := network.RPCAddr(i)
+		fmt.Printf("node %d jsonrpc %s grpc %s\n", i, jrpc, grpc)
+	}
+}
+
+func execute(network *testnode.Network, args []string) (bool, error) {
+	switch args[0] {
+	case "status":
+		for i := 0; i < network.Len(); i++ {
+			node := network.Node(i)
+			if node == nil {
+				fmt.Printf("node %d stopped\n", i)
+				continue
+			}
+			header, err := node.GetAPI().GetLastHeader()
+			if err != nil {
+				return false, err
+			}
+			fmt.Printf("node %d height %d hash %s\n", i, header.Height, common.ToHex(header.Hash))
+		}
+	case "send":
+		ints, err := atois(args[1:], 2)
+		if err != nil {
+			return false, err
+		}
+		if err = network.CheckIndex(ints[0]); err != nil {
+			return false, err
+		}
+		return false, send(network, ints[0], ints[1])
+	case "partition":
+		var groups [][]int
+		for _, arg := range args[1:] {
+			group, err := parseNodeIndexes(network, arg)
+			if err != nil {
+				return false, err
+			}
+			groups = append(groups, group)
+		}
+		network.Partition(groups...)
+	case "disconnect", "connect":
+		ints, err := atois(args[1:], 2)
+		if err != nil {
+			return false, err
+		}
+		if err = checkIndexes(network, ints[:2]); err != nil {
+			return false, err
+		}
+		if args[0] == "connect" {
+			network.Connect(ints[0], ints[1])
+		} else {
+			network.Disconnect(ints[0], ints[1])
+		}
+	case "heal":
+		network.Heal()
+	case "stop", "restart":
+		ints, err := atois(args[1:], 1)
+		if err != nil {
+			return false, err
+		}
+		if args[0] == "stop" {
+			return false, network.StopNode(ints[0])
+		}
+		if _, err = network.RestartNode(ints[0]); err != nil {
+			return false, err
+		}
+		jrpc, grpc := network.RPCAddr(ints[0])
+		fmt.Printf("node %d jsonrpc %s grpc %s\n", ints[0], jrpc, grpc)
+	case "wait-height":
+		ints, err := atois(args[1:2], 1)
+		if err != nil {
+			return false, err
+		}
+		timeout, err := parseTimeout(args[2:])
+		if err != nil {
+			return false, err
+		}
+		return false, network.WaitHeight(int64(ints[0]), timeout)
+	case "wait-sync":
+		timeout, err := parseTimeout(args[1:])
+		if err != nil {
+			return false, err
+		}
+		return false, network.WaitSync(timeout)
+	case "sleep":
+		timeout, err := parseTimeout(args[1:])
+		if err != nil {
+			return false, err
+		}
+		time.Sleep(timeout)
+	case "quit", "exit":
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown command %s", args[0])
+	}
+	return false, nil
+}
+
+//send 从生成的账户或者创世地址发送转账交易
+func send(network *testnode.Network, index, count int) error {
+	node := network.Node(index)
+	if node == nil {
+		return fmt.Errorf("node %d stopped", index)
+	}
+	cfg := node.GetClient().GetConfig()
+	keys := network.Keys()
+	for i := 0; i < count; i++ {
+		from := keys.Genesis
+		if len(keys.Accounts) > 0 && *fund > 0 {
+			from = keys.Accounts[i%len(keys.Accounts)]
+		}
+		to, _ := util.Genaddress()
+		_, err := node.GetAPI().SendTx(util.CreateCoinsTx(cfg, from, to, types.Coin/100))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseIndexes(s string) ([]int, error) {
+	return atois(strings.Split(s, ","), 0)
+}
+
+//parseNodeIndexes 解析节点序号并检查是否在网络范围内
+func parseNodeIndexes(network *testnode.Network, s string) ([]int, error) {
+	ints, err := parseIndexes(s)
+	if err != nil {
+		return nil, err
+	}
+	return ints, checkIndexes(network, ints)
+}
+
+func checkIndexes(network *testnode.Network, ints []int) error {
+	for _, i := range ints {
+		if err := network.CheckIndex(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//atois 转换为整数, n大于0时要求至少有n个参数
+func atois(args []string, n int) ([]int, error) {
+	if len(args) < n {
+		return nil, types.ErrInvalidParam
+	}
+	var ints []int
+	for _, arg := range args {
+		i, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, err
+		}
+		ints = append(ints, i)
+	}
+	return ints, nil
+}
+
+func parseTimeout(args []string) (time.Duration, error) {
+	if len(args) == 0 {
+		return 30 * time.Second, nil
+	}
+	return time.ParseDuration(args[0])
+}
@@ -0,0 +1,114 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package main 把节点记录的区块, 交易和jsonrpc请求重放到进程内的测试节点, 输出处理结果不一致的记录
+//
+// 节点配置中开启 [record] 后记录收到的数据, 出现问题时使用同样的链配置重放:
+//
+//	replay -f logs/record.gz -conf chain33.toml -speed 10
+//	replay -f logs/record.gz -conf chain33.toml -datadir /backup/chain33
+//	replay -f logs/record.gz -dump
+//
+// 记录不是从创世区块开始时, 使用开始记录时节点数据目录的副本作为初始状态
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/record"
+	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util/testnode"
+)
+
+var (
+	recordFile = flag.String("f", "record.gz", "record file")
+	confFile   = flag.String("conf", "", "chain config of the recorded node, default testnode config")
+	datadir    = flag.String("datadir", "", "copy of the recorded node's data directory as initial state, kept after replay")
+	speed      = flag.Float64("speed", 0, "replay speed, 1 is the original speed, 0 means no wait")
+	kinds      = flag.String("kinds", "", "comma separated kinds to replay: block,tx,rpc, default all")
+	ignore     = flag.String("ignore", "Chain33.GetTimeStatus,Chain33.GetPeerInfo,Chain33.GetNetInfo", "comma separated rpc methods not compared")
+	dump       = flag.Bool("dump", false, "print records without replay")
+)
+
+func main() {
+	flag.Parse()
+	entries, err := record.ReadFile(*recordFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "ReadFile", *recordFile, err)
+		if len(entries) == 0 {
+			os.Exit(1)
+		}
+	}
+	if *dump {
+		for i, e := range entries {
+			printEntry(i, e)
+		}
+		return
+	}
+	cfg := &testnode.ReplayConfig{Speed: *speed, IgnoreMethods: split(*ignore)}
+	for _, name := range split(*kinds) {
+		k, err := record.ParseKind(name)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err, name)
+			os.Exit(1)
+		}
+		cfg.Kinds = append(cfg.Kinds, k)
+	}
+
+	chainCfg := testnode.GetDefaultConfig()
+	if *confFile != "" {
+		chainCfg = types.NewChain33Config(types.ReadFile(*confFile))
+	}
+	mock := testnode.NewReplayNode(chainCfg, *datadir)
+	defer mock.Close()
+	report := mock.Replay(entries, cfg)
+	for _, d := range report.Diffs {
+		fmt.Println(d)
+	}
+	fmt.Printf("records %d, replayed block %d tx %d rpc %d, skipped %d, diffs %d, height %d, cost %v\n",
+		report.Total, report.Replayed[record.KindBlock], report.Replayed[record.KindTx], report.Replayed[record.KindRPC],
+		report.Skipped, len(report.Diffs), report.Height, report.Time)
+	if len(report.Diffs) > 0 {
+		os.Exit(2)
+	}
+}
+
+func split(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func printEntry(i int, e *record.Entry) {
+	t := time.Unix(0, e.Time).Format("2006-01-02 15:04:05.000")
+	desc := ""
+	switch e.Kind {
+	case record.KindBlock:
+		block := &types.Block{}
+		if err := types.Decode(e.Data, block); err == nil {
+			desc = fmt.Sprintf("%s height %d txs %d from %s", e.Method, block.Height, len(block.Txs), e.Source)
+		}
+	case record.KindTx:
+		tx := &types.Transaction{}
+		if err := types.Decode(e.Data, tx); err == nil {
+			desc = fmt.Sprintf("%s %s", tx.Execer, common.ToHex(tx.Hash()))
+		}
+	case record.KindRPC:
+		desc = fmt.Sprintf("%s from %s", e.Method, e.Source)
+	}
+	if e.Error != "" {
+		desc += " err " + e.Error
+	}
+	fmt.Printf("#%d %s %s %s\n", i, t, e.Kind, desc)
+}
@@ -29,7 +29,7 @@ func addGenDappFlag(cmd *cobra.Command) {
 	cmd.Flags().StringP("proto", "p", "", "dapp protobuf file path")
 	cmd.MarkFlagRequired("proto")
 	cmd.Flags().StringP("output", "o", "", "go package for output (default github.com/33cn/plugin/plugin/dapp/)")
-
+	cmd.Flags().BoolP("update", "u", false, "update existing dapp code after proto changed, hand-written code is kept")
 }
 
 func genDapp(cmd *cobra.Command, args []string) {
@@ -37,6 +37,7 @@ func genDapp(cmd *cobra.Command, args []string) {
 	dappName, _ := cmd.Flags().GetString("name")
 	outDir, _ := cmd.Flags().GetString("output")
 	propFile, _ := cmd.Flags().GetString("proto")
+	update, _ := cmd.Flags().GetBool("update")
 
 	s := strategy.New(types.KeyGenDapp)
 	if s == nil {
@@ -47,5 +48,8 @@ func genDapp(cmd *cobra.Command, args []string) {
 	s.SetParam(types.KeyExecutorName, dappName)
 	s.SetParam(types.KeyDappOutDir, outDir)
 	s.SetParam(types.KeyProtobufFile, propFile)
+	if update {
+		s.SetParam(types.KeyDappUpdate, "true")
+	}
 	s.Run()
 }
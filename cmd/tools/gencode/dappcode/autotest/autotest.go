@@ -0,0 +1,100 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autotest
+
+import (
+	"github.com/33cn/chain33/cmd/tools/gencode/base"
+	"github.com/33cn/chain33/cmd/tools/types"
+)
+
+func init() {
+
+	base.RegisterCodeFile(autoTestCodeFile{})
+	base.RegisterCodeFile(autoTestCaseFile{})
+}
+
+type autoTestCodeFile struct {
+	base.DappCodeFile
+}
+
+func (c autoTestCodeFile) GetDirName() string {
+
+	return "autotest"
+}
+
+func (c autoTestCodeFile) GetFiles() map[string]string {
+
+	return map[string]string{
+		commandName: commandContent,
+	}
+}
+
+func (c autoTestCodeFile) GetFileReplaceTags() []string {
+
+	return []string{types.TagExecName, types.TagImportPath, types.TagClassName, types.TagAutoTestCommandText}
+}
+
+type autoTestCaseFile struct {
+	autoTestCodeFile
+}
+
+func (c autoTestCaseFile) GetFiles() map[string]string {
+
+	return map[string]string{
+		caseName: caseContent,
+	}
+}
+
+func (c autoTestCaseFile) GetFileReplaceTags() []string {
+
+	return []string{types.TagExecName, types.TagAutoTestCaseText}
+}
+
+var (
+	commandName    = "${EXECNAME}.go"
+	commandContent = types.GenCodeHeader + `
+package autotest
+
+import (
+	"encoding/json"
+
+	"github.com/33cn/chain33/cmd/autotest/scenario"
+	${EXECNAME}types "${IMPORTPATH}/${EXECNAME}/types"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+ * 注册场景测试引擎(cmd/autotest/scenario)的交易命令, 每一种action对应一条命令
+ * send ${EXECNAME} <action> -p <json格式的action参数> -k <私钥或者钱包中的地址>
+ * 自定义的检查项在其他文件中通过 scenario.RegisterCheck 注册
+*/
+
+func init() {
+${AUTOTESTCOMMANDTEXT}}
+
+func createTx(action string) scenario.TxCommand {
+	return func(b scenario.Backend, flags scenario.Flags) (*types.Transaction, error) {
+		payload := flags.Get("p", "payload")
+		if payload == "" {
+			payload = "{}"
+		}
+		cfg := b.GetConfig()
+		data, err := types.CallCreateTxJSON(cfg, cfg.ExecName(${EXECNAME}types.${CLASSNAME}X), action, json.RawMessage(payload))
+		if err != nil {
+			return nil, err
+		}
+		tx := &types.Transaction{}
+		return tx, types.Decode(data, tx)
+	}
+}
+`
+
+	caseName    = "${EXECNAME}.toml"
+	caseContent = `# ${EXECNAME}合约的场景测试用例, autotest.toml中通过 [[TestCaseFile]] 引用
+# -p 为json格式的action参数, 不能含有空格, -k 为签名的私钥或者钱包中的地址
+# 根据合约逻辑填写参数, 通过expect或者checkItem检查回执
+
+${AUTOTESTCASETEXT}`
+)
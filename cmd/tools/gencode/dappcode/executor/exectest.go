@@ -0,0 +1,81 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/cmd/tools/gencode/base"
+	"github.com/33cn/chain33/cmd/tools/types"
+)
+
+func init() {
+	base.RegisterCodeFile(execTestCode{})
+}
+
+type execTestCode struct {
+	executorCodeFile
+}
+
+func (execTestCode) GetFiles() map[string]string {
+
+	return map[string]string{
+		execTestName: execTestContent,
+	}
+}
+
+func (execTestCode) GetFileReplaceTags() []string {
+
+	return []string{types.TagExecName, types.TagImportPath, types.TagClassName, types.TagExecTestCaseText}
+}
+
+var (
+	execTestName    = "${EXECNAME}_test.go"
+	execTestContent = `package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util/testnode"
+	_ "${IMPORTPATH}/${EXECNAME}"
+	${EXECNAME}types "${IMPORTPATH}/${EXECNAME}/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * 执行器单元测试, 在进程内的测试节点上发送每一种action的交易并检查回执
+ * 根据合约逻辑填写交易参数和预期的回执类型, 增加更多的用例
+*/
+
+func Test${CLASSNAME}Exec(t *testing.T) {
+	mock := testnode.New("", nil)
+	defer mock.Close()
+	cfg := mock.GetClient().GetConfig()
+	execName := cfg.ExecName(${EXECNAME}types.${CLASSNAME}X)
+
+	tests := []struct {
+		name    string
+		action  string
+		payload types.Message
+		//预期的回执类型, types.ExecOk 或者 types.ExecPack
+		receiptTy int32
+	}{
+${EXECTESTCASETEXT}	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txData, err := types.CallCreateTx(cfg, execName, tt.action, tt.payload)
+			require.Nil(t, err)
+			hash, err := mock.SendAndSign(mock.GetGenesisKey(), common.ToHex(txData))
+			require.Nil(t, err)
+			detail, err := mock.WaitTx(hash)
+			require.Nil(t, err)
+			assert.Equal(t, tt.receiptTy, detail.Receipt.Ty, detail.Receipt.TyName)
+		})
+	}
+}
+`
+)
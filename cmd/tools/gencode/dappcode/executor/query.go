@@ -0,0 +1,66 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/cmd/tools/gencode/base"
+	"github.com/33cn/chain33/cmd/tools/types"
+)
+
+func init() {
+	base.RegisterCodeFile(queryCode{})
+	base.RegisterCodeFile(tableCode{})
+}
+
+type queryCode struct {
+	executorCodeFile
+}
+
+func (queryCode) GetFiles() map[string]string {
+
+	return map[string]string{
+		queryName: queryContent,
+	}
+}
+
+func (queryCode) GetFileReplaceTags() []string {
+
+	return []string{types.TagExecName, types.TagImportPath, types.TagExecQueryImportText,
+		types.TagExecQueryFileContent, types.TagExecObject}
+}
+
+//tableCode proto中声明的localdb表, 没有声明时不生成文件
+type tableCode struct {
+	executorCodeFile
+}
+
+func (tableCode) GetFiles() map[string]string {
+
+	return map[string]string{
+		tableName: tableContent,
+	}
+}
+
+func (tableCode) GetFileReplaceTags() []string {
+
+	return []string{types.TagExecName, types.TagImportPath, types.TagTableFileContent}
+}
+
+var (
+	queryName    = "query.go"
+	queryContent = `package executor
+
+${EXECQUERYIMPORTTEXT}
+
+/*
+ * 实现合约的查询接口, 函数名称为 Query_+方法名
+ * 对应proto中service声明的rpc方法, 通过框架的Chain33.Query或者合约rpc调用
+*/
+
+${EXECQUERYFILECONTENT}`
+
+	tableName    = "table.go"
+	tableContent = `${TABLEFILECONTENT}`
+)
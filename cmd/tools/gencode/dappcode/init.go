@@ -5,6 +5,7 @@
 package dappcode
 
 import (
+	_ "github.com/33cn/chain33/cmd/tools/gencode/dappcode/autotest" //init autotest
 	_ "github.com/33cn/chain33/cmd/tools/gencode/dappcode/cmd"      //init cmd
 	_ "github.com/33cn/chain33/cmd/tools/gencode/dappcode/commands" // init command
 	_ "github.com/33cn/chain33/cmd/tools/gencode/dappcode/executor" // init executor
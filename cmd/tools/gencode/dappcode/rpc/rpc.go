@@ -33,21 +33,24 @@ func (c rpcCodeFile) GetFiles() map[string]string {
 
 func (c rpcCodeFile) GetFileReplaceTags() []string {
 
-	return []string{types.TagExecName, types.TagImportPath, types.TagClassName}
+	return []string{types.TagExecName, types.TagImportPath, types.TagClassName,
+		types.TagRPCImportText, types.TagRPCFileContent}
 }
 
 var (
 	rpcName    = "rpc.go"
 	rpcContent = `package rpc
 
+${RPCIMPORTTEXT}
 
 /* 
  * 实现json rpc和grpc service接口
  * json rpc用Jrpc结构作为接收实例
  * grpc使用channelClient结构作为接收实例
+ * 默认通过框架Query接口调用执行器中对应的Query_函数
 */
 
-`
+${RPCFILECONTENT}`
 
 	typesName    = "types.go"
 	typesContent = `package rpc
@@ -12,6 +12,7 @@ import (
 func init() {
 
 	base.RegisterCodeFile(typesCode{})
+	base.RegisterCodeFile(actionCode{})
 }
 
 type typesCode struct {
@@ -37,8 +38,24 @@ func (c typesCode) GetDirReplaceTags() []string {
 func (c typesCode) GetFileReplaceTags() []string {
 
 	return []string{types.TagExecName, types.TagExecObject, types.TagClassName,
-		types.TagActionIDText, types.TagTyLogActionType,
-		types.TagLogMapText, types.TagTypeMapText}
+		types.TagTyLogActionType, types.TagLogMapText}
+}
+
+//actionCode action类型定义, 随proto更新
+type actionCode struct {
+	typesCode
+}
+
+func (c actionCode) GetFiles() map[string]string {
+
+	return map[string]string{
+		actionName: actionContent,
+	}
+}
+
+func (c actionCode) GetFileReplaceTags() []string {
+
+	return []string{types.TagActionIDText, types.TagTypeMapText}
 }
 
 var (
@@ -48,7 +65,6 @@ var (
 import (
 log "github.com/33cn/chain33/common/log/log15"
 "github.com/33cn/chain33/types"
-"reflect"
 )
 
 /* 
@@ -58,17 +74,12 @@ log "github.com/33cn/chain33/common/log/log15"
 */
 
 
-// action类型id和name，这些常量可以自定义修改
-${ACTIONIDTEXT}
-
 // log类型id值
 ${TYLOGACTIONTYPE}
 
 var (
     //${CLASSNAME}X 执行器名称定义
 	${CLASSNAME}X = "${EXECNAME}"
-	//定义actionMap
-	actionMap = ${TYPEMAPTEXT}
 	//定义log的id和具体log类型及名称，填入具体自定义log类型
 	logMap = ${LOGMAPTEXT}
 	tlog = log.New("module", "${EXECNAME}.types")
@@ -118,5 +129,20 @@ func (${EXEC_OBJECT} *${EXECNAME}Type) GetLogMap() map[int64]*types.LogInfo {
     return logMap
 }
 
+`
+
+	actionName    = "action.go"
+	actionContent = types.GenCodeHeader + `
+package types
+
+/*
+ * action类型id和name, 根据proto中的action定义生成
+ * 修改proto后通过 gendapp -u 更新
+*/
+
+${ACTIONIDTEXT}
+
+//定义actionMap
+var actionMap = ${TYPEMAPTEXT}
 `
 )
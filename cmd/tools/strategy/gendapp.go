@@ -22,6 +22,7 @@ type genDappStrategy struct {
 	dappDir     string
 	dappProto   string
 	packagePath string
+	update      bool
 }
 
 func (ad *genDappStrategy) Run() error {
@@ -43,6 +44,7 @@ func (ad *genDappStrategy) initMember() bool {
 	dappName, _ := ad.getParam(types.KeyExecutorName)
 	outDir, _ := ad.getParam(types.KeyDappOutDir)
 	protoPath, _ := ad.getParam(types.KeyProtobufFile)
+	update, _ := ad.getParam(types.KeyDappUpdate)
 	//统一转为小写字母
 	dappName = strings.ToLower(dappName)
 
@@ -65,11 +67,15 @@ func (ad *genDappStrategy) initMember() bool {
 	packPath := strings.Replace(filepath.Join(outDir), string(filepath.Separator), "/", -1)
 	//绝对路径
 	dappRootDir := filepath.Join(goPath, "src", outDir, dappName)
-	//check dapp output directory exist
-	if util.CheckPathExisted(dappRootDir) {
+	//check dapp output directory exist, 更新时目录必须存在
+	if update == "" && util.CheckPathExisted(dappRootDir) {
 		mlog.Error("InitGenDapp", "Err", "generate dapp directory exist", "Dir", dappRootDir)
 		return false
 	}
+	if update != "" && !util.CheckPathExisted(dappRootDir) {
+		mlog.Error("InitGenDapp", "Err", "update dapp directory not exist", "Dir", dappRootDir)
+		return false
+	}
 
 	if protoPath != "" {
 		bExist, _ := util.CheckFileExists(protoPath)
@@ -90,6 +96,7 @@ func (ad *genDappStrategy) initMember() bool {
 	ad.dappDir = dappRootDir
 	ad.dappProto = protoPath
 	ad.packagePath = packPath
+	ad.update = update != ""
 
 	return true
 }
@@ -117,6 +124,7 @@ func (ad *genDappStrategy) buildTask() []tasks.Task {
 			DappDir:     ad.dappDir,
 			ProtoFile:   ad.dappProto,
 			PackagePath: ad.packagePath,
+			Update:      ad.update,
 		},
 		&tasks.FormatDappSourceTask{
 			OutputFolder: ad.dappDir,
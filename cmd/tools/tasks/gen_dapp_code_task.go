@@ -20,10 +20,12 @@ import (
 // GenDappCodeTask 通过生成好的pb.go和预先设计的模板，生成反射程序源码
 type GenDappCodeTask struct {
 	TaskBase
-	DappName     string
-	DappDir      string
-	ProtoFile    string
-	PackagePath  string
+	DappName    string
+	DappDir     string
+	ProtoFile   string
+	PackagePath string
+	//更新已经存在的合约代码, 工具生成的文件重新生成, 其他文件只补充缺少的函数
+	Update       bool
 	replacePairs map[string]string
 }
 
@@ -92,6 +94,25 @@ func (c *GenDappCodeTask) calcReplacePairs(pbContent string) error {
 	c.replacePairs[types.TagLogMapText] = buildLogMapText()
 	c.replacePairs[types.TagTypeMapText] = buildTypeMapText(actionInfos, className)
 
+	//query and rpc
+	methods := readProtoServices(pbContent)
+	c.replacePairs[types.TagExecQueryImportText] = formatQueryImportText(methods, dapp, c.PackagePath)
+	c.replacePairs[types.TagExecQueryFileContent] = formatQueryContent(methods, dapp)
+	c.replacePairs[types.TagRPCImportText] = formatRPCImportText(methods, dapp, c.PackagePath)
+	c.replacePairs[types.TagRPCFileContent] = formatRPCContent(methods, dapp, className)
+
+	//localdb table
+	tables, err := readProtoTables(pbContent)
+	if err != nil {
+		return fmt.Errorf("ReadProtoTableErr:%s", err.Error())
+	}
+	c.replacePairs[types.TagTableFileContent] = formatTableContent(tables, dapp, c.PackagePath)
+
+	//test
+	c.replacePairs[types.TagExecTestCaseText] = buildExecTestCaseText(actionInfos, dapp)
+	c.replacePairs[types.TagAutoTestCommandText] = buildAutoTestCommandText(actionInfos, dapp)
+	c.replacePairs[types.TagAutoTestCaseText] = buildAutoTestCaseText(actionInfos, dapp, className)
+
 	return nil
 
 }
@@ -121,8 +142,13 @@ func (c *GenDappCodeTask) genDappCode() error {
 				name = strings.Replace(name, tag, c.replacePairs[tag], -1)
 				content = strings.Replace(content, tag, c.replacePairs[tag], -1)
 			}
+			//proto中没有相关定义的文件不生成, 如localdb表
+			if strings.TrimSpace(content) == "" {
+				c.removeGenFile(filepath.Join(dirPath, name))
+				continue
+			}
 
-			_, err = util.WriteStringToFile(filepath.Join(dirPath, name), content)
+			err = c.writeCodeFile(filepath.Join(dirPath, name), content)
 
 			if err != nil {
 				mlog.Error("GenNewCodeFile", "Err", err.Error(), "CodeFile", filepath.Join(dirPath, name))
@@ -134,3 +160,42 @@ func (c *GenDappCodeTask) genDappCode() error {
 
 	return nil
 }
+
+//writeCodeFile 写入生成的文件, 更新时工具生成的文件和proto文件直接覆盖,
+//go文件只补充缺少的函数, 其他已经存在的文件保留
+func (c *GenDappCodeTask) writeCodeFile(file, content string) error {
+	if !c.Update || !util.CheckFileIsExist(file) ||
+		strings.HasPrefix(content, types.GenCodeHeader) || filepath.Ext(file) == ".proto" {
+		_, err := util.WriteStringToFile(file, content)
+		return err
+	}
+	if filepath.Ext(file) != ".go" {
+		return nil
+	}
+	old, err := util.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	merged, changed, err := mergeGoSource(old, []byte(content))
+	if err != nil {
+		return fmt.Errorf("merge %s: %s", file, err.Error())
+	}
+	if !changed {
+		return nil
+	}
+	mlog.Info("UpdateCodeFile", "file", file)
+	_, err = util.WriteStringToFile(file, string(merged))
+	return err
+}
+
+//removeGenFile 更新时proto中删除了相关定义, 删除之前生成的文件
+func (c *GenDappCodeTask) removeGenFile(file string) {
+	if !c.Update || !util.CheckFileIsExist(file) {
+		return
+	}
+	old, err := util.ReadFile(file)
+	if err == nil && strings.HasPrefix(string(old), types.GenCodeHeader) {
+		mlog.Info("RemoveCodeFile", "file", file)
+		util.DeleteFile(file)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tasks
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+)
+
+//mergeGoSource 更新合约代码时合并go文件，保留已经存在文件中手写的逻辑，具体步骤如下：
+//1. 分别解析已经存在的文件和重新生成的文件
+//2. 以 接收者类型.函数名 区分函数, 找出新生成文件中缺少的函数, 如新增action的Exec_函数
+//3. 新增函数用到的import补充到原文件的import中
+//4. 缺少的函数连同注释追加到原文件末尾
+func mergeGoSource(old, gen []byte) ([]byte, bool, error) {
+	fset := token.NewFileSet()
+	oldFile, err := parser.ParseFile(fset, "old.go", old, parser.ParseComments)
+	if err != nil {
+		return nil, false, err
+	}
+	genFset := token.NewFileSet()
+	genFile, err := parser.ParseFile(genFset, "gen.go", gen, parser.ParseComments)
+	if err != nil {
+		return nil, false, err
+	}
+
+	exist := make(map[string]bool)
+	for _, decl := range oldFile.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			exist[funcKey(fn)] = true
+		}
+	}
+	var funcs []string
+	for _, decl := range genFile.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || exist[funcKey(fn)] {
+			continue
+		}
+		start := fn.Pos()
+		if fn.Doc != nil {
+			start = fn.Doc.Pos()
+		}
+		funcs = append(funcs, string(gen[genFset.Position(start).Offset:genFset.Position(fn.End()).Offset]))
+	}
+	if len(funcs) == 0 {
+		return old, false, nil
+	}
+
+	imported := make(map[string]bool)
+	for _, spec := range oldFile.Imports {
+		imported[spec.Path.Value] = true
+	}
+	var imports []string
+	for _, spec := range genFile.Imports {
+		if imported[spec.Path.Value] {
+			continue
+		}
+		text := spec.Path.Value
+		if spec.Name != nil {
+			text = spec.Name.Name + " " + text
+		}
+		imports = append(imports, text)
+	}
+
+	src := string(old)
+	if len(imports) > 0 {
+		offset := fset.Position(oldFile.Name.End()).Offset
+		text := "\n\nimport (\n\t" + strings.Join(imports, "\n\t") + "\n)"
+		for _, decl := range oldFile.Decls {
+			if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT && gen.Lparen.IsValid() {
+				offset = fset.Position(gen.Rparen).Offset
+				text = "\t" + strings.Join(imports, "\n\t") + "\n"
+				break
+			}
+		}
+		src = src[:offset] + text + src[offset:]
+	}
+	src = strings.TrimRight(src, "\n") + "\n\n" + strings.Join(funcs, "\n\n") + "\n"
+	return []byte(src), true, nil
+}
+
+//funcKey 接收者类型和函数名, 如 Jrpc.QueryCount
+func funcKey(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	if ident, ok := recv.(*ast.Ident); ok {
+		return ident.Name + "." + fn.Name.Name
+	}
+	return fn.Name.Name
+}
@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/33cn/chain33/cmd/tools/types"
 	sysutil "github.com/33cn/chain33/util"
 )
 
@@ -151,3 +152,312 @@ func buildLogMapText() (text string) {
 	text = fmt.Sprintf("map[int64]*types.LogInfo{\n\t//LogID:	{Ty: reflect.TypeOf(LogStruct), Name: LogName},\n}")
 	return
 }
+
+type serviceMethod struct {
+	name      string
+	reqType   string
+	replyType string
+}
+
+//queryName 执行器中Query_函数的名称, 去掉rpc方法名的Query前缀
+func (m *serviceMethod) queryName() string {
+	if strings.HasPrefix(m.name, "Query") && len(m.name) > len("Query") {
+		return m.name[len("Query"):]
+	}
+	return m.name
+}
+
+//readProtoServices 通过正则获取service中声明的rpc方法
+func readProtoServices(protoContent string) []*serviceMethod {
+	reg := regexp.MustCompile(`\brpc\s+(\w+)\s*\(\s*([\w.]+)\s*\)\s*returns\s*\(\s*([\w.]+)\s*\)`)
+	methods := make([]*serviceMethod, 0)
+	for _, match := range reg.FindAllStringSubmatch(protoContent, -1) {
+		methods = append(methods, &serviceMethod{name: match[1], reqType: match[2], replyType: match[3]})
+	}
+	return methods
+}
+
+type protoField struct {
+	name     string
+	typeName string
+	repeated bool
+}
+
+type tableInfo struct {
+	message string
+	primary string
+	index   []string
+	fields  []*protoField
+}
+
+//getField 获取message的成员
+func (t *tableInfo) getField(name string) *protoField {
+	for _, field := range t.fields {
+		if field.name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+//readProtoTables 通过注释声明localdb表, 注释需要紧挨着message定义, 如 "// gendapp:table primary=id index=addr,status",
+//primary不填或者为auto时主键由表自动生成
+func readProtoTables(protoContent string) ([]*tableInfo, error) {
+	reg := regexp.MustCompile(`//\s*gendapp:table([^\n]*)\n\s*message\s+(\w+)\s*\{([^}]*)\}`)
+	fieldReg := regexp.MustCompile(`^\s*(repeated\s+)?([\w.]+)\s+(\w+)\s*=\s*\d+`)
+	tables := make([]*tableInfo, 0)
+	for _, match := range reg.FindAllStringSubmatch(protoContent, -1) {
+		info := &tableInfo{message: match[2], primary: "auto"}
+		for _, opt := range strings.Fields(match[1]) {
+			kv := strings.SplitN(opt, "=", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("table %s invalid option %s", info.message, opt)
+			}
+			switch kv[0] {
+			case "primary":
+				info.primary = kv[1]
+			case "index":
+				info.index = strings.Split(kv[1], ",")
+			default:
+				return nil, fmt.Errorf("table %s unknown option %s", info.message, kv[0])
+			}
+		}
+		for _, line := range strings.Split(match[3], "\n") {
+			if f := fieldReg.FindStringSubmatch(line); f != nil {
+				info.fields = append(info.fields, &protoField{name: f[3], typeName: f[2], repeated: f[1] != ""})
+			}
+		}
+		keys := info.index
+		if info.primary != "auto" {
+			keys = append([]string{info.primary}, keys...)
+		}
+		for _, key := range keys {
+			field := info.getField(key)
+			if field == nil {
+				return nil, fmt.Errorf("table %s field %s not exist", info.message, key)
+			}
+			if _, ok := tableKeyFormat[field.typeName]; !ok || field.repeated {
+				return nil, fmt.Errorf("table %s field %s type %s can not be index", info.message, key, field.typeName)
+			}
+		}
+		tables = append(tables, info)
+	}
+	return tables, nil
+}
+
+//tableKeyFormat 各类型索引字段转换为key的格式, 整数补齐位数保证按数值排序
+var tableKeyFormat = map[string]string{
+	"string":   "[]byte(%s)",
+	"bytes":    "%s",
+	"bool":     `[]byte(fmt.Sprintf("%%t", %s))`,
+	"int32":    `[]byte(fmt.Sprintf("%%010d", %s))`,
+	"sint32":   `[]byte(fmt.Sprintf("%%010d", %s))`,
+	"sfixed32": `[]byte(fmt.Sprintf("%%010d", %s))`,
+	"uint32":   `[]byte(fmt.Sprintf("%%010d", %s))`,
+	"fixed32":  `[]byte(fmt.Sprintf("%%010d", %s))`,
+	"int64":    `[]byte(fmt.Sprintf("%%019d", %s))`,
+	"sint64":   `[]byte(fmt.Sprintf("%%019d", %s))`,
+	"sfixed64": `[]byte(fmt.Sprintf("%%019d", %s))`,
+	"uint64":   `[]byte(fmt.Sprintf("%%020d", %s))`,
+	"fixed64":  `[]byte(fmt.Sprintf("%%020d", %s))`,
+}
+
+//goFieldName 根据proto生成pb.go的规则, 下划线分隔的字段名转为驼峰格式
+func goFieldName(name string) string {
+	var goName string
+	for _, part := range strings.Split(name, "_") {
+		part, _ = sysutil.MakeStringToUpper(part, 0, 1)
+		goName += part
+	}
+	return goName
+}
+
+//goTypeName proto中引用的类型在go代码中的名称, 带包名的类型视为chain33框架的类型
+func goTypeName(typeName, dappName string) string {
+	if idx := strings.LastIndex(typeName, "."); idx >= 0 {
+		return "types." + typeName[idx+1:]
+	}
+	return dappName + "types." + typeName
+}
+
+func formatTableContent(tables []*tableInfo, dappName, packagePath string) string {
+	if len(tables) == 0 {
+		return ""
+	}
+	tableFmtStr := `var opt%[1]sTable = &table.Option{
+	Prefix:  "LODB-%[2]s",
+	Name:    "%[3]s",
+	Primary: "%[4]s",
+	Index:   %[5]s,
+}
+
+//New%[1]sTable 新建%[1]s表
+func New%[1]sTable(kvdb dbm.KV) *table.Table {
+	rowmeta := New%[1]sRow()
+	t, err := table.NewTable(rowmeta, kvdb, opt%[1]sTable)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+//%[1]sRow table meta 结构
+type %[1]sRow struct {
+	*%[2]stypes.%[1]s
+}
+
+//New%[1]sRow 新建一个meta 结构
+func New%[1]sRow() *%[1]sRow {
+	return &%[1]sRow{%[1]s: &%[2]stypes.%[1]s{}}
+}
+
+//CreateRow 新建数据行
+func (r *%[1]sRow) CreateRow() *table.Row {
+	return &table.Row{Data: &%[2]stypes.%[1]s{}}
+}
+
+//SetPayload 设置数据
+func (r *%[1]sRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*%[2]stypes.%[1]s); ok {
+		r.%[1]s = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 获取索引对应的key值
+func (r *%[1]sRow) Get(key string) ([]byte, error) {
+	switch key {
+%[6]s	}
+	return nil, types.ErrNotFound
+}
+
+`
+	content := ""
+	useFmt := false
+	for _, info := range tables {
+		index := "nil"
+		if len(info.index) > 0 {
+			index = fmt.Sprintf("[]string{\"%s\"}", strings.Join(info.index, "\", \""))
+		}
+		keys := info.index
+		if info.primary != "auto" {
+			keys = append([]string{info.primary}, keys...)
+		}
+		cases := ""
+		for _, key := range keys {
+			format := tableKeyFormat[info.getField(key).typeName]
+			useFmt = useFmt || strings.Contains(format, "fmt.")
+			cases += fmt.Sprintf("\tcase \"%s\":\n\t\treturn %s, nil\n", key, fmt.Sprintf(format, "r.Get"+goFieldName(key)+"()"))
+		}
+		content += fmt.Sprintf(tableFmtStr, info.message, dappName, strings.ToLower(info.message), info.primary, index, cases)
+	}
+
+	imports := ""
+	if useFmt {
+		imports = "\"fmt\"\n\n"
+	}
+	imports += fmt.Sprintf("dbm \"github.com/33cn/chain33/common/db\"\n\"github.com/33cn/chain33/common/db/table\"\n"+
+		"%stypes \"%s/%s/types\"\n\"github.com/33cn/chain33/types\"\n", dappName, packagePath, dappName)
+	header := `package executor
+
+import (
+%s)
+
+/*
+ * localdb表定义, 根据proto中 gendapp:table 注释生成
+ * 在ExecLocal中通过 New<Message>Table(localdb) 获取表, 修改数据后调用Save获取kv
+*/
+
+`
+	return types.GenCodeHeader + "\n" + fmt.Sprintf(header, imports) + content
+}
+
+func formatQueryImportText(methods []*serviceMethod, dappName, packagePath string) string {
+	if len(methods) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("import (\n\t%stypes \"%s/%s/types\"\n\t\"github.com/33cn/chain33/types\"\n)\n", dappName, packagePath, dappName)
+}
+
+func formatQueryContent(methods []*serviceMethod, dappName string) string {
+
+	fnFmtStr := `func (${EXEC_OBJECT} *%s) Query_%s(in *%s) (types.Message, error) {
+	//implement code
+	return &%s{}, nil
+}
+
+`
+	content := ""
+	for _, m := range methods {
+		content += fmt.Sprintf(fnFmtStr, dappName, m.queryName(), goTypeName(m.reqType, dappName), goTypeName(m.replyType, dappName))
+	}
+	return content
+}
+
+func formatRPCImportText(methods []*serviceMethod, dappName, packagePath string) string {
+	if len(methods) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("import (\n\t\"context\"\n\n\t%stypes \"%s/%s/types\"\n\t\"github.com/33cn/chain33/types\"\n)\n", dappName, packagePath, dappName)
+}
+
+func formatRPCContent(methods []*serviceMethod, dappName, className string) string {
+
+	fnFmtStr := `//%[1]s grpc接口, 通过框架Query接口调用执行器的Query_%[2]s
+func (c *channelClient) %[1]s(ctx context.Context, in *%[3]s) (*%[4]s, error) {
+	msg, err := c.Query(%[5]stypes.%[6]sX, "%[2]s", in)
+	if err != nil {
+		return nil, err
+	}
+	if reply, ok := msg.(*%[4]s); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+//%[1]s json rpc接口, 调用grpc接口实现
+func (j *Jrpc) %[1]s(in *%[3]s, result *interface{}) error {
+	reply, err := j.cli.%[1]s(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+`
+	content := ""
+	for _, m := range methods {
+		content += fmt.Sprintf(fnFmtStr, m.name, m.queryName(), goTypeName(m.reqType, dappName),
+			goTypeName(m.replyType, dappName), dappName, className)
+	}
+	return content
+}
+
+// 每一种action一个用例, 默认预期执行成功
+func buildExecTestCaseText(infos []*actionInfoItem, dappName string) (text string) {
+	for _, info := range infos {
+		text += fmt.Sprintf("\t\t{name: \"%s\", action: %stypes.Name%sAction, payload: &%s, receiptTy: types.ExecOk},\n",
+			info.memberName, dappName, info.memberName, goTypeName(info.memberType, dappName)+"{}")
+	}
+	return
+}
+
+// 命令名称为 合约名 + 小写的ActionMemberName
+func buildAutoTestCommandText(infos []*actionInfoItem, dappName string) (text string) {
+	for _, info := range infos {
+		text += fmt.Sprintf("\tscenario.RegisterTxCommand(\"%s %s\", createTx(%stypes.Name%sAction))\n",
+			dappName, strings.ToLower(info.memberName), dappName, info.memberName)
+	}
+	return
+}
+
+// 用例类型为 ClassName + Case, 使用autotest默认的创世地址签名
+func buildAutoTestCaseText(infos []*actionInfoItem, dappName, className string) (text string) {
+	for _, info := range infos {
+		text += fmt.Sprintf("[[%sCase]]\nid = \"%s%s\"\ncommand = 'send %s %s -p {} -k 12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv'\n\n",
+			className, dappName, info.memberName, dappName, strings.ToLower(info.memberName))
+	}
+	return
+}
@@ -3,3 +3,161 @@
 // license that can be found in the LICENSE file.
 
 package tasks
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testProto = `syntax = "proto3";
+
+package types;
+
+message DemoAction {
+    oneof value {
+        DemoHello hello = 1;
+        DemoEcho  echo  = 2;
+    }
+    int32 ty = 3;
+}
+
+message DemoHello {
+    string name = 1;
+}
+
+message DemoEcho {
+    string msg = 1;
+}
+
+// gendapp:table primary=id index=from_addr,height
+message DemoRecord {
+    string id        = 1;
+    string from_addr = 2;
+    int64  height    = 3;
+    repeated string tags = 4;
+}
+
+message ReqDemoCount {
+    string name = 1;
+}
+
+message ReplyDemoCount {
+    int64 count = 1;
+}
+
+service demo {
+    rpc QueryDemoCount(ReqDemoCount) returns (ReplyDemoCount) {}
+}
+`
+
+func TestReadProto(t *testing.T) {
+	methods := readProtoServices(testProto)
+	require.Equal(t, 1, len(methods))
+	assert.Equal(t, "QueryDemoCount", methods[0].name)
+	assert.Equal(t, "DemoCount", methods[0].queryName())
+	assert.Equal(t, "ReqDemoCount", methods[0].reqType)
+	assert.Equal(t, "ReplyDemoCount", methods[0].replyType)
+
+	tables, err := readProtoTables(testProto)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(tables))
+	assert.Equal(t, "DemoRecord", tables[0].message)
+	assert.Equal(t, "id", tables[0].primary)
+	assert.Equal(t, []string{"from_addr", "height"}, tables[0].index)
+	assert.Equal(t, 4, len(tables[0].fields))
+	assert.Equal(t, "FromAddr", goFieldName("from_addr"))
+
+	_, err = readProtoTables(strings.Replace(testProto, "index=from_addr,height", "index=tags", 1))
+	assert.NotNil(t, err)
+	_, err = readProtoTables(strings.Replace(testProto, "index=from_addr,height", "index=unknown", 1))
+	assert.NotNil(t, err)
+}
+
+func genDemo(t *testing.T, dir, proto string, update bool) {
+	file := filepath.Join(dir, "demo.proto")
+	require.Nil(t, ioutil.WriteFile(file, []byte(proto), 0644))
+	task := &GenDappCodeTask{DappName: "demo", DappDir: filepath.Join(dir, "demo"), ProtoFile: file,
+		PackagePath: "github.com/33cn/plugin/plugin/dapp", Update: update}
+	require.Nil(t, task.Execute())
+}
+
+func readDemo(t *testing.T, dir, name string) string {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "demo", name))
+	require.Nil(t, err)
+	return string(data)
+}
+
+func TestGenDappUpdate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gendapp")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	genDemo(t, dir, testProto, false)
+	//生成的go文件语法正确
+	err = filepath.Walk(filepath.Join(dir, "demo"), func(path string, info os.FileInfo, err error) error {
+		if err != nil || filepath.Ext(path) != ".go" {
+			return err
+		}
+		_, err = parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		assert.Nil(t, err, path)
+		return nil
+	})
+	require.Nil(t, err)
+	assert.Contains(t, readDemo(t, dir, "executor/table.go"), `case "from_addr":`)
+	assert.Contains(t, readDemo(t, dir, "executor/query.go"), "Query_DemoCount(in *demotypes.ReqDemoCount)")
+	assert.Contains(t, readDemo(t, dir, "rpc/rpc.go"), "func (j *Jrpc) QueryDemoCount(")
+	assert.Contains(t, readDemo(t, dir, "executor/demo_test.go"), "demotypes.NameHelloAction")
+	assert.Contains(t, readDemo(t, dir, "autotest/demo.go"), `"demo echo"`)
+	assert.Contains(t, readDemo(t, dir, "autotest/demo.toml"), "send demo hello")
+
+	//手写的代码
+	exec := strings.Replace(readDemo(t, dir, "executor/exec.go"), "//implement code", "//hand-written", 1)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "demo", "executor/exec.go"), []byte(exec), 0644))
+
+	//增加action和查询, 删除表定义
+	proto := strings.Replace(testProto, "DemoEcho  echo  = 2;", "DemoEcho  echo  = 2;\n        DemoEcho  ping  = 4;", 1)
+	proto = strings.Replace(proto, "// gendapp:table primary=id index=from_addr,height\n", "", 1)
+	proto = strings.Replace(proto, "service demo {", "service demo {\n    rpc GetLast(ReqDemoCount) returns (DemoRecord) {}", 1)
+	genDemo(t, dir, proto, true)
+
+	exec = readDemo(t, dir, "executor/exec.go")
+	assert.Contains(t, exec, "//hand-written")
+	assert.Equal(t, 1, strings.Count(exec, "Exec_Hello("))
+	assert.Contains(t, exec, "Exec_Ping(payload *demotypes.DemoEcho")
+	assert.Contains(t, readDemo(t, dir, "executor/exec_local.go"), "ExecLocal_Ping(")
+	assert.Contains(t, readDemo(t, dir, "types/action.go"), "NamePingAction")
+	assert.Contains(t, readDemo(t, dir, "executor/query.go"), "Query_GetLast(in *demotypes.ReqDemoCount)")
+	rpc := readDemo(t, dir, "rpc/rpc.go")
+	assert.Equal(t, 1, strings.Count(rpc, "func (j *Jrpc) QueryDemoCount("))
+	assert.Contains(t, rpc, "func (c *channelClient) GetLast(")
+	assert.Contains(t, readDemo(t, dir, "autotest/demo.go"), `"demo ping"`)
+	assert.Contains(t, readDemo(t, dir, "proto/demo.proto"), "ping  = 4;")
+	_, err = os.Stat(filepath.Join(dir, "demo", "executor/table.go"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestMergeGoSource(t *testing.T) {
+	old := "package rpc\n\n/*\n * rpc\n*/\n\nfunc (j *Jrpc) A() {\n\t//hand-written\n}\n"
+	gen := "package rpc\n\nimport (\n\t\"context\"\n)\n\n//A a\nfunc (j *Jrpc) A() {}\n\n//B b\nfunc (j *Jrpc) B(ctx context.Context) {}\n\nfunc (c *channelClient) A() {}\n"
+	merged, changed, err := mergeGoSource([]byte(old), []byte(gen))
+	require.Nil(t, err)
+	assert.True(t, changed)
+	f, err := parser.ParseFile(token.NewFileSet(), "merged.go", merged, parser.ParseComments)
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(f.Imports))
+	assert.Equal(t, 3, len(f.Decls)-1)
+	assert.Contains(t, string(merged), "//hand-written")
+	assert.Contains(t, string(merged), "//B b\nfunc (j *Jrpc) B(")
+
+	_, changed, err = mergeGoSource(merged, []byte(gen))
+	require.Nil(t, err)
+	assert.False(t, changed)
+}
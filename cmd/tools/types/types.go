@@ -22,6 +22,7 @@ const (
 	KeyCreatePlugin             = "create_plugin"
 	KeyGenDapp                  = "generate_dapp"
 	KeyDappOutDir               = "generate_dapp_out_dir"
+	KeyDappUpdate               = "generate_dapp_update"
 
 	DefCpmConfigfile = "chain33.cpm.toml"
 
@@ -52,4 +53,25 @@ const (
 	TagExecDelLocalFileContent = "${EXECDELLOCALFILECONTENT}"
 
 	TagExecObject = "${EXEC_OBJECT}" //执行器类函数接收对象, 默认为首字母
+
+	//Tag query.go file
+	TagExecQueryImportText  = "${EXECQUERYIMPORTTEXT}"
+	TagExecQueryFileContent = "${EXECQUERYFILECONTENT}"
+
+	//Tag executor test file
+	TagExecTestCaseText = "${EXECTESTCASETEXT}"
+
+	//Tag table.go file
+	TagTableFileContent = "${TABLEFILECONTENT}"
+
+	//Tag rpc.go file
+	TagRPCImportText  = "${RPCIMPORTTEXT}"
+	TagRPCFileContent = "${RPCFILECONTENT}"
+
+	//Tag autotest
+	TagAutoTestCommandText = "${AUTOTESTCOMMANDTEXT}"
+	TagAutoTestCaseText    = "${AUTOTESTCASETEXT}"
+
+	// GenCodeHeader 完全由工具生成的文件头, update时覆盖, 其他文件只补充缺少的函数
+	GenCodeHeader = "// Code generated by chain33-tool gendapp. DO NOT EDIT.\n"
 )
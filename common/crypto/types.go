@@ -13,6 +13,12 @@ type Crypto interface {
 	Validate(msg, pub, sig []byte) error
 }
 
+//HeightValidator 签名的校验结果与区块高度相关的加密插件, 如证书可以通过链上交易管理的签名
+//parentState为高度height-1的区块执行后的状态哈希, 为空时使用当前主链上的区块
+type HeightValidator interface {
+	ValidateByHeight(msg, pub, sig []byte, height int64, parentState []byte) error
+}
+
 //AggregateCrypto 聚合签名
 type AggregateCrypto interface {
 	Aggregate(sigs []Signature) (Signature, error)
@@ -77,6 +77,14 @@ func WithRegOptionDefaultDisable() RegOption {
 	}
 }
 
+// WithRegOptionEnableHeight 设置默认的启用高度, 负数表示需要在配置中指定启用高度后才能使用
+func WithRegOptionEnableHeight(height int64) RegOption {
+	return func(d *Driver) error {
+		d.enableHeight = height
+		return nil
+	}
+}
+
 // MaxManualTypeID 手动指定ID最大值 65534
 const MaxManualTypeID = math.MaxUint16 - 1
 
@@ -17,6 +17,7 @@ var (
 	// 保存日志处理器的引用，方便后续调整日志信息，而不重新初始化
 	fileHandler    *log15.Handler
 	consoleHandler *log15.Handler
+	rotateLogger   *lumberjack.Logger
 )
 
 func init() {
@@ -42,6 +43,20 @@ func SetFileLog(log *types.Log) {
 	}
 }
 
+//ReloadLog 配置热加载时根据新的配置重建文件和控制台日志, 日志文件变化时关闭原来的文件
+func ReloadLog(log *types.Log) {
+	old := rotateLogger
+	fileHandler = nil
+	consoleHandler = nil
+	if log != nil && log.LogFile == "" {
+		rotateLogger = nil
+	}
+	SetFileLog(log)
+	if old != nil && old != rotateLogger {
+		old.Close()
+	}
+}
+
 // 清空原来所有的日志Handler，根据配置文件信息重置文件和控制台日志
 func resetLog(log *types.Log) {
 	fillDefaultValue(log)
@@ -85,7 +100,7 @@ func getFileLogHandler(log *types.Log) *log15.Handler {
 		return fileHandler
 	}
 
-	rotateLogger := &lumberjack.Logger{
+	logger := &lumberjack.Logger{
 		Filename:   log.LogFile,
 		MaxSize:    int(log.MaxFileSize),
 		MaxBackups: int(log.MaxBackups),
@@ -93,10 +108,15 @@ func getFileLogHandler(log *types.Log) *log15.Handler {
 		LocalTime:  log.LocalTime,
 		Compress:   log.Compress,
 	}
+	//热加载时日志文件的配置没有变化, 继续使用原来打开的文件
+	if sameRotateLogger(rotateLogger, logger) {
+		logger = rotateLogger
+	}
+	rotateLogger = logger
 
 	fileh := log15.LvlFilterHandler(
 		getLevel(log.Loglevel),
-		log15.StreamHandler(rotateLogger, log15.LogfmtFormat()),
+		log15.StreamHandler(logger, log15.LogfmtFormat()),
 	)
 
 	// 增加打印调用源文件、方法和代码行的判断
@@ -112,6 +132,11 @@ func getFileLogHandler(log *types.Log) *log15.Handler {
 	return &fileh
 }
 
+func sameRotateLogger(a, b *lumberjack.Logger) bool {
+	return a != nil && a.Filename == b.Filename && a.MaxSize == b.MaxSize && a.MaxBackups == b.MaxBackups &&
+		a.MaxAge == b.MaxAge && a.LocalTime == b.LocalTime && a.Compress == b.Compress
+}
+
 func getLevel(lvlString string) log15.Lvl {
 	lvl, err := log15.LvlFromString(lvlString)
 	if err != nil {
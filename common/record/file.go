@@ -0,0 +1,211 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package record
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+)
+
+// 文件格式: gzip压缩, 开头是magic, 之后每条记录为 uvarint长度 + 记录内容
+// 记录内容: varint时间, 1字节类型, 然后依次是uvarint长度前缀的 Source, Method, Data, Result, Error
+const magic = "chain33record1\n"
+
+// maxEntrySize 单条记录的最大长度
+const maxEntrySize = 256 * 1024 * 1024
+
+// ErrBadFormat 记录文件格式错误
+var ErrBadFormat = errors.New("ErrBadRecordFormat")
+
+// Writer 记录文件写入
+type Writer struct {
+	f   io.Closer
+	gz  *gzip.Writer
+	buf []byte
+}
+
+// Create 创建记录文件, 已经存在时覆盖
+func Create(file string) (*Writer, error) {
+	f, err := os.Create(file)
+	if err != nil {
+		return nil, err
+	}
+	w, err := NewWriter(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	w.f = f
+	return w, nil
+}
+
+// NewWriter 创建写入w的记录Writer, Close时不关闭w
+func NewWriter(w io.Writer) (*Writer, error) {
+	gz := gzip.NewWriter(w)
+	if _, err := gz.Write([]byte(magic)); err != nil {
+		return nil, err
+	}
+	return &Writer{gz: gz}, nil
+}
+
+// Write 写入一条记录
+func (w *Writer) Write(e *Entry) error {
+	var num [binary.MaxVarintLen64]byte
+	buf := append(w.buf[:0], num[:binary.PutVarint(num[:], e.Time)]...)
+	buf = append(buf, byte(e.Kind))
+	buf = appendBytes(buf, []byte(e.Source))
+	buf = appendBytes(buf, []byte(e.Method))
+	buf = appendBytes(buf, e.Data)
+	buf = appendBytes(buf, e.Result)
+	buf = appendBytes(buf, []byte(e.Error))
+	w.buf = buf
+	n := binary.PutUvarint(num[:], uint64(len(buf)))
+	if _, err := w.gz.Write(num[:n]); err != nil {
+		return err
+	}
+	_, err := w.gz.Write(buf)
+	return err
+}
+
+func appendBytes(buf, data []byte) []byte {
+	var size [binary.MaxVarintLen64]byte
+	buf = append(buf, size[:binary.PutUvarint(size[:], uint64(len(data)))]...)
+	return append(buf, data...)
+}
+
+// Flush 把缓存的数据写入文件
+func (w *Writer) Flush() error {
+	return w.gz.Flush()
+}
+
+// Close 结束gzip流, 文件由Create创建时关闭文件
+func (w *Writer) Close() error {
+	err := w.gz.Close()
+	if w.f != nil {
+		if cerr := w.f.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// Reader 记录文件读取
+type Reader struct {
+	f  io.Closer
+	gz *gzip.Reader
+	r  *bufio.Reader
+}
+
+// Open 打开记录文件
+func Open(file string) (*Reader, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	r.f = f
+	return r, nil
+}
+
+// NewReader 从r读取记录
+func NewReader(r io.Reader) (*Reader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(gz)
+	head := make([]byte, len(magic))
+	if _, err := io.ReadFull(br, head); err != nil || string(head) != magic {
+		return nil, ErrBadFormat
+	}
+	return &Reader{gz: gz, r: br}, nil
+}
+
+// Next 读取下一条记录, 读完时返回io.EOF, 节点异常退出时文件末尾不完整的记录忽略
+func (r *Reader) Next() (*Entry, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, err
+	}
+	if size > maxEntrySize {
+		return nil, ErrBadFormat
+	}
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r.r, buf); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	return decodeEntry(buf)
+}
+
+// Close 关闭记录文件
+func (r *Reader) Close() error {
+	err := r.gz.Close()
+	if r.f != nil {
+		if cerr := r.f.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// ReadFile 读取文件中所有的记录
+func ReadFile(file string) ([]*Entry, error) {
+	r, err := Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	var entries []*Entry
+	for {
+		e, err := r.Next()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return entries, err
+		}
+		entries = append(entries, e)
+	}
+}
+
+func decodeEntry(buf []byte) (*Entry, error) {
+	e := &Entry{}
+	t, n := binary.Varint(buf)
+	if n <= 0 || n >= len(buf) {
+		return nil, ErrBadFormat
+	}
+	e.Time = t
+	e.Kind = Kind(buf[n])
+	buf = buf[n+1:]
+	fields := make([][]byte, 5)
+	for i := range fields {
+		size, n := binary.Uvarint(buf)
+		if n <= 0 || uint64(len(buf)-n) < size {
+			return nil, ErrBadFormat
+		}
+		fields[i] = buf[n : n+int(size)]
+		buf = buf[n+int(size):]
+	}
+	e.Source = string(fields[0])
+	e.Method = string(fields[1])
+	e.Data = fields[2]
+	e.Result = fields[3]
+	e.Error = string(fields[4])
+	return e, nil
+}
@@ -0,0 +1,216 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package record 记录节点收到的区块, mempool交易以及jsonrpc请求, 用于在测试节点上重放问题现场
+// 1. 区块在 ProcAddBlockMsg 记录, 包括来源节点以及处理结果
+// 2. 交易在mempool收到时记录, 回复时补充检查结果
+// 3. jsonrpc记录请求和返回的json, 重放时比较返回值
+// 记录异步写入gzip压缩的文件, 写入队列满时丢弃, 不阻塞业务流程
+package record
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/33cn/chain33/common/log/log15"
+)
+
+var rlog = log.New("module", "record")
+
+const (
+	queueSize     = 4096
+	flushInterval = time.Second
+)
+
+// Config 记录配置
+type Config struct {
+	Enable bool `json:"enable,omitempty"`
+	// File 记录文件
+	File string `json:"file,omitempty"`
+	// Kinds 记录的类型 block, tx, rpc, 为空时记录全部
+	Kinds []string `json:"kinds,omitempty"`
+}
+
+// ErrUnknownKind 不支持的记录类型
+var ErrUnknownKind = errors.New("ErrUnknownRecordKind")
+
+// Kind 记录类型
+type Kind byte
+
+// 记录类型
+const (
+	KindBlock Kind = iota + 1
+	KindTx
+	KindRPC
+)
+
+var kindNames = map[Kind]string{KindBlock: "block", KindTx: "tx", KindRPC: "rpc"}
+
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("kind(%d)", k)
+}
+
+// ParseKind 解析记录类型名
+func ParseKind(name string) (Kind, error) {
+	for k, n := range kindNames {
+		if n == strings.TrimSpace(name) {
+			return k, nil
+		}
+	}
+	return 0, ErrUnknownKind
+}
+
+// 区块记录的Method
+const (
+	// MethodBroadcast 广播收到的区块
+	MethodBroadcast = "broadcast"
+	// MethodSync 同步下载的区块
+	MethodSync = "sync"
+)
+
+// Entry 一条记录
+type Entry struct {
+	// Time 收到的时间, 纳秒
+	Time int64
+	Kind Kind
+	// Source 区块的来源节点, rpc请求方的ip
+	Source string
+	// Method rpc的方法名, 区块为 broadcast 或者 sync
+	Method string
+	// Data 区块, 交易的protobuf编码, rpc请求的json
+	Data []byte
+	// Result rpc返回的json
+	Result []byte
+	// Error 处理结果的错误信息
+	Error string
+}
+
+type recorder struct {
+	kinds   map[Kind]bool
+	writer  *Writer
+	entries chan *Entry
+	done    chan struct{}
+	wg      sync.WaitGroup
+	dropped int64
+}
+
+var global atomic.Value
+
+func getRecorder() *recorder {
+	r, _ := global.Load().(*recorder)
+	return r
+}
+
+// Enabled 是否记录指定类型, 调用方在编码数据之前先判断
+func Enabled(kind Kind) bool {
+	r := getRecorder()
+	return r != nil && r.kinds[kind]
+}
+
+// Init 根据配置开始记录, 重复调用时关闭之前的记录文件
+func Init(cfg *Config) error {
+	if cfg == nil || !cfg.Enable {
+		return nil
+	}
+	kinds := make(map[Kind]bool)
+	for _, name := range cfg.Kinds {
+		k, err := ParseKind(name)
+		if err != nil {
+			return fmt.Errorf("%v: %s", err, name)
+		}
+		kinds[k] = true
+	}
+	if len(kinds) == 0 {
+		for k := range kindNames {
+			kinds[k] = true
+		}
+	}
+	w, err := Create(cfg.File)
+	if err != nil {
+		return err
+	}
+	r := &recorder{
+		kinds:   kinds,
+		writer:  w,
+		entries: make(chan *Entry, queueSize),
+		done:    make(chan struct{}),
+	}
+	r.wg.Add(1)
+	go r.run()
+	Close()
+	global.Store(r)
+	rlog.Info("record start", "file", cfg.File, "kinds", cfg.Kinds)
+	return nil
+}
+
+// Close 停止记录, 写入剩余的记录并关闭文件
+func Close() {
+	r := getRecorder()
+	if r == nil {
+		return
+	}
+	global.Store((*recorder)(nil))
+	close(r.done)
+	r.wg.Wait()
+	if err := r.writer.Close(); err != nil {
+		rlog.Error("record close", "err", err)
+	}
+}
+
+// Record 写入一条记录, 没有启用该类型时忽略
+func Record(e *Entry) {
+	r := getRecorder()
+	if r == nil || !r.kinds[e.Kind] {
+		return
+	}
+	if e.Time == 0 {
+		e.Time = time.Now().UnixNano()
+	}
+	select {
+	case r.entries <- e:
+	default:
+		if atomic.AddInt64(&r.dropped, 1)%1000 == 1 {
+			rlog.Error("record queue full, entry dropped", "dropped", atomic.LoadInt64(&r.dropped))
+		}
+	}
+}
+
+func (r *recorder) write(e *Entry) {
+	if err := r.writer.Write(e); err != nil {
+		rlog.Error("record write", "kind", e.Kind, "err", err)
+	}
+}
+
+// run 定时刷新到文件, 节点异常退出时最多丢失一个周期的记录
+func (r *recorder) run() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case e := <-r.entries:
+			r.write(e)
+		case <-ticker.C:
+			if err := r.writer.Flush(); err != nil {
+				rlog.Error("record flush", "err", err)
+			}
+		case <-r.done:
+			for {
+				select {
+				case e := <-r.entries:
+					r.write(e)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package record
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterReader(t *testing.T) {
+	entries := []*Entry{
+		{Time: 1, Kind: KindBlock, Source: "peer1", Method: MethodBroadcast, Data: []byte{1, 2, 3}},
+		{Time: 2, Kind: KindTx, Data: []byte{4}, Error: "ErrTxExist"},
+		{Time: 3, Kind: KindRPC, Source: "127.0.0.1", Method: "Chain33.GetLastHeader",
+			Data: []byte(`{"method":"Chain33.GetLastHeader"}`), Result: []byte(`{"result":{}}`)},
+	}
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf)
+	require.Nil(t, err)
+	for _, e := range entries {
+		require.Nil(t, w.Write(e))
+	}
+	require.Nil(t, w.Close())
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()))
+	require.Nil(t, err)
+	for _, e := range entries {
+		got, err := r.Next()
+		require.Nil(t, err)
+		if e.Result == nil {
+			e.Result = []byte{}
+		}
+		if len(e.Data) == 0 {
+			e.Data = []byte{}
+		}
+		assert.Equal(t, e, got)
+	}
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+
+	_, err = NewReader(bytes.NewReader([]byte("not gzip")))
+	assert.NotNil(t, err)
+}
+
+//TestTruncated 节点异常退出时, 已经刷新到文件的记录可以读出
+func TestTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf)
+	require.Nil(t, err)
+	require.Nil(t, w.Write(&Entry{Time: 1, Kind: KindTx, Data: []byte("tx1")}))
+	require.Nil(t, w.Flush())
+	size := buf.Len()
+	require.Nil(t, w.Write(&Entry{Time: 2, Kind: KindTx, Data: bytes.Repeat([]byte("x"), 1024)}))
+	require.Nil(t, w.Flush())
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()[:size+10]))
+	require.Nil(t, err)
+	e, err := r.Next()
+	require.Nil(t, err)
+	assert.Equal(t, "tx1", string(e.Data))
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestRecord(t *testing.T) {
+	dir, err := ioutil.TempDir("", "record")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "record.gz")
+
+	assert.False(t, Enabled(KindBlock))
+	Record(&Entry{Kind: KindBlock})
+	assert.NotNil(t, Init(&Config{Enable: true, File: file, Kinds: []string{"unknown"}}))
+	require.Nil(t, Init(&Config{Enable: true, File: file, Kinds: []string{"block", "rpc"}}))
+	assert.True(t, Enabled(KindBlock))
+	assert.False(t, Enabled(KindTx))
+	Record(&Entry{Kind: KindBlock, Data: []byte("block")})
+	Record(&Entry{Kind: KindTx, Data: []byte("tx")})
+	Record(&Entry{Kind: KindRPC, Method: "Chain33.Version"})
+	Close()
+	assert.False(t, Enabled(KindBlock))
+
+	entries, err := ReadFile(file)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, KindBlock, entries[0].Kind)
+	assert.Equal(t, "block", string(entries[0].Data))
+	assert.True(t, entries[0].Time > 0)
+	assert.Equal(t, "Chain33.Version", entries[1].Method)
+
+	k, err := ParseKind("tx")
+	assert.Nil(t, err)
+	assert.Equal(t, KindTx, k)
+	assert.Equal(t, "tx", k.String())
+}
@@ -0,0 +1,187 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// FileExporter 导出到本地文件, 每行一个json格式的span, 用于离线分析
+type FileExporter struct {
+	mu   sync.Mutex
+	file *os.File
+	w    *bufio.Writer
+}
+
+// NewFileExporter 以追加方式打开导出文件
+func NewFileExporter(path string) (*FileExporter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileExporter{file: file, w: bufio.NewWriter(file)}, nil
+}
+
+// Export 导出
+func (e *FileExporter) Export(spans []*SpanData) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	enc := json.NewEncoder(e.w)
+	for _, span := range spans {
+		if err := enc.Encode(span); err != nil {
+			return err
+		}
+	}
+	return e.w.Flush()
+}
+
+// Close 关闭
+func (e *FileExporter) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err := e.w.Flush(); err != nil {
+		return err
+	}
+	return e.file.Close()
+}
+
+// OTLPExporter 以OTLP/HTTP json格式导出到collector
+type OTLPExporter struct {
+	endpoint string
+	service  string
+	client   *http.Client
+}
+
+// NewOTLPExporter 创建OTLP导出器
+func NewOTLPExporter(endpoint, service string) *OTLPExporter {
+	if endpoint == "" {
+		endpoint = "http://localhost:4318/v1/traces"
+	}
+	if service == "" {
+		service = "chain33"
+	}
+	return &OTLPExporter{endpoint: endpoint, service: service, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpAttr struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID      string      `json:"traceId"`
+	SpanID       string      `json:"spanId"`
+	ParentSpanID string      `json:"parentSpanId,omitempty"`
+	Name         string      `json:"name"`
+	Kind         int         `json:"kind"`
+	Start        string      `json:"startTimeUnixNano"`
+	End          string      `json:"endTimeUnixNano"`
+	Attributes   []*otlpAttr `json:"attributes,omitempty"`
+	Status       otlpStatus  `json:"status"`
+}
+
+type otlpScopeSpans struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpResourceSpans struct {
+	Resource struct {
+		Attributes []*otlpAttr `json:"attributes"`
+	} `json:"resource"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpRequest struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+}
+
+const (
+	otlpKindInternal = 1
+	otlpStatusOk     = 1
+	otlpStatusError  = 2
+)
+
+func toAttrs(m map[string]string) []*otlpAttr {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	attrs := make([]*otlpAttr, len(keys))
+	for i, k := range keys {
+		attrs[i] = &otlpAttr{Key: k, Value: otlpValue{StringValue: m[k]}}
+	}
+	return attrs
+}
+
+func (e *OTLPExporter) encode(spans []*SpanData) ([]byte, error) {
+	scope := &otlpScopeSpans{}
+	scope.Scope.Name = "github.com/33cn/chain33"
+	for _, s := range spans {
+		span := &otlpSpan{
+			TraceID:      s.TraceID,
+			SpanID:       s.SpanID,
+			ParentSpanID: s.ParentID,
+			Name:         s.Name,
+			Kind:         otlpKindInternal,
+			Start:        strconv.FormatInt(s.Start.UnixNano(), 10),
+			End:          strconv.FormatInt(s.End.UnixNano(), 10),
+			Attributes:   toAttrs(s.Attributes),
+			Status:       otlpStatus{Code: otlpStatusOk},
+		}
+		if s.Error != "" {
+			span.Status = otlpStatus{Code: otlpStatusError, Message: s.Error}
+		}
+		scope.Spans = append(scope.Spans, span)
+	}
+	rs := &otlpResourceSpans{ScopeSpans: []*otlpScopeSpans{scope}}
+	rs.Resource.Attributes = toAttrs(map[string]string{"service.name": e.service})
+	return json.Marshal(&otlpRequest{ResourceSpans: []*otlpResourceSpans{rs}})
+}
+
+// Export 导出
+func (e *OTLPExporter) Export(spans []*SpanData) error {
+	data, err := e.encode(spans)
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("otlp export status %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// Close 关闭
+func (e *OTLPExporter) Close() error {
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package trace 轻量级的分布式追踪, 数据格式与OpenTelemetry兼容
+// 1. rpc请求入口创建根span, 根据采样率决定是否追踪, 也可以通过w3c traceparent头延续调用方的追踪
+// 2. span上下文随queue.Message在模块之间传递, 发送, 处理以及等待回复都会生成子span
+// 3. 结束的span批量导出到OTLP collector(http json格式)或者本地文件
+package trace
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Config 追踪配置
+type Config struct {
+	Enable bool `json:"enable,omitempty"`
+	// SampleRate 根span的采样率, 0到1之间, 0表示1
+	SampleRate float64 `json:"sampleRate,omitempty"`
+	// Exporter 导出方式, 支持otlp以及file
+	Exporter string `json:"exporter,omitempty"`
+	// Endpoint OTLP collector的http地址, 如 http://localhost:4318/v1/traces
+	Endpoint string `json:"endpoint,omitempty"`
+	// File 本地导出文件, 每行一个json格式的span
+	File string `json:"file,omitempty"`
+	// ServiceName 服务名称, 默认chain33
+	ServiceName string `json:"serviceName,omitempty"`
+	// TrustRemote 请求方通过traceparent传入的上下文不再按照本地采样率采样, 默认不信任
+	TrustRemote bool `json:"trustRemote,omitempty"`
+}
+
+var (
+	// ErrUnknownExporter 不支持的导出方式
+	ErrUnknownExporter = errors.New("ErrUnknownTraceExporter")
+	// ErrTraceParent traceparent格式错误
+	ErrTraceParent = errors.New("ErrTraceParent")
+)
+
+// TraceID 追踪ID
+type TraceID [16]byte
+
+// SpanID span ID
+type SpanID [8]byte
+
+// SpanContext 需要在模块之间传递的span上下文
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+}
+
+// IsValid 是否是有效的上下文, 无效表示不追踪
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != TraceID{} && sc.SpanID != SpanID{}
+}
+
+// TraceParent w3c traceparent格式
+func (sc SpanContext) TraceParent() string {
+	return fmt.Sprintf("00-%s-%s-01", hex.EncodeToString(sc.TraceID[:]), hex.EncodeToString(sc.SpanID[:]))
+}
+
+// ParseTraceParent 解析w3c traceparent, 未采样的上下文返回无效值
+func ParseTraceParent(s string) (SpanContext, error) {
+	var sc SpanContext
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) != 4 || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return sc, ErrTraceParent
+	}
+	if _, err := hex.Decode(sc.TraceID[:], []byte(parts[1])); err != nil {
+		return SpanContext{}, ErrTraceParent
+	}
+	if _, err := hex.Decode(sc.SpanID[:], []byte(parts[2])); err != nil {
+		return SpanContext{}, ErrTraceParent
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil || !sc.IsValid() {
+		return SpanContext{}, ErrTraceParent
+	}
+	if flags[0]&1 == 0 {
+		return SpanContext{}, nil
+	}
+	return sc, nil
+}
+
+// SpanData 结束后导出的span数据
+type SpanData struct {
+	TraceID    string            `json:"traceId"`
+	SpanID     string            `json:"spanId"`
+	ParentID   string            `json:"parentSpanId,omitempty"`
+	Name       string            `json:"name"`
+	Start      time.Time         `json:"start"`
+	End        time.Time         `json:"end"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// Span 一次操作的追踪记录, nil表示不追踪, 所有方法都可以在nil上调用
+type Span struct {
+	ctx    SpanContext
+	parent SpanID
+	data   SpanData
+	ended  int32
+}
+
+// Start 创建span, parent无效时作为根span并按照采样率决定是否追踪, 未启用或者未采样时返回nil
+func Start(name string, parent SpanContext) *Span {
+	t := getTracer()
+	if t == nil {
+		return nil
+	}
+	if !parent.IsValid() && !t.sample() {
+		return nil
+	}
+	return newSpan(name, parent, time.Now())
+}
+
+// StartRemote 延续请求方传入的上下文, 没有配置TrustRemote时仍然按照本地采样率决定是否追踪
+func StartRemote(name string, parent SpanContext) *Span {
+	t := getTracer()
+	if t == nil {
+		return nil
+	}
+	if !t.trustRemote && !t.sample() {
+		return nil
+	}
+	return newSpan(name, parent, time.Now())
+}
+
+// StartChild 在有效的上下文下创建子span, 上下文无效时不追踪
+func StartChild(name string, parent SpanContext) *Span {
+	if !parent.IsValid() {
+		return nil
+	}
+	return Start(name, parent)
+}
+
+// StartAt 指定开始时间创建子span, 用于事后记录
+func StartAt(name string, parent SpanContext, start time.Time) *Span {
+	if !parent.IsValid() || getTracer() == nil {
+		return nil
+	}
+	return newSpan(name, parent, start)
+}
+
+func newSpan(name string, parent SpanContext, start time.Time) *Span {
+	s := &Span{parent: parent.SpanID}
+	if parent.IsValid() {
+		s.ctx.TraceID = parent.TraceID
+	} else {
+		randRead(s.ctx.TraceID[:])
+	}
+	randRead(s.ctx.SpanID[:])
+	s.data.Name = name
+	s.data.Start = start
+	return s
+}
+
+func randRead(b []byte) {
+	_, err := rand.Read(b)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Context span上下文, 用于创建子span以及跨模块传递
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.ctx
+}
+
+// SetAttr 设置属性, 需要在End之前调用
+func (s *Span) SetAttr(key, value string) {
+	if s == nil {
+		return
+	}
+	if s.data.Attributes == nil {
+		s.data.Attributes = make(map[string]string)
+	}
+	s.data.Attributes[key] = value
+}
+
+// SetError 记录错误
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.data.Error = err.Error()
+}
+
+// End 结束span并提交导出, 重复调用只生效一次
+func (s *Span) End() {
+	if s == nil || !atomic.CompareAndSwapInt32(&s.ended, 0, 1) {
+		return
+	}
+	s.data.End = time.Now()
+	s.data.TraceID = hex.EncodeToString(s.ctx.TraceID[:])
+	s.data.SpanID = hex.EncodeToString(s.ctx.SpanID[:])
+	if s.parent != (SpanID{}) {
+		s.data.ParentID = hex.EncodeToString(s.parent[:])
+	}
+	if t := getTracer(); t != nil {
+		t.submit(&s.data)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memExporter struct {
+	mu    sync.Mutex
+	spans []*SpanData
+}
+
+func (e *memExporter) Export(spans []*SpanData) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *memExporter) Close() error { return nil }
+
+func TestTraceParent(t *testing.T) {
+	sc, err := ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.Nil(t, err)
+	assert.True(t, sc.IsValid())
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", sc.TraceParent())
+
+	//未采样
+	sc, err = ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	assert.Nil(t, err)
+	assert.False(t, sc.IsValid())
+
+	for _, s := range []string{"", "00-xx-00f067aa0ba902b7-01", "00-00000000000000000000000000000000-00f067aa0ba902b7-01"} {
+		_, err = ParseTraceParent(s)
+		assert.Equal(t, ErrTraceParent, err, s)
+	}
+}
+
+func TestSpan(t *testing.T) {
+	//未启用时不追踪
+	assert.Nil(t, Start("root", SpanContext{}))
+	var nilSpan *Span
+	nilSpan.SetAttr("k", "v")
+	nilSpan.End()
+
+	exp := &memExporter{}
+	require.Nil(t, InitWithExporter(exp, 1))
+	root := Start("root", SpanContext{})
+	require.NotNil(t, root)
+	child := StartChild("child", root.Context())
+	child.SetAttr("topic", "mempool")
+	child.SetError(errors.New("ErrTest"))
+	child.End()
+	child.End()
+	root.End()
+	assert.Nil(t, StartChild("none", SpanContext{}))
+	Close()
+	assert.False(t, Enabled())
+
+	require.Equal(t, 2, len(exp.spans))
+	c, r := exp.spans[0], exp.spans[1]
+	assert.Equal(t, "child", c.Name)
+	assert.Equal(t, r.TraceID, c.TraceID)
+	assert.Equal(t, r.SpanID, c.ParentID)
+	assert.Equal(t, "", r.ParentID)
+	assert.Equal(t, "mempool", c.Attributes["topic"])
+	assert.Equal(t, "ErrTest", c.Error)
+}
+
+func TestStartRemote(t *testing.T) {
+	remote := SpanContext{TraceID: TraceID{1}, SpanID: SpanID{1}}
+	//默认按照本地采样率决定是否延续请求方的追踪
+	require.Nil(t, InitWithExporter(&memExporter{}, 1e-12))
+	assert.Nil(t, StartRemote("rpc", remote))
+	Close()
+
+	start(&memExporter{}, 1e-12, true)
+	defer Close()
+	span := StartRemote("rpc", remote)
+	require.NotNil(t, span)
+	assert.Equal(t, remote.TraceID, span.Context().TraceID)
+	assert.Equal(t, remote.SpanID, span.parent)
+}
+
+func TestFileExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trace")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "trace.json")
+	require.Nil(t, Init(&Config{Enable: true, Exporter: "file", File: path}))
+	span := Start("root", SpanContext{})
+	StartChild("child", span.Context()).End()
+	span.End()
+	Close()
+
+	f, err := os.Open(path)
+	require.Nil(t, err)
+	defer f.Close()
+	var names []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var data SpanData
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &data))
+		names = append(names, data.Name)
+	}
+	assert.Equal(t, []string{"child", "root"}, names)
+
+	assert.Equal(t, ErrUnknownExporter, Init(&Config{Enable: true, Exporter: "jaeger"}))
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var req otlpRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+	}))
+	defer server.Close()
+
+	require.Nil(t, Init(&Config{Enable: true, Exporter: "otlp", Endpoint: server.URL, ServiceName: "node1"}))
+	span := Start("root", SpanContext{})
+	span.SetError(errors.New("ErrTest"))
+	span.End()
+	Close()
+
+	require.Equal(t, 1, len(req.ResourceSpans))
+	rs := req.ResourceSpans[0]
+	assert.Equal(t, "service.name", rs.Resource.Attributes[0].Key)
+	assert.Equal(t, "node1", rs.Resource.Attributes[0].Value.StringValue)
+	s := rs.ScopeSpans[0].Spans[0]
+	assert.Equal(t, "root", s.Name)
+	assert.Equal(t, 32, len(s.TraceID))
+	assert.Equal(t, otlpStatusError, s.Status.Code)
+	assert.Equal(t, "ErrTest", s.Status.Message)
+}
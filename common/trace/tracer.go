@@ -0,0 +1,163 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/33cn/chain33/common/log/log15"
+)
+
+var tlog = log.New("module", "trace")
+
+const (
+	queueSize     = 4096
+	batchSize     = 256
+	flushInterval = 2 * time.Second
+)
+
+// Exporter span导出接口
+type Exporter interface {
+	Export(spans []*SpanData) error
+	Close() error
+}
+
+type tracer struct {
+	sampleRate  float64
+	trustRemote bool
+	exporter    Exporter
+	spans       chan *SpanData
+	done        chan struct{}
+	wg          sync.WaitGroup
+	dropped     int64
+}
+
+var global atomic.Value
+
+func getTracer() *tracer {
+	t, _ := global.Load().(*tracer)
+	return t
+}
+
+// Enabled 是否启用了追踪
+func Enabled() bool {
+	return getTracer() != nil
+}
+
+// Init 根据配置启用追踪, 重复调用时关闭之前的导出器
+func Init(cfg *Config) error {
+	if cfg == nil || !cfg.Enable {
+		return nil
+	}
+	var exporter Exporter
+	var err error
+	switch cfg.Exporter {
+	case "otlp":
+		exporter = NewOTLPExporter(cfg.Endpoint, cfg.ServiceName)
+	case "file":
+		exporter, err = NewFileExporter(cfg.File)
+	default:
+		err = ErrUnknownExporter
+	}
+	if err != nil {
+		return err
+	}
+	start(exporter, cfg.SampleRate, cfg.TrustRemote)
+	return nil
+}
+
+// InitWithExporter 使用指定的导出器启用追踪
+func InitWithExporter(exporter Exporter, sampleRate float64) error {
+	start(exporter, sampleRate, false)
+	return nil
+}
+
+func start(exporter Exporter, sampleRate float64, trustRemote bool) {
+	if sampleRate <= 0 || sampleRate > 1 {
+		sampleRate = 1
+	}
+	t := &tracer{
+		sampleRate:  sampleRate,
+		trustRemote: trustRemote,
+		exporter:    exporter,
+		spans:       make(chan *SpanData, queueSize),
+		done:        make(chan struct{}),
+	}
+	t.wg.Add(1)
+	go t.run()
+	Close()
+	global.Store(t)
+	tlog.Info("trace start", "sampleRate", sampleRate, "trustRemote", trustRemote)
+}
+
+// Close 停止追踪并导出剩余的span
+func Close() {
+	t := getTracer()
+	if t == nil {
+		return
+	}
+	global.Store((*tracer)(nil))
+	close(t.done)
+	t.wg.Wait()
+	err := t.exporter.Close()
+	if err != nil {
+		tlog.Error("trace close", "err", err)
+	}
+}
+
+func (t *tracer) sample() bool {
+	return t.sampleRate >= 1 || rand.Float64() < t.sampleRate
+}
+
+// submit 导出队列满时丢弃, 不阻塞业务流程
+func (t *tracer) submit(span *SpanData) {
+	select {
+	case t.spans <- span:
+	default:
+		if atomic.AddInt64(&t.dropped, 1)%1000 == 1 {
+			tlog.Error("trace queue full, span dropped", "dropped", atomic.LoadInt64(&t.dropped))
+		}
+	}
+}
+
+func (t *tracer) run() {
+	defer t.wg.Done()
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	batch := make([]*SpanData, 0, batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := t.exporter.Export(batch); err != nil {
+			tlog.Error("trace export", "spans", len(batch), "err", err)
+		}
+		batch = make([]*SpanData, 0, batchSize)
+	}
+	for {
+		select {
+		case span := <-t.spans:
+			batch = append(batch, span)
+			if len(batch) >= batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-t.done:
+			for {
+				select {
+				case span := <-t.spans:
+					batch = append(batch, span)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/system/crypto/common/authority"
+	"github.com/33cn/chain33/system/crypto/common/authority/core"
+	mty "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// 按父区块状态哈希缓存的链上证书配置数量
+const certCacheSize = 128
+
+// newCertProvider 创建从链上状态读取证书配置的接口, 高度height的区块使用父区块执行后的状态
+// 执行区块时由区块上下文提供父区块的状态哈希, 同一区块中的交易共用缓存的配置, 侧链上的区块也使用自己的父状态
+func newCertProvider(api client.QueueProtocolAPI) authority.CertProvider {
+	cache, err := lru.New(certCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return func(height int64, parentState []byte) (*core.AuthConfig, error) {
+		if height <= 0 {
+			return nil, nil
+		}
+		if parentState == nil {
+			//没有区块上下文时(如mempool校验交易)使用主链上的父区块
+			headers, err := api.GetHeaders(&types.ReqBlocks{Start: height - 1, End: height - 1})
+			if err != nil {
+				return nil, err
+			}
+			if len(headers.GetItems()) != 1 {
+				return nil, types.ErrBlockNotFound
+			}
+			parentState = headers.Items[0].StateHash
+		}
+		if config, ok := cache.Get(string(parentState)); ok {
+			return config.(*core.AuthConfig), nil
+		}
+		list, err := mty.GetCertList(func(keys [][]byte) ([][]byte, error) {
+			reply, err := api.StoreGet(&types.StoreGet{StateHash: parentState, Keys: keys})
+			if err != nil {
+				return nil, err
+			}
+			return reply.GetValues(), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		config := list.ToAuthConfig()
+		cache.Add(string(parentState), config)
+		return config, nil
+	}
+}
@@ -210,6 +210,16 @@ func (e *executor) execCheckTx(tx *types.Transaction, index int) error {
 	} else {
 		exec = e.loadDriver(tx, index)
 	}
+	//账户序号检查, 序号大于链上序号的交易由mempool排队等待
+	if seq, ok := tx.GetSeqNonce(e.cfg, e.height); ok {
+		next, err := e.loadAccountSeq(tx.From())
+		if err != nil {
+			return err
+		}
+		if seq < next {
+			return types.ErrTxNonceTooLow
+		}
+	}
 	//手续费检查
 	if !exec.IsFree() && e.cfg.GetMinTxFeeRate() > 0 {
 		from := tx.From()
@@ -429,8 +439,35 @@ func (e *executor) loadFlag(key []byte) (int64, error) {
 	return 0, err
 }
 
+//loadAccountSeq 账户下一个可用的序号
+func (e *executor) loadAccountSeq(addr string) (int64, error) {
+	seq := &types.Int64{}
+	value, err := e.stateDB.Get(types.CalcAccountSeqKey(addr))
+	if err == types.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	err = types.Decode(value, seq)
+	if err != nil {
+		return 0, err
+	}
+	return seq.GetData(), nil
+}
+
 func (e *executor) execFee(tx *types.Transaction, index int) (*types.Receipt, error) {
 	feelog := &types.Receipt{Ty: types.ExecPack}
+	seq, seqMode := tx.GetSeqNonce(e.cfg, e.height)
+	if seqMode {
+		next, err := e.loadAccountSeq(tx.From())
+		if err != nil {
+			return nil, err
+		}
+		if seq != next {
+			return nil, types.ErrTxNonceNotMatch
+		}
+	}
 	execer := string(tx.Execer)
 	ex := e.loadDriver(tx, index)
 	//执行器名称 和  pubkey 相同，费用从内置的执行器中扣除,但是checkTx 中要过
@@ -449,6 +486,15 @@ func (e *executor) execFee(tx *types.Transaction, index int) (*types.Receipt, er
 			return nil, err
 		}
 	}
+	//账户序号和手续费一起更新, 交易执行失败序号也会增加
+	if seqMode {
+		kv := &types.KeyValue{Key: types.CalcAccountSeqKey(tx.From()), Value: types.Encode(&types.Int64{Data: seq + 1})}
+		err = e.stateDB.Set(kv.Key, kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		feelog.KV = append(feelog.KV, kv)
+	}
 	return feelog, nil
 }
 
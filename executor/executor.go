@@ -18,6 +18,7 @@ import (
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/pluginmgr"
 	"github.com/33cn/chain33/rpc/grpcclient"
+	"github.com/33cn/chain33/system/crypto/common/authority"
 	drivers "github.com/33cn/chain33/system/dapp"
 
 	// register drivers
@@ -111,6 +112,8 @@ func (exec *Executor) SetQueueClient(qcli queue.Client) {
 	}
 	types.AssertConfig(exec.client)
 	cfg := exec.client.GetConfig()
+	//证书签名使用链上管理的证书配置校验
+	authority.SetCertProvider(newCertProvider(exec.qclient))
 	if cfg.IsPara() {
 		exec.grpccli, err = grpcclient.NewMainChainClient(cfg, "")
 		if err != nil {
@@ -0,0 +1,83 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	go_metrics "github.com/rcrowley/go-metrics"
+)
+
+// enabled 只有在配置中启用metrics后才记录, 避免关键路径上不必要的开销
+var enabled int32
+
+// Enabled 是否启用了metrics
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+// SetEnabled 设置是否启用metrics
+func SetEnabled(on bool) {
+	if on {
+		atomic.StoreInt32(&enabled, 1)
+	} else {
+		atomic.StoreInt32(&enabled, 0)
+	}
+}
+
+// Name 生成带标签的指标名称, labels为key, value交替排列, 如 Name("chain33/queue/send", "topic", "mempool")
+func Name(name string, labels ...string) string {
+	if len(labels) < 2 {
+		return name
+	}
+	var b strings.Builder
+	b.WriteString(name)
+	b.WriteByte('{')
+	for i := 0; i+1 < len(labels); i += 2 {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(labels[i])
+		b.WriteByte('=')
+		b.WriteString(strconv.Quote(labels[i+1]))
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// UpdateTimerSince 记录从start开始的耗时
+func UpdateTimerSince(name string, start time.Time) {
+	if !Enabled() {
+		return
+	}
+	go_metrics.GetOrRegisterTimer(name, nil).UpdateSince(start)
+}
+
+// UpdateGauge 更新瞬时值
+func UpdateGauge(name string, v int64) {
+	if !Enabled() {
+		return
+	}
+	go_metrics.GetOrRegisterGauge(name, nil).Update(v)
+}
+
+// IncCounter 计数器增加n
+func IncCounter(name string, n int64) {
+	if !Enabled() {
+		return
+	}
+	go_metrics.GetOrRegisterCounter(name, nil).Inc(n)
+}
+
+// UpdateHistogram 记录数值分布
+func UpdateHistogram(name string, v int64) {
+	if !Enabled() {
+		return
+	}
+	go_metrics.GetOrRegisterHistogram(name, nil, go_metrics.NewExpDecaySample(1028, 0.015)).Update(v)
+}
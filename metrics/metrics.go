@@ -1,10 +1,12 @@
 package metrics
 
 import (
+	"net/http"
 	"time"
 
 	chain33log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/metrics/influxdb"
+	"github.com/33cn/chain33/metrics/prometheus"
 	"github.com/33cn/chain33/types"
 	go_metrics "github.com/rcrowley/go-metrics"
 )
@@ -19,6 +21,11 @@ type influxDBPara struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+type prometheusPara struct {
+	ListenAddr string `json:"listenAddr,omitempty"`
+	Path       string `json:"path,omitempty"`
+}
+
 var (
 	log = chain33log.New("module", "chain33 metrics")
 )
@@ -53,8 +60,24 @@ func StartMetrics(cfg *types.Chain33Config) {
 			influxdbcfg.Username,
 			influxdbcfg.Password,
 			"")
+	case "prometheus":
+		sub := cfg.GetSubConfig().Metrics
+		promcfg := prometheusPara{ListenAddr: "localhost:8866", Path: "/metrics"}
+		if subcfg, ok := sub[metrics.DataEmitMode]; ok {
+			types.MustDecode(subcfg, &promcfg)
+		}
+		log.Info("StartMetrics with prometheus", "listenAddr", promcfg.ListenAddr, "path", promcfg.Path)
+		mux := http.NewServeMux()
+		mux.Handle(promcfg.Path, prometheus.Handler(go_metrics.DefaultRegistry))
+		go func() {
+			err := http.ListenAndServe(promcfg.ListenAddr, mux)
+			if err != nil {
+				log.Error("StartMetrics", "prometheus listen err", err)
+			}
+		}()
 	default:
 		log.Error("startMetrics", "The dataEmitMode set is not supported now ", metrics.DataEmitMode)
 		return
 	}
+	SetEnabled(true)
 }
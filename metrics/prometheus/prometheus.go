@@ -0,0 +1,138 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package prometheus 将go-metrics中注册的指标以prometheus文本格式输出
+// 指标名称中的'/'等字符转换为'_', 名称后的{...}部分作为标签原样输出
+// 计时器以秒为单位输出为summary
+package prometheus
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var quantiles = []float64{0.5, 0.9, 0.99}
+
+type sample struct {
+	labels string
+	metric interface{}
+}
+
+// Handler prometheus拉取指标的http接口
+func Handler(reg metrics.Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		err := Write(w, reg)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// Write 按照prometheus文本格式输出全部指标, 同名指标按标签区分
+func Write(w io.Writer, reg metrics.Registry) error {
+	groups := make(map[string][]*sample)
+	reg.Each(func(name string, i interface{}) {
+		base, labels := splitName(name)
+		groups[base] = append(groups[base], &sample{labels: labels, metric: i})
+	})
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := bufio.NewWriter(w)
+	for _, name := range names {
+		samples := groups[name]
+		sort.Slice(samples, func(i, j int) bool { return samples[i].labels < samples[j].labels })
+		fmt.Fprintf(buf, "# TYPE %s %s\n", name, typeName(samples[0].metric))
+		for _, s := range samples {
+			writeSample(buf, name, s)
+		}
+	}
+	return buf.Flush()
+}
+
+func typeName(i interface{}) string {
+	switch i.(type) {
+	case metrics.Counter, metrics.Meter:
+		return "counter"
+	case metrics.Histogram, metrics.Timer:
+		return "summary"
+	default:
+		return "gauge"
+	}
+}
+
+func writeSample(w io.Writer, name string, s *sample) {
+	switch m := s.metric.(type) {
+	case metrics.Counter:
+		fmt.Fprintf(w, "%s%s %d\n", name, wrap(s.labels), m.Count())
+	case metrics.Meter:
+		fmt.Fprintf(w, "%s%s %d\n", name, wrap(s.labels), m.Count())
+	case metrics.Gauge:
+		fmt.Fprintf(w, "%s%s %d\n", name, wrap(s.labels), m.Value())
+	case metrics.GaugeFloat64:
+		fmt.Fprintf(w, "%s%s %g\n", name, wrap(s.labels), m.Value())
+	case metrics.Histogram:
+		ms := m.Snapshot()
+		writeSummary(w, name, s.labels, ms.Percentiles(quantiles), float64(ms.Sum()), ms.Count())
+	case metrics.Timer:
+		//计时器内部以纳秒记录
+		ms := m.Snapshot()
+		ps := ms.Percentiles(quantiles)
+		for i := range ps {
+			ps[i] /= 1e9
+		}
+		writeSummary(w, name, s.labels, ps, float64(ms.Sum())/1e9, ms.Count())
+	}
+}
+
+func writeSummary(w io.Writer, name, labels string, ps []float64, sum float64, count int64) {
+	for i, q := range quantiles {
+		fmt.Fprintf(w, "%s%s %g\n", name, wrap(join(labels, fmt.Sprintf(`quantile="%g"`, q))), ps[i])
+	}
+	fmt.Fprintf(w, "%s_sum%s %g\n", name, wrap(labels), sum)
+	fmt.Fprintf(w, "%s_count%s %d\n", name, wrap(labels), count)
+}
+
+// splitName 拆分指标名称和标签, 并把名称转换为prometheus合法的格式
+func splitName(name string) (string, string) {
+	var labels string
+	if i := strings.IndexByte(name, '{'); i >= 0 && strings.HasSuffix(name, "}") {
+		labels = name[i+1 : len(name)-1]
+		name = name[:i]
+	}
+	b := []byte(name)
+	for i, c := range b {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == ':') {
+			b[i] = '_'
+		}
+	}
+	if len(b) > 0 && b[0] >= '0' && b[0] <= '9' {
+		return "_" + string(b), labels
+	}
+	return string(b), labels
+}
+
+func join(labels, label string) string {
+	if labels == "" {
+		return label
+	}
+	return labels + "," + label
+}
+
+func wrap(labels string) string {
+	if labels == "" {
+		return ""
+	}
+	return "{" + labels + "}"
+}
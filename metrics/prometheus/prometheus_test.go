@@ -0,0 +1,59 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prometheus
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	reg := metrics.NewRegistry()
+	metrics.GetOrRegisterCounter(`chain33/mempool/reject{reason="ErrTxExpire"}`, reg).Inc(2)
+	metrics.GetOrRegisterCounter(`chain33/mempool/reject{reason="ErrDupTx"}`, reg).Inc(1)
+	metrics.GetOrRegisterGauge("chain33/mempool/size", reg).Update(10)
+	metrics.GetOrRegisterTimer(`chain33/queue/send{topic="mempool"}`, reg).Update(2 * time.Second)
+
+	var buf bytes.Buffer
+	require.Nil(t, Write(&buf, reg))
+	out := buf.String()
+	expected := []string{
+		"# TYPE chain33_mempool_reject counter\n" +
+			`chain33_mempool_reject{reason="ErrDupTx"} 1` + "\n" +
+			`chain33_mempool_reject{reason="ErrTxExpire"} 2` + "\n",
+		"# TYPE chain33_mempool_size gauge\nchain33_mempool_size 10\n",
+		"# TYPE chain33_queue_send summary\n",
+		`chain33_queue_send{topic="mempool",quantile="0.5"} 2` + "\n",
+		`chain33_queue_send_sum{topic="mempool"} 2` + "\n",
+		`chain33_queue_send_count{topic="mempool"} 1` + "\n",
+	}
+	for _, e := range expected {
+		assert.True(t, strings.Contains(out, e), e)
+	}
+	//按名称排序
+	assert.True(t, strings.Index(out, "chain33_mempool_reject") < strings.Index(out, "chain33_queue_send"))
+
+	rec := httptest.NewRecorder()
+	Handler(reg).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Equal(t, out, rec.Body.String())
+}
+
+func TestSplitName(t *testing.T) {
+	name, labels := splitName(`leveldb/compact/time`)
+	assert.Equal(t, "leveldb_compact_time", name)
+	assert.Equal(t, "", labels)
+	name, labels = splitName(`p2p/bandwidth.in{protocol="/chain33/v1"}`)
+	assert.Equal(t, "p2p_bandwidth_in", name)
+	assert.Equal(t, `protocol="/chain33/v1"`, labels)
+	name, _ = splitName("1m")
+	assert.Equal(t, "_1m", name)
+}
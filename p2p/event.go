@@ -25,6 +25,8 @@ func (mgr *Manager) handleSysEvent() {
 
 		case types.EventTxBroadcast, types.EventBlockBroadcast: //广播
 			mgr.pub2All(msg)
+		case types.EventConfigReloaded: //配置热加载
+			mgr.pub2All(msg)
 		case types.EventFetchBlocks, types.EventGetMempool, types.EventFetchBlockHeaders:
 			mgr.pub2P2P(msg, mgr.p2pCfg.Types[0])
 		case types.EventPeerInfo:
@@ -12,6 +12,7 @@ import (
 
 	"unsafe"
 
+	"github.com/33cn/chain33/common/trace"
 	"github.com/33cn/chain33/types"
 )
 
@@ -97,11 +98,27 @@ func (client *client) SendTimeout(msg *Message, waitReply bool, timeout time.Dur
 	if client.isClose() {
 		return ErrIsQueueClosed
 	}
+	var span *trace.Span
+	if msg.traceCtx.IsValid() {
+		span = trace.StartChild(msg.spanName("send"), msg.traceCtx)
+		msg.traceCtx = span.Context()
+	}
 	if !waitReply {
 		//msg.chReply = nil
-		return client.q.sendLowTimeout(msg, timeout)
+		err = client.q.sendLowTimeout(msg, timeout)
+		span.SetError(err)
+		span.End()
+		return err
+	}
+	err = client.q.send(msg, timeout)
+	if err != nil {
+		span.SetError(err)
+		span.End()
+		return err
 	}
-	return client.q.send(msg, timeout)
+	//等待回复时结束
+	msg.sendSpan = span
+	return nil
 }
 
 //系统设计出两种优先级别的消息发送
@@ -116,6 +133,11 @@ func (client *client) NewMessage(topic string, ty int64, data interface{}) (msg
 	msg.Ty = ty
 	msg.Data = data
 	msg.Topic = topic
+	msg.traceCtx = trace.SpanContext{}
+	msg.sendSpan = nil
+	msg.recvTime = time.Time{}
+	msg.priority = PriorityAuto
+	msg.sendTime = time.Time{}
 	return
 }
 
@@ -126,6 +148,8 @@ func (client *client) FreeMessage(msgs ...*Message) {
 			continue
 		}
 		msg.Data = nil
+		msg.traceCtx = trace.SpanContext{}
+		msg.sendSpan = nil
 		client.q.msgPool.Put(msg)
 	}
 }
@@ -145,6 +169,9 @@ func (client *client) WaitTimeout(msg *Message, timeout time.Duration) (*Message
 	if msg.chReply == nil {
 		return &Message{}, errors.New("empty wait channel")
 	}
+	span := msg.sendSpan
+	msg.sendSpan = nil
+	defer span.End()
 
 	var t <-chan time.Time
 	if timeout > 0 {
@@ -154,10 +181,12 @@ func (client *client) WaitTimeout(msg *Message, timeout time.Duration) (*Message
 	}
 	select {
 	case msg = <-msg.chReply:
+		span.SetError(msg.Err())
 		return msg, msg.Err()
 	case <-client.done:
 		return &Message{}, ErrIsQueueClosed
 	case <-t:
+		span.SetError(ErrQueueTimeout)
 		return &Message{}, ErrQueueTimeout
 	}
 }
@@ -236,6 +265,14 @@ func (client *client) isEnd(data *Message, ok bool) bool {
 	return false
 }
 
+// deliver 投递给订阅者, 记录追踪消息的接收时间
+func (client *client) deliver(msg *Message) {
+	if msg.traceCtx.IsValid() {
+		msg.recvTime = time.Now()
+	}
+	client.Recv() <- msg
+}
+
 // Sub 订阅消息类型
 func (client *client) Sub(topic string) {
 	//正在关闭或者已经关闭
@@ -245,37 +282,57 @@ func (client *client) Sub(topic string) {
 	client.wg.Add(1)
 	client.setTopic(topic)
 	sub := client.q.chanSub(topic)
+	critical, normal := sub.lanes[laneCritical], sub.lanes[laneNormal]
+	async, background := sub.lanes[laneAsync], sub.lanes[laneBackground]
 	go func() {
 		defer func() {
 			client.wg.Done()
 		}()
 		for {
+			var data *Message
+			var ok bool
+			var lane int
+			//依次尝试从高优先级的通道取消息, 都没有时等待任意通道
 			select {
-			case data, ok := <-sub.high:
-				if client.isEnd(data, ok) {
-					qlog.Info("unsub1", "topic", topic)
-					return
-				}
-				client.Recv() <- data
+			case data, ok = <-critical:
+				lane = laneCritical
 			default:
 				select {
-				case data, ok := <-sub.high:
-					if client.isEnd(data, ok) {
-						qlog.Info("unsub2", "topic", topic)
-						return
+				case data, ok = <-critical:
+					lane = laneCritical
+				case data, ok = <-normal:
+					lane = laneNormal
+				default:
+					select {
+					case data, ok = <-critical:
+						lane = laneCritical
+					case data, ok = <-normal:
+						lane = laneNormal
+					case data, ok = <-async:
+						lane = laneAsync
+					default:
+						select {
+						case data, ok = <-critical:
+							lane = laneCritical
+						case data, ok = <-normal:
+							lane = laneNormal
+						case data, ok = <-async:
+							lane = laneAsync
+						case data, ok = <-background:
+							lane = laneBackground
+						case <-client.done:
+							qlog.Error("unsub", "topic", topic)
+							return
+						}
 					}
-					client.Recv() <- data
-				case data, ok := <-sub.low:
-					if client.isEnd(data, ok) {
-						qlog.Info("unsub3", "topic", topic)
-						return
-					}
-					client.Recv() <- data
-				case <-client.done:
-					qlog.Error("unsub4", "topic", topic)
-					return
 				}
 			}
+			if client.isEnd(data, ok) {
+				qlog.Info("unsub", "topic", topic, "lane", laneNames[lane])
+				return
+			}
+			sub.stats[lane].onRecv(data)
+			client.deliver(data)
 		}
 	}()
 }
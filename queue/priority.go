@@ -0,0 +1,189 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue
+
+import (
+	"errors"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/types"
+)
+
+//每个topic按照优先级分为多个通道, 订阅者总是优先取高优先级通道中的消息
+//1. critical 区块添加, 执行, 存储提交等共识关键路径上的消息
+//2. normal 默认的同步消息
+//3. async 不需要等待回复的异步消息
+//4. background rpc查询等后台消息, 队列繁忙时最先被拒绝
+
+// Priority 消息优先级
+type Priority int32
+
+// 消息优先级, PriorityAuto根据事件类型决定
+const (
+	PriorityAuto Priority = iota
+	PriorityCritical
+	PriorityNormal
+	PriorityBackground
+)
+
+const (
+	laneCritical = iota
+	laneNormal
+	laneAsync
+	laneBackground
+	laneCount
+)
+
+var laneNames = [laneCount]string{"critical", "normal", "async", "background"}
+
+const (
+	criticalChanBuffer   = 1024
+	backgroundChanBuffer = 1024
+)
+
+// ErrQueueOverload 队列繁忙, 后台消息被拒绝
+var ErrQueueOverload = errors.New("ErrQueueOverload")
+
+// eventPriority 事件类型的默认优先级
+var eventPriority = map[int64]Priority{
+	types.EventAddBlock:                PriorityCritical,
+	types.EventAddBlockDetail:          PriorityCritical,
+	types.EventBroadcastAddBlock:       PriorityCritical,
+	types.EventAddParaChainBlockDetail: PriorityCritical,
+	types.EventDelParaChainBlockDetail: PriorityCritical,
+	types.EventDelBlock:                PriorityCritical,
+	types.EventCheckBlock:              PriorityCritical,
+	types.EventCmpBestBlock:            PriorityCritical,
+	types.EventExecTxList:              PriorityCritical,
+	types.EventTxList:                  PriorityCritical,
+	types.EventDelTxList:               PriorityCritical,
+	types.EventStoreSet:                PriorityCritical,
+	types.EventStoreMemSet:             PriorityCritical,
+	types.EventStoreCommit:             PriorityCritical,
+	types.EventStoreRollback:           PriorityCritical,
+}
+
+// SetEventPriority 设置事件类型的默认优先级, 只能在模块初始化时调用
+func SetEventPriority(ty int64, p Priority) {
+	eventPriority[ty] = p
+}
+
+// SetPriority 设置消息的优先级, 覆盖事件类型的默认优先级
+func (msg *Message) SetPriority(p Priority) {
+	msg.priority = p
+}
+
+func (msg *Message) lane() int {
+	p := msg.priority
+	if p == PriorityAuto {
+		p = eventPriority[msg.Ty]
+	}
+	switch p {
+	case PriorityCritical:
+		return laneCritical
+	case PriorityBackground:
+		return laneBackground
+	default:
+		return laneNormal
+	}
+}
+
+type laneStats struct {
+	sent     int64
+	rejected int64
+	received int64
+	waitSum  int64
+	waitMax  int64
+}
+
+func (s *laneStats) onSent() {
+	atomic.AddInt64(&s.sent, 1)
+}
+
+func (s *laneStats) onReject() {
+	atomic.AddInt64(&s.rejected, 1)
+}
+
+func (s *laneStats) onRecv(msg *Message) {
+	if msg.sendTime.IsZero() {
+		return
+	}
+	wait := int64(time.Since(msg.sendTime))
+	atomic.AddInt64(&s.received, 1)
+	atomic.AddInt64(&s.waitSum, wait)
+	for {
+		max := atomic.LoadInt64(&s.waitMax)
+		if wait <= max || atomic.CompareAndSwapInt64(&s.waitMax, max, wait) {
+			return
+		}
+	}
+}
+
+// overloaded 高优先级通道积压超过一半时拒绝后台消息
+func (sub *chanSub) overloaded() bool {
+	critical, normal := sub.lanes[laneCritical], sub.lanes[laneNormal]
+	return len(critical) >= cap(critical)/2 || len(normal) >= cap(normal)/2
+}
+
+func (sub *chanSub) sendBackground(msg *Message) error {
+	stats := &sub.stats[laneBackground]
+	if sub.overloaded() {
+		stats.onReject()
+		return ErrQueueOverload
+	}
+	msg.sendTime = time.Now()
+	select {
+	case sub.lanes[laneBackground] <- msg:
+		stats.onSent()
+		return nil
+	default:
+		stats.onReject()
+		return ErrQueueOverload
+	}
+}
+
+func (sub *chanSub) topicStats(topic string) *types.QueueTopicStats {
+	ts := &types.QueueTopicStats{Topic: topic}
+	for i := range sub.lanes {
+		s := &sub.stats[i]
+		lane := &types.QueueLaneStats{
+			Lane:      laneNames[i],
+			Depth:     int64(len(sub.lanes[i])),
+			Capacity:  int64(cap(sub.lanes[i])),
+			Sent:      atomic.LoadInt64(&s.sent),
+			Rejected:  atomic.LoadInt64(&s.rejected),
+			MaxWaitMs: atomic.LoadInt64(&s.waitMax) / int64(time.Millisecond),
+		}
+		if received := atomic.LoadInt64(&s.received); received > 0 {
+			lane.AvgWaitMs = atomic.LoadInt64(&s.waitSum) / received / int64(time.Millisecond)
+		}
+		ts.Lanes = append(ts.Lanes, lane)
+	}
+	return ts
+}
+
+func (q *queue) stats() *types.QueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	stats := &types.QueueStats{}
+	for topic, sub := range q.chanSubs {
+		if sub.isClose == 1 {
+			continue
+		}
+		stats.Topics = append(stats.Topics, sub.topicStats(topic))
+	}
+	sort.Slice(stats.Topics, func(i, j int) bool { return stats.Topics[i].Topic < stats.Topics[j].Topic })
+	return stats
+}
+
+// Stats 获取消息队列各个topic的通道统计, client不是消息队列创建的客户端时返回nil
+func Stats(c Client) *types.QueueStats {
+	if cli, ok := c.(*client); ok {
+		return cli.q.stats()
+	}
+	return nil
+}
@@ -15,6 +15,8 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/33cn/chain33/common/trace"
+	"github.com/33cn/chain33/metrics"
 	"github.com/33cn/chain33/types"
 
 	log "github.com/33cn/chain33/common/log/log15"
@@ -47,9 +49,12 @@ func DisableLog() {
 }
 
 type chanSub struct {
-	high    chan *Message
-	low     chan *Message
+	//按照优先级从高到低排列的消息通道
+	lanes   [laneCount]chan *Message
+	stats   [laneCount]laneStats
 	isClose int32
+	//发送消息耗时的指标名称
+	sendMetric string
 }
 
 // Queue only one obj in project
@@ -162,8 +167,8 @@ func (q *queue) Close() {
 	q.mu.Lock()
 	for topic, ch := range q.chanSubs {
 		if ch.isClose == 0 {
-			ch.high <- &Message{}
-			ch.low <- &Message{}
+			ch.lanes[laneNormal] <- &Message{}
+			ch.lanes[laneAsync] <- &Message{}
 			q.chanSubs[topic] = &chanSub{isClose: 1}
 		}
 	}
@@ -180,9 +185,14 @@ func (q *queue) chanSub(topic string) *chanSub {
 	_, ok := q.chanSubs[topic]
 	if !ok {
 		q.chanSubs[topic] = &chanSub{
-			high:    make(chan *Message, defaultChanBuffer),
-			low:     make(chan *Message, defaultLowChanBuffer),
-			isClose: 0,
+			lanes: [laneCount]chan *Message{
+				laneCritical:   make(chan *Message, criticalChanBuffer),
+				laneNormal:     make(chan *Message, defaultChanBuffer),
+				laneAsync:      make(chan *Message, defaultLowChanBuffer),
+				laneBackground: make(chan *Message, backgroundChanBuffer),
+			},
+			isClose:    0,
+			sendMetric: metrics.Name("chain33/queue/send", "topic", topic),
 		}
 	}
 	return q.chanSubs[topic]
@@ -196,8 +206,8 @@ func (q *queue) closeTopic(topic string) {
 		return
 	}
 	if sub.isClose == 0 {
-		sub.high <- &Message{}
-		sub.low <- &Message{}
+		sub.lanes[laneNormal] <- &Message{}
+		sub.lanes[laneAsync] <- &Message{}
 	}
 	q.chanSubs[topic] = &chanSub{isClose: 1}
 }
@@ -210,8 +220,26 @@ func (q *queue) send(msg *Message, timeout time.Duration) (err error) {
 	if sub.isClose == 1 {
 		return types.ErrChannelClosed
 	}
+	defer metrics.UpdateTimerSince(sub.sendMetric, time.Now())
+	lane := msg.lane()
+	if lane == laneBackground {
+		err = sub.sendBackground(msg)
+		if err != nil {
+			qlog.Debug("send overload", "msg", msg, "topic", msg.Topic)
+		}
+		return err
+	}
+	return sub.sendLane(msg, lane, timeout)
+}
+
+// sendLane 发送到指定的通道, timeout为-1时一直等待, 为0时通道满立即返回
+func (sub *chanSub) sendLane(msg *Message, lane int, timeout time.Duration) (err error) {
+	ch := sub.lanes[lane]
+	stats := &sub.stats[lane]
+	msg.sendTime = time.Now()
 	if timeout == -1 {
-		sub.high <- msg
+		ch <- msg
+		stats.onSent()
 		return nil
 	}
 	defer func() {
@@ -222,39 +250,30 @@ func (q *queue) send(msg *Message, timeout time.Duration) (err error) {
 	}()
 	if timeout == 0 {
 		select {
-		case sub.high <- msg:
+		case ch <- msg:
+			stats.onSent()
 			return nil
 		default:
-			qlog.Error("send chainfull", "msg", msg, "topic", msg.Topic, "sub", sub)
+			stats.onReject()
+			qlog.Error("send chainfull", "msg", msg, "topic", msg.Topic, "lane", laneNames[lane])
 			return ErrQueueChannelFull
 		}
 	}
 	t := time.NewTimer(timeout)
 	defer t.Stop()
 	select {
-	case sub.high <- msg:
+	case ch <- msg:
+		stats.onSent()
 	case <-t.C:
-		qlog.Error("send timeout", "msg", msg, "topic", msg.Topic, "sub", sub)
+		stats.onReject()
+		qlog.Error("send timeout", "msg", msg, "topic", msg.Topic, "lane", laneNames[lane])
 		return ErrQueueTimeout
 	}
 	return nil
 }
 
 func (q *queue) sendAsyn(msg *Message) error {
-	if q.isClosed() {
-		return types.ErrChannelClosed
-	}
-	sub := q.chanSub(msg.Topic)
-	if sub.isClose == 1 {
-		return types.ErrChannelClosed
-	}
-	select {
-	case sub.low <- msg:
-		return nil
-	default:
-		qlog.Error("send asyn err", "msg", msg, "err", ErrQueueChannelFull)
-		return ErrQueueChannelFull
-	}
+	return q.sendLowTimeout(msg, 0)
 }
 
 func (q *queue) sendLowTimeout(msg *Message, timeout time.Duration) error {
@@ -265,22 +284,14 @@ func (q *queue) sendLowTimeout(msg *Message, timeout time.Duration) error {
 	if sub.isClose == 1 {
 		return types.ErrChannelClosed
 	}
-	if timeout == -1 {
-		sub.low <- msg
-		return nil
-	}
-	if timeout == 0 {
-		return q.sendAsyn(msg)
-	}
-	t := time.NewTimer(timeout)
-	defer t.Stop()
-	select {
-	case sub.low <- msg:
-		return nil
-	case <-t.C:
-		qlog.Error("send asyn timeout", "msg", msg)
-		return ErrQueueTimeout
+	lane := msg.lane()
+	switch lane {
+	case laneBackground:
+		return sub.sendBackground(msg)
+	case laneNormal:
+		lane = laneAsync
 	}
+	return sub.sendLane(msg, lane, timeout)
 }
 
 // Client new client
@@ -296,6 +307,12 @@ type Message struct {
 	Data     interface{}
 	chReply  chan *Message
 	callback func(msg *Message)
+	//追踪上下文, 未追踪时无效
+	traceCtx trace.SpanContext
+	sendSpan *trace.Span
+	recvTime time.Time
+	priority Priority
+	sendTime time.Time
 }
 
 // NewMessage new message
@@ -336,8 +353,49 @@ func (msg *Message) Err() error {
 	return nil
 }
 
+// SetTrace 设置消息的追踪上下文, 模块内发出的消息延续收到的消息的追踪
+func (msg *Message) SetTrace(sc trace.SpanContext) {
+	msg.traceCtx = sc
+}
+
+// Trace 消息的追踪上下文
+func (msg *Message) Trace() trace.SpanContext {
+	return msg.traceCtx
+}
+
+// tracedClient 创建的消息都设置相同的追踪上下文
+type tracedClient struct {
+	Client
+	sc trace.SpanContext
+}
+
+func (c *tracedClient) NewMessage(topic string, ty int64, data interface{}) *Message {
+	msg := c.Client.NewMessage(topic, ty, data)
+	msg.SetTrace(c.sc)
+	return msg
+}
+
+// WithTrace 返回创建的消息都延续sc追踪的client, 用于处理收到的消息时发出的消息, sc无效时返回原client
+func WithTrace(client Client, sc trace.SpanContext) Client {
+	if !sc.IsValid() {
+		return client
+	}
+	return &tracedClient{Client: client, sc: sc}
+}
+
+func (msg *Message) spanName(action string) string {
+	return action + " " + msg.Topic + "." + types.GetEventName(int(msg.Ty))
+}
+
 // Reply reply message to reply chan
 func (msg *Message) Reply(replyMsg *Message) {
+	if msg.traceCtx.IsValid() && !msg.recvTime.IsZero() {
+		//记录从订阅者收到消息到回复的处理时间
+		span := trace.StartAt(msg.spanName("handle"), msg.traceCtx, msg.recvTime)
+		span.SetError(replyMsg.Err())
+		span.End()
+		replyMsg.traceCtx = msg.traceCtx
+	}
 	if msg.chReply == nil {
 		qlog.Debug("reply a empty chreply", "msg", msg)
 		return
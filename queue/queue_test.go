@@ -6,9 +6,11 @@ package queue
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/33cn/chain33/common/trace"
 	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -284,7 +286,7 @@ func TestChanSubCallback(t *testing.T) {
 			msg := NewMessageCallback(1, "", 0, nil, func(msg *Message) {
 				done <- struct{}{}
 			})
-			sub.high <- msg
+			sub.lanes[laneNormal] <- msg
 		}
 	}()
 	for i := 0; i < 1025; i++ {
@@ -390,7 +392,7 @@ func BenchmarkChanSub2(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			sub := q.(*queue).chanSub("hello")
 			msg := NewMessage(1, "", 0, nil)
-			sub.high <- msg
+			sub.lanes[laneNormal] <- msg
 			_, err := client.Wait(msg)
 			if err != nil {
 				b.Fatal(err)
@@ -412,7 +414,7 @@ func BenchmarkChanSub3(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			sub := q.(*queue).chanSub("hello")
 			msg := NewMessage(1, "", 0, nil)
-			sub.high <- msg
+			sub.lanes[laneNormal] <- msg
 		}
 	}()
 	for i := 0; i < b.N; i++ {
@@ -430,7 +432,7 @@ func BenchmarkChanSub4(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			sub := q.(*queue).chanSub("hello")
 			msg := &Message{ID: 1}
-			sub.high <- msg
+			sub.lanes[laneNormal] <- msg
 		}
 	}()
 	for i := 0; i < b.N; i++ {
@@ -449,7 +451,7 @@ func BenchmarkChanSubCallback(b *testing.B) {
 			msg := NewMessageCallback(1, "", 0, nil, func(msg *Message) {
 				done <- struct{}{}
 			})
-			sub.high <- msg
+			sub.lanes[laneNormal] <- msg
 		}
 	}()
 	go func() {
@@ -474,7 +476,7 @@ func BenchmarkChanSubCallback2(b *testing.B) {
 			msg := NewMessageCallback(1, "", 0, nil, func(msg *Message) {
 				done <- struct{}{}
 			})
-			sub.high <- msg
+			sub.lanes[laneNormal] <- msg
 			<-done
 		}
 	}()
@@ -533,3 +535,142 @@ func TestChannelClose(t *testing.T) {
 		}
 	}
 }
+
+type memExporter struct {
+	mu    sync.Mutex
+	spans []*trace.SpanData
+}
+
+func (e *memExporter) Export(spans []*trace.SpanData) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *memExporter) Close() error { return nil }
+
+func TestTrace(t *testing.T) {
+	exp := &memExporter{}
+	assert.Nil(t, trace.InitWithExporter(exp, 1))
+	q := New("channel")
+	go func() {
+		client := q.Client()
+		client.Sub("execs")
+		for msg := range client.Recv() {
+			msg.Reply(client.NewMessage("", types.EventReply, &types.Reply{IsOk: true}))
+		}
+	}()
+	go func() {
+		client := q.Client()
+		client.Sub("mempool")
+		for msg := range client.Recv() {
+			//处理过程中发出的消息延续收到的消息的追踪
+			check := WithTrace(client, msg.Trace()).NewMessage("execs", types.EventCheckTx, nil)
+			assert.Nil(t, client.Send(check, true))
+			_, err := client.Wait(check)
+			assert.Nil(t, err)
+			msg.Reply(client.NewMessage("", types.EventReply, types.ErrNotSync))
+		}
+	}()
+
+	root := trace.Start("rpc", trace.SpanContext{})
+	client := q.Client()
+	msg := client.NewMessage("mempool", types.EventTx, nil)
+	msg.SetTrace(root.Context())
+	assert.Nil(t, client.Send(msg, true))
+	_, err := client.Wait(msg)
+	assert.Equal(t, types.ErrNotSync, err)
+	root.End()
+	//未追踪的消息不产生span
+	msg = client.NewMessage("execs", types.EventCheckTx, nil)
+	assert.Nil(t, client.Send(msg, true))
+	_, err = client.Wait(msg)
+	assert.Nil(t, err)
+	trace.Close()
+
+	spans := make(map[string]*trace.SpanData)
+	for _, span := range exp.spans {
+		spans[span.Name] = span
+	}
+	assert.Equal(t, 5, len(exp.spans))
+	rootSpan := spans["rpc"]
+	sendTx := spans["send mempool.EventTx"]
+	handleTx := spans["handle mempool.EventTx"]
+	sendCheck := spans["send execs.EventCheckTx"]
+	handleCheck := spans["handle execs.EventCheckTx"]
+	for _, span := range []*trace.SpanData{sendTx, handleTx, sendCheck, handleCheck} {
+		assert.NotNil(t, span)
+		assert.Equal(t, rootSpan.TraceID, span.TraceID)
+	}
+	assert.Equal(t, rootSpan.SpanID, sendTx.ParentID)
+	assert.Equal(t, sendTx.SpanID, handleTx.ParentID)
+	assert.Equal(t, sendTx.SpanID, sendCheck.ParentID)
+	assert.Equal(t, sendCheck.SpanID, handleCheck.ParentID)
+	assert.Equal(t, types.ErrNotSync.Error(), handleTx.Error)
+	assert.Equal(t, types.ErrNotSync.Error(), sendTx.Error)
+}
+
+func TestPriorityLanes(t *testing.T) {
+	q := New("channel")
+	sub := q.(*queue).chanSub("lanes")
+	client := q.Client()
+	//订阅前放入消息, 订阅后按照优先级取出
+	msgs := []*Message{
+		client.NewMessage("lanes", types.EventGetBlocks, nil),
+		client.NewMessage("lanes", types.EventTx, nil),
+		client.NewMessage("lanes", types.EventAddBlock, nil),
+	}
+	msgs[0].SetPriority(PriorityBackground)
+	for _, msg := range msgs {
+		assert.Nil(t, client.Send(msg, true))
+	}
+	assert.Equal(t, 1, len(sub.lanes[laneCritical]))
+	assert.Equal(t, 1, len(sub.lanes[laneNormal]))
+	assert.Equal(t, 1, len(sub.lanes[laneBackground]))
+	client.Sub("lanes")
+	var tys []int64
+	for i := 0; i < len(msgs); i++ {
+		msg := <-client.Recv()
+		tys = append(tys, msg.Ty)
+	}
+	assert.Equal(t, []int64{types.EventAddBlock, types.EventTx, types.EventGetBlocks}, tys)
+
+	stats := Stats(client)
+	assert.Equal(t, 1, len(stats.Topics))
+	assert.Equal(t, "lanes", stats.Topics[0].Topic)
+	assert.Equal(t, int(laneCount), len(stats.Topics[0].Lanes))
+	for _, lane := range stats.Topics[0].Lanes {
+		if lane.Lane == "async" {
+			continue
+		}
+		assert.Equal(t, int64(1), lane.Sent, lane.Lane)
+		assert.Equal(t, int64(0), lane.Depth, lane.Lane)
+	}
+	assert.Nil(t, Stats(nil))
+	client.Close()
+}
+
+func TestBackgroundShedding(t *testing.T) {
+	q := New("channel")
+	sub := q.(*queue).chanSub("shed")
+	client := q.Client()
+	//普通通道积压超过一半时后台消息被拒绝, 普通消息依然可以发送
+	for i := 0; i < defaultChanBuffer/2; i++ {
+		assert.Nil(t, client.Send(client.NewMessage("shed", types.EventTx, nil), true))
+	}
+	msg := client.NewMessage("shed", types.EventGetBlocks, nil)
+	msg.SetPriority(PriorityBackground)
+	assert.Equal(t, ErrQueueOverload, client.Send(msg, true))
+	assert.Nil(t, client.Send(client.NewMessage("shed", types.EventTx, nil), true))
+	assert.Equal(t, int64(1), sub.stats[laneBackground].rejected)
+
+	//积压消费后后台消息恢复
+	client.Sub("shed")
+	for i := 0; i < defaultChanBuffer/2+1; i++ {
+		<-client.Recv()
+	}
+	assert.Nil(t, client.Send(msg, true))
+	assert.Equal(t, int64(types.EventGetBlocks), (<-client.Recv()).Ty)
+	client.Close()
+}
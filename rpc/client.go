@@ -30,7 +30,11 @@ type channelClient struct {
 func (c *channelClient) Init(q queue.Client, api client.QueueProtocolAPI) {
 	if api == nil {
 		var err error
-		api, err = client.New(q, nil)
+		api, err = client.New(q, &client.QueueProtocolOption{
+			SendTimeout:     -1,
+			WaitTimeout:     -1,
+			BackgroundQuery: true,
+		})
 		if err != nil {
 			panic(err)
 		}
@@ -330,6 +334,29 @@ func (c *channelClient) GetBalance(in *types.ReqBalance) ([]*types.Account, erro
 	return acc.GetBalance(c.QueueProtocolAPI, in)
 }
 
+// GetAccountNonce 获取账户下一个可用的交易序号
+func (c *channelClient) GetAccountNonce(addr string) (int64, error) {
+	if err := address.CheckAddress(addr); err != nil {
+		return 0, types.ErrInvalidAddress
+	}
+	header, err := c.GetLastHeader()
+	if err != nil {
+		return 0, err
+	}
+	reply, err := c.StoreGet(&types.StoreGet{StateHash: header.StateHash, Keys: [][]byte{types.CalcAccountSeqKey(addr)}})
+	if err != nil {
+		return 0, err
+	}
+	seq := &types.Int64{}
+	if len(reply.Values) > 0 && reply.Values[0] != nil {
+		err = types.Decode(reply.Values[0], seq)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return seq.Data, nil
+}
+
 // GetAllExecBalance get balance of exec
 func (c *channelClient) GetAllExecBalance(in *types.ReqAllExecBalance) (*types.AllExecBalance, error) {
 	types.AssertConfig(c.QueueProtocolAPI)
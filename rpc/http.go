@@ -15,7 +15,13 @@ import (
 	"net/http"
 	"net/rpc/jsonrpc"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/33cn/chain33/common/record"
+	"github.com/33cn/chain33/common/trace"
+	"github.com/33cn/chain33/metrics"
 	"github.com/rs/cors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -27,6 +33,8 @@ type HTTPConn struct {
 	r   *http.Request
 	in  io.Reader
 	out io.Writer
+	//不为nil时保存返回的json, 用于记录rpc请求
+	rec *bytes.Buffer
 }
 
 // Read rewrite the read of http
@@ -34,6 +42,9 @@ func (c *HTTPConn) Read(p []byte) (n int, err error) { return c.in.Read(p) }
 
 // Write rewrite the write of http
 func (c *HTTPConn) Write(d []byte) (n int, err error) { //添加支持gzip 发送
+	if c.rec != nil {
+		c.rec.Write(d)
+	}
 
 	if strings.Contains(c.r.Header.Get("Accept-Encoding"), "gzip") {
 		gw := gzip.NewWriter(c.out)
@@ -100,13 +111,27 @@ func (j *JSONRPCServer) Listen() (int, error) {
 					return
 				}
 			}
-			serverCodec := jsonrpc.NewServerCodec(&HTTPConn{in: ioutil.NopCloser(bytes.NewReader(data)), out: w, r: r})
+			var rec *bytes.Buffer
+			if record.Enabled(record.KindRPC) && recordableRPC(funcName) {
+				rec = new(bytes.Buffer)
+			}
+			serverCodec := jsonrpc.NewServerCodec(&HTTPConn{in: ioutil.NopCloser(bytes.NewReader(data)), out: w, r: r, rec: rec})
 			w.Header().Set("Content-type", "application/json")
 			if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
 				w.Header().Set("Content-Encoding", "gzip")
 			}
 			w.WriteHeader(200)
-			err = j.s.ServeRequest(serverCodec)
+			beg := time.Now()
+			span := startRPCSpan("jsonrpc "+client.Method, r.Header.Get("traceparent"))
+			err = j.serveRequest(serverCodec, client.Method, span.Context())
+			span.SetError(err)
+			span.End()
+			if metrics.Enabled() {
+				metrics.UpdateTimerSince(jrpcMetricName(client.Method), beg)
+			}
+			if rec != nil {
+				recordRPC(client.Method, ip, data, rec.Bytes(), beg, err)
+			}
 			if err != nil {
 				log.Debug("Error while serving JSON request: %v", err)
 				return
@@ -123,6 +148,83 @@ func (j *JSONRPCServer) Listen() (int, error) {
 	return listener.Addr().(*net.TCPAddr).Port, nil
 }
 
+// rpcRecordDenylist 请求或者返回中包含密码, 私钥或者助记词的方法, 按照方法名匹配, 不区分服务
+var rpcRecordDenylist = map[string]bool{
+	"UnLock":             true,
+	"SetPasswd":          true,
+	"ImportPrivkey":      true,
+	"DumpPrivkey":        true,
+	"ImportPrivkeysFile": true,
+	"DumpPrivkeysFile":   true,
+	"SignRawTx":          true,
+	"GenSeed":            true,
+	"SaveSeed":           true,
+	"GetSeed":            true,
+	"ExecWallet":         true,
+}
+
+// recordableRPC 敏感的方法不记录请求和返回
+func recordableRPC(funcName string) bool {
+	return !rpcRecordDenylist[funcName]
+}
+
+// recordRPC 记录jsonrpc请求和返回的json, 用于在测试节点上重放并比较返回值
+func recordRPC(method, ip string, req, resp []byte, recv time.Time, err error) {
+	if !recordableRPC(method[strings.LastIndex(method, ".")+1:]) {
+		return
+	}
+	entry := &record.Entry{
+		Time:   recv.UnixNano(),
+		Kind:   record.KindRPC,
+		Source: ip,
+		Method: method,
+		Data:   req,
+		Result: resp,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	record.Record(entry)
+}
+
+// startRPCSpan 创建rpc请求的span, 请求方通过traceparent传入上下文时延续其追踪, 是否采样仍由本地配置决定
+func startRPCSpan(name, traceParent string) *trace.Span {
+	if !trace.Enabled() {
+		return nil
+	}
+	if traceParent != "" {
+		parent, err := trace.ParseTraceParent(traceParent)
+		if err != nil {
+			log.Debug("startRPCSpan", "traceparent", traceParent, "err", err)
+		} else if !parent.IsValid() {
+			//请求方没有采样
+			return nil
+		} else {
+			return trace.StartRemote(name, parent)
+		}
+	}
+	return trace.Start(name, trace.SpanContext{})
+}
+
+// maxJrpcMetrics 方法名由请求方指定, 限制记录的方法数量, 超出部分统一记录为other
+const maxJrpcMetrics = 1024
+
+var (
+	jrpcMetricNames sync.Map
+	jrpcMetricCount int32
+)
+
+func jrpcMetricName(method string) string {
+	if name, ok := jrpcMetricNames.Load(method); ok {
+		return name.(string)
+	}
+	if atomic.AddInt32(&jrpcMetricCount, 1) > maxJrpcMetrics {
+		return metrics.Name("chain33/rpc/jsonrpc", "method", "other")
+	}
+	name, _ := jrpcMetricNames.LoadOrStore(method, metrics.Name("chain33/rpc/jsonrpc", "method", method))
+	return name.(string)
+}
+
 type serverResponse struct {
 	ID     uint64      `json:"id"`
 	Result interface{} `json:"result"`
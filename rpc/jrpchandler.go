@@ -192,6 +192,58 @@ func (c *Chain33) QueryTransaction(in rpctypes.QueryParm, result *interface{}) e
 	return nil
 }
 
+// DecodeTransactionReceipt 按交易hash 或区块解析交易的payload, 回执日志和账户变化
+// 回执不保存执行时的KV 变化, 返回结果中不包含KV 变化
+func (c *Chain33) DecodeTransactionReceipt(in *rpctypes.ReqDecodeReceipt, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	if in.TxHash != "" {
+		hash, err := common.FromHex(in.TxHash)
+		if err != nil {
+			return err
+		}
+		detail, err := c.cli.QueryTx(&types.ReqHash{Hash: hash})
+		if err != nil {
+			return err
+		}
+		txr := rpctypes.DecodeTxReceipt(detail.GetTx(), detail.GetReceipt())
+		txr.Index = detail.GetIndex()
+		*result = &rpctypes.DecodedReceipts{
+			Height:    detail.GetHeight(),
+			BlockTime: detail.GetBlocktime(),
+			Txs:       []*rpctypes.DecodedTxReceipt{txr},
+		}
+		return nil
+	}
+	var reply *types.BlockDetails
+	var err error
+	if in.BlockHash != "" {
+		var hash []byte
+		hash, err = common.FromHex(in.BlockHash)
+		if err != nil {
+			return err
+		}
+		reply, err = c.cli.GetBlockByHashes(&types.ReqHashes{Hashes: [][]byte{hash}})
+		if err != nil {
+			return err
+		}
+	} else {
+		if in.Height < 0 {
+			return types.ErrInvalidParam
+		}
+		reply, err = c.cli.GetBlocks(&types.ReqBlocks{Start: in.Height, End: in.Height, IsDetail: true, Pid: []string{""}})
+		if err != nil {
+			return err
+		}
+	}
+	if len(reply.GetItems()) == 0 || reply.GetItems()[0].GetBlock() == nil {
+		return types.ErrBlockNotFound
+	}
+	*result = rpctypes.DecodeBlockReceipts(c.cli.GetConfig(), reply.GetItems()[0])
+	return nil
+}
+
 // GetBlocks get block information
 func (c *Chain33) GetBlocks(in rpctypes.BlockParam, result *interface{}) error {
 	reply, err := c.cli.GetBlocks(&types.ReqBlocks{Start: in.Start, End: in.End, IsDetail: in.Isdetail, Pid: []string{""}})
@@ -692,6 +744,16 @@ func (c *Chain33) GetProperFee(in types.ReqProperFee, result *interface{}) error
 	return nil
 }
 
+// GetQueueStats 获取消息队列各个topic通道的积压深度和等待时间
+func (c *Chain33) GetQueueStats(in types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.GetQueueStats()
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
 // GetBlockOverview get overview of block
 // GetBlockOverview(parm *types.ReqHash) (*types.BlockOverview, error)
 func (c *Chain33) GetBlockOverview(in rpctypes.QueryParm, result *interface{}) error {
@@ -831,6 +893,16 @@ func (c *Chain33) GetBalance(in types.ReqBalance, result *interface{}) error {
 	return nil
 }
 
+// GetAccountNonce 获取账户下一个可用的交易序号, 使用序号的交易设置isSeqNonce, nonce为序号
+func (c *Chain33) GetAccountNonce(in types.ReqString, result *interface{}) error {
+	seq, err := c.cli.GetAccountNonce(in.Data)
+	if err != nil {
+		return err
+	}
+	*result = seq
+	return nil
+}
+
 // GetAllExecBalance get all balance of exec
 func (c *Chain33) GetAllExecBalance(in types.ReqAllExecBalance, result *interface{}) error {
 	balance, err := c.cli.GetAllExecBalance(&in)
@@ -958,6 +1030,12 @@ func (c *Chain33) Version(in *types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+// GetRPCSchema 获取所有json rpc 接口和执行器action, 查询的openapi 描述文档
+func (c *Chain33) GetRPCSchema(in *types.ReqNil, result *interface{}) error {
+	*result = rpctypes.BuildRPCSchema()
+	return nil
+}
+
 // GetTotalCoins get total coins
 func (c *Chain33) GetTotalCoins(in *types.ReqGetTotalCoins, result *interface{}) error {
 	resp, err := c.cli.GetTotalCoins(in)
@@ -1132,6 +1210,16 @@ func (c *Chain33) CloseQueue(in *types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+// ReloadConfig 重新加载节点的配置文件, 只有rpc 白名单, mempool 交易费, 日志等非共识相关的配置可以热加载
+func (c *Chain33) ReloadConfig(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.ReloadConfig(in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
 // GetLastBlockSequence get sequence last block
 func (c *Chain33) GetLastBlockSequence(in *types.ReqNil, result *interface{}) error {
 	resp, err := c.cli.GetLastBlockSequence()
@@ -1353,6 +1441,39 @@ func (c *Chain33) NetProtocols(in types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+// GetPeerScores 查询节点评分
+func (c *Chain33) GetPeerScores(in types.ReqNil, result *interface{}) error {
+	resp, err := c.cli.GetPeerScores(&in)
+	if err != nil {
+		return err
+	}
+
+	*result = resp
+	return nil
+}
+
+// BanPeer 手动封禁节点, seconds为0时使用默认封禁时长
+func (c *Chain33) BanPeer(in types.ReqBanPeer, result *interface{}) error {
+	reply, err := c.cli.BanPeer(&in)
+	if err != nil {
+		return err
+	}
+
+	*result = &rpctypes.Reply{IsOk: reply.GetIsOk(), Msg: string(reply.GetMsg())}
+	return nil
+}
+
+// UnbanPeer 解除节点封禁
+func (c *Chain33) UnbanPeer(in types.ReqString, result *interface{}) error {
+	reply, err := c.cli.UnbanPeer(&in)
+	if err != nil {
+		return err
+	}
+
+	*result = &rpctypes.Reply{IsOk: reply.GetIsOk(), Msg: string(reply.GetMsg())}
+	return nil
+}
+
 //GetSequenceByHash get sequcen by hashes
 func (c *Chain33) GetSequenceByHash(in rpctypes.ReqHashes, result *interface{}) error {
 	if len(in.Hashes) != 0 && common.IsHex(in.Hashes[0]) {
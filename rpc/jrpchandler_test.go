@@ -602,6 +602,40 @@ func TestChain33_QueryTransactionOk(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, api)
 }
 
+func TestChain33_DecodeTransactionReceipt(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	testChain33 := newTestChain33(api)
+	var testResult interface{}
+	err := testChain33.DecodeTransactionReceipt(nil, &testResult)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	err = testChain33.DecodeTransactionReceipt(&rpctypes.ReqDecodeReceipt{Height: -1}, &testResult)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	tx := &types.Transaction{Execer: []byte("coins")}
+	receipt := &types.ReceiptData{Ty: types.ExecOk}
+	api.On("QueryTx", mock.Anything).Return(&types.TransactionDetail{Tx: tx, Receipt: receipt, Height: 3, Index: 1}, nil).Once()
+	err = testChain33.DecodeTransactionReceipt(&rpctypes.ReqDecodeReceipt{TxHash: common.ToHex(tx.Hash())}, &testResult)
+	assert.Nil(t, err)
+	res := testResult.(*rpctypes.DecodedReceipts)
+	assert.Equal(t, int64(3), res.Height)
+	assert.Equal(t, int64(1), res.Txs[0].Index)
+
+	block := &types.Block{Height: 2, Txs: []*types.Transaction{tx}}
+	details := &types.BlockDetails{Items: []*types.BlockDetail{{Block: block, Receipts: []*types.ReceiptData{receipt}}}}
+	api.On("GetBlocks", mock.Anything).Return(details, nil).Once()
+	err = testChain33.DecodeTransactionReceipt(&rpctypes.ReqDecodeReceipt{Height: 2}, &testResult)
+	assert.Nil(t, err)
+	res = testResult.(*rpctypes.DecodedReceipts)
+	assert.Equal(t, common.ToHex(block.Hash(cfg)), res.BlockHash)
+	assert.Equal(t, "ExecOk", res.Txs[0].TyName)
+
+	api.On("GetBlockByHashes", mock.Anything).Return(&types.BlockDetails{Items: []*types.BlockDetail{nil}}, nil).Once()
+	err = testChain33.DecodeTransactionReceipt(&rpctypes.ReqDecodeReceipt{BlockHash: "0x01"}, &testResult)
+	assert.Equal(t, types.ErrBlockNotFound, err)
+}
+
 func TestChain33_GetBlocks(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
@@ -1236,6 +1270,21 @@ func TestChain33_Version(t *testing.T) {
 	assert.NotNil(t, testResult)
 }
 
+func TestChain33_GetRPCSchema(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	testChain33 := newTestChain33(api)
+	rpctypes.RegisterJrpcService("Chain33", testChain33)
+	var testResult interface{}
+	err := testChain33.GetRPCSchema(&types.ReqNil{}, &testResult)
+	assert.Nil(t, err)
+	doc, ok := testResult.(*rpctypes.OpenAPIDoc)
+	assert.True(t, ok)
+	assert.NotNil(t, doc.Paths["/Chain33.GetRPCSchema"])
+	assert.NotNil(t, doc.Paths["/Chain33.CreateTransaction/coins/Transfer"])
+}
+
 func TestChain33_GetTimeStatus(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
@@ -1570,6 +1619,23 @@ func TestChain33_DecodeRawTransaction(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestChain33_ReloadConfig(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	var testResult interface{}
+	api.On("ReloadConfig", mock.Anything).Return(nil, types.ErrConfigNotReloadable).Once()
+	err := client.ReloadConfig(&types.ReqNil{}, &testResult)
+	assert.Equal(t, types.ErrConfigNotReloadable, err)
+
+	reply := &types.ReplyReloadConfig{Applied: []string{"rpc.whitelist"}}
+	api.On("ReloadConfig", mock.Anything).Return(reply, nil).Once()
+	err = client.ReloadConfig(&types.ReqNil{}, &testResult)
+	assert.Nil(t, err)
+	assert.Equal(t, reply, testResult)
+}
+
 func TestChain33_CloseQueue(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
@@ -1795,3 +1861,21 @@ func TestChain33_GetCryptoList(t *testing.T) {
 	err := client.GetCryptoList(&types.ReqNil{}, &result)
 	assert.Nil(t, err)
 }
+
+func TestChain33_GetAccountNonce(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	addr := "1Jn2qu84Z1SUUosWjySggBS9pKWdAP3tZt"
+	api.On("GetLastHeader").Return(&types.Header{StateHash: []byte("hash")}, nil)
+	api.On("StoreGet", mock.Anything).Return(&types.StoreReplyValue{Values: [][]byte{types.Encode(&types.Int64{Data: 5})}}, nil).Once()
+	api.On("StoreGet", mock.Anything).Return(&types.StoreReplyValue{Values: [][]byte{nil}}, nil)
+	var result interface{}
+	err := client.GetAccountNonce(types.ReqString{Data: addr}, &result)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), result)
+	err = client.GetAccountNonce(types.ReqString{Data: addr}, &result)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), result)
+	err = client.GetAccountNonce(types.ReqString{Data: "abc"}, &result)
+	assert.Equal(t, types.ErrInvalidAddress, err)
+}
@@ -7,6 +7,7 @@ package jsonclient
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
@@ -67,6 +68,11 @@ type clientResponse struct {
 
 // Call jsonclinet call method
 func (client *JSONClient) Call(method string, params, resp interface{}) error {
+	return client.CallContext(context.Background(), method, params, resp)
+}
+
+// CallContext 带有context的请求, context取消或者超时时请求立即返回
+func (client *JSONClient) CallContext(ctx context.Context, method string, params, resp interface{}) error {
 	method = addPrefix(client.prefix, method)
 	req := &clientRequest{}
 	req.Method = method
@@ -76,7 +82,12 @@ func (client *JSONClient) Call(method string, params, resp interface{}) error {
 		return err
 	}
 	//println("request JsonStr", string(data), "")
-	postresp, err := client.client.Post(client.url, "application/json", bytes.NewBuffer(data))
+	httpreq, err := http.NewRequestWithContext(ctx, http.MethodPost, client.url, bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	httpreq.Header.Set("Content-Type", "application/json")
+	postresp, err := client.client.Do(httpreq)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,1552 @@
+// Code generated by rpc/sdk/gen. DO NOT EDIT.
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+)
+
+// AddPushSubscribe Chain33.AddPushSubscribe (jsonrpc)
+func (c *Client) AddPushSubscribe(ctx context.Context, in *types.PushSubscribeReq) (*types.ReplySubscribePush, error) {
+	resp := new(types.ReplySubscribePush)
+	err := c.invoke(ctx, &call{
+		method:     "AddPushSubscribe",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// BanPeer Chain33.BanPeer (jsonrpc)
+func (c *Client) BanPeer(ctx context.Context, in *types.ReqBanPeer) (*rpctypes.Reply, error) {
+	resp := new(rpctypes.Reply)
+	err := c.invoke(ctx, &call{
+		method:     "BanPeer",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// CloseQueue Chain33.CloseQueue (jsonrpc, grpc)
+func (c *Client) CloseQueue(ctx context.Context) (*types.Reply, error) {
+	in := &types.ReqNil{}
+	resp := new(types.Reply)
+	err := c.invoke(ctx, &call{
+		method:     "CloseQueue",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.CloseQueue(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// ConvertExectoAddr Chain33.ConvertExectoAddr (jsonrpc)
+func (c *Client) ConvertExectoAddr(ctx context.Context, in *rpctypes.ExecNameParm) (string, error) {
+	var resp string
+	err := c.invoke(ctx, &call{
+		method:     "ConvertExectoAddr",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// CreateNoBalanceTransaction Chain33.CreateNoBalanceTransaction (jsonrpc)
+func (c *Client) CreateNoBalanceTransaction(ctx context.Context, in *types.NoBalanceTx) (string, error) {
+	var resp string
+	err := c.invoke(ctx, &call{
+		method:     "CreateNoBalanceTransaction",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// CreateNoBalanceTxs Chain33.CreateNoBalanceTxs (grpc)
+func (c *Client) CreateNoBalanceTxs(ctx context.Context, in *types.NoBalanceTxs) (*types.ReplySignRawTx, error) {
+	resp := new(types.ReplySignRawTx)
+	err := c.invoke(ctx, &call{
+		method:     "CreateNoBalanceTxs",
+		idempotent: true,
+		json:       false,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.CreateNoBalanceTxs(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// CreateNoBlanaceTxs Chain33.CreateNoBlanaceTxs (jsonrpc)
+func (c *Client) CreateNoBlanaceTxs(ctx context.Context, in *types.NoBalanceTxs) (string, error) {
+	var resp string
+	err := c.invoke(ctx, &call{
+		method:     "CreateNoBlanaceTxs",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// CreateRawTransaction Chain33.CreateRawTransaction (jsonrpc)
+func (c *Client) CreateRawTransaction(ctx context.Context, in *rpctypes.CreateTx) (string, error) {
+	var resp string
+	err := c.invoke(ctx, &call{
+		method:     "CreateRawTransaction",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// CreateRawTxGroup Chain33.CreateRawTxGroup (jsonrpc)
+func (c *Client) CreateRawTxGroup(ctx context.Context, in *types.CreateTransactionGroup) (string, error) {
+	var resp string
+	err := c.invoke(ctx, &call{
+		method:     "CreateRawTxGroup",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// CreateTransaction Chain33.CreateTransaction (jsonrpc)
+func (c *Client) CreateTransaction(ctx context.Context, in *rpctypes.CreateTxIn) (string, error) {
+	var resp string
+	err := c.invoke(ctx, &call{
+		method:     "CreateTransaction",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// DecodeRawTransaction Chain33.DecodeRawTransaction (jsonrpc)
+func (c *Client) DecodeRawTransaction(ctx context.Context, in *types.ReqDecodeRawTransaction) (*rpctypes.ReplyTxList, error) {
+	resp := new(rpctypes.ReplyTxList)
+	err := c.invoke(ctx, &call{
+		method:     "DecodeRawTransaction",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// DecodeTransactionReceipt Chain33.DecodeTransactionReceipt (jsonrpc)
+func (c *Client) DecodeTransactionReceipt(ctx context.Context, in *rpctypes.ReqDecodeReceipt) (*rpctypes.DecodedReceipts, error) {
+	resp := new(rpctypes.DecodedReceipts)
+	err := c.invoke(ctx, &call{
+		method:     "DecodeTransactionReceipt",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// DumpPrivkey Chain33.DumpPrivkey (jsonrpc)
+func (c *Client) DumpPrivkey(ctx context.Context, in *types.ReqString) (json.RawMessage, error) {
+	var resp json.RawMessage
+	err := c.invoke(ctx, &call{
+		method:     "DumpPrivkey",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// DumpPrivkeysFile Chain33.DumpPrivkeysFile (jsonrpc)
+func (c *Client) DumpPrivkeysFile(ctx context.Context, in *types.ReqPrivkeysFile) (*rpctypes.Reply, error) {
+	resp := new(rpctypes.Reply)
+	err := c.invoke(ctx, &call{
+		method:     "DumpPrivkeysFile",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// ExecWallet Chain33.ExecWallet (jsonrpc)
+func (c *Client) ExecWallet(ctx context.Context, in *rpctypes.ChainExecutor) (json.RawMessage, error) {
+	var resp json.RawMessage
+	err := c.invoke(ctx, &call{
+		method:     "ExecWallet",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// GenSeed Chain33.GenSeed (jsonrpc)
+func (c *Client) GenSeed(ctx context.Context, in *types.GenSeedLang) (json.RawMessage, error) {
+	var resp json.RawMessage
+	err := c.invoke(ctx, &call{
+		method:     "GenSeed",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// GetAccount Chain33.GetAccount (jsonrpc)
+func (c *Client) GetAccount(ctx context.Context, in *types.ReqGetAccount) (*rpctypes.WalletAccount, error) {
+	resp := new(rpctypes.WalletAccount)
+	err := c.invoke(ctx, &call{
+		method:     "GetAccount",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetAccountNonce Chain33.GetAccountNonce (jsonrpc)
+func (c *Client) GetAccountNonce(ctx context.Context, in *types.ReqString) (int64, error) {
+	var resp int64
+	err := c.invoke(ctx, &call{
+		method:     "GetAccountNonce",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// GetAccounts Chain33.GetAccounts (jsonrpc)
+func (c *Client) GetAccounts(ctx context.Context, in *types.ReqAccountList) (*rpctypes.WalletAccounts, error) {
+	resp := new(rpctypes.WalletAccounts)
+	err := c.invoke(ctx, &call{
+		method:     "GetAccounts",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetAccountsV2 Chain33.GetAccountsV2 (jsonrpc)
+func (c *Client) GetAccountsV2(ctx context.Context) (json.RawMessage, error) {
+	in := &types.ReqNil{}
+	var resp json.RawMessage
+	err := c.invoke(ctx, &call{
+		method:     "GetAccountsV2",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// GetAddrOverview Chain33.GetAddrOverview (jsonrpc, grpc)
+func (c *Client) GetAddrOverview(ctx context.Context, in *types.ReqAddr) (*types.AddrOverview, error) {
+	resp := new(types.AddrOverview)
+	err := c.invoke(ctx, &call{
+		method:     "GetAddrOverview",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.GetAddrOverview(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetAllExecBalance Chain33.GetAllExecBalance (jsonrpc)
+func (c *Client) GetAllExecBalance(ctx context.Context, in *types.ReqAllExecBalance) (*rpctypes.AllExecBalance, error) {
+	resp := new(rpctypes.AllExecBalance)
+	err := c.invoke(ctx, &call{
+		method:     "GetAllExecBalance",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetBalance Chain33.GetBalance (jsonrpc)
+func (c *Client) GetBalance(ctx context.Context, in *types.ReqBalance) ([]*rpctypes.Account, error) {
+	var resp []*rpctypes.Account
+	err := c.invoke(ctx, &call{
+		method:     "GetBalance",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// GetBlockByHashes Chain33.GetBlockByHashes (jsonrpc)
+func (c *Client) GetBlockByHashes(ctx context.Context, in *rpctypes.ReqHashes) (*rpctypes.BlockDetails, error) {
+	resp := new(rpctypes.BlockDetails)
+	err := c.invoke(ctx, &call{
+		method:     "GetBlockByHashes",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetBlockBySeq Chain33.GetBlockBySeq (jsonrpc)
+func (c *Client) GetBlockBySeq(ctx context.Context, in *types.Int64) (*rpctypes.BlockSeq, error) {
+	resp := new(rpctypes.BlockSeq)
+	err := c.invoke(ctx, &call{
+		method:     "GetBlockBySeq",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetBlockHash Chain33.GetBlockHash (jsonrpc)
+func (c *Client) GetBlockHash(ctx context.Context, in *types.ReqInt) (*rpctypes.ReplyHash, error) {
+	resp := new(rpctypes.ReplyHash)
+	err := c.invoke(ctx, &call{
+		method:     "GetBlockHash",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetBlockOverview Chain33.GetBlockOverview (jsonrpc)
+func (c *Client) GetBlockOverview(ctx context.Context, in *rpctypes.QueryParm) (*rpctypes.BlockOverview, error) {
+	resp := new(rpctypes.BlockOverview)
+	err := c.invoke(ctx, &call{
+		method:     "GetBlockOverview",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetBlockSequences Chain33.GetBlockSequences (jsonrpc)
+func (c *Client) GetBlockSequences(ctx context.Context, in *rpctypes.BlockParam) (*rpctypes.ReplyBlkSeqs, error) {
+	resp := new(rpctypes.ReplyBlkSeqs)
+	err := c.invoke(ctx, &call{
+		method:     "GetBlockSequences",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetBlocks Chain33.GetBlocks (jsonrpc)
+func (c *Client) GetBlocks(ctx context.Context, in *rpctypes.BlockParam) (*rpctypes.BlockDetails, error) {
+	resp := new(rpctypes.BlockDetails)
+	err := c.invoke(ctx, &call{
+		method:     "GetBlocks",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetChainID Chain33.GetChainID (jsonrpc)
+func (c *Client) GetChainID(ctx context.Context) (*rpctypes.ChainIDInfo, error) {
+	in := &types.ReqNil{}
+	resp := new(rpctypes.ChainIDInfo)
+	err := c.invoke(ctx, &call{
+		method:     "GetChainID",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetCoinSymbol Chain33.GetCoinSymbol (jsonrpc)
+func (c *Client) GetCoinSymbol(ctx context.Context) (*types.ReplyString, error) {
+	in := &types.ReqNil{}
+	resp := new(types.ReplyString)
+	err := c.invoke(ctx, &call{
+		method:     "GetCoinSymbol",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetCryptoList Chain33.GetCryptoList (jsonrpc, grpc)
+func (c *Client) GetCryptoList(ctx context.Context) (*types.CryptoList, error) {
+	in := &types.ReqNil{}
+	resp := new(types.CryptoList)
+	err := c.invoke(ctx, &call{
+		method:     "GetCryptoList",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.GetCryptoList(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetExecBalance Chain33.GetExecBalance (jsonrpc)
+func (c *Client) GetExecBalance(ctx context.Context, in *types.ReqGetExecBalance) (string, error) {
+	var resp string
+	err := c.invoke(ctx, &call{
+		method:     "GetExecBalance",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// GetFatalFailure Chain33.GetFatalFailure (jsonrpc)
+func (c *Client) GetFatalFailure(ctx context.Context) (int32, error) {
+	in := &types.ReqNil{}
+	var resp int32
+	err := c.invoke(ctx, &call{
+		method:     "GetFatalFailure",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// GetFork Chain33.GetFork (grpc)
+func (c *Client) GetFork(ctx context.Context, in *types.ReqKey) (*types.Int64, error) {
+	resp := new(types.Int64)
+	err := c.invoke(ctx, &call{
+		method:     "GetFork",
+		idempotent: true,
+		json:       false,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.GetFork(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetHeaders Chain33.GetHeaders (jsonrpc)
+func (c *Client) GetHeaders(ctx context.Context, in *types.ReqBlocks) (*rpctypes.Headers, error) {
+	resp := new(rpctypes.Headers)
+	err := c.invoke(ctx, &call{
+		method:     "GetHeaders",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetHexTxByHash Chain33.GetHexTxByHash (jsonrpc)
+func (c *Client) GetHexTxByHash(ctx context.Context, in *rpctypes.QueryParm) (string, error) {
+	var resp string
+	err := c.invoke(ctx, &call{
+		method:     "GetHexTxByHash",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// GetLastBlockSequence Chain33.GetLastBlockSequence (jsonrpc)
+func (c *Client) GetLastBlockSequence(ctx context.Context) (int64, error) {
+	in := &types.ReqNil{}
+	var resp int64
+	err := c.invoke(ctx, &call{
+		method:     "GetLastBlockSequence",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// GetLastHeader Chain33.GetLastHeader (jsonrpc)
+func (c *Client) GetLastHeader(ctx context.Context) (*rpctypes.Header, error) {
+	in := &types.ReqNil{}
+	resp := new(rpctypes.Header)
+	err := c.invoke(ctx, &call{
+		method:     "GetLastHeader",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetLastMemPool Chain33.GetLastMemPool (jsonrpc)
+func (c *Client) GetLastMemPool(ctx context.Context) (*rpctypes.ReplyTxList, error) {
+	in := &types.ReqNil{}
+	resp := new(rpctypes.ReplyTxList)
+	err := c.invoke(ctx, &call{
+		method:     "GetLastMemPool",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetMemPool Chain33.GetMemPool (grpc)
+func (c *Client) GetMemPool(ctx context.Context, in *types.ReqGetMempool) (*types.ReplyTxList, error) {
+	resp := new(types.ReplyTxList)
+	err := c.invoke(ctx, &call{
+		method:     "GetMemPool",
+		idempotent: true,
+		json:       false,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.GetMemPool(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetMempool Chain33.GetMempool (jsonrpc)
+func (c *Client) GetMempool(ctx context.Context, in *types.ReqGetMempool) (*rpctypes.ReplyTxList, error) {
+	resp := new(rpctypes.ReplyTxList)
+	err := c.invoke(ctx, &call{
+		method:     "GetMempool",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetNetInfo Chain33.GetNetInfo (jsonrpc)
+func (c *Client) GetNetInfo(ctx context.Context, in *types.P2PGetNetInfoReq) (*rpctypes.NodeNetinfo, error) {
+	resp := new(rpctypes.NodeNetinfo)
+	err := c.invoke(ctx, &call{
+		method:     "GetNetInfo",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetParaTxByHeight Chain33.GetParaTxByHeight (jsonrpc)
+func (c *Client) GetParaTxByHeight(ctx context.Context, in *types.ReqParaTxByHeight) (*rpctypes.ParaTxDetails, error) {
+	resp := new(rpctypes.ParaTxDetails)
+	err := c.invoke(ctx, &call{
+		method:     "GetParaTxByHeight",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetParaTxByTitle Chain33.GetParaTxByTitle (jsonrpc)
+func (c *Client) GetParaTxByTitle(ctx context.Context, in *types.ReqParaTxByTitle) (*rpctypes.ParaTxDetails, error) {
+	resp := new(rpctypes.ParaTxDetails)
+	err := c.invoke(ctx, &call{
+		method:     "GetParaTxByTitle",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetPeerInfo Chain33.GetPeerInfo (jsonrpc)
+func (c *Client) GetPeerInfo(ctx context.Context, in *types.P2PGetPeerReq) (*rpctypes.PeerList, error) {
+	resp := new(rpctypes.PeerList)
+	err := c.invoke(ctx, &call{
+		method:     "GetPeerInfo",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetPeerScores Chain33.GetPeerScores (jsonrpc)
+func (c *Client) GetPeerScores(ctx context.Context) (*types.PeerScoreList, error) {
+	in := &types.ReqNil{}
+	resp := new(types.PeerScoreList)
+	err := c.invoke(ctx, &call{
+		method:     "GetPeerScores",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetProperFee Chain33.GetProperFee (jsonrpc)
+func (c *Client) GetProperFee(ctx context.Context, in *types.ReqProperFee) (*rpctypes.ReplyProperFee, error) {
+	resp := new(rpctypes.ReplyProperFee)
+	err := c.invoke(ctx, &call{
+		method:     "GetProperFee",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetPushSeqLastNum Chain33.GetPushSeqLastNum (jsonrpc)
+func (c *Client) GetPushSeqLastNum(ctx context.Context, in *types.ReqString) (*types.Int64, error) {
+	resp := new(types.Int64)
+	err := c.invoke(ctx, &call{
+		method:     "GetPushSeqLastNum",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetQueueStats Chain33.GetQueueStats (jsonrpc)
+func (c *Client) GetQueueStats(ctx context.Context) (*types.QueueStats, error) {
+	in := &types.ReqNil{}
+	resp := new(types.QueueStats)
+	err := c.invoke(ctx, &call{
+		method:     "GetQueueStats",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetRPCSchema Chain33.GetRPCSchema (jsonrpc)
+func (c *Client) GetRPCSchema(ctx context.Context) (*rpctypes.OpenAPIDoc, error) {
+	in := &types.ReqNil{}
+	resp := new(rpctypes.OpenAPIDoc)
+	err := c.invoke(ctx, &call{
+		method:     "GetRPCSchema",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetSeed Chain33.GetSeed (jsonrpc)
+func (c *Client) GetSeed(ctx context.Context, in *types.GetSeedByPw) (json.RawMessage, error) {
+	var resp json.RawMessage
+	err := c.invoke(ctx, &call{
+		method:     "GetSeed",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// GetSequenceByHash Chain33.GetSequenceByHash (jsonrpc)
+func (c *Client) GetSequenceByHash(ctx context.Context, in *rpctypes.ReqHashes) (*types.Int64, error) {
+	resp := new(types.Int64)
+	err := c.invoke(ctx, &call{
+		method:     "GetSequenceByHash",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetServerTime Chain33.GetServerTime (jsonrpc, grpc)
+func (c *Client) GetServerTime(ctx context.Context) (*types.ServerTime, error) {
+	in := &types.ReqNil{}
+	resp := new(types.ServerTime)
+	err := c.invoke(ctx, &call{
+		method:     "GetServerTime",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.GetServerTime(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetTimeStatus Chain33.GetTimeStatus (jsonrpc)
+func (c *Client) GetTimeStatus(ctx context.Context) (*rpctypes.TimeStatus, error) {
+	in := &types.ReqNil{}
+	resp := new(rpctypes.TimeStatus)
+	err := c.invoke(ctx, &call{
+		method:     "GetTimeStatus",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetTotalCoins Chain33.GetTotalCoins (jsonrpc)
+func (c *Client) GetTotalCoins(ctx context.Context, in *types.ReqGetTotalCoins) (*types.ReplyGetTotalCoins, error) {
+	resp := new(types.ReplyGetTotalCoins)
+	err := c.invoke(ctx, &call{
+		method:     "GetTotalCoins",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetTransactionByAddr Chain33.GetTransactionByAddr (grpc)
+func (c *Client) GetTransactionByAddr(ctx context.Context, in *types.ReqAddr) (*types.ReplyTxInfos, error) {
+	resp := new(types.ReplyTxInfos)
+	err := c.invoke(ctx, &call{
+		method:     "GetTransactionByAddr",
+		idempotent: true,
+		json:       false,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.GetTransactionByAddr(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetTransactionByHashes Chain33.GetTransactionByHashes (grpc)
+func (c *Client) GetTransactionByHashes(ctx context.Context, in *types.ReqHashes) (*types.TransactionDetails, error) {
+	resp := new(types.TransactionDetails)
+	err := c.invoke(ctx, &call{
+		method:     "GetTransactionByHashes",
+		idempotent: true,
+		json:       false,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.GetTransactionByHashes(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetTxByAddr Chain33.GetTxByAddr (jsonrpc)
+func (c *Client) GetTxByAddr(ctx context.Context, in *types.ReqAddr) (*rpctypes.ReplyTxInfos, error) {
+	resp := new(rpctypes.ReplyTxInfos)
+	err := c.invoke(ctx, &call{
+		method:     "GetTxByAddr",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetTxByHashes Chain33.GetTxByHashes (jsonrpc)
+func (c *Client) GetTxByHashes(ctx context.Context, in *rpctypes.ReqHashes) (*rpctypes.TransactionDetails, error) {
+	resp := new(rpctypes.TransactionDetails)
+	err := c.invoke(ctx, &call{
+		method:     "GetTxByHashes",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// GetWalletStatus Chain33.GetWalletStatus (jsonrpc)
+func (c *Client) GetWalletStatus(ctx context.Context) (*rpctypes.WalletStatus, error) {
+	in := &types.ReqNil{}
+	resp := new(rpctypes.WalletStatus)
+	err := c.invoke(ctx, &call{
+		method:     "GetWalletStatus",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// ImportPrivkey Chain33.ImportPrivkey (jsonrpc)
+func (c *Client) ImportPrivkey(ctx context.Context, in *types.ReqWalletImportPrivkey) (json.RawMessage, error) {
+	var resp json.RawMessage
+	err := c.invoke(ctx, &call{
+		method:     "ImportPrivkey",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// ImportPrivkeysFile Chain33.ImportPrivkeysFile (jsonrpc)
+func (c *Client) ImportPrivkeysFile(ctx context.Context, in *types.ReqPrivkeysFile) (*rpctypes.Reply, error) {
+	resp := new(rpctypes.Reply)
+	err := c.invoke(ctx, &call{
+		method:     "ImportPrivkeysFile",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// IsNtpClockSync Chain33.IsNtpClockSync (jsonrpc)
+func (c *Client) IsNtpClockSync(ctx context.Context) (bool, error) {
+	in := &types.ReqNil{}
+	var resp bool
+	err := c.invoke(ctx, &call{
+		method:     "IsNtpClockSync",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// IsSync Chain33.IsSync (jsonrpc)
+func (c *Client) IsSync(ctx context.Context) (bool, error) {
+	in := &types.ReqNil{}
+	var resp bool
+	err := c.invoke(ctx, &call{
+		method:     "IsSync",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// ListPushes Chain33.ListPushes (jsonrpc)
+func (c *Client) ListPushes(ctx context.Context) (*types.PushSubscribes, error) {
+	in := &types.ReqNil{}
+	resp := new(types.PushSubscribes)
+	err := c.invoke(ctx, &call{
+		method:     "ListPushes",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// LoadParaTxByTitle Chain33.LoadParaTxByTitle (jsonrpc)
+func (c *Client) LoadParaTxByTitle(ctx context.Context, in *types.ReqHeightByTitle) (*rpctypes.ReplyHeightByTitle, error) {
+	resp := new(rpctypes.ReplyHeightByTitle)
+	err := c.invoke(ctx, &call{
+		method:     "LoadParaTxByTitle",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// Lock Chain33.Lock (jsonrpc)
+func (c *Client) Lock(ctx context.Context) (*rpctypes.Reply, error) {
+	in := &types.ReqNil{}
+	resp := new(rpctypes.Reply)
+	err := c.invoke(ctx, &call{
+		method:     "Lock",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// MergeBalance Chain33.MergeBalance (jsonrpc)
+func (c *Client) MergeBalance(ctx context.Context, in *types.ReqWalletMergeBalance) (*rpctypes.ReplyHashes, error) {
+	resp := new(rpctypes.ReplyHashes)
+	err := c.invoke(ctx, &call{
+		method:     "MergeBalance",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// NetInfo Chain33.NetInfo (grpc)
+func (c *Client) NetInfo(ctx context.Context, in *types.P2PGetNetInfoReq) (*types.NodeNetInfo, error) {
+	resp := new(types.NodeNetInfo)
+	err := c.invoke(ctx, &call{
+		method:     "NetInfo",
+		idempotent: false,
+		json:       false,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.NetInfo(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// NetProtocols Chain33.NetProtocols (jsonrpc)
+func (c *Client) NetProtocols(ctx context.Context) (*types.NetProtocolInfos, error) {
+	in := &types.ReqNil{}
+	resp := new(types.NetProtocolInfos)
+	err := c.invoke(ctx, &call{
+		method:     "NetProtocols",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// NewAccount Chain33.NewAccount (jsonrpc)
+func (c *Client) NewAccount(ctx context.Context, in *types.ReqNewAccount) (json.RawMessage, error) {
+	var resp json.RawMessage
+	err := c.invoke(ctx, &call{
+		method:     "NewAccount",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// Query Chain33.Query (jsonrpc)
+func (c *Client) Query(ctx context.Context, in *rpctypes.Query4Jrpc) (json.RawMessage, error) {
+	var resp json.RawMessage
+	err := c.invoke(ctx, &call{
+		method:     "Query",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// QueryChain Chain33.QueryChain (jsonrpc)
+func (c *Client) QueryChain(ctx context.Context, in *rpctypes.ChainExecutor) (json.RawMessage, error) {
+	var resp json.RawMessage
+	err := c.invoke(ctx, &call{
+		method:     "QueryChain",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// QueryConsensus Chain33.QueryConsensus (grpc)
+func (c *Client) QueryConsensus(ctx context.Context, in *types.ChainExecutor) (*types.Reply, error) {
+	resp := new(types.Reply)
+	err := c.invoke(ctx, &call{
+		method:     "QueryConsensus",
+		idempotent: true,
+		json:       false,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.QueryConsensus(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// QueryRandNum Chain33.QueryRandNum (grpc)
+func (c *Client) QueryRandNum(ctx context.Context, in *types.ReqRandHash) (*types.ReplyHash, error) {
+	resp := new(types.ReplyHash)
+	err := c.invoke(ctx, &call{
+		method:     "QueryRandNum",
+		idempotent: true,
+		json:       false,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.QueryRandNum(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// QueryTotalFee Chain33.QueryTotalFee (jsonrpc)
+func (c *Client) QueryTotalFee(ctx context.Context, in *types.LocalDBGet) (*types.TotalFee, error) {
+	resp := new(types.TotalFee)
+	err := c.invoke(ctx, &call{
+		method:     "QueryTotalFee",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// QueryTransaction Chain33.QueryTransaction (jsonrpc)
+func (c *Client) QueryTransaction(ctx context.Context, in *rpctypes.QueryParm) (*rpctypes.TransactionDetail, error) {
+	resp := new(rpctypes.TransactionDetail)
+	err := c.invoke(ctx, &call{
+		method:     "QueryTransaction",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// ReWriteRawTx Chain33.ReWriteRawTx (jsonrpc)
+func (c *Client) ReWriteRawTx(ctx context.Context, in *rpctypes.ReWriteRawTx) (string, error) {
+	var resp string
+	err := c.invoke(ctx, &call{
+		method:     "ReWriteRawTx",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// ReloadConfig Chain33.ReloadConfig (jsonrpc)
+func (c *Client) ReloadConfig(ctx context.Context) (*types.ReplyReloadConfig, error) {
+	in := &types.ReqNil{}
+	resp := new(types.ReplyReloadConfig)
+	err := c.invoke(ctx, &call{
+		method:     "ReloadConfig",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// SaveSeed Chain33.SaveSeed (jsonrpc)
+func (c *Client) SaveSeed(ctx context.Context, in *types.SaveSeedByPw) (*rpctypes.Reply, error) {
+	resp := new(rpctypes.Reply)
+	err := c.invoke(ctx, &call{
+		method:     "SaveSeed",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// SendToAddress Chain33.SendToAddress (jsonrpc)
+func (c *Client) SendToAddress(ctx context.Context, in *types.ReqWalletSendToAddress) (*rpctypes.ReplyHash, error) {
+	resp := new(rpctypes.ReplyHash)
+	err := c.invoke(ctx, &call{
+		method:     "SendToAddress",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// SendTransaction Chain33.SendTransaction (jsonrpc)
+func (c *Client) SendTransaction(ctx context.Context, in *rpctypes.RawParm) (string, error) {
+	var resp string
+	err := c.invoke(ctx, &call{
+		method:     "SendTransaction",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// SendTransactionSync Chain33.SendTransactionSync (jsonrpc)
+func (c *Client) SendTransactionSync(ctx context.Context, in *rpctypes.RawParm) (json.RawMessage, error) {
+	var resp json.RawMessage
+	err := c.invoke(ctx, &call{
+		method:     "SendTransactionSync",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// SetLabl Chain33.SetLabl (jsonrpc)
+func (c *Client) SetLabl(ctx context.Context, in *types.ReqWalletSetLabel) (*rpctypes.WalletAccount, error) {
+	resp := new(rpctypes.WalletAccount)
+	err := c.invoke(ctx, &call{
+		method:     "SetLabl",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// SetPasswd Chain33.SetPasswd (jsonrpc)
+func (c *Client) SetPasswd(ctx context.Context, in *types.ReqWalletSetPasswd) (*rpctypes.Reply, error) {
+	resp := new(rpctypes.Reply)
+	err := c.invoke(ctx, &call{
+		method:     "SetPasswd",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// SetTxFee Chain33.SetTxFee (jsonrpc)
+func (c *Client) SetTxFee(ctx context.Context, in *types.ReqWalletSetFee) (*rpctypes.Reply, error) {
+	resp := new(rpctypes.Reply)
+	err := c.invoke(ctx, &call{
+		method:     "SetTxFee",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// SignRawTx Chain33.SignRawTx (jsonrpc)
+func (c *Client) SignRawTx(ctx context.Context, in *types.ReqSignRawTx) (string, error) {
+	var resp string
+	err := c.invoke(ctx, &call{
+		method:     "SignRawTx",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     &resp,
+	})
+	return resp, err
+}
+
+// UnLock Chain33.UnLock (jsonrpc)
+func (c *Client) UnLock(ctx context.Context, in *types.WalletUnLock) (*rpctypes.Reply, error) {
+	resp := new(rpctypes.Reply)
+	err := c.invoke(ctx, &call{
+		method:     "UnLock",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// UnbanPeer Chain33.UnbanPeer (jsonrpc)
+func (c *Client) UnbanPeer(ctx context.Context, in *types.ReqString) (*rpctypes.Reply, error) {
+	resp := new(rpctypes.Reply)
+	err := c.invoke(ctx, &call{
+		method:     "UnbanPeer",
+		idempotent: false,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// Version Chain33.Version (jsonrpc, grpc)
+func (c *Client) Version(ctx context.Context) (*types.VersionInfo, error) {
+	in := &types.ReqNil{}
+	resp := new(types.VersionInfo)
+	err := c.invoke(ctx, &call{
+		method:     "Version",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.Version(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// WalletTransactionList Chain33.WalletTransactionList (grpc)
+func (c *Client) WalletTransactionList(ctx context.Context, in *types.ReqWalletTransactionList) (*types.WalletTxDetails, error) {
+	resp := new(types.WalletTxDetails)
+	err := c.invoke(ctx, &call{
+		method:     "WalletTransactionList",
+		idempotent: false,
+		json:       false,
+		params:     in,
+		result:     resp,
+		grpc: func(ctx context.Context, cli types.Chain33Client) error {
+			reply, err := cli.WalletTransactionList(ctx, in)
+			if err != nil {
+				return err
+			}
+			*resp = *reply
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+// WalletTxList Chain33.WalletTxList (jsonrpc)
+func (c *Client) WalletTxList(ctx context.Context, in *rpctypes.ReqWalletTransactionList) (*rpctypes.WalletTxDetails, error) {
+	resp := new(rpctypes.WalletTxDetails)
+	err := c.invoke(ctx, &call{
+		method:     "WalletTxList",
+		idempotent: true,
+		json:       true,
+		params:     in,
+		result:     resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
@@ -0,0 +1,375 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// gen 根据 rpc.Chain33 的jsonrpc接口和 types.Chain33Client 的grpc接口生成sdk的api.go
+//
+// jsonrpc接口的返回值类型通过类型检查 *result 的赋值语句推导, 无法推导或者有多种类型时使用json.RawMessage
+//
+// 指定 -schema 时同时生成rpc schema 使用的返回值类型, 包括系统dapp 的Query_ 函数返回的proto 消息
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	rpcPath      = "github.com/33cn/chain33/rpc"
+	typesPath    = "github.com/33cn/chain33/types"
+	rpctypesPath = "github.com/33cn/chain33/rpc/types"
+	rawMessage   = "json.RawMessage"
+)
+
+var (
+	out     = flag.String("out", "api.go", "output file")
+	rpcDir  = flag.String("rpc", "..", "rpc package dir")
+	schema  = flag.String("schema", "", "rpc schema result types output file")
+	dappDir = flag.String("dapp", "../../system/dapp", "system dapp dir, used with -schema")
+)
+
+// idempotentPrefix 只读接口的前缀, 这些接口失败后可以重试
+var idempotentPrefix = []string{"Get", "Query", "Is", "List", "Load", "Version", "Convert", "Decode", "Create", "NetProtocols", "WalletTxList"}
+
+// reserved sdk中手写的方法
+var reserved = map[string]bool{"Close": true, "GRPC": true, "SendTx": true, "WaitTx": true}
+
+type method struct {
+	name       string
+	param      string
+	paramNil   bool
+	result     string
+	idempotent bool
+	json       bool
+	grpc       bool
+}
+
+var qualifier = func(p *types.Package) string {
+	switch p.Path() {
+	case typesPath:
+		return "types"
+	case rpctypesPath:
+		return "rpctypes"
+	}
+	return p.Name()
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "source", nil)
+	pkg, files, info, err := checkDir(fset, imp, rpcPath, *rpcDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	methods := jsonMethods(pkg, files, info)
+	methods = grpcMethods(pkg, methods)
+	src, err := generate(methods)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	err = ioutil.WriteFile(*out, src, 0644)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if *schema == "" {
+		return
+	}
+	queries, err := queryResults(fset, imp, *dappDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	src, err = generateSchema(methods, queries)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	err = ioutil.WriteFile(*schema, src, 0644)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func checkDir(fset *token.FileSet, imp types.Importer, path, dir string) (*types.Package, []*ast.File, *types.Info, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var files []*ast.File
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		files = append(files, f)
+	}
+	conf := types.Config{Importer: imp}
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Defs:  make(map[*ast.Ident]types.Object),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	pkg, err := conf.Check(path, fset, files, info)
+	return pkg, files, info, err
+}
+
+func isIdempotent(name string) bool {
+	for _, p := range idempotentPrefix {
+		if strings.HasPrefix(name, p) {
+			return true
+		}
+	}
+	return false
+}
+
+//jsonMethods Chain33的导出方法, 形如 func (c *Chain33) Name(in T, result *interface{}) error
+func jsonMethods(pkg *types.Package, files []*ast.File, info *types.Info) map[string]*method {
+	decls := make(map[string]*ast.FuncDecl)
+	for _, f := range files {
+		for _, d := range f.Decls {
+			fd, ok := d.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || len(fd.Recv.List) != 1 {
+				continue
+			}
+			if star, ok := fd.Recv.List[0].Type.(*ast.StarExpr); ok {
+				if id, ok := star.X.(*ast.Ident); ok && id.Name == "Chain33" {
+					decls[fd.Name.Name] = fd
+				}
+			}
+		}
+	}
+	chain33 := pkg.Scope().Lookup("Chain33").Type()
+	mset := types.NewMethodSet(types.NewPointer(chain33))
+	methods := make(map[string]*method)
+	for i := 0; i < mset.Len(); i++ {
+		fn := mset.At(i).Obj().(*types.Func)
+		sig := fn.Type().(*types.Signature)
+		if !fn.Exported() || sig.Params().Len() != 2 || sig.Results().Len() != 1 {
+			continue
+		}
+		if reserved[fn.Name()] {
+			panic("method name reserved: " + fn.Name())
+		}
+		m := &method{name: fn.Name(), idempotent: isIdempotent(fn.Name()), json: true}
+		m.param, m.paramNil = paramType(sig.Params().At(0).Type())
+		m.result = resultType(decls[fn.Name()], sig.Params().At(1), info)
+		methods[m.name] = m
+	}
+	return methods
+}
+
+func paramType(t types.Type) (string, bool) {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	name := types.TypeString(t, qualifier)
+	if _, ok := t.Underlying().(*types.Struct); ok {
+		return "*" + name, name == "types.ReqNil"
+	}
+	return name, false
+}
+
+func resultType(fd *ast.FuncDecl, result *types.Var, info *types.Info) string {
+	if p, ok := result.Type().(*types.Pointer); ok {
+		if _, ok := p.Elem().(*types.Interface); !ok {
+			return types.TypeString(p.Elem(), qualifier)
+		}
+	}
+	if fd == nil {
+		return rawMessage
+	}
+	found := make(map[string]bool)
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+		star, ok := assign.Lhs[0].(*ast.StarExpr)
+		if !ok {
+			return true
+		}
+		if id, ok := star.X.(*ast.Ident); !ok || id.Name != result.Name() {
+			return true
+		}
+		found[typeName(info.TypeOf(assign.Rhs[0]))] = true
+		return true
+	})
+	if len(found) != 1 {
+		return rawMessage
+	}
+	for name := range found {
+		return name
+	}
+	return rawMessage
+}
+
+func typeName(t types.Type) string {
+	if t == nil {
+		return rawMessage
+	}
+	switch u := t.(type) {
+	case *types.Pointer:
+		if _, ok := u.Elem().Underlying().(*types.Struct); ok {
+			return "*" + types.TypeString(u.Elem(), qualifier)
+		}
+		return rawMessage
+	case *types.Named:
+		if u.Obj().Pkg() != nil && u.Obj().Pkg().Path() == "encoding/json" {
+			return rawMessage
+		}
+		switch u.Underlying().(type) {
+		case *types.Struct:
+			return "*" + types.TypeString(u, qualifier)
+		case *types.Interface:
+			return rawMessage
+		}
+	case *types.Basic, *types.Slice, *types.Map:
+		name := types.TypeString(t, qualifier)
+		if strings.Contains(name, ".") && !strings.HasPrefix(strings.TrimLeft(name, "[]*"), "types.") &&
+			!strings.HasPrefix(strings.TrimLeft(name, "[]*"), "rpctypes.") {
+			return rawMessage
+		}
+		if u, ok := t.(*types.Basic); ok && u.Kind() == types.UntypedBool {
+			return "bool"
+		}
+		if u, ok := t.(*types.Basic); ok && u.Info()&types.IsUntyped != 0 {
+			return rawMessage
+		}
+		return name
+	}
+	return rawMessage
+}
+
+//grpcMethods 合并 types.Chain33Client 的接口, 参数和返回值与jsonrpc一致的接口同时支持grpc
+func grpcMethods(pkg *types.Package, methods map[string]*method) map[string]*method {
+	var typesPkg *types.Package
+	for _, p := range pkg.Imports() {
+		if p.Path() == typesPath {
+			typesPkg = p
+		}
+	}
+	iface := typesPkg.Scope().Lookup("Chain33Client").Type().Underlying().(*types.Interface)
+	for i := 0; i < iface.NumMethods(); i++ {
+		fn := iface.Method(i)
+		sig := fn.Type().(*types.Signature)
+		if sig.Params().Len() != 3 || sig.Results().Len() != 2 {
+			continue
+		}
+		in, ok1 := sig.Params().At(1).Type().(*types.Pointer)
+		res, ok2 := sig.Results().At(0).Type().(*types.Pointer)
+		if !ok1 || !ok2 {
+			continue
+		}
+		param := "*" + types.TypeString(in.Elem(), qualifier)
+		result := "*" + types.TypeString(res.Elem(), qualifier)
+		if m, ok := methods[fn.Name()]; ok {
+			m.grpc = m.param == param && m.result == result
+			continue
+		}
+		if reserved[fn.Name()] {
+			panic("method name reserved: " + fn.Name())
+		}
+		methods[fn.Name()] = &method{
+			name:       fn.Name(),
+			param:      param,
+			paramNil:   param == "*types.ReqNil",
+			result:     result,
+			idempotent: isIdempotent(fn.Name()),
+			grpc:       true,
+		}
+	}
+	return methods
+}
+
+func generate(methods map[string]*method) ([]byte, error) {
+	var names []string
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var body bytes.Buffer
+	for _, name := range names {
+		writeMethod(&body, methods[name])
+	}
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by rpc/sdk/gen. DO NOT EDIT.\n\npackage sdk\n\nimport (\n\"context\"\n")
+	if bytes.Contains(body.Bytes(), []byte("json.")) {
+		buf.WriteString("\"encoding/json\"\n")
+	}
+	buf.WriteString("\n")
+	if bytes.Contains(body.Bytes(), []byte("rpctypes.")) {
+		buf.WriteString("rpctypes \"" + rpctypesPath + "\"\n")
+	}
+	buf.WriteString("\"" + typesPath + "\"\n)\n")
+	buf.Write(body.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+func writeMethod(buf *bytes.Buffer, m *method) {
+	var transport string
+	switch {
+	case m.json && m.grpc:
+		transport = "jsonrpc, grpc"
+	case m.json:
+		transport = "jsonrpc"
+	default:
+		transport = "grpc"
+	}
+	fmt.Fprintf(buf, "\n// %s Chain33.%s (%s)\n", m.name, m.name, transport)
+	params := "ctx context.Context"
+	if !m.paramNil {
+		params += ", in " + m.param
+	}
+	ptr := strings.HasPrefix(m.result, "*")
+	fmt.Fprintf(buf, "func (c *Client) %s(%s) (%s, error) {\n", m.name, params, m.result)
+	if m.paramNil {
+		buf.WriteString("in := &types.ReqNil{}\n")
+	}
+	if ptr {
+		fmt.Fprintf(buf, "resp := new(%s)\n", m.result[1:])
+	} else {
+		fmt.Fprintf(buf, "var resp %s\n", m.result)
+	}
+	fmt.Fprintf(buf, "err := c.invoke(ctx, &call{\nmethod: %q,\nidempotent: %v,\njson: %v,\nparams: in,\n", m.name, m.idempotent, m.json)
+	if ptr {
+		buf.WriteString("result: resp,\n")
+	} else {
+		buf.WriteString("result: &resp,\n")
+	}
+	if m.grpc {
+		fmt.Fprintf(buf, `grpc: func(ctx context.Context, cli types.Chain33Client) error {
+	reply, err := cli.%s(ctx, in)
+	if err != nil {
+		return err
+	}
+	*resp = *reply
+	return nil
+},
+`, m.name)
+	}
+	buf.WriteString("})\n")
+	if ptr {
+		buf.WriteString("if err != nil {\nreturn nil, err\n}\n")
+	}
+	buf.WriteString("return resp, err\n}\n")
+}
@@ -0,0 +1,199 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const dappPath = "github.com/33cn/chain33/system/dapp"
+
+//funcDecl 函数定义和所在包的类型信息
+type funcDecl struct {
+	decl *ast.FuncDecl
+	info *types.Info
+}
+
+//queryResults 推导系统dapp 的Query_ 函数返回的proto 消息, key 为 执行器.函数名
+//dapp 的proto 包名都是types, 消息全名为 types.类型名
+func queryResults(fset *token.FileSet, imp types.Importer, dir string) (map[string]string, error) {
+	decls := make(map[string]*funcDecl)
+	_, files, info, err := checkDir(fset, imp, dappPath, dir)
+	if err != nil {
+		return nil, err
+	}
+	addDecls(decls, files, info)
+	execDirs, err := filepath.Glob(filepath.Join(dir, "*", "executor"))
+	if err != nil {
+		return nil, err
+	}
+	type query struct {
+		key  string
+		decl *funcDecl
+	}
+	var queries []query
+	for _, execDir := range execDirs {
+		execer := filepath.Base(filepath.Dir(execDir))
+		_, files, info, err := checkDir(fset, imp, dappPath+"/"+execer+"/executor", execDir)
+		if err != nil {
+			return nil, err
+		}
+		addDecls(decls, files, info)
+		for _, f := range files {
+			for _, d := range f.Decls {
+				fd, ok := d.(*ast.FuncDecl)
+				if ok && fd.Recv != nil && strings.HasPrefix(fd.Name.Name, "Query_") {
+					key := execer + "." + strings.TrimPrefix(fd.Name.Name, "Query_")
+					queries = append(queries, query{key: key, decl: &funcDecl{decl: fd, info: info}})
+				}
+			}
+		}
+	}
+	results := make(map[string]string)
+	for _, q := range queries {
+		found := make(map[string]bool)
+		returnTypes(q.decl, decls, make(map[*ast.FuncDecl]bool), found)
+		if len(found) != 1 {
+			continue
+		}
+		for name := range found {
+			if name != "" {
+				results[q.key] = name
+			}
+		}
+	}
+	return results, nil
+}
+
+func addDecls(decls map[string]*funcDecl, files []*ast.File, info *types.Info) {
+	for _, f := range files {
+		for _, d := range f.Decls {
+			if fd, ok := d.(*ast.FuncDecl); ok {
+				if fn, ok := info.Defs[fd.Name].(*types.Func); ok {
+					decls[fn.FullName()] = &funcDecl{decl: fd, info: info}
+				}
+			}
+		}
+	}
+}
+
+//returnTypes 收集函数第一个返回值的具体类型, 返回值是types.Message 的函数调用时继续推导被调用的函数,
+//无法推导时记录空字符串
+func returnTypes(fd *funcDecl, decls map[string]*funcDecl, visited map[*ast.FuncDecl]bool, found map[string]bool) {
+	if visited[fd.decl] || fd.decl.Body == nil {
+		return
+	}
+	visited[fd.decl] = true
+	ast.Inspect(fd.decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			if len(n.Results) == 0 {
+				return true
+			}
+			expr := n.Results[0]
+			tv := fd.info.Types[expr]
+			if tv.IsNil() {
+				return true
+			}
+			t := tv.Type
+			if tuple, ok := t.(*types.Tuple); ok && tuple.Len() > 0 {
+				t = tuple.At(0).Type()
+			}
+			if name, ok := messageName(t); ok {
+				found[name] = true
+				return true
+			}
+			if callee := calleeDecl(expr, fd.info, decls); callee != nil {
+				returnTypes(callee, decls, visited, found)
+				return true
+			}
+			found[""] = true
+		}
+		return true
+	})
+}
+
+func messageName(t types.Type) (string, bool) {
+	p, ok := t.(*types.Pointer)
+	if !ok {
+		return "", false
+	}
+	named, ok := p.Elem().(*types.Named)
+	if !ok {
+		return "", false
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return "", false
+	}
+	return "types." + named.Obj().Name(), true
+}
+
+func calleeDecl(expr ast.Expr, info *types.Info, decls map[string]*funcDecl) *funcDecl {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	var id *ast.Ident
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
+		return nil
+	}
+	fn, ok := info.Uses[id].(*types.Func)
+	if !ok {
+		return nil
+	}
+	return decls[fn.FullName()]
+}
+
+//generateSchema 生成rpc/types 中rpc schema 使用的返回值类型
+func generateSchema(methods map[string]*method, queries map[string]string) ([]byte, error) {
+	var names []string
+	for name, m := range methods {
+		if m.json && m.result != rawMessage {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by rpc/sdk/gen. DO NOT EDIT.\n\npackage types\n\nimport (\n\"reflect\"\n\n")
+	buf.WriteString("\"" + typesPath + "\"\n)\n\n")
+	buf.WriteString("// jrpcResultTypes Chain33 接口的返回值类型, 由handler 中 *result 的赋值语句推导\n")
+	buf.WriteString("var jrpcResultTypes = map[string]reflect.Type{\n")
+	for _, name := range names {
+		result := strings.Replace(methods[name].result, "rpctypes.", "", -1)
+		if strings.HasPrefix(result, "*") {
+			fmt.Fprintf(&buf, "%q: reflect.TypeOf((%s)(nil)),\n", "Chain33."+name, result)
+		} else {
+			fmt.Fprintf(&buf, "%q: reflect.TypeOf((*%s)(nil)).Elem(),\n", "Chain33."+name, result)
+		}
+	}
+	buf.WriteString("}\n\n")
+	keys := make([]string, 0, len(queries))
+	for key := range queries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	buf.WriteString("// queryResultTypes 系统dapp 查询函数返回的proto 消息全名, key 为 执行器.函数名\n")
+	buf.WriteString("var queryResultTypes = map[string]string{\n")
+	for _, key := range keys {
+		fmt.Fprintf(&buf, "%q: %q,\n", key, queries[key])
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,207 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sdk chain33 rpc的go语言客户端, 每个rpc接口对应一个带类型的方法
+//
+// api.go 由 gen 根据 rpc.Chain33 的jsonrpc接口和 types.Chain33Client 的grpc接口生成,
+// 同时生成rpc/types/schema_result.go, 为rpc schema 提供返回值类型, rpc接口变化后执行 go generate 重新生成
+package sdk
+
+//go:generate go run ./gen -out api.go -schema ../types/schema_result.go
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/rpc/grpcclient"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = log15.New("module", "rpc.sdk")
+
+// grpcRecvSize grpc接收消息的最大长度
+const grpcRecvSize = 100 * 1024 * 1024
+
+var (
+	// ErrNoTransport 没有配置接口所需的传输方式
+	ErrNoTransport = errors.New("ErrNoTransport")
+)
+
+// retryErrors 服务端繁忙时返回的错误, 可以重试
+var retryErrors = []string{"ErrQueueOverload", "ErrQueueTimeout", "ErrQueueChannelFull"}
+
+// Config sdk客户端配置
+type Config struct {
+	// jsonrpc地址, 如 http://localhost:8801
+	JSONRPCAddr string
+	// grpc地址, 多个地址用逗号分隔, 通过multiple负载均衡
+	GRPCAddr string
+	// 单次请求超时时间, 0表示只受context控制
+	Timeout time.Duration
+	// 幂等接口失败后的重试次数
+	RetryTimes int
+	// 重试间隔
+	RetryInterval time.Duration
+	// WaitTx查询交易的间隔
+	PollInterval time.Duration
+}
+
+// Client chain33 rpc客户端, 同时配置jsonrpc和grpc时, 参数和返回值一致的接口优先使用grpc
+type Client struct {
+	cfg  Config
+	json *jsonclient.JSONClient
+	grpc types.Chain33Client
+	conn *grpc.ClientConn
+}
+
+// call 一次rpc调用, jsonrpc和grpc至少支持一种
+type call struct {
+	method     string
+	idempotent bool
+	// 是否支持jsonrpc
+	json   bool
+	params interface{}
+	result interface{}
+	// 支持grpc时不为nil
+	grpc func(ctx context.Context, cli types.Chain33Client) error
+}
+
+// New 创建sdk客户端
+func New(cfg *Config) (*Client, error) {
+	if cfg == nil || (cfg.JSONRPCAddr == "" && cfg.GRPCAddr == "") {
+		return nil, types.ErrInvalidParam
+	}
+	c := &Client{cfg: *cfg}
+	if c.cfg.RetryInterval == 0 {
+		c.cfg.RetryInterval = time.Second / 2
+	}
+	if c.cfg.PollInterval == 0 {
+		c.cfg.PollInterval = time.Second / 2
+	}
+	if cfg.JSONRPCAddr != "" {
+		jcli, err := jsonclient.NewJSONClient(cfg.JSONRPCAddr)
+		if err != nil {
+			return nil, err
+		}
+		c.json = jcli
+	}
+	if cfg.GRPCAddr != "" {
+		conn, err := grpc.Dial(grpcclient.NewMultipleURL(cfg.GRPCAddr), grpc.WithInsecure(),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(grpcRecvSize)))
+		if err != nil {
+			return nil, err
+		}
+		c.conn = conn
+		c.grpc = types.NewChain33Client(conn)
+	}
+	return c, nil
+}
+
+// Close 关闭grpc连接
+func (c *Client) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
+
+// GRPC 返回底层的grpc客户端, 没有配置grpc时返回nil
+func (c *Client) GRPC() types.Chain33Client {
+	return c.grpc
+}
+
+func (c *Client) invoke(ctx context.Context, req *call) error {
+	retry := 0
+	if req.idempotent {
+		retry = c.cfg.RetryTimes
+	}
+	for i := 0; ; i++ {
+		err := c.invokeOnce(ctx, req)
+		if err == nil || i >= retry || !isRetryable(err) {
+			return err
+		}
+		log.Debug("invoke retry", "method", req.method, "times", i+1, "err", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.cfg.RetryInterval):
+		}
+	}
+}
+
+func (c *Client) invokeOnce(ctx context.Context, req *call) error {
+	if c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+	if req.grpc != nil && c.grpc != nil {
+		return req.grpc(ctx, c.grpc)
+	}
+	if req.json && c.json != nil {
+		return c.json.CallContext(ctx, "Chain33."+req.method, req.params, req.result)
+	}
+	return ErrNoTransport
+}
+
+//isRetryable 网络错误以及服务端繁忙可以重试, 接口本身返回的错误不重试
+func isRetryable(err error) bool {
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return false
+	}
+	var uerr *url.Error
+	if errors.As(err, &uerr) {
+		return !errors.Is(uerr.Err, context.Canceled)
+	}
+	var nerr net.Error
+	if errors.As(err, &nerr) {
+		return true
+	}
+	msg := err.Error()
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.ResourceExhausted:
+			return true
+		}
+		msg = s.Message()
+	}
+	for _, e := range retryErrors {
+		if strings.Contains(msg, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// SendTx 发送已签名的交易, 返回交易哈希
+func (c *Client) SendTx(ctx context.Context, tx *types.Transaction) (string, error) {
+	return c.SendTransaction(ctx, &rpctypes.RawParm{Data: common.ToHex(types.Encode(tx))})
+}
+
+// WaitTx 等待交易被打包, 直到查询到交易详情或者context结束
+func (c *Client) WaitTx(ctx context.Context, hash string) (*rpctypes.TransactionDetail, error) {
+	for {
+		detail, err := c.QueryTransaction(ctx, &rpctypes.QueryParm{Hash: hash})
+		if err == nil {
+			return detail, nil
+		}
+		log.Debug("WaitTx", "hash", hash, "err", err)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.cfg.PollInterval):
+		}
+	}
+}
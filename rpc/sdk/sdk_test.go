@@ -0,0 +1,219 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/client/mocks"
+	qmocks "github.com/33cn/chain33/queue/mocks"
+	"github.com/33cn/chain33/rpc"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type jsonReq struct {
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+	ID     uint64            `json:"id"`
+}
+
+//newJSONServer 模拟jsonrpc服务, handler返回result和error
+func newJSONServer(handler func(req *jsonReq) (interface{}, string)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		req := &jsonReq{}
+		_ = json.Unmarshal(data, req)
+		result, errstr := handler(req)
+		resp := map[string]interface{}{"id": req.ID, "result": result, "error": nil}
+		if errstr != "" {
+			resp["error"] = errstr
+			resp["result"] = nil
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestJSONRPC(t *testing.T) {
+	var calls int32
+	server := newJSONServer(func(req *jsonReq) (interface{}, string) {
+		n := atomic.AddInt32(&calls, 1)
+		switch req.Method {
+		case "Chain33.GetLastHeader":
+			if n == 1 {
+				return nil, "ErrQueueOverload"
+			}
+			return &rpctypes.Header{Height: 10, Hash: "0x01"}, ""
+		case "Chain33.SendTransaction":
+			return nil, "ErrQueueOverload"
+		case "Chain33.IsSync":
+			return true, ""
+		}
+		return nil, "ErrActionNotSupport"
+	})
+	defer server.Close()
+	cli, err := New(&Config{JSONRPCAddr: server.URL, RetryTimes: 2, RetryInterval: time.Millisecond})
+	assert.Nil(t, err)
+	defer cli.Close()
+	ctx := context.Background()
+
+	//幂等接口繁忙时重试
+	header, err := cli.GetLastHeader(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), header.Height)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	//非幂等接口不重试
+	atomic.StoreInt32(&calls, 0)
+	_, err = cli.SendTransaction(ctx, &rpctypes.RawParm{Data: "0x00"})
+	assert.Equal(t, "ErrQueueOverload", err.Error())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	//接口错误不重试
+	atomic.StoreInt32(&calls, 0)
+	_, err = cli.GetPeerInfo(ctx, &types.P2PGetPeerReq{})
+	assert.Equal(t, "ErrActionNotSupport", err.Error())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	ok, err := cli.IsSync(ctx)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	//只支持grpc的接口
+	_, err = cli.GetFork(ctx, &types.ReqKey{Key: []byte("ForkV1")})
+	assert.Equal(t, ErrNoTransport, err)
+
+	_, err = New(&Config{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+func TestContext(t *testing.T) {
+	block := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-block
+	}))
+	defer server.Close()
+	defer close(block)
+	cli, err := New(&Config{JSONRPCAddr: server.URL, RetryTimes: 3, RetryInterval: time.Millisecond})
+	assert.Nil(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	start := time.Now()
+	_, err = cli.GetLastHeader(ctx)
+	assert.NotNil(t, err)
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func TestWaitTx(t *testing.T) {
+	var calls int32
+	server := newJSONServer(func(req *jsonReq) (interface{}, string) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			return nil, "ErrTxNotExist"
+		}
+		return &rpctypes.TransactionDetail{Height: 5, Tx: &rpctypes.Transaction{Execer: "coins"}}, ""
+	})
+	defer server.Close()
+	cli, err := New(&Config{JSONRPCAddr: server.URL, PollInterval: time.Millisecond})
+	assert.Nil(t, err)
+	detail, err := cli.WaitTx(context.Background(), "0x01")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), detail.Height)
+	assert.Equal(t, "coins", detail.Tx.Execer)
+
+	atomic.StoreInt32(&calls, -1000)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	_, err = cli.WaitTx(ctx, "0x01")
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestGRPC(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	qapi := new(mocks.QueueProtocolAPI)
+	qapi.On("GetConfig", mock.Anything).Return(cfg)
+	qapi.On("Version").Return(&types.VersionInfo{Chain33: "6.0.0"}, nil)
+	qapi.On("Close").Return()
+	rpcCfg := new(types.RPC)
+	rpcCfg.GrpcBindAddr = "127.0.0.1:18903"
+	rpcCfg.Whitelist = []string{"127.0.0.1"}
+	rpcCfg.GrpcFuncWhitelist = []string{"*"}
+	rpc.InitCfg(rpcCfg)
+	qm := &qmocks.Client{}
+	qm.On("GetConfig", mock.Anything).Return(cfg)
+	server := rpc.NewGRpcServer(qm, qapi)
+	go server.Listen()
+	defer server.Close()
+	time.Sleep(time.Second / 2)
+
+	cli, err := New(&Config{GRPCAddr: "127.0.0.1:18903", Timeout: time.Second * 5})
+	assert.Nil(t, err)
+	defer cli.Close()
+	assert.NotNil(t, cli.GRPC())
+	ctx := context.Background()
+	version, err := cli.Version(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "6.0.0", version.Chain33)
+	fork, err := cli.GetFork(ctx, &types.ReqKey{Key: []byte("ForkV1")})
+	assert.Nil(t, err)
+	assert.Equal(t, cfg.GetFork("ForkV1"), fork.Data)
+	//只支持jsonrpc的接口
+	_, err = cli.GetLastHeader(ctx)
+	assert.Equal(t, ErrNoTransport, err)
+}
+
+func TestTxBuilder(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	priv := "CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944"
+	to := "1CbEVT9RnM5oZhWMj4fxUrJX94VtRotzvs"
+	b := NewTxBuilder(cfg).SetExpire(time.Minute * 5)
+
+	tx, err := b.Transfer(to, types.Coin, "hello")
+	assert.Nil(t, err)
+	assert.Equal(t, "coins", string(tx.Execer))
+	assert.Equal(t, to, tx.To)
+	assert.Equal(t, cfg.GetChainID(), tx.ChainID)
+	assert.True(t, tx.Fee >= cfg.GetMinTxFeeRate())
+	assert.True(t, tx.Expire > types.Now().Unix())
+	assert.Nil(t, SignHex(tx, types.SECP256K1, priv))
+	assert.True(t, tx.CheckSign(0))
+	assert.Equal(t, "14KEKbYtKKQm4wMthSK9J4La4nAiidGozt", tx.From())
+
+	tx, err = b.SetFee(types.Coin).TransferToExec("ticket", types.Coin, "")
+	assert.Nil(t, err)
+	assert.Equal(t, types.Coin, tx.Fee)
+	assert.Equal(t, "16htvcBNSEA7fZhAdLJphDwQRQJaHpyHTp", tx.To)
+	_, err = b.Withdraw("ticket", 0, "")
+	assert.Equal(t, types.ErrAmount, err)
+	_, err = b.Transfer("abc", types.Coin, "")
+	assert.NotNil(t, err)
+
+	tx, err = b.ModifyConfig("token-blacklist", "add", "BTY")
+	assert.Nil(t, err)
+	assert.Equal(t, "manage", string(tx.Execer))
+	_, err = b.ModifyConfig("token-blacklist", "update", "BTY")
+	assert.Equal(t, types.ErrInvalidParam, err)
+	assert.NotNil(t, SignHex(tx, types.SECP256K1, "0xzz"))
+
+	//账户序号依次递增
+	b.SetSeqNonce(7)
+	for i := int64(7); i < 9; i++ {
+		tx, err = b.Transfer(to, types.Coin, "")
+		assert.Nil(t, err)
+		assert.Equal(t, i, tx.Nonce)
+		assert.True(t, tx.IsSeqNonce)
+	}
+	tx, err = b.SetSeqNonce(-1).Transfer(to, types.Coin, "")
+	assert.Nil(t, err)
+	assert.False(t, tx.IsSeqNonce)
+}
@@ -0,0 +1,150 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	mty "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+
+	//本地签名需要注册签名算法
+	_ "github.com/33cn/chain33/system/crypto/init"
+)
+
+// TxBuilder 构造系统合约(coins, manage)交易, 平行链上自动使用带title前缀的执行器名
+type TxBuilder struct {
+	cfg    *types.Chain33Config
+	fee    int64
+	expire time.Duration
+	//下一笔交易使用的账户序号, 小于0表示使用随机nonce
+	seq int64
+}
+
+// NewTxBuilder 创建交易构造器, 默认手续费按照最低费率计算
+func NewTxBuilder(cfg *types.Chain33Config) *TxBuilder {
+	return &TxBuilder{cfg: cfg, seq: -1}
+}
+
+// SetFee 设置固定的手续费
+func (b *TxBuilder) SetFee(fee int64) *TxBuilder {
+	b.fee = fee
+	return b
+}
+
+// SetExpire 设置交易过期时间, 规则同 types.Transaction.SetExpire
+func (b *TxBuilder) SetExpire(expire time.Duration) *TxBuilder {
+	b.expire = expire
+	return b
+}
+
+// SetSeqNonce 之后构造的交易使用从seq开始递增的账户序号, 链上序号可以通过 GetAccountNonce 获取
+func (b *TxBuilder) SetSeqNonce(seq int64) *TxBuilder {
+	b.seq = seq
+	return b
+}
+
+// Transfer coins转账
+func (b *TxBuilder) Transfer(to string, amount int64, note string) (*types.Transaction, error) {
+	if err := checkAmount(amount); err != nil {
+		return nil, err
+	}
+	if err := address.CheckAddress(to); err != nil {
+		return nil, err
+	}
+	action := &cty.CoinsAction{
+		Ty:    cty.CoinsActionTransfer,
+		Value: &cty.CoinsAction_Transfer{Transfer: &types.AssetsTransfer{Amount: amount, Note: []byte(note), To: to}},
+	}
+	return b.build(cty.CoinsX, action, to)
+}
+
+// TransferToExec 转账到合约
+func (b *TxBuilder) TransferToExec(execName string, amount int64, note string) (*types.Transaction, error) {
+	if err := checkAmount(amount); err != nil {
+		return nil, err
+	}
+	to := address.ExecAddress(execName)
+	action := &cty.CoinsAction{
+		Ty: cty.CoinsActionTransferToExec,
+		Value: &cty.CoinsAction_TransferToExec{TransferToExec: &types.AssetsTransferToExec{
+			Amount: amount, Note: []byte(note), ExecName: execName, To: to}},
+	}
+	return b.build(cty.CoinsX, action, to)
+}
+
+// Withdraw 从合约中取回
+func (b *TxBuilder) Withdraw(execName string, amount int64, note string) (*types.Transaction, error) {
+	if err := checkAmount(amount); err != nil {
+		return nil, err
+	}
+	to := address.ExecAddress(execName)
+	action := &cty.CoinsAction{
+		Ty: cty.CoinsActionWithdraw,
+		Value: &cty.CoinsAction_Withdraw{Withdraw: &types.AssetsWithdraw{
+			Amount: amount, Note: []byte(note), ExecName: execName, To: to}},
+	}
+	return b.build(cty.CoinsX, action, to)
+}
+
+// ModifyConfig manage合约修改配置, op为add或者delete
+func (b *TxBuilder) ModifyConfig(key, op, value string) (*types.Transaction, error) {
+	if key == "" || (op != "add" && op != "delete") {
+		return nil, types.ErrInvalidParam
+	}
+	action := &mty.ManageAction{
+		Ty:    mty.ManageActionModifyConfig,
+		Value: &mty.ManageAction_Modify{Modify: &types.ModifyConfig{Key: key, Op: op, Value: value}},
+	}
+	return b.build(mty.ManageX, action, "")
+}
+
+func (b *TxBuilder) build(execer string, action types.Message, to string) (*types.Transaction, error) {
+	execName := b.cfg.ExecName(execer)
+	tx := &types.Transaction{Payload: types.Encode(action), To: to, Fee: b.fee}
+	if b.seq >= 0 {
+		tx.SetSeqNonce(b.seq)
+	}
+	tx, err := types.FormatTx(b.cfg, execName, tx)
+	if err != nil {
+		return nil, err
+	}
+	if b.seq >= 0 {
+		b.seq++
+	}
+	if b.expire != 0 {
+		tx.SetExpire(b.cfg, b.expire)
+	}
+	return tx, nil
+}
+
+func checkAmount(amount int64) error {
+	if amount <= 0 || amount >= types.MaxCoin {
+		return types.ErrAmount
+	}
+	return nil
+}
+
+// SignHex 使用16进制私钥在本地签名, signType为types.SECP256K1等
+func SignHex(tx *types.Transaction, signType int32, hexKey string) error {
+	key, err := common.FromHex(hexKey)
+	if err != nil {
+		return err
+	}
+	c, err := crypto.New(types.GetSignName("", int(signType)))
+	if err != nil {
+		return err
+	}
+	priv, err := c.PrivKeyFromBytes(key)
+	if err != nil {
+		return err
+	}
+	tx.Sign(signType, priv)
+	return nil
+}
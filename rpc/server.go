@@ -10,20 +10,26 @@ import (
 	"net"
 	"net/http"
 	"net/rpc"
+	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common/trace"
+	"github.com/33cn/chain33/metrics"
 	"github.com/33cn/chain33/pluginmgr"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/rpc/grpcclient"
 	_ "github.com/33cn/chain33/rpc/grpcclient" // register grpc multiple resolver
+	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	_ "google.golang.org/grpc/encoding/gzip" // register gzip
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 )
 
 var (
@@ -34,6 +40,8 @@ var (
 	jrpcFuncBlacklist           = make(map[string]bool)
 	grpcFuncBlacklist           = make(map[string]bool)
 	rpcFilterPrintFuncBlacklist = make(map[string]bool)
+	//配置热加载时会重建ip 白名单和函数的白名单以及黑名单
+	aclMu sync.RWMutex
 )
 
 // Chain33  a channel client
@@ -103,6 +111,8 @@ func checkBasicAuth(r *http.Request) bool {
 }
 
 func checkIPWhitelist(addr string) bool {
+	aclMu.RLock()
+	defer aclMu.RUnlock()
 	//回环网络直接允许
 	ip := net.ParseIP(addr)
 	if ip.IsLoopback() {
@@ -122,6 +132,8 @@ func checkIPWhitelist(addr string) bool {
 }
 
 func checkJrpcFuncWhitelist(funcName string) bool {
+	aclMu.RLock()
+	defer aclMu.RUnlock()
 
 	if _, ok := jrpcFuncWhitelist["*"]; ok {
 		return true
@@ -133,6 +145,8 @@ func checkJrpcFuncWhitelist(funcName string) bool {
 	return false
 }
 func checkGrpcFuncWhitelist(funcName string) bool {
+	aclMu.RLock()
+	defer aclMu.RUnlock()
 
 	if _, ok := grpcFuncWhitelist["*"]; ok {
 		return true
@@ -144,12 +158,16 @@ func checkGrpcFuncWhitelist(funcName string) bool {
 	return false
 }
 func checkJrpcFuncBlacklist(funcName string) bool {
+	aclMu.RLock()
+	defer aclMu.RUnlock()
 	if _, ok := jrpcFuncBlacklist[funcName]; ok {
 		return true
 	}
 	return false
 }
 func checkGrpcFuncBlacklist(funcName string) bool {
+	aclMu.RLock()
+	defer aclMu.RUnlock()
 	if _, ok := grpcFuncBlacklist[funcName]; ok {
 		return true
 	}
@@ -183,8 +201,21 @@ func NewGRpcServer(c queue.Client, api client.QueueProtocolAPI) *Grpcserver {
 		if err := auth(ctx, info); err != nil {
 			return nil, err
 		}
+		defer metrics.UpdateTimerSince(metrics.Name("chain33/rpc/grpc", "method", info.FullMethod), time.Now())
+		var traceParent string
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get("traceparent")) > 0 {
+			traceParent = md.Get("traceparent")[0]
+		}
+		span := startRPCSpan("grpc "+info.FullMethod, traceParent)
+		defer span.End()
 		// Continue processing the request
-		return handler(ctx, req)
+		if traced := s.grpc.traced(span.Context()); traced != nil && info.Server == s.grpc {
+			resp, err = traced.call(ctx, info.FullMethod, req, handler)
+		} else {
+			resp, err = handler(ctx, req)
+		}
+		span.SetError(err)
+		return resp, err
 	}
 	opts = append(opts, grpc.UnaryInterceptor(interceptor))
 	if rpcCfg.EnableTLS {
@@ -208,6 +239,41 @@ func NewGRpcServer(c queue.Client, api client.QueueProtocolAPI) *Grpcserver {
 	return s
 }
 
+// serveRequest 被采样的Chain33请求使用延续追踪的接口处理, 处理过程中发出的queue消息都带有追踪上下文
+func (j *JSONRPCServer) serveRequest(codec rpc.ServerCodec, method string, sc trace.SpanContext) error {
+	if !sc.IsValid() || !strings.HasPrefix(method, "Chain33.") {
+		return j.s.ServeRequest(codec)
+	}
+	traced := *j.jrpc
+	traced.cli.QueueProtocolAPI = client.WithTrace(traced.cli.QueueProtocolAPI, sc)
+	server := rpc.NewServer()
+	if err := server.RegisterName("Chain33", &traced); err != nil {
+		return j.s.ServeRequest(codec)
+	}
+	return server.ServeRequest(codec)
+}
+
+// traced 被采样的grpc请求使用延续追踪的接口, 未采样时返回nil
+func (g *Grpc) traced(sc trace.SpanContext) *Grpc {
+	if !sc.IsValid() {
+		return nil
+	}
+	traced := *g
+	traced.cli.QueueProtocolAPI = client.WithTrace(traced.cli.QueueProtocolAPI, sc)
+	return &traced
+}
+
+// call 按照grpc方法名调用同名的处理函数, 找不到时使用原处理函数
+func (g *Grpc) call(ctx context.Context, fullMethod string, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+	method := reflect.ValueOf(g).MethodByName(fullMethod[strings.LastIndex(fullMethod, "/")+1:])
+	if !method.IsValid() || method.Type().NumIn() != 2 || method.Type().NumOut() != 2 {
+		return handler(ctx, req)
+	}
+	out := method.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)})
+	err, _ := out[1].Interface().(error)
+	return out[0].Interface(), err
+}
+
 // NewJSONRPCServer new json rpcserver object
 func NewJSONRPCServer(c queue.Client, api client.QueueProtocolAPI) *JSONRPCServer {
 	j := &JSONRPCServer{jrpc: &Chain33{}}
@@ -225,6 +291,7 @@ func NewJSONRPCServer(c queue.Client, api client.QueueProtocolAPI) *JSONRPCServe
 	if err != nil {
 		return nil
 	}
+	rpctypes.RegisterJrpcService("Chain33", j.jrpc)
 	return j
 }
 
@@ -248,6 +315,22 @@ func InitCfg(cfg *types.RPC) {
 	InitFilterPrintFuncBlacklist()
 }
 
+// ReloadCfg 配置热加载时重建ip 白名单以及函数的白名单和黑名单
+func ReloadCfg(cfg *types.RPC) {
+	aclMu.Lock()
+	defer aclMu.Unlock()
+	remoteIPWhitelist = make(map[string]bool)
+	jrpcFuncWhitelist = make(map[string]bool)
+	grpcFuncWhitelist = make(map[string]bool)
+	jrpcFuncBlacklist = make(map[string]bool)
+	grpcFuncBlacklist = make(map[string]bool)
+	InitIPWhitelist(cfg)
+	InitJrpcFuncWhitelist(cfg)
+	InitGrpcFuncWhitelist(cfg)
+	InitJrpcFuncBlacklist(cfg)
+	InitGrpcFuncBlacklist(cfg)
+}
+
 // New produce a rpc by cfg
 func New(cfg *types.Chain33Config) *RPC {
 	mcfg := cfg.GetModuleConfig().RPC
@@ -273,6 +356,24 @@ func (r *RPC) SetQueueClient(c queue.Client) {
 	//注册系统rpc
 	pluginmgr.AddRPC(r)
 	r.Listen()
+	c.Sub("rpc")
+	go r.handleEvent()
+}
+
+// handleEvent 处理发送到rpc模块的通知
+func (r *RPC) handleEvent() {
+	for msg := range r.c.Recv() {
+		switch msg.Ty {
+		case types.EventConfigReloaded:
+			reload, ok := msg.GetData().(*types.ReloadConfig)
+			if ok && reload.Config.RPC != nil {
+				ReloadCfg(reload.Config.RPC)
+				log.Info("rpc config reloaded", "whitelist", reload.Config.RPC.Whitelist)
+			}
+		default:
+			log.Error("rpc handleEvent", "unknown message type", msg.Ty)
+		}
+	}
 }
 
 // SetQueueClientNoListen  set queue client with  no listen
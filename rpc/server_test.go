@@ -6,18 +6,25 @@ package rpc
 
 import (
 	"errors"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/33cn/chain33/client/mocks"
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/record"
+	"github.com/33cn/chain33/common/trace"
+	"github.com/33cn/chain33/queue"
 	qmocks "github.com/33cn/chain33/queue/mocks"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
@@ -238,6 +245,8 @@ func TestRPC(t *testing.T) {
 	rpc := New(cfg)
 	client := &qmocks.Client{}
 	client.On("GetConfig", mock.Anything).Return(cfg)
+	client.On("Sub", "rpc").Return()
+	client.On("Recv").Return(make(chan *queue.Message))
 	rpc.SetQueueClient(client)
 
 	assert.Equal(t, client, rpc.GetQueueClient())
@@ -245,6 +254,29 @@ func TestRPC(t *testing.T) {
 	assert.NotNil(t, rpc.JRPC())
 }
 
+func TestReloadCfg(t *testing.T) {
+	ipWhitelist, jrpcWhitelist, jrpcBlacklist := remoteIPWhitelist, jrpcFuncWhitelist, jrpcFuncBlacklist
+	grpcWhitelist, grpcBlacklist := grpcFuncWhitelist, grpcFuncBlacklist
+	defer func() {
+		remoteIPWhitelist, jrpcFuncWhitelist, jrpcFuncBlacklist = ipWhitelist, jrpcWhitelist, jrpcBlacklist
+		grpcFuncWhitelist, grpcFuncBlacklist = grpcWhitelist, grpcBlacklist
+	}()
+	ReloadCfg(&types.RPC{
+		Whitelist:         []string{"192.168.0.1"},
+		JrpcFuncWhitelist: []string{"GetPeerInfo"},
+		JrpcFuncBlacklist: []string{"SendTransaction"},
+	})
+	assert.True(t, checkIPWhitelist("192.168.0.1"))
+	assert.False(t, checkIPWhitelist("192.168.0.2"))
+	assert.True(t, checkJrpcFuncWhitelist("GetPeerInfo"))
+	assert.False(t, checkJrpcFuncWhitelist("GetBlocks"))
+	assert.True(t, checkJrpcFuncBlacklist("SendTransaction"))
+	//原来默认的黑名单不再生效
+	assert.False(t, checkJrpcFuncBlacklist("CloseQueue"))
+	assert.True(t, checkGrpcFuncWhitelist("GetBlocks"))
+	assert.True(t, checkGrpcFuncBlacklist("CloseQueue"))
+}
+
 func TestCheckFuncList(t *testing.T) {
 	funcName := "abc"
 	jrpcFuncWhitelist = make(map[string]bool)
@@ -276,3 +308,45 @@ func TestCheckFuncList(t *testing.T) {
 	assert.True(t, checkGrpcFuncBlacklist(funcName))
 
 }
+
+func TestGrpcTracedCall(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	api.On("IsSync").Return(&types.Reply{IsOk: true}, nil)
+	g := &Grpc{}
+	g.cli.QueueProtocolAPI = api
+	assert.Nil(t, g.traced(trace.SpanContext{}))
+	traced := g.traced(trace.SpanContext{TraceID: trace.TraceID{1}, SpanID: trace.SpanID{1}})
+	require.NotNil(t, traced)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, types.ErrNotSupport
+	}
+	resp, err := traced.call(context.Background(), "/types.chain33/IsSync", &types.ReqNil{}, handler)
+	assert.Nil(t, err)
+	assert.True(t, resp.(*types.Reply).IsOk)
+	//没有同名的处理函数时使用原处理函数
+	_, err = traced.call(context.Background(), "/types.chain33/Unknown", &types.ReqNil{}, handler)
+	assert.Equal(t, types.ErrNotSupport, err)
+}
+
+func TestRecordRPCDenylist(t *testing.T) {
+	assert.False(t, recordableRPC("UnLock"))
+	assert.False(t, recordableRPC("DumpPrivkey"))
+	assert.True(t, recordableRPC("GetLastHeader"))
+
+	dir, err := ioutil.TempDir("", "record")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "rpc.gz")
+	require.Nil(t, record.Init(&record.Config{Enable: true, File: file, Kinds: []string{"rpc"}}))
+	now := types.Now()
+	recordRPC("Chain33.UnLock", "127.0.0.1", []byte(`{"passwd":"123456"}`), nil, now, nil)
+	recordRPC("Chain33.ImportPrivkey", "127.0.0.1", []byte(`{"privkey":"0x01"}`), nil, now, nil)
+	recordRPC("Chain33.GetLastHeader", "127.0.0.1", []byte(`{}`), []byte(`{}`), now, nil)
+	record.Close()
+
+	entries, err := record.ReadFile(file)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, "Chain33.GetLastHeader", entries[0].Method)
+}
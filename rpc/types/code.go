@@ -7,6 +7,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -18,18 +19,7 @@ import (
 
 // DecodeLog decode log
 func DecodeLog(execer []byte, rlog *ReceiptData) (*ReceiptDataResult, error) {
-	var rTy string
-	switch rlog.Ty {
-	case 0:
-		rTy = "ExecErr"
-	case 1:
-		rTy = "ExecPack"
-	case 2:
-		rTy = "ExecOk"
-	default:
-		rTy = "Unknown"
-	}
-	rd := &ReceiptDataResult{Ty: rlog.Ty, TyName: rTy}
+	rd := &ReceiptDataResult{Ty: rlog.Ty, TyName: receiptTyName(rlog.Ty)}
 	for _, l := range rlog.Logs {
 		var lTy string
 		var logIns json.RawMessage
@@ -50,6 +40,147 @@ func DecodeLog(execer []byte, rlog *ReceiptData) (*ReceiptDataResult, error) {
 	return rd, nil
 }
 
+func receiptTyName(ty int32) string {
+	switch ty {
+	case types.ExecErr:
+		return "ExecErr"
+	case types.ExecPack:
+		return "ExecPack"
+	case types.ExecOk:
+		return "ExecOk"
+	}
+	return "Unknown"
+}
+
+// DecodeBlockReceipts 解析区块中所有交易的payload, 回执日志和账户变化, 不包含KV 变化, 见DecodeTxReceipt
+func DecodeBlockReceipts(cfg *types.Chain33Config, detail *types.BlockDetail) *DecodedReceipts {
+	block := detail.GetBlock()
+	res := &DecodedReceipts{
+		Height:    block.GetHeight(),
+		BlockHash: common.ToHex(block.Hash(cfg)),
+		BlockTime: block.GetBlockTime(),
+		Txs:       make([]*DecodedTxReceipt, 0, len(block.GetTxs())),
+	}
+	receipts := detail.GetReceipts()
+	for i, tx := range block.GetTxs() {
+		var receipt *types.ReceiptData
+		if i < len(receipts) {
+			receipt = receipts[i]
+		}
+		txr := DecodeTxReceipt(tx, receipt)
+		txr.Index = int64(i)
+		res.Txs = append(res.Txs, txr)
+	}
+	return res
+}
+
+// DecodeTxReceipt 解析交易的payload, 回执日志和账户变化, 解析失败的部分记录在Errors 中而不是忽略
+// 链上保存的回执(ReceiptData)只有类型和日志, 执行时的KV 变化不会持久化, 所以结果中没有KV 变化,
+// 需要状态变化时根据日志或者按高度查询状态
+func DecodeTxReceipt(tx *types.Transaction, receipt *types.ReceiptData) *DecodedTxReceipt {
+	res := &DecodedTxReceipt{
+		Hash:       common.ToHex(tx.Hash()),
+		Execer:     string(tx.Execer),
+		ActionName: tx.ActionName(),
+		From:       tx.From(),
+		To:         tx.GetRealToAddr(),
+		RawPayload: common.ToHex(tx.GetPayload()),
+		Logs:       make([]*DecodedLog, 0, len(receipt.GetLogs())),
+	}
+	payload, err := decodePayloadJSON(tx)
+	if err != nil {
+		res.Errors = append(res.Errors, fmt.Sprintf("payload: %s", err))
+	} else {
+		res.Payload = payload
+	}
+	if receipt == nil {
+		res.TyName = "Unknown"
+		res.Errors = append(res.Errors, fmt.Sprintf("receipt: %s", types.ErrNotFound))
+		return res
+	}
+	res.Ty = receipt.GetTy()
+	res.TyName = receiptTyName(receipt.GetTy())
+	for i, l := range receipt.GetLogs() {
+		dlog := &DecodedLog{Ty: l.GetTy(), RawLog: common.ToHex(l.GetLog())}
+		res.Logs = append(res.Logs, dlog)
+		logType := types.LoadLog(tx.Execer, int64(l.GetTy()))
+		if logType == nil {
+			dlog.TyName = "unkownType"
+			dlog.Error = types.ErrLogType.Error()
+			res.Errors = append(res.Errors, fmt.Sprintf("log %d: %s", i, dlog.Error))
+			continue
+		}
+		dlog.TyName = logType.Name()
+		data, err := logType.Decode(l.GetLog())
+		if err == nil {
+			dlog.Log, err = logToJSON(data)
+		}
+		if err != nil {
+			dlog.Error = err.Error()
+			res.Errors = append(res.Errors, fmt.Sprintf("log %d: %s", i, dlog.Error))
+			continue
+		}
+		if change := accountChange(dlog.TyName, data); change != nil {
+			res.AccountChanges = append(res.AccountChanges, change)
+		}
+	}
+	return res
+}
+
+func decodePayloadJSON(tx *types.Transaction) (json.RawMessage, error) {
+	if strings.HasSuffix(string(tx.Execer), "user.write") {
+		return types.PBToJSONUTF8(decodeUserWrite(tx.GetPayload()))
+	}
+	ety := types.LoadExecutorType(string(tx.Execer))
+	if ety == nil {
+		return nil, types.ErrExecNotFound
+	}
+	pl, err := ety.DecodePayload(tx)
+	if err != nil {
+		return nil, err
+	}
+	return types.PBToJSONUTF8(pl)
+}
+
+func logToJSON(data interface{}) (json.RawMessage, error) {
+	if msg, ok := data.(types.Message); ok {
+		return types.PBToJSON(msg)
+	}
+	return json.Marshal(data)
+}
+
+func accountChange(logName string, data interface{}) *AccountChange {
+	var execAddr string
+	var prev, current *types.Account
+	switch l := data.(type) {
+	case *types.ReceiptAccountTransfer:
+		prev, current = l.GetPrev(), l.GetCurrent()
+	case *types.ReceiptExecAccountTransfer:
+		execAddr, prev, current = l.GetExecAddr(), l.GetPrev(), l.GetCurrent()
+	case *types.ReceiptAccountMint:
+		prev, current = l.GetPrev(), l.GetCurrent()
+	case *types.ReceiptAccountBurn:
+		prev, current = l.GetPrev(), l.GetCurrent()
+	default:
+		return nil
+	}
+	addr := current.GetAddr()
+	if addr == "" {
+		addr = prev.GetAddr()
+	}
+	return &AccountChange{
+		Addr:         addr,
+		ExecAddr:     execAddr,
+		LogName:      logName,
+		PrevBalance:  prev.GetBalance(),
+		Balance:      current.GetBalance(),
+		BalanceDelta: current.GetBalance() - prev.GetBalance(),
+		PrevFrozen:   prev.GetFrozen(),
+		Frozen:       current.GetFrozen(),
+		FrozenDelta:  current.GetFrozen() - prev.GetFrozen(),
+	}
+}
+
 // ConvertWalletTxDetailToJSON conver the wallet tx detail to json
 func ConvertWalletTxDetailToJSON(in *types.WalletTxDetails, out *WalletTxDetails) error {
 	if in == nil || out == nil {
@@ -132,6 +263,7 @@ func DecodeTx(tx *types.Transaction) (*Transaction, error) {
 		Next:       common.ToHex(tx.Next),
 		Hash:       common.ToHex(tx.Hash()),
 		ChainID:    tx.ChainID,
+		IsSeqNonce: tx.IsSeqNonce,
 	}
 	feeResult := strconv.FormatFloat(float64(tx.Fee)/float64(types.Coin), 'f', 4, 64)
 	result.FeeFmt = feeResult
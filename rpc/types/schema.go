@@ -0,0 +1,421 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/version"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// OpenAPIVersion rpc schema 文档使用的openapi 版本
+const OpenAPIVersion = "3.0.3"
+
+const (
+	schemaRefPrefix   = "#/components/schemas/"
+	schemaDescription = "所有接口都以json rpc 的方式post 到节点的jrpc 地址, path 只用于区分接口. " +
+		"json rpc 的参数按encoding/json 解码; 以proto 全名命名的类型(如types.AssetsTransfer)是交易和查询的payload, " +
+		"按protobuf json 规则解码: int64/uint64 为字符串, bytes 为hex 字符串"
+)
+
+var (
+	jrpcServiceLock sync.Mutex
+	jrpcServices    = make(map[string]reflect.Type)
+
+	errorType       = reflect.TypeOf((*error)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	timeType        = reflect.TypeOf(time.Time{})
+)
+
+// RegisterJrpcService 记录已注册的json rpc 服务, 用于生成rpc schema
+func RegisterJrpcService(name string, rcvr interface{}) {
+	jrpcServiceLock.Lock()
+	defer jrpcServiceLock.Unlock()
+	jrpcServices[name] = reflect.TypeOf(rcvr)
+}
+
+// JSONSchema 类型描述, 字段含义同openapi 3.0 的schema object
+type JSONSchema struct {
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Nullable             bool                   `json:"nullable,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	MinItems             int                    `json:"minItems,omitempty"`
+	MaxItems             int                    `json:"maxItems,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+}
+
+// OpenAPIDoc rpc 接口的openapi 描述文档
+type OpenAPIDoc struct {
+	OpenAPI    string                  `json:"openapi"`
+	Info       OpenAPIInfo             `json:"info"`
+	Paths      map[string]*OpenAPIPath `json:"paths"`
+	Components OpenAPIComponents       `json:"components"`
+}
+
+// OpenAPIInfo 文档信息
+type OpenAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// OpenAPIPath 一个rpc 接口, 统一用post 方式调用
+type OpenAPIPath struct {
+	Post *OpenAPIOperation `json:"post"`
+}
+
+// OpenAPIOperation rpc 接口的请求和返回
+type OpenAPIOperation struct {
+	OperationID string                  `json:"operationId"`
+	Tags        []string                `json:"tags,omitempty"`
+	RequestBody *OpenAPIBody            `json:"requestBody"`
+	Responses   map[string]*OpenAPIBody `json:"responses"`
+}
+
+// OpenAPIBody 请求或返回的内容
+type OpenAPIBody struct {
+	Description string                   `json:"description,omitempty"`
+	Required    bool                     `json:"required,omitempty"`
+	Content     map[string]*OpenAPIMedia `json:"content"`
+}
+
+// OpenAPIMedia 内容对应的schema
+type OpenAPIMedia struct {
+	Schema *JSONSchema `json:"schema"`
+}
+
+// OpenAPIComponents 接口中引用的类型定义
+type OpenAPIComponents struct {
+	Schemas map[string]*JSONSchema `json:"schemas"`
+}
+
+// BuildRPCSchema 反射已注册的json rpc 服务, 执行器的action 和Query_ 函数, 生成openapi 文档
+// json rpc 方法对应 /服务名.方法名, action 对应 /Chain33.CreateTransaction/执行器/action,
+// 查询对应 /Chain33.Query/执行器/函数名
+func BuildRPCSchema() *OpenAPIDoc {
+	b := &schemaBuilder{
+		schemas: make(map[string]*JSONSchema),
+		paths:   make(map[string]*OpenAPIPath),
+	}
+	jrpcServiceLock.Lock()
+	services := make(map[string]reflect.Type, len(jrpcServices))
+	for name, ty := range jrpcServices {
+		services[name] = ty
+	}
+	jrpcServiceLock.Unlock()
+	for _, name := range sortedKeys(services) {
+		b.addService(name, services[name])
+	}
+	b.addExecutors()
+	return &OpenAPIDoc{
+		OpenAPI: OpenAPIVersion,
+		Info: OpenAPIInfo{
+			Title:       "chain33 json rpc",
+			Description: schemaDescription,
+			Version:     version.GetVersion(),
+		},
+		Paths:      b.paths,
+		Components: OpenAPIComponents{Schemas: b.schemas},
+	}
+}
+
+type schemaBuilder struct {
+	schemas map[string]*JSONSchema
+	paths   map[string]*OpenAPIPath
+}
+
+func sortedKeys(m map[string]reflect.Type) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+//isJrpcMethod 和net/rpc 的要求一致: func (t *T) Method(in T1, reply *T2) error
+func isJrpcMethod(m reflect.Method) bool {
+	mt := m.Type
+	return m.PkgPath == "" && mt.NumIn() == 3 && mt.In(2).Kind() == reflect.Ptr &&
+		mt.NumOut() == 1 && mt.Out(0) == errorType
+}
+
+func (b *schemaBuilder) addService(name string, ty reflect.Type) {
+	for i := 0; i < ty.NumMethod(); i++ {
+		m := ty.Method(i)
+		if !isJrpcMethod(m) {
+			continue
+		}
+		method := name + "." + m.Name
+		//result 为*interface{} 时使用由handler 推导出的类型
+		result := m.Type.In(2).Elem()
+		if ty, ok := jrpcResultTypes[method]; ok {
+			result = ty
+		}
+		b.addPath("/"+method, method, name, b.goSchema(m.Type.In(1)), b.goSchema(result))
+	}
+}
+
+func (b *schemaBuilder) addExecutors() {
+	for _, name := range types.ListExecutorType() {
+		ety := types.LoadExecutorType(name)
+		if ety == nil {
+			continue
+		}
+		actions := make([]string, 0, len(ety.GetTypeMap()))
+		for action := range ety.GetTypeMap() {
+			actions = append(actions, action)
+		}
+		sort.Strings(actions)
+		for _, action := range actions {
+			msg, err := ety.GetAction(action)
+			if err != nil {
+				continue
+			}
+			params := &JSONSchema{
+				Type: "object",
+				Properties: map[string]*JSONSchema{
+					"execer":     {Type: "string", Enum: []string{name}},
+					"actionName": {Type: "string", Enum: []string{action}},
+					"payload":    b.pbSchema(msg),
+				},
+				Required: []string{"execer", "actionName", "payload"},
+			}
+			result := &JSONSchema{Type: "string", Format: "hex", Description: "未签名的交易"}
+			b.addPath("/Chain33.CreateTransaction/"+name+"/"+action, "Chain33.CreateTransaction", name, params, result)
+		}
+		_, queries := types.BuildQueryType("Query_", ety.GetExecFuncMap())
+		for _, funcName := range sortedKeys(queries) {
+			msg, ok := reflect.New(queries[funcName].In(1).Elem()).Interface().(proto.Message)
+			if !ok {
+				continue
+			}
+			params := &JSONSchema{
+				Type: "object",
+				Properties: map[string]*JSONSchema{
+					"execer":   {Type: "string", Enum: []string{name}},
+					"funcName": {Type: "string", Enum: []string{funcName}},
+					"payload":  b.pbSchema(msg),
+				},
+				Required: []string{"execer", "funcName", "payload"},
+			}
+			b.addPath("/Chain33.Query/"+name+"/"+funcName, "Chain33.Query", name, params, b.queryResultSchema(name, funcName))
+		}
+	}
+}
+
+//queryResultSchema Query_ 函数的返回值都是types.Message, 按生成的proto 消息名描述, 未知时不限定类型
+func (b *schemaBuilder) queryResultSchema(execer, funcName string) *JSONSchema {
+	name, ok := queryResultTypes[execer+"."+funcName]
+	if !ok {
+		return &JSONSchema{}
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(name))
+	if err != nil {
+		return &JSONSchema{}
+	}
+	return b.messageSchema(mt.Descriptor())
+}
+
+func (b *schemaBuilder) addPath(path, method, tag string, params, result *JSONSchema) {
+	request := &JSONSchema{
+		Type: "object",
+		Properties: map[string]*JSONSchema{
+			"id":     {Type: "integer"},
+			"method": {Type: "string", Enum: []string{method}},
+			"params": {Type: "array", Items: params, MinItems: 1, MaxItems: 1},
+		},
+		Required: []string{"method", "params"},
+	}
+	response := &JSONSchema{
+		Type: "object",
+		Properties: map[string]*JSONSchema{
+			"id":     {Type: "integer"},
+			"result": result,
+			"error":  {Type: "string", Nullable: true},
+		},
+	}
+	b.paths[path] = &OpenAPIPath{Post: &OpenAPIOperation{
+		OperationID: strings.Replace(strings.TrimPrefix(path, "/"), "/", ".", -1),
+		Tags:        []string{tag},
+		RequestBody: &OpenAPIBody{Required: true, Content: jsonContent(request)},
+		Responses: map[string]*OpenAPIBody{
+			"200": {Description: "OK", Content: jsonContent(response)},
+		},
+	}}
+}
+
+func jsonContent(s *JSONSchema) map[string]*OpenAPIMedia {
+	return map[string]*OpenAPIMedia{"application/json": {Schema: s}}
+}
+
+//goSchema 按encoding/json 的规则描述go 类型
+func (b *schemaBuilder) goSchema(t reflect.Type) *JSONSchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return &JSONSchema{Type: "string", Format: "date-time"}
+	}
+	//自定义解码的类型(如json.RawMessage)无法确定格式
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		return &JSONSchema{}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &JSONSchema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &JSONSchema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &JSONSchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &JSONSchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &JSONSchema{Type: "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &JSONSchema{Type: "string", Format: "byte"}
+		}
+		return &JSONSchema{Type: "array", Items: b.goSchema(t.Elem())}
+	case reflect.Array:
+		return &JSONSchema{Type: "array", Items: b.goSchema(t.Elem())}
+	case reflect.Map:
+		return &JSONSchema{Type: "object", AdditionalProperties: b.goSchema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			s := &JSONSchema{Type: "object", Properties: make(map[string]*JSONSchema)}
+			b.fillStruct(s, t)
+			return s
+		}
+		name := goTypeName(t)
+		if _, ok := b.schemas[name]; !ok {
+			s := &JSONSchema{Type: "object", Properties: make(map[string]*JSONSchema)}
+			b.schemas[name] = s
+			b.fillStruct(s, t)
+		}
+		return &JSONSchema{Ref: schemaRefPrefix + name}
+	}
+	return &JSONSchema{}
+}
+
+func (b *schemaBuilder) fillStruct(s *JSONSchema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				b.fillStruct(s, ft)
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		s.Properties[name] = b.goSchema(f.Type)
+	}
+}
+
+//goTypeName go 类型以包路径的最后两级加类型名命名, 如 rpc.types.BlockParam
+func goTypeName(t reflect.Type) string {
+	pkg := strings.Split(t.PkgPath(), "/")
+	if len(pkg) > 2 {
+		pkg = pkg[len(pkg)-2:]
+	}
+	return strings.Join(append(pkg, t.Name()), ".")
+}
+
+//pbSchema 按jsonpb 的规则描述proto 消息, 以proto 全名命名
+func (b *schemaBuilder) pbSchema(msg proto.Message) *JSONSchema {
+	return b.messageSchema(proto.MessageReflect(msg).Descriptor())
+}
+
+func (b *schemaBuilder) messageSchema(md protoreflect.MessageDescriptor) *JSONSchema {
+	name := string(md.FullName())
+	//google.protobuf 的内置类型在jsonpb 中有特殊的格式
+	if strings.HasPrefix(name, "google.protobuf.") {
+		return &JSONSchema{Description: name}
+	}
+	if _, ok := b.schemas[name]; !ok {
+		s := &JSONSchema{Type: "object", Properties: make(map[string]*JSONSchema)}
+		b.schemas[name] = s
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			s.Properties[fd.JSONName()] = b.fieldSchema(fd)
+		}
+	}
+	return &JSONSchema{Ref: schemaRefPrefix + name}
+}
+
+func (b *schemaBuilder) fieldSchema(fd protoreflect.FieldDescriptor) *JSONSchema {
+	if fd.IsMap() {
+		return &JSONSchema{Type: "object", AdditionalProperties: b.kindSchema(fd.MapValue())}
+	}
+	if fd.IsList() {
+		return &JSONSchema{Type: "array", Items: b.kindSchema(fd)}
+	}
+	return b.kindSchema(fd)
+}
+
+func (b *schemaBuilder) kindSchema(fd protoreflect.FieldDescriptor) *JSONSchema {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return &JSONSchema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &JSONSchema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &JSONSchema{Type: "integer", Format: "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return &JSONSchema{Type: "string", Format: "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &JSONSchema{Type: "string", Format: "uint64"}
+	case protoreflect.FloatKind:
+		return &JSONSchema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &JSONSchema{Type: "number", Format: "double"}
+	case protoreflect.StringKind:
+		return &JSONSchema{Type: "string"}
+	case protoreflect.BytesKind:
+		return &JSONSchema{Type: "string", Format: "hex"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return &JSONSchema{Type: "string", Enum: names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.messageSchema(fd.Message())
+	}
+	return &JSONSchema{}
+}
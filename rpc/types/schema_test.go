@@ -0,0 +1,110 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type schemaTestBase struct {
+	Title string `json:"title"`
+}
+
+type schemaTestIn struct {
+	schemaTestBase
+	Hash    []byte            `json:"hash"`
+	Height  int64             `json:"height,omitempty"`
+	Ignore  string            `json:"-"`
+	Req     *types.ReqHash    `json:"req"`
+	Payload json.RawMessage   `json:"payload"`
+	Extra   map[string]string `json:"extra"`
+	NoTag   bool
+}
+
+type schemaTestService struct{}
+
+func (s *schemaTestService) Echo(in *schemaTestIn, result *interface{}) error {
+	return nil
+}
+
+func (s *schemaTestService) Name() string {
+	return "schemaTest"
+}
+
+type schemaTestChain33 struct{}
+
+func (c *schemaTestChain33) GetLastHeader(in *types.ReqNil, result *interface{}) error {
+	return nil
+}
+
+func TestBuildRPCSchema(t *testing.T) {
+	//创建配置时注册执行器
+	types.NewChain33Config(types.GetDefaultCfgstring())
+	RegisterJrpcService("SchemaTest", &schemaTestService{})
+	RegisterJrpcService("Chain33", &schemaTestChain33{})
+	doc := BuildRPCSchema()
+	assert.Equal(t, OpenAPIVersion, doc.OpenAPI)
+	assert.Nil(t, doc.Paths["/SchemaTest.Name"])
+
+	path := doc.Paths["/SchemaTest.Echo"]
+	require.NotNil(t, path)
+	assert.Equal(t, "SchemaTest.Echo", path.Post.OperationID)
+	req := path.Post.RequestBody.Content["application/json"].Schema
+	assert.Equal(t, []string{"SchemaTest.Echo"}, req.Properties["method"].Enum)
+	assert.Equal(t, schemaRefPrefix+"rpc.types.schemaTestIn", req.Properties["params"].Items.Ref)
+
+	//json rpc 参数按encoding/json 描述
+	in := doc.Components.Schemas["rpc.types.schemaTestIn"]
+	require.NotNil(t, in)
+	assert.Equal(t, "string", in.Properties["title"].Type)
+	assert.Equal(t, "byte", in.Properties["hash"].Format)
+	assert.Equal(t, "integer", in.Properties["height"].Type)
+	assert.Nil(t, in.Properties["Ignore"])
+	assert.Equal(t, &JSONSchema{}, in.Properties["payload"])
+	assert.Equal(t, "string", in.Properties["extra"].AdditionalProperties.Type)
+	assert.Equal(t, "boolean", in.Properties["NoTag"].Type)
+	assert.Equal(t, schemaRefPrefix+"chain33.types.ReqHash", in.Properties["req"].Ref)
+	reqHash := doc.Components.Schemas["chain33.types.ReqHash"]
+	require.NotNil(t, reqHash)
+	assert.Equal(t, "byte", reqHash.Properties["hash"].Format)
+	assert.Equal(t, "boolean", reqHash.Properties["upgrade"].Type)
+
+	//执行器的action 按jsonpb 描述
+	path = doc.Paths["/Chain33.CreateTransaction/coins/Transfer"]
+	require.NotNil(t, path)
+	assert.Equal(t, "Chain33.CreateTransaction.coins.Transfer", path.Post.OperationID)
+	req = path.Post.RequestBody.Content["application/json"].Schema
+	assert.Equal(t, []string{"Chain33.CreateTransaction"}, req.Properties["method"].Enum)
+	params := req.Properties["params"].Items
+	assert.Equal(t, []string{"coins"}, params.Properties["execer"].Enum)
+	assert.Equal(t, []string{"Transfer"}, params.Properties["actionName"].Enum)
+	assert.Equal(t, schemaRefPrefix+"types.AssetsTransfer", params.Properties["payload"].Ref)
+	transfer := doc.Components.Schemas["types.AssetsTransfer"]
+	require.NotNil(t, transfer)
+	assert.Equal(t, &JSONSchema{Type: "string", Format: "int64"}, transfer.Properties["amount"])
+	assert.Equal(t, &JSONSchema{Type: "string", Format: "hex"}, transfer.Properties["note"])
+
+	//*interface{} 的返回值使用生成的类型
+	res := doc.Paths["/SchemaTest.Echo"].Post.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, &JSONSchema{}, res.Properties["result"])
+	res = doc.Paths["/Chain33.GetLastHeader"].Post.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, schemaRefPrefix+"rpc.types.Header", res.Properties["result"].Ref)
+	require.NotNil(t, doc.Components.Schemas["rpc.types.Header"])
+
+	//查询的返回值按生成的proto 消息名描述
+	b := &schemaBuilder{schemas: make(map[string]*JSONSchema)}
+	assert.Equal(t, schemaRefPrefix+"types.ReplyTxInfos", b.queryResultSchema("coins", "GetTxsByAddr").Ref)
+	require.NotNil(t, b.schemas["types.ReplyTxInfos"])
+	assert.Equal(t, &JSONSchema{}, b.queryResultSchema("coins", "NotExist"))
+
+	data, err := json.Marshal(doc)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"$ref":"#/components/schemas/types.AssetsTransfer"`)
+}
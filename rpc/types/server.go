@@ -36,6 +36,7 @@ func (c *ChannelClient) Init(name string, s RPCServer, jrpc, grpc interface{}) {
 	}
 	if jrpc != nil {
 		s.JRPC().RegisterName(name, jrpc)
+		RegisterJrpcService(name, jrpc)
 	}
 	c.grpc = grpc
 	c.jrpc = jrpc
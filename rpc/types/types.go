@@ -83,6 +83,7 @@ type Transaction struct {
 	Next       string          `json:"next,omitempty"`
 	Hash       string          `json:"hash,omitempty"`
 	ChainID    int32           `json:"chainID,omitempty"`
+	IsSeqNonce bool            `json:"isSeqNonce,omitempty"`
 }
 
 // ReceiptLog defines receipt log command
@@ -456,3 +457,58 @@ type BlockInfo struct {
 type ChainIDInfo struct {
 	ChainID int32 `json:"chainID"`
 }
+
+// ReqDecodeReceipt 解析交易回执的参数, 依次按txHash, blockHash, height 查找
+type ReqDecodeReceipt struct {
+	TxHash    string `json:"txHash,omitempty"`
+	BlockHash string `json:"blockHash,omitempty"`
+	Height    int64  `json:"height"`
+}
+
+// DecodedReceipts 解析后的交易和回执
+type DecodedReceipts struct {
+	Height    int64               `json:"height"`
+	BlockHash string              `json:"blockHash,omitempty"`
+	BlockTime int64               `json:"blockTime"`
+	Txs       []*DecodedTxReceipt `json:"txs"`
+}
+
+// DecodedTxReceipt 单个交易的解析结果, 无法解析的部分都列在errors 中
+// 回执不保存KV 变化, 账户变化由账户类日志解析得到
+type DecodedTxReceipt struct {
+	Hash           string           `json:"hash"`
+	Index          int64            `json:"index"`
+	Execer         string           `json:"execer"`
+	ActionName     string           `json:"actionName"`
+	From           string           `json:"from"`
+	To             string           `json:"to"`
+	Payload        json.RawMessage  `json:"payload,omitempty"`
+	RawPayload     string           `json:"rawPayload"`
+	Ty             int32            `json:"ty"`
+	TyName         string           `json:"tyName"`
+	Logs           []*DecodedLog    `json:"logs"`
+	AccountChanges []*AccountChange `json:"accountChanges,omitempty"`
+	Errors         []string         `json:"errors,omitempty"`
+}
+
+// DecodedLog 解析后的回执日志
+type DecodedLog struct {
+	Ty     int32           `json:"ty"`
+	TyName string          `json:"tyName"`
+	Log    json.RawMessage `json:"log,omitempty"`
+	RawLog string          `json:"rawLog"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// AccountChange 账户类日志(转账, 冻结, 激活, 铸币, 销毁)对应的余额变化
+type AccountChange struct {
+	Addr         string `json:"addr"`
+	ExecAddr     string `json:"execAddr,omitempty"`
+	LogName      string `json:"logName"`
+	PrevBalance  int64  `json:"prevBalance"`
+	Balance      int64  `json:"balance"`
+	BalanceDelta int64  `json:"balanceDelta"`
+	PrevFrozen   int64  `json:"prevFrozen"`
+	Frozen       int64  `json:"frozen"`
+	FrozenDelta  int64  `json:"frozenDelta"`
+}
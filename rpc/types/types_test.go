@@ -10,6 +10,7 @@ import (
 
 	"github.com/33cn/chain33/client/mocks"
 	"github.com/33cn/chain33/common"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -70,6 +71,59 @@ func TestDecodeLog(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestDecodeTxReceipt(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	transfer := &types.AssetsTransfer{Amount: 100, To: "1EDnnePAZN48aC2hiTDzhkczfF39g1pZZX"}
+	action := &cty.CoinsAction{Ty: cty.CoinsActionTransfer, Value: &cty.CoinsAction_Transfer{Transfer: transfer}}
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: types.Encode(action), To: transfer.To}
+	logTransfer := &types.ReceiptAccountTransfer{
+		Prev:    &types.Account{Balance: 1000, Addr: transfer.To},
+		Current: &types.Account{Balance: 1100, Addr: transfer.To},
+	}
+	logFrozen := &types.ReceiptExecAccountTransfer{
+		ExecAddr: "execaddr",
+		Prev:     &types.Account{Balance: 500, Frozen: 0, Addr: transfer.To},
+		Current:  &types.Account{Balance: 400, Frozen: 100, Addr: transfer.To},
+	}
+	receipt := &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{
+		{Ty: types.TyLogTransfer, Log: types.Encode(logTransfer)},
+		{Ty: types.TyLogExecFrozen, Log: types.Encode(logFrozen)},
+		{Ty: 100000, Log: []byte("unknown")},
+		{Ty: types.TyLogTransfer, Log: []byte("bad")},
+	}}
+	res := DecodeTxReceipt(tx, receipt)
+	assert.Equal(t, "transfer", res.ActionName)
+	assert.Equal(t, "ExecOk", res.TyName)
+	assert.Contains(t, string(res.Payload), `"amount":"100"`)
+	assert.Equal(t, 4, len(res.Logs))
+	assert.Equal(t, "LogTransfer", res.Logs[0].TyName)
+	assert.Equal(t, "LogExecFrozen", res.Logs[1].TyName)
+	assert.Equal(t, types.ErrLogType.Error(), res.Logs[2].Error)
+	assert.NotEqual(t, "", res.Logs[3].Error)
+	assert.Equal(t, 2, len(res.Errors))
+
+	assert.Equal(t, 2, len(res.AccountChanges))
+	assert.Equal(t, int64(100), res.AccountChanges[0].BalanceDelta)
+	assert.Equal(t, "execaddr", res.AccountChanges[1].ExecAddr)
+	assert.Equal(t, int64(-100), res.AccountChanges[1].BalanceDelta)
+	assert.Equal(t, int64(100), res.AccountChanges[1].FrozenDelta)
+
+	//执行器未注册和回执缺失都明确报告
+	tx = &types.Transaction{Execer: []byte("user.unknown"), Payload: []byte("data")}
+	res = DecodeTxReceipt(tx, nil)
+	assert.Nil(t, res.Payload)
+	assert.Equal(t, []string{"payload: " + types.ErrExecNotFound.Error(), "receipt: " + types.ErrNotFound.Error()}, res.Errors)
+
+	block := &types.Block{Height: 10, Txs: []*types.Transaction{tx, tx}}
+	detail := &types.BlockDetail{Block: block, Receipts: []*types.ReceiptData{receipt}}
+	blockRes := DecodeBlockReceipts(cfg, detail)
+	assert.Equal(t, int64(10), blockRes.Height)
+	assert.Equal(t, common.ToHex(block.Hash(cfg)), blockRes.BlockHash)
+	assert.Equal(t, 2, len(blockRes.Txs))
+	assert.Equal(t, int64(1), blockRes.Txs[1].Index)
+	assert.Equal(t, "Unknown", blockRes.Txs[1].TyName)
+}
+
 func TestConvertWalletTxDetailToJSON(t *testing.T) {
 	// 需要先注册执行器类型
 	types.NewChain33Config(types.GetDefaultCfgstring())
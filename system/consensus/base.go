@@ -44,6 +44,12 @@ type Miner interface {
 	CmpBestBlock(newBlock *types.Block, cmpBlock *types.Block) bool
 }
 
+//HeaderChecker 共识可选实现, 只有区块头时按共识规则校验区块头, 比如出块人是否有出块权限, 难度是否正确
+//轻节点只同步区块头, 共识没有实现该接口时轻节点不接受peer的区块头
+type HeaderChecker interface {
+	CheckHeader(parent, header *types.Header) error
+}
+
 //BaseClient ...
 type BaseClient struct {
 	client       queue.Client
@@ -250,6 +256,10 @@ func (bc *BaseClient) EventLoop() {
 				block := msg.GetData().(*types.BlockDetail)
 				err := bc.CheckBlock(block)
 				msg.ReplyErr("EventCheckBlock", err)
+			} else if msg.Ty == types.EventCheckBlockHeaders {
+				headers := msg.GetData().(*types.Headers)
+				err := bc.CheckHeaders(headers.GetItems())
+				msg.ReplyErr("EventCheckBlockHeaders", err)
 			} else if msg.Ty == types.EventMinerStart {
 				if !atomic.CompareAndSwapInt32(&bc.minerStart, 0, 1) {
 					msg.ReplyErr("EventMinerStart", types.ErrMinerIsStared)
@@ -317,6 +327,33 @@ func (bc *BaseClient) CheckBlock(block *types.BlockDetail) error {
 	return err
 }
 
+//CheckHeaders 校验连续的区块头, headers[0]为已经校验过的父区块头
+func (bc *BaseClient) CheckHeaders(headers []*types.Header) error {
+	checker, ok := bc.child.(HeaderChecker)
+	if !ok {
+		return types.ErrNotSupport
+	}
+	types.AssertConfig(bc.client)
+	cfg := bc.client.GetConfig()
+	for i := 1; i < len(headers); i++ {
+		parent, header := headers[i-1], headers[i]
+		if parent.Height+1 != header.Height {
+			return types.ErrBlockHeight
+		}
+		if !bytes.Equal(header.ParentHash, parent.Hash) {
+			return types.ErrParentHash
+		}
+		if cfg.IsFork(header.Height, "ForkCheckBlockTime") && parent.BlockTime > header.BlockTime {
+			return types.ErrBlockTime
+		}
+		err := checker.CheckHeader(parent, header)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 //RequestTx Mempool中取交易列表
 func (bc *BaseClient) RequestTx(listSize int, txHashList [][]byte) []*types.Transaction {
 	if bc.client == nil {
@@ -432,12 +469,21 @@ func (bc *BaseClient) WriteBlock(prev []byte, block *types.Block) error {
 
 // PreExecBlock 预执行区块, 用于raft, tendermint等共识, errReturn表示区块来源于自己还是别人
 func (bc *BaseClient) PreExecBlock(block *types.Block, errReturn bool) *types.Block {
+	return bc.preExecBlock(block, errReturn, true)
+}
+
+// PreExecOwnBlock 预执行本节点打包的区块, 不经过共识CheckBlock, 用于出块前需要对执行结果签名的共识
+func (bc *BaseClient) PreExecOwnBlock(block *types.Block) *types.Block {
+	return bc.preExecBlock(block, false, false)
+}
+
+func (bc *BaseClient) preExecBlock(block *types.Block, errReturn, checkBlock bool) *types.Block {
 	lastBlock, err := bc.RequestBlock(block.Height - 1)
 	if err != nil {
 		log.Error("PreExecBlock RequestBlock fail", "err", err)
 		return nil
 	}
-	blockdetail, deltx, err := util.PreExecBlock(bc.client, lastBlock.StateHash, block, errReturn, false, true)
+	blockdetail, deltx, err := util.PreExecBlock(bc.client, lastBlock.StateHash, block, errReturn, false, checkBlock)
 	if err != nil {
 		log.Error("util.PreExecBlock fail", "err", err)
 		return nil
@@ -528,11 +574,25 @@ func (bc *BaseClient) AddTxsToBlock(block *types.Block, txs []*types.Transaction
 	cfg := bc.client.GetConfig()
 	maxTx := cfg.GetP(block.Height).MaxTxNumber
 	addedTx := make([]*types.Transaction, 0, len(txs))
+	seqs := make(map[string]int64)
+	for _, tx := range block.Txs {
+		if seq, ok := tx.GetSeqNonce(cfg, block.Height); ok {
+			seqs[tx.From()] = seq
+		}
+	}
 	for i := 0; i < len(txs); i++ {
 		txGroup, err := txs[i].GetTxGroup()
 		if err != nil {
 			continue
 		}
+		//同一账户使用序号的交易在区块中必须连续
+		if seq, ok := txs[i].GetSeqNonce(cfg, block.Height); ok {
+			from := txs[i].From()
+			if last, exist := seqs[from]; exist && seq != last+1 {
+				continue
+			}
+			seqs[from] = seq
+		}
 		if txGroup == nil {
 			currentCount++
 			if currentCount > maxTx {
@@ -0,0 +1,378 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cft 崩溃容错共识, 基于raft的leader选举实现多节点出块和故障切换
+package cft
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/consensus"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	mty "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+)
+
+var clog = log.New("module", "cft")
+
+var (
+	errNoBlockSignature = errors.New("ErrNoBlockSignature")
+	errNotMember        = errors.New("ErrNotConsensusMember")
+	errBadMsgSignature  = errors.New("ErrBadMsgSignature")
+	errBadProposal      = errors.New("ErrBadProposal")
+	errNoQuorum         = errors.New("ErrNoQuorum")
+)
+
+func init() {
+	drivers.Reg("cft", New)
+	drivers.QueryData.Register("cft", &Client{})
+}
+
+type subConfig struct {
+	Genesis          string `json:"genesis"`
+	GenesisBlockTime int64  `json:"genesisBlockTime"`
+	WaitTxMs         int64  `json:"waitTxMs"`
+	// 本节点出块签名私钥, 对应地址必须是共识成员
+	PrivateKey string `json:"privateKey"`
+	// 选举服务监听地址
+	ListenAddr string `json:"listenAddr"`
+	// 其他成员的选举服务地址, 格式为 "成员地址=host:port"
+	Peers []string `json:"peers"`
+	// 创世共识成员, 链上未通过manage配置cft-members时生效
+	Members           []string `json:"members"`
+	HeartbeatMs       int64    `json:"heartbeatMs"`
+	ElectionTimeoutMs int64    `json:"electionTimeoutMs"`
+}
+
+//Client 客户端
+type Client struct {
+	*drivers.BaseClient
+	subcfg    *subConfig
+	sleepTime time.Duration
+	privKey   crypto.PrivKey
+	self      string
+	election  *election
+	trans     *httpTransport
+	db        dbm.DB
+	done      chan struct{}
+
+	memberLock  sync.Mutex
+	memberState []byte
+	memberCache []string
+}
+
+//New new
+func New(cfg *types.Consensus, sub []byte) queue.Module {
+	c := drivers.NewBaseClient(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.WaitTxMs == 0 {
+		subcfg.WaitTxMs = 1000
+	}
+	if subcfg.Genesis == "" {
+		subcfg.Genesis = cfg.Genesis
+	}
+	if subcfg.GenesisBlockTime == 0 {
+		subcfg.GenesisBlockTime = cfg.GenesisBlockTime
+	}
+	if subcfg.HeartbeatMs == 0 {
+		subcfg.HeartbeatMs = 200
+	}
+	if subcfg.ElectionTimeoutMs == 0 {
+		subcfg.ElectionTimeoutMs = 10 * subcfg.HeartbeatMs
+	}
+	peers, err := parsePeers(subcfg.Peers)
+	if err != nil {
+		panic(err)
+	}
+	privKey, err := loadPrivKey(subcfg.PrivateKey)
+	if err != nil {
+		panic(err)
+	}
+	client := &Client{
+		BaseClient: c,
+		subcfg:     &subcfg,
+		sleepTime:  time.Duration(subcfg.WaitTxMs) * time.Millisecond,
+		privKey:    privKey,
+		self:       address.PubKeyToAddress(privKey.PubKey().Bytes()).String(),
+		done:       make(chan struct{}),
+	}
+	heartbeat := time.Duration(subcfg.HeartbeatMs) * time.Millisecond
+	client.trans = newHTTPTransport(peers, heartbeat)
+	client.election = newElection(privKey, heartbeat, time.Duration(subcfg.ElectionTimeoutMs)*time.Millisecond, client.trans, client)
+	c.SetChild(client)
+	return client
+}
+
+func loadPrivKey(hexKey string) (crypto.PrivKey, error) {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	key, err := common.FromHex(hexKey)
+	if err != nil {
+		return nil, err
+	}
+	return cr.PrivKeyFromBytes(key)
+}
+
+//SetQueueClient 恢复选举状态并启动选举服务后再启动出块
+func (client *Client) SetQueueClient(c queue.Client) {
+	bcfg := c.GetConfig().GetModuleConfig().BlockChain
+	client.db = dbm.NewDB("cft", bcfg.Driver, bcfg.DbPath, 16)
+	err := client.election.load(client.db)
+	if err != nil {
+		panic(err)
+	}
+	err = client.trans.serve(client.subcfg.ListenAddr, client.election)
+	if err != nil {
+		panic(err)
+	}
+	client.BaseClient.SetQueueClient(c)
+	go client.election.run(client.done)
+}
+
+//Close close
+func (client *Client) Close() {
+	close(client.done)
+	client.trans.close()
+	client.BaseClient.Close()
+	client.db.Close()
+	clog.Info("consensus cft closed")
+}
+
+//GetGenesisBlockTime 获取创世区块时间
+func (client *Client) GetGenesisBlockTime() int64 {
+	return client.subcfg.GenesisBlockTime
+}
+
+//CreateGenesisTx 创建创世交易
+func (client *Client) CreateGenesisTx() (ret []*types.Transaction) {
+	var tx types.Transaction
+	tx.Execer = []byte("coins")
+	tx.To = client.subcfg.Genesis
+	//gen payload
+	g := &cty.CoinsAction_Genesis{}
+	g.Genesis = &types.AssetsGenesis{}
+	g.Genesis.Amount = 1e8 * types.Coin
+	tx.Payload = types.Encode(&cty.CoinsAction{Value: g, Ty: cty.CoinsActionGenesis})
+	ret = append(ret, &tx)
+	return
+}
+
+//ProcEvent false
+func (client *Client) ProcEvent(msg *queue.Message) bool {
+	return false
+}
+
+//CheckBlock 区块必须由父区块状态下的共识成员签名
+func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail) error {
+	block := current.Block
+	if len(block.Txs) == 0 {
+		return types.ErrEmptyTx
+	}
+	sig := block.GetSignature()
+	if sig == nil {
+		return errNoBlockSignature
+	}
+	addr := address.PubKeyToAddress(sig.Pubkey).String()
+	if !isMember(client.getMembers(parent.StateHash), addr) {
+		clog.Error("CheckBlock", "height", block.Height, "signer", addr, "err", errNotMember)
+		return errNotMember
+	}
+	return nil
+}
+
+//CheckHeader 区块头必须由父区块状态下的共识成员签名, 用于只同步区块头的轻节点
+func (client *Client) CheckHeader(parent, header *types.Header) error {
+	sig := header.GetSignature()
+	if sig == nil || !types.CheckSign(header.Hash, "", sig, header.Height) {
+		return errNoBlockSignature
+	}
+	if header.Difficulty != client.GetAPI().GetConfig().GetP(0).PowLimitBits {
+		return types.ErrBlockHeaderDifficulty
+	}
+	addr := address.PubKeyToAddress(sig.Pubkey).String()
+	if !isMember(client.getMembers(parent.StateHash), addr) {
+		return errNotMember
+	}
+	return nil
+}
+
+//CreateBlock 只有leader打包区块
+func (client *Client) CreateBlock() {
+	issleep := true
+	types.AssertConfig(client.GetAPI())
+	cfg := client.GetAPI().GetConfig()
+	for {
+		if client.IsClosed() {
+			break
+		}
+		if !client.IsMining() || !client.election.isLeader() || !client.IsCaughtUp() {
+			time.Sleep(client.sleepTime)
+			continue
+		}
+		if issleep {
+			time.Sleep(client.sleepTime)
+		}
+		lastBlock := client.GetCurrentBlock()
+		//上一任leader已经得到多数派确认的区块需要先提交
+		if pending := client.election.takePending(lastBlock.Height, lastBlock.Hash(cfg)); pending != nil {
+			err := client.commitBlock(lastBlock, pending)
+			if err != nil {
+				clog.Error("CreateBlock commit pending", "height", pending.Height, "err", err)
+				issleep = true
+			}
+			continue
+		}
+		maxTxNum := int(cfg.GetP(lastBlock.Height + 1).MaxTxNumber)
+		txs := client.RequestTx(maxTxNum, nil)
+		txs = client.CheckTxDup(txs)
+		if len(txs) == 0 {
+			issleep = true
+			continue
+		}
+		issleep = false
+
+		var newblock types.Block
+		newblock.ParentHash = lastBlock.Hash(cfg)
+		newblock.Height = lastBlock.Height + 1
+		client.AddTxsToBlock(&newblock, txs)
+		newblock.Difficulty = cfg.GetP(0).PowLimitBits
+		//需要首先对交易进行排序然后再计算TxHash
+		if cfg.IsFork(newblock.GetHeight(), "ForkRootHash") {
+			newblock.Txs = types.TransactionSort(newblock.Txs)
+		}
+		newblock.TxHash = merkle.CalcMerkleRoot(cfg, newblock.Height, newblock.Txs)
+		newblock.BlockTime = types.Now().Unix()
+		if lastBlock.BlockTime >= newblock.BlockTime {
+			newblock.BlockTime = lastBlock.BlockTime + 1
+		}
+		//签名覆盖StateHash, 需要先预执行
+		block := client.PreExecOwnBlock(&newblock)
+		if block == nil || len(block.Txs) == 0 {
+			issleep = true
+			continue
+		}
+		client.signBlock(block)
+		err := client.commitBlock(lastBlock, block)
+		if err != nil {
+			clog.Error("CreateBlock commitBlock", "height", block.Height, "err", err)
+			issleep = true
+			continue
+		}
+		clog.Info("CftNewBlock", "height", block.Height, "txs", len(block.Txs), "term", client.election.status().Term)
+	}
+}
+
+// commitBlock 区块得到多数派确认之后才写入, 避免多个leader同时出块造成分叉
+func (client *Client) commitBlock(lastBlock, block *types.Block) error {
+	if !client.election.replicate(block) {
+		return errNoQuorum
+	}
+	return client.WriteBlock(lastBlock.StateHash, block)
+}
+
+func (client *Client) signBlock(block *types.Block) {
+	hash := block.Hash(client.GetAPI().GetConfig())
+	block.Signature = &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    client.privKey.PubKey().Bytes(),
+		Signature: client.privKey.Sign(hash).Bytes(),
+	}
+}
+
+//CmpBestBlock 比较newBlock是不是最优区块
+func (client *Client) CmpBestBlock(newBlock *types.Block, cmpBlock *types.Block) bool {
+	return false
+}
+
+// getMembers 读取指定状态下的共识成员, 链上未配置时使用创世成员
+func (client *Client) getMembers(stateHash []byte) []string {
+	client.memberLock.Lock()
+	defer client.memberLock.Unlock()
+	if client.memberCache != nil && bytes.Equal(client.memberState, stateHash) {
+		return client.memberCache
+	}
+	members := client.subcfg.Members
+	keys := [][]byte{[]byte(types.ManageKey(mty.CftMembersKey)), []byte(types.ConfigKey(mty.CftMembersKey))}
+	values, err := client.GetAPI().StoreGet(&types.StoreGet{StateHash: stateHash, Keys: keys})
+	if err != nil {
+		clog.Error("getMembers", "err", err)
+		return members
+	}
+	for _, value := range values.GetValues() {
+		if value == nil {
+			continue
+		}
+		var item types.ConfigItem
+		err = types.Decode(value, &item)
+		if err != nil {
+			clog.Error("getMembers decode", "err", err)
+			continue
+		}
+		if len(item.GetArr().GetValue()) > 0 {
+			members = item.GetArr().GetValue()
+			break
+		}
+	}
+	client.memberState = stateHash
+	client.memberCache = members
+	return members
+}
+
+// height 实现chainState
+func (client *Client) height() int64 {
+	block := client.GetCurrentBlock()
+	if block == nil {
+		return -1
+	}
+	return block.Height
+}
+
+// members 实现chainState, 返回当前高度生效的共识成员
+func (client *Client) members() []string {
+	block := client.GetCurrentBlock()
+	if block == nil || client.GetAPI() == nil {
+		return client.subcfg.Members
+	}
+	return client.getMembers(block.StateHash)
+}
+
+// checkProposal 实现chainState, 提议的区块必须接在本节点最新区块之后并且由共识成员签名
+func (client *Client) checkProposal(block *types.Block) error {
+	lastBlock := client.GetCurrentBlock()
+	if lastBlock == nil || client.GetAPI() == nil {
+		return errBadProposal
+	}
+	cfg := client.GetAPI().GetConfig()
+	if block.Height != lastBlock.Height+1 || !bytes.Equal(block.ParentHash, lastBlock.Hash(cfg)) {
+		return errBadProposal
+	}
+	sig := block.GetSignature()
+	if sig == nil || !block.CheckSign(cfg) {
+		return errNoBlockSignature
+	}
+	if !isMember(client.getMembers(lastBlock.StateHash), address.PubKeyToAddress(sig.Pubkey).String()) {
+		return errNotMember
+	}
+	return nil
+}
+
+//GetStatus 获取本节点的选举状态
+func (client *Client) GetStatus() *Status {
+	return client.election.status()
+}
@@ -0,0 +1,379 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cft
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	mty "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	//加载系统内置store, 不要依赖plugin
+	_ "github.com/33cn/chain33/system/dapp/init"
+	_ "github.com/33cn/chain33/system/mempool/init"
+	_ "github.com/33cn/chain33/system/store/init"
+)
+
+type fakeChain struct {
+	h    int64
+	list []string
+}
+
+func (c *fakeChain) height() int64     { return c.h }
+func (c *fakeChain) members() []string { return c.list }
+func (c *fakeChain) checkProposal(block *types.Block) error {
+	if block.Height != c.h+1 {
+		return errBadProposal
+	}
+	return nil
+}
+
+// memTransport 内存传输, down中的节点不可达
+type memTransport struct {
+	mu    sync.Mutex
+	nodes map[string]*election
+	down  map[string]bool
+}
+
+func (t *memTransport) get(peer string) (*election, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	e, ok := t.nodes[peer]
+	if !ok || t.down[peer] {
+		return nil, errors.New("unreachable")
+	}
+	return e, nil
+}
+
+func (t *memTransport) requestVote(peer string, req *VoteRequest) (*Reply, error) {
+	e, err := t.get(peer)
+	if err != nil {
+		return nil, err
+	}
+	return e.handleVote(req), nil
+}
+
+func (t *memTransport) heartbeat(peer string, hb *Heartbeat) (*Reply, error) {
+	e, err := t.get(peer)
+	if err != nil {
+		return nil, err
+	}
+	return e.handleHeartbeat(hb), nil
+}
+
+func (t *memTransport) propose(peer string, p *Proposal) (*Reply, error) {
+	e, err := t.get(peer)
+	if err != nil {
+		return nil, err
+	}
+	return e.handleProposal(p), nil
+}
+
+func countLeaders(nodes map[string]*election, down map[string]bool) (int, string) {
+	count, leader := 0, ""
+	for name, e := range nodes {
+		if !down[name] && e.isLeader() {
+			count++
+			leader = name
+		}
+	}
+	return count, leader
+}
+
+func TestElection(t *testing.T) {
+	timeout := 50 * time.Millisecond
+	trans := &memTransport{nodes: make(map[string]*election), down: make(map[string]bool)}
+	chain := &fakeChain{h: 10}
+	var names []string
+	for i := 0; i < 4; i++ {
+		e := newElection(util.TestPrivkeyList[i], time.Millisecond, timeout, trans, chain)
+		names = append(names, e.self)
+		trans.nodes[e.self] = e
+	}
+	a, b, c, outsider := names[0], names[1], names[2], trans.nodes[names[3]]
+	delete(trans.nodes, outsider.self)
+	chain.list = names[:3]
+	// 新启动的节点在选举超时内不投票
+	assert.False(t, trans.nodes[a].campaign())
+	time.Sleep(2 * timeout)
+
+	// a先超时当选, 其余节点收到心跳后成为follower
+	assert.True(t, trans.nodes[a].campaign())
+	trans.nodes[a].broadcastHeartbeat()
+	count, leader := countLeaders(trans.nodes, trans.down)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, a, leader)
+	assert.Equal(t, a, trans.nodes[b].status().Leader)
+	assert.Equal(t, int64(2), trans.nodes[c].status().Term)
+
+	vote := func(e *election, term, lastHeight int64) *VoteRequest {
+		req := &VoteRequest{Term: term, Candidate: e.self, LastHeight: lastHeight}
+		req.Sig = e.sign(req.signBytes())
+		return req
+	}
+	// 选举超时内收到过leader心跳, 不给其他候选人投票
+	reply := trans.nodes[b].handleVote(vote(trans.nodes[c], 3, 10))
+	assert.False(t, reply.Success)
+	assert.Equal(t, int64(2), reply.Term)
+	time.Sleep(2 * timeout)
+	// 同一term不能重复投票
+	reply = trans.nodes[b].handleVote(vote(trans.nodes[c], 2, 10))
+	assert.False(t, reply.Success)
+	// 高度落后的候选人得不到选票
+	reply = trans.nodes[b].handleVote(vote(trans.nodes[c], 3, 9))
+	assert.False(t, reply.Success)
+	// 非成员不能当选
+	reply = trans.nodes[b].handleVote(vote(outsider, 4, 10))
+	assert.False(t, reply.Success)
+	// 伪造签名的拉票和心跳无效
+	req := vote(trans.nodes[c], 5, 10)
+	req.Sig = outsider.sign(req.signBytes())
+	reply = trans.nodes[b].handleVote(req)
+	assert.False(t, reply.Success)
+	hb := &Heartbeat{Term: 5, Leader: c, Height: 10}
+	hb.Sig = outsider.sign(hb.signBytes())
+	reply = trans.nodes[b].handleHeartbeat(hb)
+	assert.False(t, reply.Success)
+	assert.Equal(t, int64(3), trans.nodes[b].status().Term)
+	// 应答只对应所请求的消息
+	assert.Nil(t, verifyReply(chain.list, b, hb.signBytes(), reply))
+	assert.NotNil(t, verifyReply(chain.list, b, req.signBytes(), reply))
+
+	// leader宕机后重新选举
+	trans.down[a] = true
+	time.Sleep(2 * timeout)
+	assert.True(t, trans.nodes[c].campaign())
+	count, leader = countLeaders(trans.nodes, trans.down)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, c, leader)
+
+	// 旧leader恢复后收到更高term的心跳, 退回follower
+	trans.down[a] = false
+	trans.nodes[c].broadcastHeartbeat()
+	assert.False(t, trans.nodes[a].isLeader())
+	assert.Equal(t, c, trans.nodes[a].status().Leader)
+
+	// 区块提议得到多数派确认后才能写入
+	assert.True(t, trans.nodes[c].replicate(&types.Block{Height: 11}))
+	assert.Equal(t, int64(11), trans.nodes[b].pending.Height)
+	assert.NotNil(t, trans.nodes[b].takePending(10, nil))
+	assert.Nil(t, trans.nodes[b].takePending(10, []byte("other")))
+	// 高度不连续的区块提议不会被确认
+	assert.False(t, trans.nodes[c].replicate(&types.Block{Height: 13}))
+	assert.True(t, trans.nodes[c].isLeader())
+
+	// 失去多数派的leader无法提交区块, 租约到期后不再出块, 超时后退位
+	trans.down[a] = true
+	trans.down[b] = true
+	assert.False(t, trans.nodes[c].replicate(&types.Block{Height: 11}))
+	time.Sleep(2 * timeout)
+	assert.False(t, trans.nodes[c].isLeader())
+	trans.nodes[c].broadcastHeartbeat()
+	assert.Equal(t, "follower", trans.nodes[c].status().Role)
+
+	// 拉票时已确认的区块计入高度
+	trans.down[b] = false
+	reply = trans.nodes[b].handleVote(vote(trans.nodes[a], 4, 10))
+	assert.False(t, reply.Success)
+	// 少数派无法选出leader
+	trans.down[c] = true
+	time.Sleep(2 * timeout)
+	assert.False(t, trans.nodes[b].campaign())
+	assert.Nil(t, trans.nodes[b].takePending(11, nil))
+	assert.Nil(t, trans.nodes[b].pending)
+}
+
+func TestElectionPersist(t *testing.T) {
+	timeout := 50 * time.Millisecond
+	trans := &memTransport{nodes: make(map[string]*election), down: make(map[string]bool)}
+	chain := &fakeChain{h: 10}
+	var nodes []*election
+	for i := 0; i < 3; i++ {
+		e := newElection(util.TestPrivkeyList[i], time.Millisecond, timeout, trans, chain)
+		chain.list = append(chain.list, e.self)
+		nodes = append(nodes, e)
+	}
+	vote := func(e *election, term int64) *VoteRequest {
+		req := &VoteRequest{Term: term, Candidate: e.self, LastHeight: 10}
+		req.Sig = e.sign(req.signBytes())
+		return req
+	}
+	db, err := dbm.NewGoMemDB("cft", "", 0)
+	require.Nil(t, err)
+	voter := nodes[0]
+	require.Nil(t, voter.load(db))
+	voter.lastHeard = time.Time{}
+	assert.True(t, voter.handleVote(vote(nodes[1], 3)).Success)
+
+	// 重启之后恢复term和投票, 同一term不再投给其他候选人
+	restarted := newElection(util.TestPrivkeyList[0], time.Millisecond, timeout, trans, chain)
+	require.Nil(t, restarted.load(db))
+	assert.Equal(t, int64(3), restarted.term)
+	assert.Equal(t, nodes[1].self, restarted.votedFor)
+	restarted.lastHeard = time.Time{}
+	assert.False(t, restarted.handleVote(vote(nodes[2], 3)).Success)
+	assert.True(t, restarted.handleVote(vote(nodes[1], 3)).Success)
+	restarted.lastHeard = time.Time{}
+	assert.True(t, restarted.handleVote(vote(nodes[2], 4)).Success)
+
+	// 确认的区块提议所在的term也要恢复
+	p := &Proposal{Term: 4, Leader: nodes[2].self, Block: types.Encode(&types.Block{Height: 11})}
+	p.Sig = nodes[2].sign(p.signBytes())
+	assert.True(t, restarted.handleProposal(p).Success)
+	restarted = newElection(util.TestPrivkeyList[0], time.Millisecond, timeout, trans, chain)
+	require.Nil(t, restarted.load(db))
+	assert.Equal(t, int64(4), restarted.term)
+	assert.Equal(t, int64(4), restarted.lastTerm)
+}
+
+func TestParsePeers(t *testing.T) {
+	peers, err := parsePeers([]string{"addr1=127.0.0.1:1", "addr2=127.0.0.1:2"})
+	assert.Nil(t, err)
+	assert.Equal(t, "127.0.0.1:2", peers["addr2"])
+	_, err = parsePeers([]string{"addr1"})
+	assert.NotNil(t, err)
+	_, err = parsePeers([]string{"=127.0.0.1:1"})
+	assert.NotNil(t, err)
+}
+
+func freeAddrs(t *testing.T, n int) []string {
+	var addrs []string
+	for i := 0; i < n; i++ {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.Nil(t, err)
+		addrs = append(addrs, l.Addr().String())
+		defer l.Close()
+	}
+	return addrs
+}
+
+func newCftConfigs(t *testing.T, n int) []*types.Chain33Config {
+	listen := freeAddrs(t, n)
+	var members, peers []string
+	for i := 0; i < n; i++ {
+		addr := address.PubKeyToAddress(util.TestPrivkeyList[i+2].PubKey().Bytes()).String()
+		members = append(members, addr)
+		peers = append(peers, fmt.Sprintf("%s=%s", addr, listen[i]))
+	}
+	var cfgs []*types.Chain33Config
+	for i := 0; i < n; i++ {
+		cfg := testnode.GetDefaultConfig()
+		cfg.GetModuleConfig().Consensus.Name = "cft"
+		sub := map[string]interface{}{
+			"genesis":           cfg.GetModuleConfig().Consensus.Genesis,
+			"genesisBlockTime":  cfg.GetModuleConfig().Consensus.GenesisBlockTime,
+			"waitTxMs":          10,
+			"privateKey":        util.TestPrivkeyHex[i+2],
+			"listenAddr":        listen[i],
+			"peers":             peers,
+			"members":           members,
+			"heartbeatMs":       50,
+			"electionTimeoutMs": 500,
+		}
+		data, err := json.Marshal(sub)
+		require.Nil(t, err)
+		cfg.GetSubConfig().Consensus["cft"] = data
+		cfgs = append(cfgs, cfg)
+	}
+	return cfgs
+}
+
+func waitLeader(t *testing.T, network *testnode.Network) int {
+	for i := 0; i < 100; i++ {
+		for j := 0; j < network.Len(); j++ {
+			node := network.Node(j)
+			if node == nil {
+				continue
+			}
+			if getClient(node).election.isLeader() {
+				return j
+			}
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatal("no leader elected")
+	return -1
+}
+
+func getClient(node *testnode.Chain33Mock) *Client {
+	return node.GetConsensus().(*Client)
+}
+
+func sendTxs(t *testing.T, node *testnode.Chain33Mock, n int64) {
+	cfg := node.GetClient().GetConfig()
+	txs := util.GenNoneTxs(cfg, node.GetGenesisKey(), n)
+	for _, tx := range txs {
+		_, err := node.GetAPI().SendTx(tx)
+		require.Nil(t, err)
+	}
+}
+
+func waitTx(t *testing.T, node *testnode.Chain33Mock, hash []byte) *types.TransactionDetail {
+	for i := 0; i < 200; i++ {
+		detail, err := node.GetAPI().QueryTx(&types.ReqHash{Hash: hash})
+		if err == nil {
+			return detail
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatal("wait tx timeout")
+	return nil
+}
+
+func TestCftNetwork(t *testing.T) {
+	network := testnode.NewNetwork(newCftConfigs(t, 3))
+	defer network.Close()
+
+	leader := waitLeader(t, network)
+	follower := (leader + 1) % network.Len()
+	// 发往follower的交易广播给leader打包
+	sendTxs(t, network.Node(follower), 5)
+	require.Nil(t, network.WaitHeight(1, 20*time.Second))
+	block := network.Node(follower).GetBlock(1)
+	assert.Equal(t, getClient(network.Node(leader)).self, address.PubKeyToAddress(block.Signature.Pubkey).String())
+
+	// leader宕机后剩余两个节点重新选出leader继续出块
+	require.Nil(t, network.StopNode(leader))
+	newLeader := waitLeader(t, network)
+	assert.NotEqual(t, leader, newLeader)
+	sendTxs(t, network.Node(newLeader), 5)
+	require.Nil(t, network.WaitHeight(2, 20*time.Second))
+
+	// 通过manage配置把成员缩减为一个节点, 之后只有该节点能够出块
+	node := network.Node(newLeader)
+	cfg := node.GetClient().GetConfig()
+	hash := node.SendTx(util.CreateCoinsTx(cfg, node.GetGenesisKey(), node.GetHotAddress(), 10*types.Coin))
+	waitTx(t, node, hash)
+	member := (newLeader + 1) % network.Len()
+	if network.Node(member) == nil {
+		member = (member + 1) % network.Len()
+	}
+	self := getClient(network.Node(member)).self
+	tx := util.CreateManageTx(cfg, node.GetHotKey(), mty.CftMembersKey, "add", self)
+	detail := waitTx(t, node, node.SendTx(tx))
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+
+	for i := 0; i < 100 && !getClient(network.Node(member)).election.isLeader(); i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.True(t, getClient(network.Node(member)).election.isLeader())
+	height := network.Node(member).GetLastBlock().Height
+	sendTxs(t, network.Node(member), 5)
+	require.Nil(t, network.WaitHeight(height+1, 20*time.Second))
+	last := network.Node(newLeader).GetLastBlock()
+	assert.Equal(t, self, address.PubKeyToAddress(last.Signature.Pubkey).String())
+}
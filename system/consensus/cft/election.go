@@ -0,0 +1,593 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+// 节点角色
+const (
+	roleFollower int32 = iota
+	roleCandidate
+	roleLeader
+)
+
+var roleName = map[int32]string{
+	roleFollower:  "follower",
+	roleCandidate: "candidate",
+	roleLeader:    "leader",
+}
+
+//MsgSignature 选举消息签名, 签名公钥对应的地址必须是消息的发送者
+type MsgSignature struct {
+	Pubkey    []byte `json:"pubkey"`
+	Signature []byte `json:"signature"`
+}
+
+//VoteRequest 候选人拉票请求, LastTerm和LastHeight为候选人最新区块所在的term和高度
+type VoteRequest struct {
+	Term       int64         `json:"term"`
+	Candidate  string        `json:"candidate"`
+	LastTerm   int64         `json:"lastTerm"`
+	LastHeight int64         `json:"lastHeight"`
+	Sig        *MsgSignature `json:"sig"`
+}
+
+func (req *VoteRequest) signBytes() []byte {
+	return []byte(fmt.Sprintf("cft-vote:%d:%s:%d:%d", req.Term, req.Candidate, req.LastTerm, req.LastHeight))
+}
+
+//Heartbeat leader心跳
+type Heartbeat struct {
+	Term   int64         `json:"term"`
+	Leader string        `json:"leader"`
+	Height int64         `json:"height"`
+	Sig    *MsgSignature `json:"sig"`
+}
+
+func (hb *Heartbeat) signBytes() []byte {
+	return []byte(fmt.Sprintf("cft-heartbeat:%d:%s:%d", hb.Term, hb.Leader, hb.Height))
+}
+
+//Proposal leader提议的区块, 多数派确认之后leader才写入区块
+type Proposal struct {
+	Term   int64         `json:"term"`
+	Leader string        `json:"leader"`
+	Block  []byte        `json:"block"`
+	Sig    *MsgSignature `json:"sig"`
+}
+
+func (p *Proposal) signBytes() []byte {
+	return []byte(fmt.Sprintf("cft-proposal:%d:%s:%x", p.Term, p.Leader, common.Sha256(p.Block)))
+}
+
+//Reply 选举消息应答, Request为所应答消息的哈希, 防止应答被挪用到其他消息
+type Reply struct {
+	Term    int64         `json:"term"`
+	Success bool          `json:"success"`
+	From    string        `json:"from"`
+	Request []byte        `json:"request"`
+	Sig     *MsgSignature `json:"sig"`
+}
+
+func (r *Reply) signBytes() []byte {
+	return []byte(fmt.Sprintf("cft-reply:%d:%v:%s:%x", r.Term, r.Success, r.From, r.Request))
+}
+
+// chainState 选举依赖的链上状态
+type chainState interface {
+	// 本节点当前高度
+	height() int64
+	// 当前高度生效的共识成员地址
+	members() []string
+	// 检查leader提议的区块能否接在本节点的最新区块之后
+	checkProposal(block *types.Block) error
+}
+
+// election raft选举状态机, 区块链本身即为复制日志, leader提议的区块得到多数派确认之后才写入
+type election struct {
+	mu        sync.Mutex
+	privKey   crypto.PrivKey
+	self      string
+	term      int64
+	votedFor  string
+	role      int32
+	leader    string
+	lastHeard time.Time
+	// leader最近一次得到多数派确认的消息的发送时间, 租约从该时间开始计算
+	leaseStart time.Time
+	// 最近确认的区块提议所在的term, 拉票时和高度一起比较
+	lastTerm int64
+	// 已经确认但本节点还没有写入的区块提议
+	pending *types.Block
+
+	heartbeat time.Duration
+	timeout   time.Duration
+	// leader租约, 小于选举超时, 多数派在选举超时内不会给其他候选人投票
+	lease  time.Duration
+	random *rand.Rand
+
+	trans transport
+	chain chainState
+	// 持久化term, votedFor和lastTerm, 为空时只保存在内存中
+	db dbm.DB
+}
+
+// hardState 需要持久化的选举状态, 重启之后不会在同一term重复投票
+type hardState struct {
+	Term     int64  `json:"term"`
+	VotedFor string `json:"votedFor"`
+	LastTerm int64  `json:"lastTerm"`
+}
+
+var hardStateKey = []byte("cft-election-state")
+
+func newElection(privKey crypto.PrivKey, heartbeat, timeout time.Duration, trans transport, chain chainState) *election {
+	e := &election{
+		privKey:   privKey,
+		self:      address.PubKeyToAddress(privKey.PubKey().Bytes()).String(),
+		role:      roleFollower,
+		heartbeat: heartbeat,
+		timeout:   timeout,
+		lease:     timeout / 2,
+		trans:     trans,
+		chain:     chain,
+		random:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	e.lastHeard = time.Now()
+	return e
+}
+
+// load 从本地数据库恢复选举状态, 之后的状态变化都写入该数据库
+func (e *election) load(db dbm.DB) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.db = db
+	data, err := db.Get(hardStateKey)
+	if err == dbm.ErrNotFoundInDb {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var st hardState
+	if err := json.Unmarshal(data, &st); err != nil {
+		return err
+	}
+	e.term, e.votedFor, e.lastTerm = st.Term, st.VotedFor, st.LastTerm
+	clog.Info("load election state", "term", st.Term, "votedFor", st.VotedFor, "lastTerm", st.LastTerm)
+	return nil
+}
+
+// persist 同步写入选举状态, 需要持有锁
+func (e *election) persist() error {
+	if e.db == nil {
+		return nil
+	}
+	data, err := json.Marshal(&hardState{Term: e.term, VotedFor: e.votedFor, LastTerm: e.lastTerm})
+	if err != nil {
+		return err
+	}
+	return e.db.SetSync(hardStateKey, data)
+}
+
+func (e *election) sign(data []byte) *MsgSignature {
+	return &MsgSignature{
+		Pubkey:    e.privKey.PubKey().Bytes(),
+		Signature: e.privKey.Sign(data).Bytes(),
+	}
+}
+
+// verifyMsg 校验消息由sender签名, 并且sender是共识成员
+func verifyMsg(members []string, sender string, data []byte, sig *MsgSignature) error {
+	if !isMember(members, sender) {
+		return errNotMember
+	}
+	if sig == nil || address.PubKeyToAddress(sig.Pubkey).String() != sender {
+		return errBadMsgSignature
+	}
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return err
+	}
+	pub, err := cr.PubKeyFromBytes(sig.Pubkey)
+	if err != nil {
+		return errBadMsgSignature
+	}
+	signature, err := cr.SignatureFromBytes(sig.Signature)
+	if err != nil || !pub.VerifyBytes(data, signature) {
+		return errBadMsgSignature
+	}
+	return nil
+}
+
+// reply 对请求签名应答
+func (e *election) reply(req []byte, success bool) *Reply {
+	r := &Reply{Term: e.term, Success: success, From: e.self, Request: common.Sha256(req)}
+	r.Sig = e.sign(r.signBytes())
+	return r
+}
+
+// verifyReply 校验应答来自被请求的成员并且对应本次请求
+func verifyReply(members []string, peer string, req []byte, r *Reply) error {
+	if r.From != peer || !bytes.Equal(r.Request, common.Sha256(req)) {
+		return errBadMsgSignature
+	}
+	return verifyMsg(members, peer, r.signBytes(), r.Sig)
+}
+
+// randTimeout 选举超时随机化在[timeout, 2*timeout)之间, 避免同时发起选举
+func (e *election) randTimeout() time.Duration {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.timeout + time.Duration(e.random.Int63n(int64(e.timeout)))
+}
+
+func quorum(n int) int {
+	return n/2 + 1
+}
+
+func isMember(members []string, addr string) bool {
+	for _, m := range members {
+		if m == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// stepDown 发现更高的term时退回follower
+func (e *election) stepDown(term int64) {
+	if term > e.term {
+		e.term = term
+		e.votedFor = ""
+		e.leader = ""
+		if err := e.persist(); err != nil {
+			clog.Error("stepDown persist", "term", term, "err", err)
+		}
+	}
+	if e.role != roleFollower {
+		clog.Info("stepDown", "term", e.term, "from", roleName[e.role])
+	}
+	e.role = roleFollower
+}
+
+// lastLog 本节点最新区块所在的term和高度, 包含已确认但还没有写入的区块提议
+func (e *election) lastLog(height int64) (int64, int64) {
+	if e.pending != nil && e.pending.Height > height {
+		height = e.pending.Height
+	}
+	return e.lastTerm, height
+}
+
+//handleVote 处理拉票请求, 只投给区块不落后于本节点的候选人
+func (e *election) handleVote(req *VoteRequest) *Reply {
+	members := e.chain.members()
+	height := e.chain.height()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err := verifyMsg(members, req.Candidate, req.signBytes(), req.Sig); err != nil {
+		clog.Debug("handleVote", "candidate", req.Candidate, "err", err)
+		return e.reply(req.signBytes(), false)
+	}
+	if req.Term < e.term {
+		return e.reply(req.signBytes(), false)
+	}
+	// 选举超时内收到过leader或者已投票候选人的消息时忽略其他候选人, 保证leader租约内不会选出新的leader
+	if time.Since(e.lastHeard) < e.timeout && req.Candidate != e.leader && req.Candidate != e.votedFor {
+		return e.reply(req.signBytes(), false)
+	}
+	if req.Term > e.term {
+		e.stepDown(req.Term)
+	}
+	lastTerm, lastHeight := e.lastLog(height)
+	upToDate := req.LastTerm > lastTerm || (req.LastTerm == lastTerm && req.LastHeight >= lastHeight)
+	if (e.votedFor == "" || e.votedFor == req.Candidate) && upToDate {
+		// 投票写入本地之后才能应答, 否则重启之后可能在同一term再投给其他候选人
+		votedFor := e.votedFor
+		e.votedFor = req.Candidate
+		if err := e.persist(); err != nil {
+			clog.Error("handleVote persist", "term", e.term, "err", err)
+			e.votedFor = votedFor
+			return e.reply(req.signBytes(), false)
+		}
+		e.lastHeard = time.Now()
+		clog.Info("handleVote grant", "term", e.term, "candidate", req.Candidate)
+		return e.reply(req.signBytes(), true)
+	}
+	return e.reply(req.signBytes(), false)
+}
+
+// acceptLeader 接受term内leader的消息, 需要持有锁
+func (e *election) acceptLeader(term int64, leader string) bool {
+	if term < e.term {
+		return false
+	}
+	if term > e.term || e.role != roleFollower {
+		e.stepDown(term)
+	}
+	if e.leader != "" && e.leader != leader {
+		clog.Error("acceptLeader two leaders in one term", "term", term, "leader", e.leader, "other", leader)
+		return false
+	}
+	e.leader = leader
+	e.lastHeard = time.Now()
+	return true
+}
+
+//handleHeartbeat 处理leader心跳
+func (e *election) handleHeartbeat(hb *Heartbeat) *Reply {
+	members := e.chain.members()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err := verifyMsg(members, hb.Leader, hb.signBytes(), hb.Sig); err != nil {
+		clog.Debug("handleHeartbeat", "leader", hb.Leader, "err", err)
+		return e.reply(hb.signBytes(), false)
+	}
+	return e.reply(hb.signBytes(), e.acceptLeader(hb.Term, hb.Leader))
+}
+
+//handleProposal 处理leader提议的区块, 区块能接在本节点最新区块之后才确认
+func (e *election) handleProposal(p *Proposal) *Reply {
+	members := e.chain.members()
+	var block types.Block
+	err := verifyMsg(members, p.Leader, p.signBytes(), p.Sig)
+	if err == nil {
+		err = types.Decode(p.Block, &block)
+	}
+	if err == nil {
+		err = e.chain.checkProposal(&block)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err != nil {
+		clog.Debug("handleProposal", "leader", p.Leader, "err", err)
+		return e.reply(p.signBytes(), false)
+	}
+	if !e.acceptLeader(p.Term, p.Leader) {
+		return e.reply(p.signBytes(), false)
+	}
+	lastTerm := e.lastTerm
+	e.lastTerm = p.Term
+	if err := e.persist(); err != nil {
+		clog.Error("handleProposal persist", "term", p.Term, "err", err)
+		e.lastTerm = lastTerm
+		return e.reply(p.signBytes(), false)
+	}
+	e.pending = &block
+	return e.reply(p.signBytes(), true)
+}
+
+// collectAcks 向其他成员发送请求并统计成功的应答(包含自己), 发现更高的term时退回follower并返回0
+func (e *election) collectAcks(members []string, req []byte, send func(peer string) (*Reply, error)) int {
+	acks := 1
+	for _, m := range members {
+		if m == e.self {
+			continue
+		}
+		reply, err := send(m)
+		if err == nil {
+			err = verifyReply(members, m, req, reply)
+		}
+		if err != nil {
+			clog.Debug("collectAcks", "peer", m, "err", err)
+			continue
+		}
+		e.mu.Lock()
+		if reply.Term > e.term {
+			e.stepDown(reply.Term)
+			e.mu.Unlock()
+			return 0
+		}
+		e.mu.Unlock()
+		if reply.Success {
+			acks++
+		}
+	}
+	return acks
+}
+
+// renewLease 得到多数派确认后续约, start为消息发送时间, 需要持有锁
+func (e *election) renewLease(start time.Time) {
+	e.leaseStart = start
+	e.lastHeard = start
+}
+
+// campaign 发起一轮选举, 返回是否当选
+func (e *election) campaign() bool {
+	members := e.chain.members()
+	if !isMember(members, e.self) {
+		return false
+	}
+	height := e.chain.height()
+	start := time.Now()
+	e.mu.Lock()
+	e.term++
+	e.role = roleCandidate
+	e.votedFor = e.self
+	e.leader = ""
+	e.lastHeard = start
+	if err := e.persist(); err != nil {
+		clog.Error("campaign persist", "term", e.term, "err", err)
+		e.role = roleFollower
+		e.mu.Unlock()
+		return false
+	}
+	lastTerm, lastHeight := e.lastLog(height)
+	req := &VoteRequest{Term: e.term, Candidate: e.self, LastTerm: lastTerm, LastHeight: lastHeight}
+	req.Sig = e.sign(req.signBytes())
+	e.mu.Unlock()
+
+	clog.Info("campaign", "term", req.Term, "height", lastHeight, "members", len(members))
+	votes := e.collectAcks(members, req.signBytes(), func(peer string) (*Reply, error) {
+		return e.trans.requestVote(peer, req)
+	})
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.role != roleCandidate || e.term != req.Term {
+		return false
+	}
+	if votes < quorum(len(members)) {
+		clog.Info("campaign fail", "term", req.Term, "votes", votes)
+		return false
+	}
+	e.role = roleLeader
+	e.leader = e.self
+	e.renewLease(start)
+	clog.Info("campaign win", "term", req.Term, "votes", votes)
+	return true
+}
+
+// broadcastHeartbeat leader向所有成员发送心跳, 失去多数派超过选举超时后主动退位
+func (e *election) broadcastHeartbeat() {
+	members := e.chain.members()
+	height := e.chain.height()
+	start := time.Now()
+	e.mu.Lock()
+	if e.role != roleLeader {
+		e.mu.Unlock()
+		return
+	}
+	if !isMember(members, e.self) {
+		e.stepDown(e.term)
+		e.mu.Unlock()
+		return
+	}
+	hb := &Heartbeat{Term: e.term, Leader: e.self, Height: height}
+	hb.Sig = e.sign(hb.signBytes())
+	e.mu.Unlock()
+
+	acks := e.collectAcks(members, hb.signBytes(), func(peer string) (*Reply, error) {
+		return e.trans.heartbeat(peer, hb)
+	})
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.role != roleLeader || e.term != hb.Term {
+		return
+	}
+	if acks >= quorum(len(members)) {
+		e.renewLease(start)
+	} else if time.Since(e.leaseStart) > e.timeout {
+		clog.Info("leader lost quorum", "term", e.term, "acks", acks)
+		e.stepDown(e.term)
+	}
+}
+
+// replicate leader把区块提议给其他成员, 得到多数派确认之后才能写入区块
+func (e *election) replicate(block *types.Block) bool {
+	members := e.chain.members()
+	start := time.Now()
+	e.mu.Lock()
+	if e.role != roleLeader {
+		e.mu.Unlock()
+		return false
+	}
+	p := &Proposal{Term: e.term, Leader: e.self, Block: types.Encode(block)}
+	p.Sig = e.sign(p.signBytes())
+	e.mu.Unlock()
+
+	acks := e.collectAcks(members, p.signBytes(), func(peer string) (*Reply, error) {
+		return e.trans.propose(peer, p)
+	})
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.role != roleLeader || e.term != p.Term {
+		return false
+	}
+	if acks < quorum(len(members)) {
+		clog.Info("replicate no quorum", "term", p.Term, "height", block.Height, "acks", acks)
+		return false
+	}
+	e.renewLease(start)
+	e.lastTerm = p.Term
+	e.pending = nil
+	if err := e.persist(); err != nil {
+		clog.Error("replicate persist", "term", p.Term, "err", err)
+	}
+	return true
+}
+
+// takePending 返回可以接在最新区块之后的已确认区块提议, 新leader需要先提交上一任leader已经得到多数派确认的区块
+func (e *election) takePending(height int64, hash []byte) *types.Block {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.pending == nil {
+		return nil
+	}
+	if e.pending.Height == height+1 && bytes.Equal(e.pending.ParentHash, hash) {
+		return e.pending
+	}
+	if e.pending.Height <= height {
+		e.pending = nil
+	}
+	return nil
+}
+
+// tick 驱动一次状态机: leader发心跳, 其他角色超时后发起选举, 返回是否发起了选举
+func (e *election) tick(electionTimeout time.Duration) bool {
+	e.mu.Lock()
+	role := e.role
+	expired := time.Since(e.lastHeard) > electionTimeout
+	e.mu.Unlock()
+	if role == roleLeader {
+		e.broadcastHeartbeat()
+		return false
+	}
+	if expired {
+		e.campaign()
+		return true
+	}
+	return false
+}
+
+// run 状态机主循环, 直到done关闭
+func (e *election) run(done <-chan struct{}) {
+	ticker := time.NewTicker(e.heartbeat)
+	defer ticker.Stop()
+	electionTimeout := e.randTimeout()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if e.tick(electionTimeout) {
+				electionTimeout = e.randTimeout()
+			}
+		}
+	}
+}
+
+// isLeader 是否为leader, 且在租约内得到过多数派确认
+func (e *election) isLeader() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.role == roleLeader && time.Since(e.leaseStart) < e.lease
+}
+
+//Status 当前选举状态
+type Status struct {
+	Self   string `json:"self"`
+	Term   int64  `json:"term"`
+	Role   string `json:"role"`
+	Leader string `json:"leader"`
+}
+
+func (e *election) status() *Status {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return &Status{Self: e.self, Term: e.term, Role: roleName[e.role], Leader: e.leader}
+}
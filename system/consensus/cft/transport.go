@@ -0,0 +1,154 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cft
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	votePath      = "/cft/vote"
+	heartbeatPath = "/cft/heartbeat"
+	proposalPath  = "/cft/proposal"
+)
+
+var errUnknownPeer = errors.New("ErrUnknownPeer")
+
+// transport 共识节点之间的选举消息传输, 消息都由发送者签名, 接收方校验签名和成员身份
+type transport interface {
+	requestVote(peer string, req *VoteRequest) (*Reply, error)
+	heartbeat(peer string, hb *Heartbeat) (*Reply, error)
+	propose(peer string, p *Proposal) (*Reply, error)
+}
+
+// httpTransport 基于http json的传输, peers为成员地址到host:port的映射
+type httpTransport struct {
+	peers  map[string]string
+	client *http.Client
+	server *http.Server
+}
+
+// parsePeers 解析 "成员地址=host:port" 格式的配置
+func parsePeers(list []string) (map[string]string, error) {
+	peers := make(map[string]string)
+	for _, item := range list {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+			return nil, fmt.Errorf("cft: bad peer config %s", item)
+		}
+		peers[kv[0]] = kv[1]
+	}
+	return peers, nil
+}
+
+func newHTTPTransport(peers map[string]string, timeout time.Duration) *httpTransport {
+	return &httpTransport{
+		peers:  peers,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (t *httpTransport) post(peer, path string, req, reply interface{}) error {
+	host, ok := t.peers[peer]
+	if !ok {
+		return errUnknownPeer
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	resp, err := t.client.Post("http://"+host+path, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("cft: peer %s status %d", peer, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(reply)
+}
+
+func (t *httpTransport) call(peer, path string, req interface{}) (*Reply, error) {
+	var reply Reply
+	err := t.post(peer, path, req, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+func (t *httpTransport) requestVote(peer string, req *VoteRequest) (*Reply, error) {
+	return t.call(peer, votePath, req)
+}
+
+func (t *httpTransport) heartbeat(peer string, hb *Heartbeat) (*Reply, error) {
+	return t.call(peer, heartbeatPath, hb)
+}
+
+func (t *httpTransport) propose(peer string, p *Proposal) (*Reply, error) {
+	return t.call(peer, proposalPath, p)
+}
+
+// serve 在listenAddr上启动选举消息服务
+func (t *httpTransport) serve(listenAddr string, e *election) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc(votePath, func(w http.ResponseWriter, r *http.Request) {
+		var req VoteRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, e.handleVote(&req))
+	})
+	mux.HandleFunc(heartbeatPath, func(w http.ResponseWriter, r *http.Request) {
+		var hb Heartbeat
+		if err := json.NewDecoder(r.Body).Decode(&hb); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, e.handleHeartbeat(&hb))
+	})
+	mux.HandleFunc(proposalPath, func(w http.ResponseWriter, r *http.Request) {
+		var p Proposal
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, e.handleProposal(&p))
+	})
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return err
+	}
+	t.server = &http.Server{Handler: mux}
+	go func() {
+		err := t.server.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			clog.Error("cft transport serve", "err", err)
+		}
+	}()
+	return nil
+}
+
+func (t *httpTransport) close() {
+	if t.server != nil {
+		t.server.Close()
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		clog.Error("cft writeJSON", "err", err)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package election 基于VRF的出块节点以及委员会选举
+// 1. 种子由之前的区块hash计算, 每个节点使用自己的私钥对种子计算VRF, 得到可以校验的随机数以及证明
+// 2. 随机数按照权益折算为优先级, 优先级最小的节点当选出块节点, 选中概率与权益严格成正比
+// 3. 优先级小于阈值的节点当选委员会成员, 委员会的期望大小可以指定
+// 4. 证明嵌入区块, CheckBlock时根据本地计算的种子以及权益表校验
+package election
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"sort"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
+	"github.com/33cn/chain33/types"
+	"github.com/btcsuite/btcd/btcec"
+)
+
+var (
+	// ErrInvalidStake 权益表错误
+	ErrInvalidStake = errors.New("ErrInvalidStake")
+	// ErrNotMember 不是权益表中的成员
+	ErrNotMember = errors.New("ErrNotMember")
+	// ErrInvalidProof 选举证明错误
+	ErrInvalidProof = errors.New("ErrInvalidElectionProof")
+	// ErrNotSelected 没有当选
+	ErrNotSelected = errors.New("ErrNotSelected")
+)
+
+//Member 参与选举的成员以及权益
+type Member struct {
+	Addr   string
+	Weight int64
+}
+
+//StakeTable 权益表, 同一高度所有节点必须使用相同的权益表
+type StakeTable struct {
+	members []*Member
+	index   map[string]*Member
+	total   int64
+}
+
+//NewStakeTable 创建权益表, 成员地址不能重复, 权益必须为正数
+func NewStakeTable(members []*Member) (*StakeTable, error) {
+	if len(members) == 0 {
+		return nil, ErrInvalidStake
+	}
+	table := &StakeTable{index: make(map[string]*Member)}
+	for _, m := range members {
+		if m.Weight <= 0 || table.index[m.Addr] != nil || table.total+m.Weight < table.total {
+			return nil, ErrInvalidStake
+		}
+		member := &Member{Addr: m.Addr, Weight: m.Weight}
+		table.members = append(table.members, member)
+		table.index[m.Addr] = member
+		table.total += m.Weight
+	}
+	//按照地址排序, 保证遍历顺序确定
+	sort.Slice(table.members, func(i, j int) bool { return table.members[i].Addr < table.members[j].Addr })
+	return table, nil
+}
+
+//Members 权益表成员
+func (table *StakeTable) Members() []*Member {
+	return table.members
+}
+
+//Total 总权益
+func (table *StakeTable) Total() int64 {
+	return table.total
+}
+
+//Weight 成员的权益, 不是成员时返回0
+func (table *StakeTable) Weight(addr string) int64 {
+	if m, ok := table.index[addr]; ok {
+		return m.Weight
+	}
+	return 0
+}
+
+//NewSeed 由之前的区块hash计算种子, 通常使用父区块以及更早的若干区块, 降低单个出块节点操纵种子的能力
+func NewSeed(prevHashes ...[]byte) []byte {
+	var buf bytes.Buffer
+	for _, hash := range prevHashes {
+		buf.Write(common.Sha256(hash))
+	}
+	return common.Sha256(buf.Bytes())
+}
+
+// vrfInput VRF的输入, 同一个种子每个高度以及轮次的输入不同
+func vrfInput(seed []byte, height, round int64) []byte {
+	input := make([]byte, len(seed)+16)
+	copy(input, seed)
+	binary.BigEndian.PutUint64(input[len(seed):], uint64(height))
+	binary.BigEndian.PutUint64(input[len(seed)+8:], uint64(round))
+	return input
+}
+
+//Elector 本节点的选举者, 使用secp256k1私钥计算VRF
+type Elector struct {
+	priv   *vrf.PrivateKey
+	pubKey []byte
+	addr   string
+}
+
+//NewElector 通过secp256k1私钥创建选举者
+func NewElector(privKey []byte) (*Elector, error) {
+	if len(privKey) != 32 {
+		return nil, ErrInvalidProof
+	}
+	priv, pub := btcec.PrivKeyFromBytes(btcec.S256(), privKey)
+	pubKey := pub.SerializeCompressed()
+	return &Elector{
+		priv:   &vrf.PrivateKey{PrivateKey: priv.ToECDSA()},
+		pubKey: pubKey,
+		addr:   address.PubKeyToAddr(pubKey),
+	}, nil
+}
+
+//Addr 选举者地址
+func (e *Elector) Addr() string {
+	return e.addr
+}
+
+//Evaluate 计算指定高度以及轮次的VRF, 返回嵌入区块的选举证明以及VRF输出
+func (e *Elector) Evaluate(seed []byte, height, round int64) (*types.ElectionProof, [32]byte) {
+	hash, proof := e.priv.Evaluate(vrfInput(seed, height, round))
+	return &types.ElectionProof{Height: height, Round: round, PubKey: e.pubKey, Proof: proof}, hash
+}
+
+//Result 校验通过的选举结果
+type Result struct {
+	Addr     string
+	Weight   int64
+	Hash     [32]byte
+	Priority *Priority
+}
+
+//VerifyProof 使用本地计算的种子以及权益表校验选举证明
+func VerifyProof(table *StakeTable, seed []byte, proof *types.ElectionProof) (*Result, error) {
+	if proof == nil {
+		return nil, ErrInvalidProof
+	}
+	addr := address.PubKeyToAddr(proof.PubKey)
+	weight := table.Weight(addr)
+	if weight == 0 {
+		return nil, ErrNotMember
+	}
+	pub, err := btcec.ParsePubKey(proof.PubKey, btcec.S256())
+	if err != nil {
+		return nil, ErrInvalidProof
+	}
+	pk := &vrf.PublicKey{PublicKey: (*ecdsa.PublicKey)(pub)}
+	hash, err := pk.ProofToHash(vrfInput(seed, proof.Height, proof.Round), proof.Proof)
+	if err != nil {
+		return nil, ErrInvalidProof
+	}
+	return &Result{Addr: addr, Weight: weight, Hash: hash, Priority: NewPriority(hash, weight)}, nil
+}
+
+//Leader 从多个校验通过的选举结果中选出优先级最小的出块节点
+func Leader(results []*Result) *Result {
+	var leader *Result
+	for _, r := range results {
+		if leader == nil || r.Priority.Less(leader.Priority) ||
+			(!leader.Priority.Less(r.Priority) && bytes.Compare(r.Hash[:], leader.Hash[:]) < 0) {
+			leader = r
+		}
+	}
+	return leader
+}
+
+//InCommittee 判断选举结果是否当选期望大小为committeeSize的委员会
+//成员当选的概率为 1-exp(-committeeSize*weight/total), 权益占比较小时近似为 committeeSize*weight/total
+func InCommittee(table *StakeTable, result *Result, committeeSize int64) bool {
+	return result.Priority.Below(committeeSize, table.Total())
+}
+
+//SlotRound 根据父区块时间以及区块时间计算轮次, 每个轮次持续slot秒, 超时未出块时进入下一轮次
+//轮次由时间决定, 出块节点不能通过自由选择轮次多次尝试VRF
+func SlotRound(parentTime, blockTime, slot int64) int64 {
+	if slot <= 0 || blockTime <= parentTime {
+		return 0
+	}
+	return (blockTime - parentTime - 1) / slot
+}
+
+//CheckLeaderProof CheckBlock时校验出块节点的选举证明, 出块节点必须是证明的所有者, 并且在期望大小为proposers的候选中当选
+//round为校验方根据区块计算的期望轮次(如SlotRound), 证明中的轮次必须与之相同
+func CheckLeaderProof(table *StakeTable, seed []byte, height, round int64, proposer string, proposers int64, proof *types.ElectionProof) (*Result, error) {
+	if proof == nil || proof.Height != height || proof.Round != round {
+		return nil, ErrInvalidProof
+	}
+	result, err := VerifyProof(table, seed, proof)
+	if err != nil {
+		return nil, err
+	}
+	if result.Addr != proposer {
+		return nil, ErrInvalidProof
+	}
+	if !InCommittee(table, result, proposers) {
+		return nil, ErrNotSelected
+	}
+	return result, nil
+}
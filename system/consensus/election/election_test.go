@@ -0,0 +1,209 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package election
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// genElectors 使用确定的私钥生成选举者, 保证测试结果可以重现
+func genElectors(t *testing.T, n int) []*Elector {
+	electors := make([]*Elector, n)
+	for i := 0; i < n; i++ {
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], uint64(i+1))
+		e, err := NewElector(common.Sha256(b[:]))
+		require.Nil(t, err)
+		electors[i] = e
+	}
+	return electors
+}
+
+func genTable(t *testing.T, electors []*Elector, weights []int64) *StakeTable {
+	members := make([]*Member, len(electors))
+	for i, e := range electors {
+		members[i] = &Member{Addr: e.Addr(), Weight: weights[i]}
+	}
+	table, err := NewStakeTable(members)
+	require.Nil(t, err)
+	return table
+}
+
+func TestStakeTable(t *testing.T) {
+	_, err := NewStakeTable(nil)
+	assert.Equal(t, ErrInvalidStake, err)
+	_, err = NewStakeTable([]*Member{{Addr: "a", Weight: 0}})
+	assert.Equal(t, ErrInvalidStake, err)
+	_, err = NewStakeTable([]*Member{{Addr: "a", Weight: 1}, {Addr: "a", Weight: 2}})
+	assert.Equal(t, ErrInvalidStake, err)
+
+	table, err := NewStakeTable([]*Member{{Addr: "b", Weight: 2}, {Addr: "a", Weight: 1}})
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), table.Total())
+	assert.Equal(t, int64(2), table.Weight("b"))
+	assert.Equal(t, int64(0), table.Weight("c"))
+	assert.Equal(t, "a", table.Members()[0].Addr)
+}
+
+func TestVerifyProof(t *testing.T) {
+	electors := genElectors(t, 3)
+	table := genTable(t, electors[:2], []int64{1, 1})
+	seed := NewSeed([]byte("block1"), []byte("block2"))
+
+	proof, hash := electors[0].Evaluate(seed, 10, 0)
+	result, err := VerifyProof(table, seed, proof)
+	require.Nil(t, err)
+	assert.Equal(t, electors[0].Addr(), result.Addr)
+	assert.Equal(t, hash, result.Hash)
+
+	//种子不同
+	_, err = VerifyProof(table, NewSeed([]byte("block1")), proof)
+	assert.Equal(t, ErrInvalidProof, err)
+	//高度不同
+	proof.Height = 11
+	_, err = VerifyProof(table, seed, proof)
+	assert.Equal(t, ErrInvalidProof, err)
+	proof.Height = 10
+	//证明被篡改
+	proof.Proof[len(proof.Proof)-1] ^= 1
+	_, err = VerifyProof(table, seed, proof)
+	assert.Equal(t, ErrInvalidProof, err)
+	//不是权益表成员
+	proof, _ = electors[2].Evaluate(seed, 10, 0)
+	_, err = VerifyProof(table, seed, proof)
+	assert.Equal(t, ErrNotMember, err)
+	_, err = VerifyProof(table, seed, nil)
+	assert.Equal(t, ErrInvalidProof, err)
+}
+
+func TestCheckLeaderProof(t *testing.T) {
+	electors := genElectors(t, 4)
+	table := genTable(t, electors, []int64{1, 1, 1, 1})
+	seed := NewSeed([]byte("parent"))
+
+	//期望候选数等于成员数时, 至少能找到一个当选的出块节点
+	var results []*Result
+	for _, e := range electors {
+		proof, _ := e.Evaluate(seed, 1, 0)
+		result, err := CheckLeaderProof(table, seed, 1, 0, e.Addr(), 4, proof)
+		if err == nil {
+			results = append(results, result)
+			continue
+		}
+		assert.Equal(t, ErrNotSelected, err)
+	}
+	require.NotEmpty(t, results)
+	leader := Leader(results)
+	for _, r := range results {
+		assert.False(t, r.Priority.Less(leader.Priority))
+	}
+
+	proof, _ := electors[0].Evaluate(seed, 1, 0)
+	_, err := CheckLeaderProof(table, seed, 1, 0, electors[1].Addr(), 4, proof)
+	assert.Equal(t, ErrInvalidProof, err)
+	_, err = CheckLeaderProof(table, seed, 2, 0, electors[0].Addr(), 4, proof)
+	assert.Equal(t, ErrInvalidProof, err)
+
+	//出块节点不能自行选择轮次
+	proof, _ = electors[0].Evaluate(seed, 1, 3)
+	_, err = CheckLeaderProof(table, seed, 1, 0, electors[0].Addr(), 4, proof)
+	assert.Equal(t, ErrInvalidProof, err)
+	_, err = CheckLeaderProof(table, seed, 1, SlotRound(100, 131, 10), electors[0].Addr(), 4, proof)
+	assert.NotEqual(t, ErrInvalidProof, err)
+}
+
+func TestSlotRound(t *testing.T) {
+	assert.Equal(t, int64(0), SlotRound(100, 100, 10))
+	assert.Equal(t, int64(0), SlotRound(100, 110, 10))
+	assert.Equal(t, int64(1), SlotRound(100, 111, 10))
+	assert.Equal(t, int64(2), SlotRound(100, 130, 10))
+	assert.Equal(t, int64(0), SlotRound(100, 90, 10))
+	assert.Equal(t, int64(0), SlotRound(100, 130, 0))
+}
+
+func TestLeaderFairness(t *testing.T) {
+	weights := []int64{1, 2, 3, 4}
+	electors := genElectors(t, len(weights))
+	table := genTable(t, electors, weights)
+
+	const rounds = 1000
+	counts := make(map[string]int)
+	for height := int64(0); height < rounds; height++ {
+		seed := NewSeed([]byte("genesis"), common.Sha256([]byte{byte(height), byte(height >> 8)}))
+		results := make([]*Result, len(electors))
+		for i, e := range electors {
+			proof, _ := e.Evaluate(seed, height, 0)
+			result, err := VerifyProof(table, seed, proof)
+			require.Nil(t, err)
+			results[i] = result
+		}
+		counts[Leader(results).Addr]++
+	}
+	//当选次数与权益成正比, 允许一定的统计误差
+	for i, e := range electors {
+		expected := float64(rounds) * float64(weights[i]) / float64(table.Total())
+		assert.InDelta(t, expected, float64(counts[e.Addr()]), expected*0.25, "member %d", i)
+	}
+}
+
+func TestCommitteeSize(t *testing.T) {
+	const members = 20
+	electors := genElectors(t, members)
+	weights := make([]int64, members)
+	for i := range weights {
+		weights[i] = 1
+	}
+	table := genTable(t, electors, weights)
+
+	const rounds = 200
+	total := 0
+	for height := int64(0); height < rounds; height++ {
+		seed := NewSeed(common.Sha256([]byte{byte(height), byte(height >> 8)}))
+		for _, e := range electors {
+			proof, _ := e.Evaluate(seed, height, 0)
+			result, err := VerifyProof(table, seed, proof)
+			require.Nil(t, err)
+			if InCommittee(table, result, 5) {
+				total++
+			}
+		}
+	}
+	//每个成员当选概率为1-exp(-5/20), 期望大小约为4.42
+	mean := float64(total) / rounds
+	assert.True(t, mean > 4.0 && mean < 4.9, "mean committee size %f", mean)
+}
+
+func TestPriority(t *testing.T) {
+	var zero, max [32]byte
+	for i := range max {
+		max[i] = 0xff
+	}
+	//u=1时优先级为0, 一定当选
+	assert.Equal(t, int64(0), negLog2(max).Int64())
+	assert.True(t, NewPriority(max, 1).Below(1, 100))
+	//u=2^-256时优先级为256
+	assert.Equal(t, int64(256), negLog2(zero).Rsh(negLog2(zero), fracBits).Int64())
+	assert.False(t, NewPriority(zero, 1).Below(1, 100))
+
+	//u=1/2时 -ln(u) = ln2
+	var half [32]byte
+	half[0] = 0x7f
+	for i := 1; i < len(half); i++ {
+		half[i] = 0xff
+	}
+	p := NewPriority(half, 1)
+	assert.Equal(t, int64(1), negLog2(half).Rsh(negLog2(half), fracBits).Int64())
+	//ln2 = 0.693, 阈值 expected/total 在两侧
+	assert.True(t, p.Below(70, 100))
+	assert.False(t, p.Below(69, 100))
+	//权益越大优先级越小
+	assert.True(t, NewPriority(half, 2).Less(p))
+	assert.False(t, p.Less(p))
+}
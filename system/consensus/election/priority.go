@@ -0,0 +1,77 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package election
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// fracBits 定点数的小数位数
+const fracBits = 62
+
+// ln2 = ln(2) * 2^64
+var ln2 = new(big.Int).SetUint64(0xB17217F7D1CF79AC)
+
+//Priority 选举优先级, 值为 -log2(u)/weight, u为VRF输出对应的(0,1]之间的均匀随机数
+//优先级服从参数与权益成正比的指数分布, 多个成员中优先级最小者当选的概率与权益严格成正比
+//全部使用整数运算, 保证不同平台上的计算结果一致
+type Priority struct {
+	log    *big.Int
+	weight int64
+}
+
+//NewPriority 根据VRF输出以及权益计算优先级
+func NewPriority(hash [32]byte, weight int64) *Priority {
+	return &Priority{log: negLog2(hash), weight: weight}
+}
+
+//Less 优先级比较, log_a/w_a < log_b/w_b
+func (p *Priority) Less(other *Priority) bool {
+	a := new(big.Int).Mul(p.log, big.NewInt(other.weight))
+	b := new(big.Int).Mul(other.log, big.NewInt(p.weight))
+	return a.Cmp(b) < 0
+}
+
+//Below 判断 -ln(u)/weight < expected/total, 即成员在期望大小为expected的选举中当选
+func (p *Priority) Below(expected, total int64) bool {
+	//log * ln2 / 2^(fracBits+64) * total < expected * weight
+	left := new(big.Int).Mul(p.log, ln2)
+	left.Mul(left, big.NewInt(total))
+	right := new(big.Int).Mul(big.NewInt(expected), big.NewInt(p.weight))
+	right.Lsh(right, fracBits+64)
+	return left.Cmp(right) < 0
+}
+
+// negLog2 计算 -log2((hash+1)/2^256) 的定点数表示
+func negLog2(hash [32]byte) *big.Int {
+	x := new(big.Int).SetBytes(hash[:])
+	x.Add(x, big.NewInt(1))
+	n := x.BitLen()
+	//x = m * 2^(n-1), m在[1,2)之间, 取最高的63位作为尾数
+	var m uint64
+	if n >= 63 {
+		m = new(big.Int).Rsh(x, uint(n-63)).Uint64()
+	} else {
+		m = x.Uint64() << uint(63-n)
+	}
+	result := big.NewInt(int64(257 - n))
+	result.Lsh(result, fracBits)
+	return result.Sub(result, new(big.Int).SetUint64(log2Frac(m)))
+}
+
+// log2Frac 逐位平方计算log2(m/2^62)的小数部分, m在[2^62, 2^63)之间
+func log2Frac(m uint64) uint64 {
+	var frac uint64
+	for i := fracBits - 1; i >= 0; i-- {
+		hi, lo := bits.Mul64(m, m)
+		m = hi<<2 | lo>>62
+		if m >= 1<<63 {
+			frac |= 1 << uint(i)
+			m >>= 1
+		}
+	}
+	return frac
+}
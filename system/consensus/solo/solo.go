@@ -95,6 +95,17 @@ func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail
 	return nil
 }
 
+//CheckHeader solo区块没有签名, 只校验难度和交易个数
+func (client *Client) CheckHeader(parent, header *types.Header) error {
+	if header.TxCount == 0 {
+		return types.ErrEmptyTx
+	}
+	if header.Difficulty != client.GetAPI().GetConfig().GetP(0).PowLimitBits {
+		return types.ErrBlockHeaderDifficulty
+	}
+	return nil
+}
+
 //CreateBlock 创建区块
 func (client *Client) CreateBlock() {
 	issleep := true
@@ -0,0 +1,391 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bls BLS12-381聚合签名加密包
+// 公钥为G1上的点(48字节压缩格式), 签名为G2上的点(96字节压缩格式)
+// 多个签名可以聚合为一个签名, 用于共识投票以及交易组减少签名数据和校验时间
+package bls
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/common/crypto"
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+const (
+	// PrivKeyLength 私钥长度
+	PrivKeyLength = 32
+	// PubKeyLength 公钥长度, G1压缩格式
+	PubKeyLength = 48
+	// SignatureLength 签名长度, G2压缩格式
+	SignatureLength = 96
+)
+
+var (
+	// dst 签名的hash to curve域分隔符, 与eth2采用相同的proof of possession方案
+	dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	// popDST 公钥持有证明的域分隔符
+	popDST = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	errPrivKey   = errors.New("invalid bls priv key")
+	errPubKey    = errors.New("invalid bls pub key")
+	errSignature = errors.New("invalid bls signature")
+	errAggregate = errors.New("nothing to aggregate")
+)
+
+//Driver 驱动
+type Driver struct{}
+
+//GenKey 生成私钥
+func (d Driver) GenKey() (crypto.PrivKey, error) {
+	q := bls12381.NewG1().Q()
+	for {
+		//64字节随机数对群的阶取模, 保证分布均匀
+		sk := new(big.Int).SetBytes(crypto.CRandBytes(64))
+		sk.Mod(sk, q)
+		if sk.Sign() != 0 {
+			return privKeyFromBig(sk), nil
+		}
+	}
+}
+
+//PrivKeyFromBytes 字节转为私钥
+func (d Driver) PrivKeyFromBytes(b []byte) (privKey crypto.PrivKey, err error) {
+	if len(b) != PrivKeyLength {
+		return nil, errPrivKey
+	}
+	sk := new(big.Int).SetBytes(b)
+	if sk.Sign() == 0 || sk.Cmp(bls12381.NewG1().Q()) >= 0 {
+		return nil, errPrivKey
+	}
+	var priv PrivKeyBLS
+	copy(priv[:], b)
+	return priv, nil
+}
+
+//PubKeyFromBytes 字节转为公钥
+func (d Driver) PubKeyFromBytes(b []byte) (pubKey crypto.PubKey, err error) {
+	if _, err = decodePubKey(b); err != nil {
+		return nil, err
+	}
+	var pub PubKeyBLS
+	copy(pub[:], b)
+	return pub, nil
+}
+
+//SignatureFromBytes 字节转为签名
+func (d Driver) SignatureFromBytes(b []byte) (sig crypto.Signature, err error) {
+	if _, err = decodeSignature(b); err != nil {
+		return nil, err
+	}
+	var s SignatureBLS
+	copy(s[:], b)
+	return s, nil
+}
+
+// Validate validate msg and signature
+func (d Driver) Validate(msg, pub, sig []byte) error {
+	return crypto.BasicValidation(d, msg, pub, sig)
+}
+
+//Aggregate 聚合多个签名
+func (d Driver) Aggregate(sigs []crypto.Signature) (crypto.Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errAggregate
+	}
+	g2 := bls12381.NewG2()
+	agg := g2.Zero()
+	for _, sig := range sigs {
+		s, ok := sig.(SignatureBLS)
+		if !ok {
+			return nil, errSignature
+		}
+		p, err := decodeSignature(s[:])
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(agg, agg, p)
+	}
+	var out SignatureBLS
+	copy(out[:], g2.ToCompressed(agg))
+	return out, nil
+}
+
+//AggregatePublic 聚合多个公钥, 参与聚合的公钥需要事先通过VerifyPossession校验, 防止rogue key攻击
+func (d Driver) AggregatePublic(pubs []crypto.PubKey) (crypto.PubKey, error) {
+	agg, err := aggregatePubKeys(pubs)
+	if err != nil {
+		return nil, err
+	}
+	var out PubKeyBLS
+	copy(out[:], bls12381.NewG1().ToCompressed(agg))
+	return out, nil
+}
+
+//VerifyAggregatedOne 校验多个公钥对同一消息的聚合签名
+func (d Driver) VerifyAggregatedOne(pubs []crypto.PubKey, m []byte, sig crypto.Signature) error {
+	agg, err := aggregatePubKeys(pubs)
+	if err != nil {
+		return err
+	}
+	if bls12381.NewG1().IsZero(agg) {
+		return crypto.ErrSign
+	}
+	if !verify(agg, m, sig, dst) {
+		return crypto.ErrSign
+	}
+	return nil
+}
+
+//VerifyAggregatedN 校验多个公钥分别对不同消息签名的聚合签名, 公钥和消息一一对应
+func (d Driver) VerifyAggregatedN(pubs []crypto.PubKey, ms [][]byte, sig crypto.Signature) error {
+	if len(pubs) == 0 || len(pubs) != len(ms) {
+		return errAggregate
+	}
+	s, err := toSignaturePoint(sig)
+	if err != nil {
+		return err
+	}
+	g2 := bls12381.NewG2()
+	engine := bls12381.NewEngine()
+	for i, pub := range pubs {
+		p, ok := pub.(PubKeyBLS)
+		if !ok {
+			return errPubKey
+		}
+		pk, err := decodePubKey(p[:])
+		if err != nil {
+			return err
+		}
+		h, err := g2.HashToCurve(ms[i], dst)
+		if err != nil {
+			return err
+		}
+		engine.AddPair(pk, h)
+	}
+	engine.AddPairInv(engine.G1.One(), s)
+	if !engine.Check() {
+		return crypto.ErrSign
+	}
+	return nil
+}
+
+//ProvePossession 生成公钥的持有证明, 公钥注册时需要同时提交
+func ProvePossession(priv crypto.PrivKey) (crypto.Signature, error) {
+	p, ok := priv.(PrivKeyBLS)
+	if !ok {
+		return nil, errPrivKey
+	}
+	pub := p.PubKey()
+	return p.sign(pub.Bytes(), popDST), nil
+}
+
+//VerifyPossession 校验公钥的持有证明
+func VerifyPossession(pub crypto.PubKey, proof crypto.Signature) bool {
+	p, ok := pub.(PubKeyBLS)
+	if !ok {
+		return false
+	}
+	pk, err := decodePubKey(p[:])
+	if err != nil {
+		return false
+	}
+	return verify(pk, p[:], proof, popDST)
+}
+
+//PrivKeyBLS PrivKey
+type PrivKeyBLS [PrivKeyLength]byte
+
+func privKeyFromBig(sk *big.Int) PrivKeyBLS {
+	var priv PrivKeyBLS
+	b := sk.Bytes()
+	copy(priv[PrivKeyLength-len(b):], b)
+	return priv
+}
+
+//Bytes 字节格式
+func (privKey PrivKeyBLS) Bytes() []byte {
+	s := make([]byte, PrivKeyLength)
+	copy(s, privKey[:])
+	return s
+}
+
+func (privKey PrivKeyBLS) sign(msg, domain []byte) SignatureBLS {
+	g2 := bls12381.NewG2()
+	h, err := g2.HashToCurve(msg, domain)
+	if err != nil {
+		panic(err)
+	}
+	g2.MulScalarBig(h, h, new(big.Int).SetBytes(privKey[:]))
+	var sig SignatureBLS
+	copy(sig[:], g2.ToCompressed(h))
+	return sig
+}
+
+//Sign 签名
+func (privKey PrivKeyBLS) Sign(msg []byte, opts ...interface{}) crypto.Signature {
+	return privKey.sign(msg, dst)
+}
+
+//PubKey 公钥
+func (privKey PrivKeyBLS) PubKey(opts ...interface{}) crypto.PubKey {
+	g1 := bls12381.NewG1()
+	p := g1.MulScalarBig(g1.New(), g1.One(), new(big.Int).SetBytes(privKey[:]))
+	var pub PubKeyBLS
+	copy(pub[:], g1.ToCompressed(p))
+	return pub
+}
+
+//Equals 相等
+func (privKey PrivKeyBLS) Equals(other crypto.PrivKey) bool {
+	if otherBLS, ok := other.(PrivKeyBLS); ok {
+		return bytes.Equal(privKey[:], otherBLS[:])
+	}
+	return false
+}
+
+//PubKeyBLS PubKey
+type PubKeyBLS [PubKeyLength]byte
+
+//Bytes 字节格式
+func (pubKey PubKeyBLS) Bytes() []byte {
+	s := make([]byte, PubKeyLength)
+	copy(s, pubKey[:])
+	return s
+}
+
+//VerifyBytes 验证字节
+func (pubKey PubKeyBLS) VerifyBytes(msg []byte, sig crypto.Signature) bool {
+	pk, err := decodePubKey(pubKey[:])
+	if err != nil {
+		return false
+	}
+	return verify(pk, msg, sig, dst)
+}
+
+//KeyString 公钥字符串格式
+func (pubKey PubKeyBLS) KeyString() string {
+	return fmt.Sprintf("%X", pubKey[:])
+}
+
+//Equals 相等
+func (pubKey PubKeyBLS) Equals(other crypto.PubKey) bool {
+	if otherBLS, ok := other.(PubKeyBLS); ok {
+		return bytes.Equal(pubKey[:], otherBLS[:])
+	}
+	return false
+}
+
+//SignatureBLS Signature
+type SignatureBLS [SignatureLength]byte
+
+//Bytes 字节格式
+func (sig SignatureBLS) Bytes() []byte {
+	s := make([]byte, SignatureLength)
+	copy(s, sig[:])
+	return s
+}
+
+//IsZero 是否是0
+func (sig SignatureBLS) IsZero() bool { return len(sig) == 0 }
+
+func (sig SignatureBLS) String() string {
+	fingerprint := make([]byte, len(sig[:]))
+	copy(fingerprint, sig[:])
+	return fmt.Sprintf("/%X.../", fingerprint)
+}
+
+//Equals 相等
+func (sig SignatureBLS) Equals(other crypto.Signature) bool {
+	if otherBLS, ok := other.(SignatureBLS); ok {
+		return bytes.Equal(sig[:], otherBLS[:])
+	}
+	return false
+}
+
+// decodePubKey 解析公钥, 拒绝无穷远点
+func decodePubKey(b []byte) (*bls12381.PointG1, error) {
+	if len(b) != PubKeyLength {
+		return nil, errPubKey
+	}
+	g1 := bls12381.NewG1()
+	p, err := g1.FromCompressed(b)
+	if err != nil || g1.IsZero(p) {
+		return nil, errPubKey
+	}
+	return p, nil
+}
+
+func decodeSignature(b []byte) (*bls12381.PointG2, error) {
+	if len(b) != SignatureLength {
+		return nil, errSignature
+	}
+	p, err := bls12381.NewG2().FromCompressed(b)
+	if err != nil {
+		return nil, errSignature
+	}
+	return p, nil
+}
+
+func toSignaturePoint(sig crypto.Signature) (*bls12381.PointG2, error) {
+	s, ok := sig.(SignatureBLS)
+	if !ok {
+		return nil, errSignature
+	}
+	return decodeSignature(s[:])
+}
+
+func aggregatePubKeys(pubs []crypto.PubKey) (*bls12381.PointG1, error) {
+	if len(pubs) == 0 {
+		return nil, errAggregate
+	}
+	g1 := bls12381.NewG1()
+	agg := g1.Zero()
+	for _, pub := range pubs {
+		p, ok := pub.(PubKeyBLS)
+		if !ok {
+			return nil, errPubKey
+		}
+		pk, err := decodePubKey(p[:])
+		if err != nil {
+			return nil, err
+		}
+		g1.Add(agg, agg, pk)
+	}
+	return agg, nil
+}
+
+// verify 校验 e(pk, H(m)) == e(g1, sig)
+func verify(pk *bls12381.PointG1, msg []byte, sig crypto.Signature, domain []byte) bool {
+	s, err := toSignaturePoint(sig)
+	if err != nil {
+		return false
+	}
+	h, err := bls12381.NewG2().HashToCurve(msg, domain)
+	if err != nil {
+		return false
+	}
+	engine := bls12381.NewEngine()
+	engine.AddPair(pk, h)
+	engine.AddPairInv(engine.G1.One(), s)
+	return engine.Check()
+}
+
+//const
+const (
+	// Name 与插件仓库中的bls签名区分
+	Name = "bls12381"
+	// ID 插件仓库的bls已占用259
+	ID = 263
+	// EnableHeight 默认不启用, 需要在配置[crypto.enableHeight]中指定分叉高度
+	EnableHeight = -1
+)
+
+func init() {
+	crypto.Register(Name, &Driver{}, crypto.WithRegOptionTypeID(ID), crypto.WithRegOptionEnableHeight(EnableHeight))
+}
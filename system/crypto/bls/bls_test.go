@@ -0,0 +1,126 @@
+package bls
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenKey(t *testing.T) {
+	d := &Driver{}
+	key, err := d.GenKey()
+	require.Nil(t, err)
+	assert.Equal(t, PrivKeyLength, len(key.Bytes()))
+	assert.Equal(t, PubKeyLength, len(key.PubKey().Bytes()))
+
+	priv, err := d.PrivKeyFromBytes(key.Bytes())
+	require.Nil(t, err)
+	assert.True(t, priv.Equals(key))
+	_, err = d.PrivKeyFromBytes(make([]byte, PrivKeyLength))
+	assert.NotNil(t, err)
+}
+
+func TestSign(t *testing.T) {
+	d := &Driver{}
+	priv, err := d.GenKey()
+	require.Nil(t, err)
+	msg := []byte("message")
+	sig := priv.Sign(msg)
+	assert.Equal(t, SignatureLength, len(sig.Bytes()))
+
+	sig2, err := d.SignatureFromBytes(sig.Bytes())
+	require.Nil(t, err)
+	assert.True(t, sig2.Equals(sig))
+	pub, err := d.PubKeyFromBytes(priv.PubKey().Bytes())
+	require.Nil(t, err)
+	assert.True(t, pub.Equals(priv.PubKey()))
+	assert.Equal(t, fmt.Sprintf("%X", pub.Bytes()), pub.KeyString())
+
+	assert.True(t, pub.VerifyBytes(msg, sig))
+	assert.False(t, pub.VerifyBytes([]byte("message2"), sig))
+	assert.Nil(t, d.Validate(msg, pub.Bytes(), sig.Bytes()))
+
+	_, err = d.PubKeyFromBytes(make([]byte, PubKeyLength))
+	assert.NotNil(t, err)
+	_, err = d.SignatureFromBytes(sig.Bytes()[1:])
+	assert.NotNil(t, err)
+}
+
+func genKeys(t *testing.T, n int) ([]crypto.PrivKey, []crypto.PubKey) {
+	d := &Driver{}
+	privs := make([]crypto.PrivKey, n)
+	pubs := make([]crypto.PubKey, n)
+	for i := 0; i < n; i++ {
+		priv, err := d.GenKey()
+		require.Nil(t, err)
+		privs[i] = priv
+		pubs[i] = priv.PubKey()
+	}
+	return privs, pubs
+}
+
+func TestAggregate(t *testing.T) {
+	var d crypto.Crypto = &Driver{}
+	aggr, err := crypto.ToAggregate(d)
+	require.Nil(t, err)
+	privs, pubs := genKeys(t, 4)
+
+	//同一消息的聚合签名
+	msg := []byte("vote")
+	sigs := make([]crypto.Signature, len(privs))
+	for i, priv := range privs {
+		sigs[i] = priv.Sign(msg)
+	}
+	sig, err := aggr.Aggregate(sigs)
+	require.Nil(t, err)
+	assert.Nil(t, aggr.VerifyAggregatedOne(pubs, msg, sig))
+	assert.Equal(t, crypto.ErrSign, aggr.VerifyAggregatedOne(pubs[1:], msg, sig))
+	assert.Equal(t, crypto.ErrSign, aggr.VerifyAggregatedOne(pubs, []byte("vote2"), sig))
+
+	pub, err := aggr.AggregatePublic(pubs)
+	require.Nil(t, err)
+	assert.True(t, pub.VerifyBytes(msg, sig))
+
+	//不同消息的聚合签名
+	msgs := make([][]byte, len(privs))
+	for i, priv := range privs {
+		msgs[i] = []byte{byte(i)}
+		sigs[i] = priv.Sign(msgs[i])
+	}
+	sig, err = aggr.Aggregate(sigs)
+	require.Nil(t, err)
+	assert.Nil(t, aggr.VerifyAggregatedN(pubs, msgs, sig))
+	msgs[0] = []byte("other")
+	assert.Equal(t, crypto.ErrSign, aggr.VerifyAggregatedN(pubs, msgs, sig))
+	assert.NotNil(t, aggr.VerifyAggregatedN(pubs[1:], msgs, sig))
+
+	_, err = aggr.Aggregate(nil)
+	assert.NotNil(t, err)
+	_, err = aggr.AggregatePublic(nil)
+	assert.NotNil(t, err)
+}
+
+func TestPossession(t *testing.T) {
+	privs, pubs := genKeys(t, 2)
+	proof, err := ProvePossession(privs[0])
+	require.Nil(t, err)
+	assert.True(t, VerifyPossession(pubs[0], proof))
+	assert.False(t, VerifyPossession(pubs[1], proof))
+	//持有证明和普通签名使用不同的域, 不能互相替代
+	assert.False(t, VerifyPossession(pubs[0], privs[0].Sign(pubs[0].Bytes())))
+}
+
+func TestEnableHeight(t *testing.T) {
+	_, err := crypto.New(Name, crypto.WithNewOptionEnableCheck(0))
+	assert.Equal(t, crypto.ErrDriverNotEnable, err)
+	crypto.Init(&crypto.Config{EnableHeight: map[string]int64{Name: 100}}, nil)
+	_, err = crypto.New(Name, crypto.WithNewOptionEnableCheck(99))
+	assert.Equal(t, crypto.ErrDriverNotEnable, err)
+	c, err := crypto.New(Name, crypto.WithNewOptionEnableCheck(100))
+	require.Nil(t, err)
+	_, err = crypto.ToAggregate(c)
+	assert.Nil(t, err)
+}
@@ -5,15 +5,51 @@
 package authority
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"sync"
 
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/system/crypto/common/authority/core"
+	lru "github.com/hashicorp/golang-lru"
 )
 
 var alog = log.New("module", "authority")
 
+// 链上证书配置对应的校验器缓存数量
+const validatorCacheSize = 16
+
+// CertProvider 读取指定高度区块生效的链上证书配置, 即高度height-1的区块执行后的状态, 链上没有配置时返回nil
+// parentState为该状态的哈希, 执行区块时由区块上下文提供, 为空时使用当前主链上高度height-1的区块
+type CertProvider func(height int64, parentState []byte) (*core.AuthConfig, error)
+
+var (
+	providerLock sync.RWMutex
+	certProvider CertProvider
+	authorities  = make(map[int]*Authority)
+)
+
+// SetCertProvider 设置链上证书配置的读取接口, 由执行器模块启动时设置
+func SetCertProvider(provider CertProvider) {
+	providerLock.Lock()
+	defer providerLock.Unlock()
+	certProvider = provider
+}
+
+func getCertProvider() CertProvider {
+	providerLock.RLock()
+	defer providerLock.RUnlock()
+	return certProvider
+}
+
+// GetAuthority 获取指定签名类型的证书校验器, 未初始化返回nil
+func GetAuthority(signType int) *Authority {
+	providerLock.RLock()
+	defer providerLock.RUnlock()
+	return authorities[signType]
+}
+
 // Authority 证书校验器主要结构
 type Authority struct {
 	// 证书文件路径
@@ -22,6 +58,10 @@ type Authority struct {
 	authConfig *core.AuthConfig
 	// 校验器
 	validator core.Validator
+	// 创建新的校验器, 用于链上证书配置
+	newValidator func() core.Validator
+	// 链上证书配置对应的校验器
+	chainValidators *lru.Cache
 	// 签名类型
 	signType int
 	// 初始化标记
@@ -34,7 +74,7 @@ type SubConfig struct {
 	CertPath   string `json:"certPath"`
 }
 
-// Init 初始化auth
+// Init 初始化auth, lclValidator为校验器或者校验器的构造函数, 只有传入构造函数时才支持链上证书配置
 func (auth *Authority) Init(conf *SubConfig, sign int, lclValidator interface{}) error {
 	if len(conf.CertPath) == 0 {
 		alog.Error("Crypto config path can not be null")
@@ -50,24 +90,58 @@ func (auth *Authority) Init(conf *SubConfig, sign int, lclValidator interface{})
 	}
 	auth.authConfig = authConfig
 
-	auth.validator = lclValidator.(core.Validator)
+	if newValidator, ok := lclValidator.(func() core.Validator); ok {
+		auth.newValidator = newValidator
+		auth.validator = newValidator()
+	} else {
+		auth.validator = lclValidator.(core.Validator)
+	}
 	auth.validator.Setup(authConfig)
+	auth.chainValidators, err = lru.New(validatorCacheSize)
+	if err != nil {
+		return err
+	}
 
 	auth.IsInit = true
+	providerLock.Lock()
+	authorities[sign] = auth
+	providerLock.Unlock()
 
 	return nil
 }
 
 // Validate 检验证书
 func (auth *Authority) Validate(pub, signature []byte) error {
+	return auth.validate(auth.validator, pub, signature)
+}
+
+// ValidateByHeight 使用指定高度区块生效的证书配置检验证书, parentState见CertProvider
+func (auth *Authority) ValidateByHeight(pub, signature []byte, height int64, parentState []byte) error {
+	validator, err := auth.getValidator(height, parentState)
+	if err != nil {
+		return err
+	}
+	return auth.validate(validator, pub, signature)
+}
+
+// ValidateCert 使用指定高度区块生效的证书配置检验证书本身, 不涉及签名
+func (auth *Authority) ValidateCert(cert []byte, height int64) error {
+	validator, err := auth.getValidator(height, nil)
+	if err != nil {
+		return err
+	}
+	return validator.ValidateCert(cert)
+}
+
+func (auth *Authority) validate(validator core.Validator, pub, signature []byte) error {
 	// 从proto中解码signature
-	cert, err := auth.validator.GetCertFromSignature(signature)
+	cert, err := validator.GetCertFromSignature(signature)
 	if err != nil {
 		return err
 	}
 
 	// 校验
-	err = auth.validator.Validate(cert, pub)
+	err = validator.Validate(cert, pub)
 	if err != nil {
 		alog.Error(fmt.Sprintf("validate cert failed. %s", err.Error()))
 		return fmt.Errorf("validate cert failed. error:%s", err.Error())
@@ -75,3 +149,60 @@ func (auth *Authority) Validate(pub, signature []byte) error {
 
 	return nil
 }
+
+// getValidator 获取指定高度生效的校验器, 链上没有证书配置时使用本地文件配置
+func (auth *Authority) getValidator(height int64, parentState []byte) (core.Validator, error) {
+	provider := getCertProvider()
+	if provider == nil || auth.newValidator == nil {
+		return auth.validator, nil
+	}
+	chainConfig, err := provider(height, parentState)
+	if err != nil {
+		alog.Error("getValidator load chain certs", "height", height, "err", err)
+		return nil, err
+	}
+	if chainConfig == nil {
+		return auth.validator, nil
+	}
+
+	config := auth.mergeConfig(chainConfig)
+	key := configDigest(config)
+	if validator, ok := auth.chainValidators.Get(key); ok {
+		return validator.(core.Validator), nil
+	}
+	validator := auth.newValidator()
+	err = validator.Setup(config)
+	if err != nil {
+		alog.Error("getValidator setup chain certs", "height", height, "err", err)
+		return nil, err
+	}
+	auth.chainValidators.Add(key, validator)
+	return validator, nil
+}
+
+// mergeConfig 链上配置了CA证书时替换本地的根证书以及中间证书, 吊销列表则与本地合并
+func (auth *Authority) mergeConfig(chainConfig *core.AuthConfig) *core.AuthConfig {
+	config := &core.AuthConfig{
+		RootCerts:         auth.authConfig.RootCerts,
+		IntermediateCerts: auth.authConfig.IntermediateCerts,
+	}
+	if len(chainConfig.RootCerts) > 0 {
+		config.RootCerts = chainConfig.RootCerts
+		config.IntermediateCerts = chainConfig.IntermediateCerts
+	}
+	config.RevocationList = append(config.RevocationList, auth.authConfig.RevocationList...)
+	config.RevocationList = append(config.RevocationList, chainConfig.RevocationList...)
+	return config
+}
+
+func configDigest(config *core.AuthConfig) string {
+	h := sha256.New()
+	for i, items := range [][][]byte{config.RootCerts, config.IntermediateCerts, config.RevocationList} {
+		fmt.Fprintf(h, "%d:%d;", i, len(items))
+		for _, item := range items {
+			digest := sha256.Sum256(item)
+			h.Write(digest[:])
+		}
+	}
+	return string(h.Sum(nil))
+}
@@ -10,6 +10,8 @@ type Validator interface {
 
 	Validate(cert []byte, pubKey []byte) error
 
+	ValidateCert(cert []byte) error
+
 	GetCertFromSignature(signature []byte) ([]byte, error)
 }
 
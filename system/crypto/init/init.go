@@ -9,6 +9,7 @@ package init
 //为了安全考虑，默认情况下，我们希望只定义合约内部的签名，系统级别的签名对所有的合约都有效
 import (
 	//初始化
+	_ "github.com/33cn/chain33/system/crypto/bls"
 	_ "github.com/33cn/chain33/system/crypto/ed25519"
 	_ "github.com/33cn/chain33/system/crypto/none"
 	_ "github.com/33cn/chain33/system/crypto/secp256k1"
@@ -96,6 +96,20 @@ func (d Driver) Validate(msg, pub, sig []byte) error {
 	return err
 }
 
+// ValidateByHeight 使用指定高度区块生效的证书配置校验签名, 证书可以通过链上交易管理
+func (d Driver) ValidateByHeight(msg, pub, sig []byte, height int64, parentState []byte) error {
+	err := crypto.BasicValidation(d, msg, pub, sig)
+	if err != nil {
+		return err
+	}
+
+	if EcdsaAuthor.IsInit {
+		err = EcdsaAuthor.ValidateByHeight(pub, sig, height, parentState)
+	}
+
+	return err
+}
+
 // PrivKeyECDSA PrivKey
 type PrivKeyECDSA [privateKeyECDSALength]byte
 
@@ -261,7 +275,7 @@ func New(sub []byte) {
 	}
 
 	if subcfg.CertEnable {
-		err := EcdsaAuthor.Init(&subcfg, ID, NewEcdsaValidator())
+		err := EcdsaAuthor.Init(&subcfg, ID, NewEcdsaValidator)
 		if err != nil {
 			panic(err.Error())
 		}
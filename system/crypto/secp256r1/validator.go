@@ -130,7 +130,21 @@ func (validator *ecdsaValidator) Validate(certByte []byte, pubKey []byte) error
 		return fmt.Errorf("Invalid public key")
 	}
 
-	cert, err = validator.sanitizeCert(cert)
+	return validator.validateCert(cert)
+}
+
+// ValidateCert 只校验证书链以及吊销列表, 不校验公钥
+func (validator *ecdsaValidator) ValidateCert(certByte []byte) error {
+	cert, err := validator.getCertFromPem(certByte)
+	if err != nil {
+		return fmt.Errorf("ParseCertificate failed %s", err)
+	}
+
+	return validator.validateCert(cert)
+}
+
+func (validator *ecdsaValidator) validateCert(cert *x509.Certificate) error {
+	cert, err := validator.sanitizeCert(cert)
 	if err != nil {
 		return fmt.Errorf("Sanitize certification failed. err %s", err)
 	}
@@ -96,6 +96,20 @@ func (d Driver) Validate(msg, pub, sig []byte) error {
 	return err
 }
 
+// ValidateByHeight 使用指定高度区块生效的证书配置校验签名, 证书可以通过链上交易管理
+func (d Driver) ValidateByHeight(msg, pub, sig []byte, height int64, parentState []byte) error {
+	err := crypto.BasicValidation(d, msg, pub, sig)
+	if err != nil {
+		return err
+	}
+
+	if SM2Author.IsInit {
+		err = SM2Author.ValidateByHeight(pub, sig, height, parentState)
+	}
+
+	return err
+}
+
 //PrivKeySM2 私钥
 type PrivKeySM2 [SM2PrivateKeyLength]byte
 
@@ -249,7 +263,7 @@ func New(sub []byte) {
 	}
 
 	if subcfg.CertEnable {
-		err := SM2Author.Init(&subcfg, ID, NewGmValidator())
+		err := SM2Author.Init(&subcfg, ID, NewGmValidator)
 		if err != nil {
 			panic(err.Error())
 		}
@@ -13,6 +13,7 @@ import (
 	sm2Util "github.com/33cn/chain33/system/crypto/sm2"
 
 	"github.com/33cn/chain33/system/crypto/common/authority"
+	"github.com/33cn/chain33/system/crypto/common/authority/core"
 	"github.com/33cn/chain33/system/crypto/common/authority/utils"
 
 	"github.com/33cn/chain33/common"
@@ -299,3 +300,54 @@ func TestChckSignWithSm2(t *testing.T) {
 	tx15.Sign(sm2Util.ID, privKeysm2)
 	assert.Equal(t, false, tx15.CheckSign(0))
 }
+
+/**
+TestCase06 使用链上管理的证书配置验签
+*/
+func TestChckSignWithChainCerts(t *testing.T) {
+	cfg, err := initEnv()
+	if err != nil {
+		t.Errorf("init env failed, error:%s", err)
+		return
+	}
+	cfg.SetMinFee(0)
+
+	var subcfg authority.SubConfig
+	utils.MustDecode(cfg.GetSubConfig().Crypto[sm2Util.Name], &subcfg)
+	err = sm2Util.SM2Author.Init(&subcfg, sm2Util.ID, sm2Util.NewGmValidator)
+	assert.Nil(t, err)
+	defer authority.SetCertProvider(nil)
+
+	ca, err := ioutil.ReadFile(path.Join(subcfg.CertPath, "cacerts", "ca-cert.pem"))
+	assert.Nil(t, err)
+	org, err := ioutil.ReadFile(path.Join(subcfg.CertPath, "intermediatecerts", "org1-cert.pem"))
+	assert.Nil(t, err)
+	user, err := ioutil.ReadFile(path.Join(subcfg.CertPath, "signcerts", "user1@org1-cert.pem"))
+	assert.Nil(t, err)
+
+	chainCerts := map[int64]*core.AuthConfig{
+		2: {RootCerts: [][]byte{ca}, IntermediateCerts: [][]byte{org}},
+		3: {RootCerts: [][]byte{user}},
+	}
+	forkCerts := map[string]*core.AuthConfig{
+		"fork": {RootCerts: [][]byte{ca}, IntermediateCerts: [][]byte{org}},
+	}
+	authority.SetCertProvider(func(height int64, parentState []byte) (*core.AuthConfig, error) {
+		if parentState != nil {
+			return forkCerts[string(parentState)], nil
+		}
+		return chainCerts[height], nil
+	})
+
+	//链上没有配置时使用本地证书
+	assert.True(t, tx1.CheckSign(1))
+	assert.True(t, tx1.CheckSign(2))
+	assert.Nil(t, sm2Util.SM2Author.ValidateCert(user, 2))
+	//链上替换了CA证书
+	assert.False(t, tx1.CheckSign(3))
+	assert.NotNil(t, sm2Util.SM2Author.ValidateCert(user, 3))
+	//执行区块时使用区块上下文中父区块的状态, 不依赖主链上相同高度的配置
+	assert.Nil(t, sm2Util.SM2Author.ValidateByHeight(tx1.Signature.Pubkey, tx1.Signature.Signature, 3, []byte("fork")))
+	//未设置高度的校验不受链上配置影响
+	assert.Nil(t, sm2Util.SM2Author.Validate(tx1.Signature.Pubkey, tx1.Signature.Signature))
+}
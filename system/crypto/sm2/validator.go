@@ -100,6 +100,20 @@ func (validator *gmValidator) Validate(certByte []byte, pubKey []byte) error {
 		return fmt.Errorf("Invalid public key")
 	}
 
+	return validator.validateCert(cert)
+}
+
+// ValidateCert 只校验证书链以及吊销列表, 不校验公钥
+func (validator *gmValidator) ValidateCert(certByte []byte) error {
+	cert, err := validator.getCertFromPem(certByte)
+	if err != nil {
+		return fmt.Errorf("ParseCertificate failed %s", err)
+	}
+
+	return validator.validateCert(cert)
+}
+
+func (validator *gmValidator) validateCert(cert *sm2.Certificate) error {
 	validationChain, err := validator.getCertificationChain(cert)
 	if err != nil {
 		return fmt.Errorf("Could not obtain certification chain, err %s", err)
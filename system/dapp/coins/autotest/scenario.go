@@ -0,0 +1,145 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autotest
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/cmd/autotest/scenario"
+	"github.com/33cn/chain33/common/address"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+)
+
+//注册场景测试引擎的coins命令和检查项, 和chain33-cli的coins命令参数一致
+func init() {
+	scenario.RegisterTxCommand("coins transfer", createTransfer)
+	scenario.RegisterTxCommand("coins withdraw", createWithdraw)
+	scenario.RegisterTxCommand("coins send_exec", createSendToExec)
+	scenario.RegisterCheck("TransferCase", "balance", checkTransferBalance)
+	scenario.RegisterCheck("WithdrawCase", "balance", checkWithdrawBalance)
+}
+
+func createTransfer(b scenario.Backend, flags scenario.Flags) (*types.Transaction, error) {
+	amount, err := flags.Amount("a", "amount")
+	if err != nil {
+		return nil, err
+	}
+	to := flags.Get("t", "to")
+	if err := address.CheckAddress(to); err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+	v := &cty.CoinsAction_Transfer{Transfer: &types.AssetsTransfer{Amount: amount, Note: []byte(flags.Get("n", "note")), To: to}}
+	return createCoinsTx(b.GetConfig(), &cty.CoinsAction{Ty: cty.CoinsActionTransfer, Value: v}, to)
+}
+
+func createWithdraw(b scenario.Backend, flags scenario.Flags) (*types.Transaction, error) {
+	amount, err := flags.Amount("a", "amount")
+	if err != nil {
+		return nil, err
+	}
+	exec := flags.Get("e", "exec")
+	if !types.IsAllowExecName([]byte(exec), []byte(exec)) {
+		return nil, types.ErrExecNameNotMatch
+	}
+	to := address.ExecAddress(exec)
+	v := &cty.CoinsAction_Withdraw{Withdraw: &types.AssetsWithdraw{Amount: amount, Note: []byte(flags.Get("n", "note")), ExecName: exec, To: to}}
+	return createCoinsTx(b.GetConfig(), &cty.CoinsAction{Ty: cty.CoinsActionWithdraw, Value: v}, to)
+}
+
+func createSendToExec(b scenario.Backend, flags scenario.Flags) (*types.Transaction, error) {
+	amount, err := flags.Amount("a", "amount")
+	if err != nil {
+		return nil, err
+	}
+	exec := flags.Get("e", "exec")
+	if !types.IsAllowExecName([]byte(exec), []byte(exec)) {
+		return nil, types.ErrExecNameNotMatch
+	}
+	to := address.ExecAddress(exec)
+	v := &cty.CoinsAction_TransferToExec{TransferToExec: &types.AssetsTransferToExec{Amount: amount, Note: []byte(flags.Get("n", "note")), ExecName: exec, To: to}}
+	return createCoinsTx(b.GetConfig(), &cty.CoinsAction{Ty: cty.CoinsActionTransferToExec, Value: v}, to)
+}
+
+func createCoinsTx(cfg *types.Chain33Config, action *cty.CoinsAction, to string) (*types.Transaction, error) {
+	if cfg.IsPara() {
+		to = address.ExecAddress(cfg.ExecName(cty.CoinsX))
+	}
+	tx := &types.Transaction{Payload: types.Encode(action), To: to}
+	return types.FormatTx(cfg, cfg.ExecName(cty.CoinsX), tx)
+}
+
+//checkAccountDelta 检查第i条日志是addr账户的余额变化
+func checkAccountDelta(r *scenario.TxResult, i int, addr string, delta int64) error {
+	log, err := r.DecodeLog(i)
+	if err != nil {
+		return err
+	}
+	var prev, current *types.Account
+	switch l := log.(type) {
+	case *types.ReceiptAccountTransfer:
+		prev, current = l.Prev, l.Current
+	case *types.ReceiptExecAccountTransfer:
+		prev, current = l.Prev, l.Current
+	default:
+		return fmt.Errorf("log %d type %T is not account transfer", i, log)
+	}
+	if current.GetAddr() != addr {
+		return fmt.Errorf("log %d addr %s, expect %s", i, current.GetAddr(), addr)
+	}
+	if current.GetBalance()-prev.GetBalance() != delta {
+		return fmt.Errorf("log %d balance of %s changed %d, expect %d", i, addr, current.GetBalance()-prev.GetBalance(), delta)
+	}
+	return nil
+}
+
+//checkTransferBalance 依次检查手续费, 转出, 转入的余额变化, 转账到合约时还有一条合约账户的存入日志
+func checkTransferBalance(c *scenario.Case, r *scenario.TxResult) error {
+	amount, err := c.Amount("amount")
+	if err != nil {
+		return err
+	}
+	from, to := c.String("from"), c.String("to")
+	if err := checkAccountDelta(r, 0, from, -r.Fee); err != nil {
+		return err
+	}
+	if err := checkAccountDelta(r, 1, from, -amount); err != nil {
+		return err
+	}
+	if err := checkAccountDelta(r, 2, to, amount); err != nil {
+		return err
+	}
+	if len(r.Receipt.GetLogs()) == 4 {
+		return checkAccountDelta(r, 3, from, amount)
+	}
+	return nil
+}
+
+//checkWithdrawBalance 依次检查手续费, 合约账户取出, 合约地址转出, 账户转入的余额变化
+func checkWithdrawBalance(c *scenario.Case, r *scenario.TxResult) error {
+	amount, err := c.Amount("amount")
+	if err != nil {
+		return err
+	}
+	addr := c.String("addr")
+	if err := checkAccountDelta(r, 0, addr, -r.Fee); err != nil {
+		return err
+	}
+	if err := checkAccountDelta(r, 1, addr, -amount); err != nil {
+		return err
+	}
+	log, err := r.DecodeLog(2)
+	if err != nil {
+		return err
+	}
+	transfer, ok := log.(*types.ReceiptAccountTransfer)
+	if !ok {
+		return fmt.Errorf("log 2 type %T is not account transfer", log)
+	}
+	if err := checkAccountDelta(r, 2, transfer.GetCurrent().GetAddr(), -amount); err != nil {
+		return err
+	}
+	return checkAccountDelta(r, 3, addr, amount)
+}
@@ -0,0 +1,36 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autotest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/cmd/autotest/scenario"
+	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoinsScenario(t *testing.T) {
+	mock := testnode.New("", nil)
+	defer mock.Close()
+	//用例文件使用的创世地址在测试节点的钱包中, 先转入余额
+	cfg := mock.GetClient().GetConfig()
+	mock.SendTx(util.CreateCoinsTx(cfg, mock.GetGenesisKey(), "12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv", 1000*types.Coin))
+	require.Nil(t, mock.Wait())
+
+	s, err := scenario.LoadFile("coins.toml")
+	require.Nil(t, err)
+	runner := scenario.NewRunner(scenario.NewAPIBackend(mock.GetAPI()), &scenario.Config{Parallel: 4, Interval: time.Second / 10})
+	result := runner.Run(s)
+	for _, c := range result.Cases {
+		assert.Equal(t, scenario.StatusPassed, c.Status, c.ID+" "+c.Message)
+	}
+	assert.Equal(t, 15, len(result.Cases))
+}
@@ -28,6 +28,9 @@ func NetCmd() *cobra.Command {
 		GetFatalFailureCmd(),
 		GetTimeStausCmd(),
 		NetProtocolsCmd(),
+		PeerScoresCmd(),
+		BanPeerCmd(),
+		UnbanPeerCmd(),
 	)
 
 	return cmd
@@ -125,6 +128,65 @@ func netProtocols(cmd *cobra.Command, args []string) {
 	ctx.Run()
 }
 
+//PeerScoresCmd get peer scores
+func PeerScoresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scores",
+		Short: "Get peer scores",
+		Run:   peerScores,
+	}
+	return cmd
+}
+
+func peerScores(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res types.PeerScoreList
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetPeerScores", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
+//BanPeerCmd ban peer
+func BanPeerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ban",
+		Short: "Ban peer for a while",
+		Run:   banPeer,
+	}
+	cmd.Flags().StringP("pid", "p", "", "peer id")
+	cmd.MarkFlagRequired("pid")
+	cmd.Flags().Int64P("seconds", "s", 0, "ban seconds, 0 means default")
+	return cmd
+}
+
+func banPeer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	pid, _ := cmd.Flags().GetString("pid")
+	seconds, _ := cmd.Flags().GetInt64("seconds")
+	var res rpctypes.Reply
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.BanPeer", &types.ReqBanPeer{Pid: pid, Seconds: seconds}, &res)
+	ctx.Run()
+}
+
+//UnbanPeerCmd unban peer
+func UnbanPeerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unban",
+		Short: "Unban peer and reset its score",
+		Run:   unbanPeer,
+	}
+	cmd.Flags().StringP("pid", "p", "", "peer id")
+	cmd.MarkFlagRequired("pid")
+	return cmd
+}
+
+func unbanPeer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	pid, _ := cmd.Flags().GetString("pid")
+	var res rpctypes.Reply
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.UnbanPeer", &types.ReqString{Data: pid}, &res)
+	ctx.Run()
+}
+
 // GetFatalFailureCmd get FatalFailure
 func GetFatalFailureCmd() *cobra.Command {
 	cmd := &cobra.Command{
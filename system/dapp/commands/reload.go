@@ -0,0 +1,28 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"github.com/33cn/chain33/rpc/jsonclient"
+	"github.com/33cn/chain33/types"
+	"github.com/spf13/cobra"
+)
+
+// ReloadConfigCmd reload config command
+func ReloadConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reload_config",
+		Short: "Reload node config file, only non-consensus settings take effect",
+		Run:   reloadConfig,
+	}
+	return cmd
+}
+
+func reloadConfig(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res types.ReplyReloadConfig
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.ReloadConfig", &types.ReqNil{}, &res)
+	ctx.Run()
+}
@@ -0,0 +1,52 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/spf13/cobra"
+)
+
+// SchemaCmd rpc schema command
+func SchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Get openapi schema of json rpc methods, executor actions and queries",
+		Run:   schema,
+	}
+	cmd.Flags().StringP("output", "o", "", "write schema to file instead of stdout")
+	return cmd
+}
+
+func schema(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	output, _ := cmd.Flags().GetString("output")
+	var res rpctypes.OpenAPIDoc
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetRPCSchema", nil, &res)
+	if output == "" {
+		ctx.Run()
+		return
+	}
+	_, err := ctx.RunResult()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	data, err := json.MarshalIndent(&res, "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	err = ioutil.WriteFile(output, data, 0644)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
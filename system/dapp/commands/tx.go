@@ -31,6 +31,7 @@ func TxCmd() *cobra.Command {
 		QueryTxsByHashesCmd(),
 		GetRawTxCmd(),
 		DecodeTxCmd(),
+		DecodeReceiptCmd(),
 		GetAddrOverviewCmd(),
 		ReWriteRawTxCmd(),
 	)
@@ -254,6 +255,43 @@ func parseReplyTxList(view interface{}) (interface{}, error) {
 	return &commandtxs, nil
 }
 
+// DecodeReceiptCmd decode payload, logs and account changes of tx or block
+func DecodeReceiptCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode_receipt",
+		Short: "Decode payload, receipt logs and account changes by tx hash or block",
+		Long:  "Decode payload, receipt logs and account changes by tx hash or block, KV changes are not included since receipts only keep type and logs",
+		Run:   decodeReceipt,
+	}
+	addDecodeReceiptFlags(cmd)
+	return cmd
+}
+
+func addDecodeReceiptFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hash", "s", "", "transaction hash")
+	cmd.Flags().StringP("block_hash", "b", "", "block hash, decode all txs in block")
+	cmd.Flags().Int64P("height", "t", -1, "block height, decode all txs in block")
+}
+
+func decodeReceipt(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hash, _ := cmd.Flags().GetString("hash")
+	blockHash, _ := cmd.Flags().GetString("block_hash")
+	height, _ := cmd.Flags().GetInt64("height")
+	if hash == "" && blockHash == "" && height < 0 {
+		fmt.Fprintln(os.Stderr, "one of hash, block_hash and height is required")
+		return
+	}
+	params := rpctypes.ReqDecodeReceipt{
+		TxHash:    hash,
+		BlockHash: blockHash,
+		Height:    height,
+	}
+	var res rpctypes.DecodedReceipts
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.DecodeTransactionReceipt", params, &res)
+	ctx.Run()
+}
+
 // GetAddrOverviewCmd get overview of an address
 func GetAddrOverviewCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -67,6 +67,7 @@ type TxResult struct {
 	Next       string              `json:"next,omitempty"`
 	Hash       string              `json:"hash,omitempty"`
 	ChainID    int32               `json:"chainID,"`
+	IsSeqNonce bool                `json:"isSeqNonce,omitempty"`
 }
 
 // ReceiptAccountTransfer defines receipt account transfer
@@ -48,6 +48,7 @@ func DecodeTransaction(tx *rpctypes.Transaction) *TxResult {
 		Next:       tx.Next,
 		Hash:       tx.Hash,
 		ChainID:    tx.ChainID,
+		IsSeqNonce: tx.IsSeqNonce,
 	}
 	return result
 }
@@ -5,7 +5,9 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"strconv"
 	"time"
@@ -39,6 +41,9 @@ func WalletCmd() *cobra.Command {
 		SetFeeCmd(),
 		SendTxCmd(),
 		SignRawTxWithCertCmd(),
+		WatchOnlyCmd(),
+		UnsignedTxCmd(),
+		SendSignedTxCmd(),
 	)
 
 	return cmd
@@ -482,3 +487,155 @@ func sendTx(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.SendTransaction", params, nil)
 	ctx.RunWithoutMarshal()
 }
+
+// WatchOnlyCmd watch-only account management
+func WatchOnlyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Watch-only account management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		ImportWatchOnlyCmd(),
+		WatchOnlyListCmd(),
+		DelWatchOnlyCmd(),
+	)
+	return cmd
+}
+
+// ImportWatchOnlyCmd import watch-only account by address or public key
+func ImportWatchOnlyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import watch-only account by address or public key",
+		Run:   importWatchOnly,
+	}
+	addImportWatchOnlyFlags(cmd)
+	return cmd
+}
+
+func addImportWatchOnlyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "account address")
+	cmd.Flags().StringP("pubkey", "p", "", "account public key hex")
+	cmd.Flags().StringP("label", "l", "", "account label")
+	cmd.MarkFlagRequired("label")
+}
+
+func importWatchOnly(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	pubkey, _ := cmd.Flags().GetString("pubkey")
+	label, _ := cmd.Flags().GetString("label")
+	params := &types.ReqWalletImportWatchOnly{
+		Addr:   addr,
+		PubKey: pubkey,
+		Label:  label,
+	}
+	execWallet(cmd, "WalletImportWatchOnly", params)
+}
+
+// WatchOnlyListCmd list watch-only accounts
+func WatchOnlyListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Get watch-only account list",
+		Run:   watchOnlyList,
+	}
+	return cmd
+}
+
+func watchOnlyList(cmd *cobra.Command, args []string) {
+	execWallet(cmd, "WalletWatchOnlyList", &types.ReqNil{})
+}
+
+// DelWatchOnlyCmd delete watch-only account
+func DelWatchOnlyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "del",
+		Short: "Delete watch-only account",
+		Run:   delWatchOnly,
+	}
+	cmd.Flags().StringP("addr", "a", "", "account address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func delWatchOnly(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	execWallet(cmd, "WalletDelWatchOnly", &types.ReqString{Data: addr})
+}
+
+// UnsignedTxCmd create unsigned transfer tx package for watch-only account
+func UnsignedTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unsigned_tx",
+		Short: "Create unsigned transfer transaction of watch-only account for offline signing",
+		Run:   unsignedTx,
+	}
+	addUnsignedTxFlags(cmd)
+	return cmd
+}
+
+func addUnsignedTxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("from", "f", "", "watch-only account address")
+	cmd.MarkFlagRequired("from")
+	cmd.Flags().StringP("to", "t", "", "receiver account address")
+	cmd.MarkFlagRequired("to")
+	cmd.Flags().Float64P("amount", "a", 0, "transaction amount")
+	cmd.MarkFlagRequired("amount")
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+	cmd.Flags().StringP("symbol", "s", "", "token symbol, transfer coins if not set")
+	cmd.Flags().StringP("expire", "e", "1h", "transaction expire time, leave time for offline signing")
+}
+
+func unsignedTx(cmd *cobra.Command, args []string) {
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	note, _ := cmd.Flags().GetString("note")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	expire, _ := cmd.Flags().GetString("expire")
+	params := &types.ReqWalletSendToAddress{
+		From:        from,
+		To:          to,
+		Amount:      int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4,
+		Note:        note,
+		IsToken:     symbol != "",
+		TokenSymbol: symbol,
+		Expire:      expire,
+	}
+	execWallet(cmd, "WalletCreateUnsignedTx", params)
+}
+
+// SendSignedTxCmd send offline signed tx of watch-only account
+func SendSignedTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send_signed",
+		Short: "Send offline signed transaction of watch-only account",
+		Run:   sendSignedTx,
+	}
+	cmd.Flags().StringP("data", "d", "", "signed transaction hex")
+	cmd.MarkFlagRequired("data")
+	return cmd
+}
+
+func sendSignedTx(cmd *cobra.Command, args []string) {
+	data, _ := cmd.Flags().GetString("data")
+	execWallet(cmd, "WalletSendSignedTx", &types.ReqWalletSendSignedTx{TxHex: data})
+}
+
+func execWallet(cmd *cobra.Command, funcName string, req types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	payload, err := types.PBToJSON(req)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	params := &rpctypes.ChainExecutor{
+		Driver:   "wallet",
+		FuncName: funcName,
+		Payload:  payload,
+	}
+	var res json.RawMessage
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.ExecWallet", params, &res)
+	ctx.Run()
+}
@@ -8,6 +8,7 @@ package commands
 import (
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"os"
 
 	"github.com/33cn/chain33/util"
@@ -30,6 +31,8 @@ func ConfigCmd() *cobra.Command {
 	cmd.AddCommand(
 		ConfigTxCmd(),
 		QueryConfigCmd(),
+		ListCertsCmd(),
+		CheckCertCmd(),
 	)
 
 	return cmd
@@ -114,3 +117,71 @@ func queryConfig(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
 	ctx.Run()
 }
+
+// ListCertsCmd 查询链上管理的证书
+func ListCertsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "certs",
+		Short: "List certificates and crls managed on chain",
+		Run:   listCerts,
+	}
+	return cmd
+}
+
+func listCerts(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, "manage")
+	params.FuncName = "ListCerts"
+	params.Payload = types.MustPBToJSON(&types.ReqNil{})
+
+	var res pty.ReplyCertList
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// CheckCertCmd 校验证书是否有效
+func CheckCertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check_cert",
+		Short: "Check certificate against the ca and crls on chain",
+		Run:   checkCert,
+	}
+	addCheckCertFlags(cmd)
+	return cmd
+}
+
+func addCheckCertFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("file", "f", "", "certificate file in pem format")
+	cmd.MarkFlagRequired("file")
+
+	cmd.Flags().StringP("sign", "s", "sm2", "sign type, sm2 or secp256r1")
+	cmd.Flags().Int64P("height", "t", 0, "block height, default the next block")
+}
+
+func checkCert(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	file, _ := cmd.Flags().GetString("file")
+	sign, _ := cmd.Flags().GetString("sign")
+	height, _ := cmd.Flags().GetInt64("height")
+	cert, err := ioutil.ReadFile(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	req := &pty.ReqCheckCert{
+		SignType: sign,
+		Cert:     string(cert),
+		Height:   height,
+	}
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, "manage")
+	params.FuncName = "CheckCert"
+	params.Payload = types.MustPBToJSON(req)
+
+	var res pty.ReplyCheckCert
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
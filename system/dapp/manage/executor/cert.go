@@ -0,0 +1,48 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/pem"
+
+	"github.com/33cn/chain33/common/address"
+	pty "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+)
+
+// checkConfigValue 分叉之后检查配置项的值, 共识成员必须是合法的地址, 证书以及吊销列表必须是pem格式
+// 分叉之前的区块不检查, 保证历史区块重新执行的结果不变
+func checkConfigValue(cfg *types.Chain33Config, height int64, modify *types.ModifyConfig) error {
+	if !cfg.IsDappFork(height, pty.ManageX, pty.ForkManageConfigCheck) {
+		return nil
+	}
+	if modify.Key == pty.CftMembersKey {
+		if err := address.CheckAddress(modify.Value); err != nil {
+			return pty.ErrBadConfigValue
+		}
+	}
+	if modify.Op == "add" {
+		return checkCertValue(modify.Key, modify.Value)
+	}
+	return nil
+}
+
+// checkCertValue 检查证书配置项的值是否为对应类型的pem数据
+func checkCertValue(key, value string) error {
+	var pemType string
+	switch key {
+	case pty.CertCAKey, pty.CertIntermediateKey:
+		pemType = "CERTIFICATE"
+	case pty.CertCRLKey:
+		pemType = "X509 CRL"
+	default:
+		return nil
+	}
+	block, _ := pem.Decode([]byte(value))
+	if block == nil || block.Type != pemType {
+		return pty.ErrBadConfigValue
+	}
+	return nil
+}
@@ -43,6 +43,9 @@ func (m *Action) modifyConfig(modify *types.ModifyConfig) (*types.Receipt, error
 	if modify.Op != "add" && modify.Op != "delete" {
 		return nil, pty.ErrBadConfigOp
 	}
+	if err := checkConfigValue(m.cfg, m.height, modify); err != nil {
+		return nil, err
+	}
 
 	var item types.ConfigItem
 	value, err := m.db.Get([]byte(types.ManageKey(modify.Key)))
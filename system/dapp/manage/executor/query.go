@@ -7,6 +7,9 @@ package executor
 import (
 	"fmt"
 
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/system/crypto/common/authority"
+	pty "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
 )
 
@@ -44,3 +47,39 @@ func (c *Manage) Query_GetConfigItem(in *types.ReqString) (types.Message, error)
 
 	return &reply, nil
 }
+
+// Query_ListCerts 查询链上管理的证书以及吊销列表
+func (c *Manage) Query_ListCerts(in *types.ReqNil) (types.Message, error) {
+	return pty.GetCertList(c.getStateValues)
+}
+
+// Query_CheckCert 校验证书在指定高度是否有效
+func (c *Manage) Query_CheckCert(in *pty.ReqCheckCert) (types.Message, error) {
+	auth := authority.GetAuthority(crypto.GetType(in.GetSignType()))
+	if auth == nil || !auth.IsInit {
+		return nil, types.ErrNotSupport
+	}
+	height := in.GetHeight()
+	if height <= 0 {
+		height = c.GetHeight() + 1
+	}
+	reply := &pty.ReplyCheckCert{Valid: true}
+	err := auth.ValidateCert([]byte(in.GetCert()), height)
+	if err != nil {
+		reply.Valid = false
+		reply.Reason = err.Error()
+	}
+	return reply, nil
+}
+
+func (c *Manage) getStateValues(keys [][]byte) ([][]byte, error) {
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		value, err := c.GetStateDB().Get(key)
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
@@ -1,9 +1,12 @@
 package executor
 
 import (
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	rpctypes "github.com/33cn/chain33/rpc/types"
+	mty "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	"github.com/33cn/chain33/util/testnode"
@@ -219,3 +222,47 @@ func TestModify(t *testing.T) {
 	_, err = manager.ExecLocal_Modify(nil, nil, receipt, 0)
 	assert.NoError(t, err)
 }
+
+func TestManageCerts(t *testing.T) {
+	ca, err := ioutil.ReadFile("../../../crypto/sm2/test/authdir/crypto/cacerts/ca-cert.pem")
+	assert.Nil(t, err)
+	assert.Nil(t, checkCertValue(mty.CertCAKey, string(ca)))
+	assert.Equal(t, mty.ErrBadConfigValue, checkCertValue(mty.CertCRLKey, string(ca)))
+	assert.Equal(t, mty.ErrBadConfigValue, checkCertValue(mty.CertCAKey, "BTY"))
+	assert.Nil(t, checkCertValue("token-blacklist", "BTY"))
+
+	//分叉之前不检查配置项的值, 历史区块重新执行的结果不变
+	str := strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1)
+	forkCfg := types.NewChain33Config(str)
+	forkCfg.SetDappFork(mty.ManageX, mty.ForkManageConfigCheck, 100)
+	modify := &types.ModifyConfig{Key: mty.CertCAKey, Op: "add", Value: "BTY"}
+	assert.Nil(t, checkConfigValue(forkCfg, 99, modify))
+	assert.Equal(t, mty.ErrBadConfigValue, checkConfigValue(forkCfg, 100, modify))
+	members := &types.ModifyConfig{Key: mty.CftMembersKey, Op: "add", Value: "BTY"}
+	assert.Nil(t, checkConfigValue(forkCfg, 99, members))
+	assert.Equal(t, mty.ErrBadConfigValue, checkConfigValue(forkCfg, 100, members))
+
+	cfg := testnode.GetDefaultConfig()
+	mocker := testnode.NewWithConfig(cfg, nil)
+	defer mocker.Close()
+	mocker.Listen()
+	err = mocker.SendHot()
+	assert.Nil(t, err)
+
+	tx := util.CreateManageTx(cfg, mocker.GetHotKey(), mty.CertCAKey, "add", string(ca))
+	hash := mocker.SendTx(tx)
+	txinfo, err := mocker.WaitTx(hash)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), txinfo.Receipt.Ty)
+
+	query := &rpctypes.Query4Jrpc{
+		Execer:   "manage",
+		FuncName: "ListCerts",
+		Payload:  types.MustPBToJSON(&types.ReqNil{}),
+	}
+	var reply mty.ReplyCertList
+	err = mocker.GetJSONC().Call("Chain33.Query", query, &reply)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{string(ca)}, reply.CaCerts)
+	assert.Equal(t, 0, len(reply.Crls))
+}
@@ -0,0 +1,56 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"github.com/33cn/chain33/system/crypto/common/authority/core"
+	"github.com/33cn/chain33/types"
+)
+
+var certKeys = []string{CertCAKey, CertIntermediateKey, CertCRLKey}
+
+// GetCertList 读取状态中的证书配置, get为状态数据库的读取接口
+func GetCertList(get func(keys [][]byte) ([][]byte, error)) (*ReplyCertList, error) {
+	list := &ReplyCertList{}
+	fields := []*[]string{&list.CaCerts, &list.IntermediateCerts, &list.Crls}
+	for i, key := range certKeys {
+		values, err := get([][]byte{[]byte(types.ManageKey(key)), []byte(types.ConfigKey(key))})
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			if value == nil {
+				continue
+			}
+			var item types.ConfigItem
+			err = types.Decode(value, &item)
+			if err != nil {
+				return nil, err
+			}
+			*fields[i] = item.GetArr().GetValue()
+			break
+		}
+	}
+	return list, nil
+}
+
+// ToAuthConfig 转换为证书校验器的配置, 没有配置CA证书以及吊销列表时返回nil
+func (list *ReplyCertList) ToAuthConfig() *core.AuthConfig {
+	if len(list.CaCerts) == 0 && len(list.Crls) == 0 {
+		return nil
+	}
+	toBytes := func(values []string) [][]byte {
+		items := make([][]byte, 0, len(values))
+		for _, value := range values {
+			items = append(items, []byte(value))
+		}
+		return items
+	}
+	return &core.AuthConfig{
+		RootCerts:         toBytes(list.CaCerts),
+		IntermediateCerts: toBytes(list.IntermediateCerts),
+		RevocationList:    toBytes(list.Crls),
+	}
+}
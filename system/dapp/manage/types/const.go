@@ -18,3 +18,17 @@ const (
 const (
 	ConfigItemArrayConfig = iota
 )
+
+// CftMembersKey cft共识成员配置项, value为成员的出块签名地址
+const CftMembersKey = "cft-members"
+
+// 链上证书管理配置项, value为pem格式的证书或者证书吊销列表
+// 配置了CA证书时替换节点本地的根证书以及中间证书, 吊销列表与本地的合并, 在配置交易的下一个区块开始生效
+const (
+	CertCAKey           = "cert-ca"
+	CertIntermediateKey = "cert-intermediate"
+	CertCRLKey          = "cert-crl"
+)
+
+// ForkManageConfigCheck 分叉之后检查共识成员地址以及证书配置项的pem格式, 之前的区块不检查配置项的值
+const ForkManageConfigCheck = "ForkManageConfigCheck"
@@ -95,8 +95,170 @@ func (*ManageAction) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// 链上管理的证书列表, 均为pem格式
+type ReplyCertList struct {
+	CaCerts              []string `protobuf:"bytes,1,rep,name=caCerts,proto3" json:"caCerts,omitempty"`
+	IntermediateCerts    []string `protobuf:"bytes,2,rep,name=intermediateCerts,proto3" json:"intermediateCerts,omitempty"`
+	Crls                 []string `protobuf:"bytes,3,rep,name=crls,proto3" json:"crls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyCertList) Reset()         { *m = ReplyCertList{} }
+func (m *ReplyCertList) String() string { return proto.CompactTextString(m) }
+func (*ReplyCertList) ProtoMessage()    {}
+func (*ReplyCertList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{1}
+}
+
+func (m *ReplyCertList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyCertList.Unmarshal(m, b)
+}
+func (m *ReplyCertList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyCertList.Marshal(b, m, deterministic)
+}
+func (m *ReplyCertList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyCertList.Merge(m, src)
+}
+func (m *ReplyCertList) XXX_Size() int {
+	return xxx_messageInfo_ReplyCertList.Size(m)
+}
+func (m *ReplyCertList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyCertList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyCertList proto.InternalMessageInfo
+
+func (m *ReplyCertList) GetCaCerts() []string {
+	if m != nil {
+		return m.CaCerts
+	}
+	return nil
+}
+
+func (m *ReplyCertList) GetIntermediateCerts() []string {
+	if m != nil {
+		return m.IntermediateCerts
+	}
+	return nil
+}
+
+func (m *ReplyCertList) GetCrls() []string {
+	if m != nil {
+		return m.Crls
+	}
+	return nil
+}
+
+// 校验证书在指定高度是否有效, height为0时使用最新状态
+type ReqCheckCert struct {
+	SignType             string   `protobuf:"bytes,1,opt,name=signType,proto3" json:"signType,omitempty"`
+	Cert                 string   `protobuf:"bytes,2,opt,name=cert,proto3" json:"cert,omitempty"`
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqCheckCert) Reset()         { *m = ReqCheckCert{} }
+func (m *ReqCheckCert) String() string { return proto.CompactTextString(m) }
+func (*ReqCheckCert) ProtoMessage()    {}
+func (*ReqCheckCert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{2}
+}
+
+func (m *ReqCheckCert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqCheckCert.Unmarshal(m, b)
+}
+func (m *ReqCheckCert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqCheckCert.Marshal(b, m, deterministic)
+}
+func (m *ReqCheckCert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqCheckCert.Merge(m, src)
+}
+func (m *ReqCheckCert) XXX_Size() int {
+	return xxx_messageInfo_ReqCheckCert.Size(m)
+}
+func (m *ReqCheckCert) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqCheckCert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqCheckCert proto.InternalMessageInfo
+
+func (m *ReqCheckCert) GetSignType() string {
+	if m != nil {
+		return m.SignType
+	}
+	return ""
+}
+
+func (m *ReqCheckCert) GetCert() string {
+	if m != nil {
+		return m.Cert
+	}
+	return ""
+}
+
+func (m *ReqCheckCert) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ReplyCheckCert struct {
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyCheckCert) Reset()         { *m = ReplyCheckCert{} }
+func (m *ReplyCheckCert) String() string { return proto.CompactTextString(m) }
+func (*ReplyCheckCert) ProtoMessage()    {}
+func (*ReplyCheckCert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{3}
+}
+
+func (m *ReplyCheckCert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyCheckCert.Unmarshal(m, b)
+}
+func (m *ReplyCheckCert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyCheckCert.Marshal(b, m, deterministic)
+}
+func (m *ReplyCheckCert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyCheckCert.Merge(m, src)
+}
+func (m *ReplyCheckCert) XXX_Size() int {
+	return xxx_messageInfo_ReplyCheckCert.Size(m)
+}
+func (m *ReplyCheckCert) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyCheckCert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyCheckCert proto.InternalMessageInfo
+
+func (m *ReplyCheckCert) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ReplyCheckCert) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ManageAction)(nil), "types.ManageAction")
+	proto.RegisterType((*ReplyCertList)(nil), "types.ReplyCertList")
+	proto.RegisterType((*ReqCheckCert)(nil), "types.ReqCheckCert")
+	proto.RegisterType((*ReplyCheckCert)(nil), "types.ReplyCheckCert")
 }
 
 func init() {
@@ -104,14 +266,23 @@ func init() {
 }
 
 var fileDescriptor_519fa8ed5ffbbc8f = []byte{
-	// 136 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xc9, 0x4d, 0xcc, 0x4b,
-	0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x96,
-	0xe2, 0x4b, 0xad, 0x48, 0x4d, 0x2e, 0x2d, 0xc9, 0x2f, 0x82, 0x08, 0x2b, 0x85, 0x71, 0xf1, 0xf8,
-	0x82, 0x95, 0x39, 0x26, 0x97, 0x64, 0xe6, 0xe7, 0x09, 0xe9, 0x72, 0xb1, 0xe5, 0xe6, 0xa7, 0x64,
-	0xa6, 0x55, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x09, 0xeb, 0x81, 0xf5, 0xe9, 0xf9, 0x82,
-	0x05, 0x9d, 0xf3, 0xf3, 0xd2, 0x32, 0xd3, 0x3d, 0x18, 0x82, 0xa0, 0x8a, 0x84, 0xf8, 0xb8, 0x98,
-	0x42, 0x2a, 0x25, 0x98, 0x14, 0x18, 0x35, 0x58, 0x83, 0x98, 0x42, 0x2a, 0x9d, 0xd8, 0xb9, 0x58,
-	0xcb, 0x12, 0x73, 0x4a, 0x53, 0x93, 0xd8, 0xc0, 0xc6, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xf1, 0x53, 0xa6, 0x25, 0x85, 0x00, 0x00, 0x00,
+	// 278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xbf, 0x4f, 0xf3, 0x30,
+	0x10, 0x86, 0xbf, 0x24, 0x5f, 0x7f, 0x1d, 0xa5, 0x12, 0x06, 0x21, 0xab, 0x53, 0xd4, 0x29, 0x03,
+	0x74, 0x80, 0x1d, 0x09, 0xba, 0x30, 0xd0, 0xc5, 0xaa, 0xd8, 0x4d, 0x7a, 0x4d, 0xac, 0x26, 0x76,
+	0xb0, 0x5d, 0x84, 0xff, 0x7b, 0xe4, 0x4b, 0xe8, 0xc2, 0xe6, 0xe7, 0xfc, 0xbc, 0x27, 0xbf, 0x86,
+	0x79, 0x2b, 0xb5, 0xac, 0x70, 0xdd, 0x59, 0xe3, 0x0d, 0x1b, 0xf9, 0xd0, 0xa1, 0x5b, 0x2e, 0xf0,
+	0x1b, 0xcb, 0x93, 0x37, 0xb6, 0x1f, 0xaf, 0xde, 0x61, 0xbe, 0x25, 0xed, 0xb9, 0xf4, 0xca, 0x68,
+	0x76, 0x0f, 0xe3, 0xd6, 0xec, 0xd5, 0x21, 0xf0, 0x24, 0x4f, 0x8a, 0x8b, 0x87, 0xeb, 0x35, 0xe5,
+	0xd6, 0x5b, 0x1a, 0x6e, 0x8c, 0x3e, 0xa8, 0xea, 0xf5, 0x9f, 0x18, 0x24, 0xb6, 0x80, 0x74, 0x17,
+	0x78, 0x9a, 0x27, 0xc5, 0x48, 0xa4, 0xbb, 0xf0, 0x32, 0x81, 0xd1, 0x97, 0x6c, 0x4e, 0xb8, 0x3a,
+	0xc2, 0xa5, 0xc0, 0xae, 0x09, 0x1b, 0xb4, 0xfe, 0x4d, 0x39, 0xcf, 0x38, 0x4c, 0x4a, 0x19, 0xc9,
+	0xf1, 0x24, 0xcf, 0x8a, 0x99, 0xf8, 0x45, 0x76, 0x07, 0x57, 0x4a, 0x7b, 0xb4, 0x2d, 0xee, 0x95,
+	0xf4, 0xd8, 0x3b, 0x29, 0x39, 0x7f, 0x2f, 0x18, 0x83, 0xff, 0xa5, 0x6d, 0x1c, 0xcf, 0x48, 0xa0,
+	0x73, 0x2c, 0x21, 0xf0, 0x73, 0x53, 0x63, 0x79, 0x8c, 0x12, 0x5b, 0xc2, 0xd4, 0xa9, 0x4a, 0xef,
+	0x42, 0x87, 0x54, 0x63, 0x26, 0xce, 0x4c, 0x79, 0xb4, 0x9e, 0xde, 0x1c, 0xf3, 0xd1, 0xbf, 0x85,
+	0x71, 0x8d, 0xaa, 0xaa, 0x3d, 0xcf, 0xf2, 0xa4, 0xc8, 0xc4, 0x40, 0xab, 0x27, 0x58, 0xf4, 0x25,
+	0xce, 0x9b, 0x6f, 0xa8, 0x9f, 0xda, 0xd3, 0xda, 0xa9, 0xe8, 0x21, 0xe6, 0x2d, 0x4a, 0x67, 0xf4,
+	0xb0, 0x75, 0xa0, 0x8f, 0x31, 0xfd, 0xf1, 0xe3, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x13, 0x8a,
+	0xf1, 0xeb, 0x8a, 0x01, 0x00, 0x00,
 }
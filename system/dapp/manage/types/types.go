@@ -34,6 +34,7 @@ func init() {
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(ManageX, "Enable", 120000)
 	cfg.RegisterDappFork(ManageX, "ForkManageExec", 400000)
+	cfg.RegisterDappFork(ManageX, ForkManageConfigCheck, types.MaxHeight)
 }
 
 //InitExecutor init Executor
@@ -0,0 +1,379 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands 多重签名账户命令
+package commands
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// MultiSigCmd multisig command
+func MultiSigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sysmultisig",
+		Short: "Multisig account management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		accountCmd(),
+		ownerCmd(),
+		txCmd(),
+		ownerAccountsCmd(),
+		walletAccountsCmd(),
+	)
+
+	return cmd
+}
+
+func toAmount(amount float64) int64 {
+	return int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4
+}
+
+func createTx(cmd *cobra.Command, actionName string, payload types.Message) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	params := &rpctypes.CreateTxIn{
+		Execer:     util.GetParaExecName(paraName, mty.MultiSigX),
+		ActionName: actionName,
+		Payload:    types.MustPBToJSON(payload),
+	}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+func query(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, mty.MultiSigX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+func addDailyLimitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("execer", "e", "", "asset execer, default coins")
+	cmd.Flags().StringP("symbol", "s", "", "asset symbol, default coin symbol")
+	cmd.Flags().Float64P("daily_limit", "d", -1, "daily limit of the asset, negative means not set")
+}
+
+func getDailyLimit(cmd *cobra.Command) *mty.MultiSigDailyLimit {
+	limit, _ := cmd.Flags().GetFloat64("daily_limit")
+	if limit < 0 {
+		return nil
+	}
+	execer, _ := cmd.Flags().GetString("execer")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	return &mty.MultiSigDailyLimit{Execer: execer, Symbol: symbol, DailyLimit: toAmount(limit)}
+}
+
+func accountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account",
+		Short: "Create, modify and query multisig account",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		accountCreateCmd(),
+		accountOperateCmd(),
+		accountInfoCmd(),
+	)
+	return cmd
+}
+
+func accountCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a multisig account",
+		Run:   accountCreate,
+	}
+	cmd.Flags().StringP("owners", "o", "", "owner addresses, separated by ','")
+	cmd.MarkFlagRequired("owners")
+	cmd.Flags().StringP("weights", "w", "", "owner weights, separated by ','")
+	cmd.MarkFlagRequired("weights")
+	cmd.Flags().Uint64P("required_weight", "r", 0, "required weight to execute a tx")
+	cmd.MarkFlagRequired("required_weight")
+	addDailyLimitFlags(cmd)
+	return cmd
+}
+
+func accountCreate(cmd *cobra.Command, args []string) {
+	owners, _ := cmd.Flags().GetString("owners")
+	weights, _ := cmd.Flags().GetString("weights")
+	required, _ := cmd.Flags().GetUint64("required_weight")
+
+	addrs := strings.Split(owners, ",")
+	ws := strings.Split(weights, ",")
+	if len(addrs) != len(ws) {
+		fmt.Fprintln(os.Stderr, "the number of owners and weights not match")
+		return
+	}
+	create := &mty.MultiSigAccountCreate{RequiredWeight: required, DailyLimit: getDailyLimit(cmd)}
+	for i, addr := range addrs {
+		weight, err := strconv.ParseUint(strings.TrimSpace(ws[i]), 10, 64)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		create.Owners = append(create.Owners, &mty.MultiSigOwner{Addr: strings.TrimSpace(addr), Weight: weight})
+	}
+	createTx(cmd, "AccountCreate", create)
+}
+
+func accountOperateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operate",
+		Short: "Submit required weight or daily limit modification",
+		Run:   accountOperate,
+	}
+	cmd.Flags().StringP("addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Uint64P("required_weight", "r", 0, "new required weight, 0 means not modify")
+	addDailyLimitFlags(cmd)
+	return cmd
+}
+
+func accountOperate(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	required, _ := cmd.Flags().GetUint64("required_weight")
+	createTx(cmd, "AccountOperate", &mty.MultiSigAccountOperate{
+		AccountAddr:       addr,
+		NewRequiredWeight: required,
+		DailyLimit:        getDailyLimit(cmd),
+	})
+}
+
+func accountInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Query multisig account",
+		Run:   accountInfo,
+	}
+	cmd.Flags().StringP("addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func accountInfo(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	var res mty.MultiSigAccount
+	query(cmd, "GetAccount", &types.ReqString{Data: addr}, &res)
+}
+
+func ownerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner",
+		Short: "Submit owner modification of multisig account",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		ownerOperateCmd("add", "Add an owner", mty.OwnerAdd),
+		ownerOperateCmd("del", "Delete an owner", mty.OwnerDel),
+		ownerOperateCmd("modify", "Modify the weight of an owner", mty.OwnerModify),
+		ownerOperateCmd("replace", "Replace an owner with a new address", mty.OwnerReplace),
+	)
+	return cmd
+}
+
+func ownerOperateCmd(use, short string, operate int32) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Run: func(cmd *cobra.Command, args []string) {
+			addr, _ := cmd.Flags().GetString("addr")
+			oldOwner, _ := cmd.Flags().GetString("old_owner")
+			newOwner, _ := cmd.Flags().GetString("new_owner")
+			weight, _ := cmd.Flags().GetUint64("weight")
+			createTx(cmd, "OwnerOperate", &mty.MultiSigOwnerOperate{
+				AccountAddr: addr,
+				Operate:     operate,
+				OldOwner:    oldOwner,
+				NewOwner:    newOwner,
+				NewWeight:   weight,
+			})
+		},
+	}
+	cmd.Flags().StringP("addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("addr")
+	if operate != mty.OwnerAdd {
+		cmd.Flags().StringP("old_owner", "o", "", "owner address to be operated")
+		cmd.MarkFlagRequired("old_owner")
+	}
+	if operate == mty.OwnerAdd || operate == mty.OwnerReplace {
+		cmd.Flags().StringP("new_owner", "n", "", "new owner address")
+		cmd.MarkFlagRequired("new_owner")
+	}
+	if operate != mty.OwnerDel {
+		cmd.Flags().Uint64P("weight", "w", 0, "owner weight")
+	}
+	return cmd
+}
+
+func txCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx",
+		Short: "Transfer, confirm and query multisig account txs",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		transferCmd("transfer_in", "Transfer asset from exec balance into multisig account", "TransferIn"),
+		transferCmd("transfer_out", "Submit asset transfer out of multisig account", "TransferOut"),
+		confirmCmd(),
+		txInfoCmd(),
+		txListCmd(),
+	)
+	return cmd
+}
+
+func transferCmd(use, short, actionName string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Run: func(cmd *cobra.Command, args []string) {
+			addr, _ := cmd.Flags().GetString("addr")
+			execer, _ := cmd.Flags().GetString("execer")
+			symbol, _ := cmd.Flags().GetString("symbol")
+			amount, _ := cmd.Flags().GetFloat64("amount")
+			note, _ := cmd.Flags().GetString("note")
+			if actionName == "TransferIn" {
+				createTx(cmd, actionName, &mty.MultiSigTransferIn{
+					AccountAddr: addr, Execer: execer, Symbol: symbol, Amount: toAmount(amount), Note: note,
+				})
+				return
+			}
+			to, _ := cmd.Flags().GetString("to")
+			createTx(cmd, actionName, &mty.MultiSigTransferOut{
+				AccountAddr: addr, Execer: execer, Symbol: symbol, Amount: toAmount(amount), To: to, Note: note,
+			})
+		},
+	}
+	cmd.Flags().StringP("addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("execer", "e", "", "asset execer, default coins")
+	cmd.Flags().StringP("symbol", "s", "", "asset symbol, default coin symbol")
+	cmd.Flags().Float64P("amount", "m", 0, "transaction amount")
+	cmd.MarkFlagRequired("amount")
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+	if actionName == "TransferOut" {
+		cmd.Flags().StringP("to", "t", "", "receiver address")
+		cmd.MarkFlagRequired("to")
+	}
+	return cmd
+}
+
+func confirmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm",
+		Short: "Confirm or revoke confirmation of a multisig account tx",
+		Run:   confirmTx,
+	}
+	cmd.Flags().StringP("addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Uint64P("txid", "i", 0, "multisig account tx id")
+	cmd.MarkFlagRequired("txid")
+	cmd.Flags().BoolP("revoke", "r", false, "revoke the confirmation")
+	return cmd
+}
+
+func confirmTx(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	txID, _ := cmd.Flags().GetUint64("txid")
+	revoke, _ := cmd.Flags().GetBool("revoke")
+	createTx(cmd, "ConfirmTx", &mty.MultiSigConfirmTx{AccountAddr: addr, TxID: txID, Confirm: !revoke})
+}
+
+func txInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Query a multisig account tx",
+		Run:   txInfo,
+	}
+	cmd.Flags().StringP("addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Uint64P("txid", "i", 0, "multisig account tx id")
+	cmd.MarkFlagRequired("txid")
+	return cmd
+}
+
+func txInfo(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	txID, _ := cmd.Flags().GetUint64("txid")
+	var res mty.MultiSigTx
+	query(cmd, "GetTx", &mty.ReqMultiSigTx{AccountAddr: addr, TxID: txID}, &res)
+}
+
+func txListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List multisig account txs from the newest",
+		Run:   txList,
+	}
+	cmd.Flags().StringP("addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Uint64P("from", "f", 0, "start tx id, 0 means the newest")
+	cmd.Flags().Int32P("count", "c", 10, "tx count")
+	cmd.Flags().BoolP("pending", "p", false, "list pending txs only")
+	return cmd
+}
+
+func txList(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	from, _ := cmd.Flags().GetUint64("from")
+	count, _ := cmd.Flags().GetInt32("count")
+	pending, _ := cmd.Flags().GetBool("pending")
+	var res mty.ReplyMultiSigTxList
+	query(cmd, "ListTxs", &mty.ReqMultiSigTxList{AccountAddr: addr, FromID: from, Count: count, PendingOnly: pending}, &res)
+}
+
+func ownerAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner_accounts",
+		Short: "List multisig accounts owned by an address",
+		Run:   ownerAccounts,
+	}
+	cmd.Flags().StringP("owner", "o", "", "owner address")
+	cmd.MarkFlagRequired("owner")
+	return cmd
+}
+
+func ownerAccounts(cmd *cobra.Command, args []string) {
+	owner, _ := cmd.Flags().GetString("owner")
+	var res mty.ReplyMultiSigAccounts
+	query(cmd, "GetOwnerAccounts", &types.ReqString{Data: owner}, &res)
+}
+
+func walletAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wallet_accounts",
+		Short: "List multisig accounts the wallet participates in",
+		Run:   walletAccounts,
+	}
+	return cmd
+}
+
+func walletAccounts(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	params := &rpctypes.ChainExecutor{
+		Driver:   "wallet",
+		FuncName: "WalletMultiSigAccounts",
+		Payload:  types.MustPBToJSON(&types.ReqNil{}),
+	}
+	var res mty.ReplyMultiSigAccounts
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.ExecWallet", params, &res)
+	ctx.Run()
+}
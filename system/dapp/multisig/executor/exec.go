@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_AccountCreate 创建多重签名账户
+func (m *MultiSig) Exec_AccountCreate(payload *mty.MultiSigAccountCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	return newAction(m, tx).accountCreate(payload)
+}
+
+// Exec_OwnerOperate 提交所有者变更
+func (m *MultiSig) Exec_OwnerOperate(payload *mty.MultiSigOwnerOperate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	return newAction(m, tx).ownerOperate(payload)
+}
+
+// Exec_AccountOperate 提交权重阈值或者每日限额变更
+func (m *MultiSig) Exec_AccountOperate(payload *mty.MultiSigAccountOperate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	return newAction(m, tx).accountOperate(payload)
+}
+
+// Exec_ConfirmTx 确认或者撤销确认交易
+func (m *MultiSig) Exec_ConfirmTx(payload *mty.MultiSigConfirmTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	return newAction(m, tx).confirmTx(payload)
+}
+
+// Exec_TransferIn 转入多重签名账户
+func (m *MultiSig) Exec_TransferIn(payload *mty.MultiSigTransferIn, tx *types.Transaction, index int) (*types.Receipt, error) {
+	return newAction(m, tx).transferIn(payload)
+}
+
+// Exec_TransferOut 提交多重签名账户转出
+func (m *MultiSig) Exec_TransferOut(payload *mty.MultiSigTransferOut, tx *types.Transaction, index int) (*types.Receipt, error) {
+	return newAction(m, tx).transferOut(payload)
+}
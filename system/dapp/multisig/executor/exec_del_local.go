@@ -0,0 +1,25 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+// ExecDelLocal_AccountCreate 回滚所有者的账户索引
+func (m *MultiSig) ExecDelLocal_AccountCreate(payload *mty.MultiSigAccountCreate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return ownerIndex(receipt, true), nil
+}
+
+// ExecDelLocal_OwnerOperate 回滚所有者变更的索引
+func (m *MultiSig) ExecDelLocal_OwnerOperate(payload *mty.MultiSigOwnerOperate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return ownerIndex(receipt, true), nil
+}
+
+// ExecDelLocal_ConfirmTx 回滚确认触发的所有者变更索引
+func (m *MultiSig) ExecDelLocal_ConfirmTx(payload *mty.MultiSigConfirmTx, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return ownerIndex(receipt, true), nil
+}
@@ -0,0 +1,74 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+func ownerKey(owner, accountAddr string) []byte {
+	return []byte(fmt.Sprintf("LODB-%s-owner-%s-%s", driverName, owner, accountAddr))
+}
+
+func ownerPrefix(owner string) []byte {
+	return []byte(fmt.Sprintf("LODB-%s-owner-%s-", driverName, owner))
+}
+
+func ownerAddrs(acc *mty.MultiSigAccount) map[string]bool {
+	addrs := make(map[string]bool)
+	for _, owner := range acc.GetOwners() {
+		addrs[owner.Addr] = true
+	}
+	return addrs
+}
+
+// ownerIndex 根据账户变更日志维护所有者到多重签名账户的索引, rollback时反向处理
+func ownerIndex(receipt *types.ReceiptData, rollback bool) *types.LocalDBSet {
+	set := &types.LocalDBSet{}
+	for _, item := range receipt.Logs {
+		if item.Ty != mty.TyLogMultiSigAccount {
+			continue
+		}
+		var log mty.ReceiptMultiSigAccount
+		err := types.Decode(item.Log, &log)
+		if err != nil {
+			panic(err) //数据错误了，已经被修改了
+		}
+		accAddr := log.Current.Addr
+		prev, current := ownerAddrs(log.Prev), ownerAddrs(log.Current)
+		if rollback {
+			prev, current = current, prev
+		}
+		for addr := range prev {
+			if !current[addr] {
+				set.KV = append(set.KV, &types.KeyValue{Key: ownerKey(addr, accAddr), Value: nil})
+			}
+		}
+		for addr := range current {
+			if !prev[addr] {
+				set.KV = append(set.KV, &types.KeyValue{Key: ownerKey(addr, accAddr), Value: []byte(accAddr)})
+			}
+		}
+	}
+	return set
+}
+
+// ExecLocal_AccountCreate 记录所有者的账户索引
+func (m *MultiSig) ExecLocal_AccountCreate(payload *mty.MultiSigAccountCreate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return ownerIndex(receipt, false), nil
+}
+
+// ExecLocal_OwnerOperate 所有者变更执行后更新索引
+func (m *MultiSig) ExecLocal_OwnerOperate(payload *mty.MultiSigOwnerOperate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return ownerIndex(receipt, false), nil
+}
+
+// ExecLocal_ConfirmTx 确认触发所有者变更执行后更新索引
+func (m *MultiSig) ExecLocal_ConfirmTx(payload *mty.MultiSigConfirmTx, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return ownerIndex(receipt, false), nil
+}
@@ -0,0 +1,57 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor 多重签名账户合约执行器
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	mlog       = log.New("module", "execs.multisig")
+	driverName = mty.MultiSigX
+)
+
+// Init register driver
+func Init(name string, cfg *types.Chain33Config, sub []byte) {
+	drivers.Register(cfg, GetName(), newMultiSig, cfg.GetDappFork(driverName, "Enable"))
+	InitExecType()
+}
+
+// InitExecType initials multisig functions.
+func InitExecType() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&MultiSig{}))
+}
+
+// GetName return multisig name
+func GetName() string {
+	return newMultiSig().GetName()
+}
+
+// MultiSig defines multisig executor
+type MultiSig struct {
+	drivers.DriverBase
+}
+
+func newMultiSig() drivers.Driver {
+	m := &MultiSig{}
+	m.SetChild(m)
+	m.SetExecutorType(types.LoadExecutorType(driverName))
+	return m
+}
+
+// GetDriverName return driver name
+func (m *MultiSig) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx check transaction
+func (m *MultiSig) CheckTx(tx *types.Transaction, index int) error {
+	return nil
+}
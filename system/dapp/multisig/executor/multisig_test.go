@@ -0,0 +1,6 @@
+package executor_test
+
+//加载系统内置的dapp, rpc_test 依赖
+import (
+	_ "github.com/33cn/chain33/system"
+)
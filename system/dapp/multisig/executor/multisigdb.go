@@ -0,0 +1,470 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+func accountKey(addr string) []byte {
+	return []byte(fmt.Sprintf("mavl-%s-account-%s", driverName, addr))
+}
+
+func txKey(addr string, txID uint64) []byte {
+	return []byte(fmt.Sprintf("mavl-%s-tx-%s-%020d", driverName, addr, txID))
+}
+
+type action struct {
+	db        dbm.KV
+	fromaddr  string
+	txhash    []byte
+	blocktime int64
+	execaddr  string
+	cfg       *types.Chain33Config
+}
+
+func newAction(m *MultiSig, tx *types.Transaction) *action {
+	types.AssertConfig(m.GetAPI())
+	return &action{
+		db:        m.GetStateDB(),
+		fromaddr:  tx.From(),
+		txhash:    tx.Hash(),
+		blocktime: m.GetBlockTime(),
+		execaddr:  dapp.ExecAddress(string(tx.Execer)),
+		cfg:       m.GetAPI().GetConfig(),
+	}
+}
+
+func getAccount(db dbm.KV, addr string) (*mty.MultiSigAccount, error) {
+	value, err := db.Get(accountKey(addr))
+	if err != nil || value == nil {
+		return nil, mty.ErrAccountNotExist
+	}
+	var acc mty.MultiSigAccount
+	err = types.Decode(value, &acc)
+	if err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+func getTx(db dbm.KV, addr string, txID uint64) (*mty.MultiSigTx, error) {
+	value, err := db.Get(txKey(addr, txID))
+	if err != nil || value == nil {
+		return nil, mty.ErrTxNotExist
+	}
+	var tx mty.MultiSigTx
+	err = types.Decode(value, &tx)
+	if err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+func (a *action) accountReceipt(prev, current *mty.MultiSigAccount) *types.Receipt {
+	value := types.Encode(current)
+	a.db.Set(accountKey(current.Addr), value)
+	log := &mty.ReceiptMultiSigAccount{Prev: prev, Current: current}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{{Key: accountKey(current.Addr), Value: value}},
+		Logs: []*types.ReceiptLog{{Ty: mty.TyLogMultiSigAccount, Log: types.Encode(log)}},
+	}
+}
+
+func (a *action) txReceipt(prev, current *mty.MultiSigTx) *types.Receipt {
+	value := types.Encode(current)
+	a.db.Set(txKey(current.AccountAddr, current.TxID), value)
+	log := &mty.ReceiptMultiSigTx{Prev: prev, Current: current}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{{Key: txKey(current.AccountAddr, current.TxID), Value: value}},
+		Logs: []*types.ReceiptLog{{Ty: mty.TyLogMultiSigTx, Log: types.Encode(log)}},
+	}
+}
+
+func mergeReceipt(receipt *types.Receipt, other *types.Receipt) *types.Receipt {
+	if other == nil {
+		return receipt
+	}
+	receipt.KV = append(receipt.KV, other.KV...)
+	receipt.Logs = append(receipt.Logs, other.Logs...)
+	return receipt
+}
+
+// normalizeAsset 资产执行器默认为coins, coins的资产符号默认为配置中的符号
+func (a *action) normalizeAsset(execer, symbol string) (string, string, error) {
+	if execer == "" {
+		execer = "coins"
+	}
+	if symbol == "" && execer == "coins" {
+		symbol = a.cfg.GetCoinSymbol()
+	}
+	if symbol == "" {
+		return "", "", mty.ErrInvalidSymbol
+	}
+	return execer, symbol, nil
+}
+
+func (a *action) accountDB(execer, symbol string) (*account.DB, error) {
+	accDB, err := account.NewAccountDB(a.cfg, execer, symbol, a.db)
+	if err != nil {
+		return nil, mty.ErrInvalidSymbol
+	}
+	return accDB, nil
+}
+
+func (a *action) checkOwners(owners []*mty.MultiSigOwner) error {
+	if len(owners) < mty.MinOwnerCount || len(owners) > mty.MaxOwnerCount {
+		return mty.ErrOwnerCount
+	}
+	exist := make(map[string]bool)
+	for _, owner := range owners {
+		if err := address.CheckAddress(owner.Addr); err != nil {
+			return types.ErrInvalidAddress
+		}
+		if owner.Weight == 0 {
+			return mty.ErrInvalidWeight
+		}
+		if exist[owner.Addr] {
+			return mty.ErrOwnerExist
+		}
+		exist[owner.Addr] = true
+	}
+	return nil
+}
+
+// setDailyLimit 设置资产的每日限额, 已经存在的限额保留当天的已用额度
+func (a *action) setDailyLimit(acc *mty.MultiSigAccount, limit *mty.MultiSigDailyLimit) error {
+	if limit.DailyLimit < 0 {
+		return mty.ErrInvalidDailyLimit
+	}
+	execer, symbol, err := a.normalizeAsset(limit.Execer, limit.Symbol)
+	if err != nil {
+		return err
+	}
+	if _, err = a.accountDB(execer, symbol); err != nil {
+		return err
+	}
+	if old := acc.GetDailyLimit(execer, symbol); old != nil {
+		old.DailyLimit = limit.DailyLimit
+		return nil
+	}
+	acc.DailyLimits = append(acc.DailyLimits, &mty.MultiSigDailyLimit{Execer: execer, Symbol: symbol, DailyLimit: limit.DailyLimit})
+	return nil
+}
+
+func (a *action) accountCreate(create *mty.MultiSigAccountCreate) (*types.Receipt, error) {
+	if err := a.checkOwners(create.Owners); err != nil {
+		return nil, err
+	}
+	acc := &mty.MultiSigAccount{
+		CreateAddr:     a.fromaddr,
+		Addr:           address.MultiSignAddress(a.txhash),
+		Owners:         create.Owners,
+		RequiredWeight: create.RequiredWeight,
+	}
+	if acc.RequiredWeight == 0 || acc.RequiredWeight > acc.TotalWeight() {
+		return nil, mty.ErrRequiredWeight
+	}
+	if create.DailyLimit != nil {
+		if err := a.setDailyLimit(acc, create.DailyLimit); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := getAccount(a.db, acc.Addr); err == nil {
+		return nil, mty.ErrAccountExist
+	}
+	return a.accountReceipt(nil, acc), nil
+}
+
+func (a *action) transferIn(in *mty.MultiSigTransferIn) (*types.Receipt, error) {
+	if _, err := getAccount(a.db, in.AccountAddr); err != nil {
+		return nil, err
+	}
+	execer, symbol, err := a.normalizeAsset(in.Execer, in.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	accDB, err := a.accountDB(execer, symbol)
+	if err != nil {
+		return nil, err
+	}
+	return accDB.ExecTransfer(a.fromaddr, in.AccountAddr, a.execaddr, in.Amount)
+}
+
+func (a *action) ownerOperate(op *mty.MultiSigOwnerOperate) (*types.Receipt, error) {
+	tx := &mty.MultiSigTx{Ty: mty.MultiSigActionOwnerOperate, OwnerOperate: op}
+	return a.submitTx(op.AccountAddr, tx)
+}
+
+func (a *action) accountOperate(op *mty.MultiSigAccountOperate) (*types.Receipt, error) {
+	if op.NewRequiredWeight == 0 && op.DailyLimit == nil {
+		return nil, mty.ErrInvalidOperate
+	}
+	tx := &mty.MultiSigTx{Ty: mty.MultiSigActionAccountOperate, AccountOperate: op}
+	return a.submitTx(op.AccountAddr, tx)
+}
+
+func (a *action) transferOut(out *mty.MultiSigTransferOut) (*types.Receipt, error) {
+	if err := address.CheckAddress(out.To); err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+	if !types.CheckAmount(out.Amount) {
+		return nil, types.ErrAmount
+	}
+	execer, symbol, err := a.normalizeAsset(out.Execer, out.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	out.Execer, out.Symbol = execer, symbol
+	tx := &mty.MultiSigTx{Ty: mty.MultiSigActionTransferOut, TransferOut: out}
+	return a.submitTx(out.AccountAddr, tx)
+}
+
+// submitTx 所有者提交交易, 提交者自动确认, 达到权重阈值或者在每日限额内时立即执行
+func (a *action) submitTx(addr string, tx *mty.MultiSigTx) (*types.Receipt, error) {
+	acc, err := getAccount(a.db, addr)
+	if err != nil {
+		return nil, err
+	}
+	owner := acc.GetOwner(a.fromaddr)
+	if owner == nil {
+		return nil, mty.ErrNotOwner
+	}
+	//提交时先在账户的副本上检查操作是否合法
+	if _, err = a.applyTx(types.Clone(acc).(*mty.MultiSigAccount), tx, false, true); err != nil {
+		return nil, err
+	}
+
+	prevAcc := types.Clone(acc).(*mty.MultiSigAccount)
+	tx.AccountAddr = acc.Addr
+	tx.TxID = acc.TxCount
+	tx.TxHash = common.ToHex(a.txhash)
+	tx.ConfirmedOwners = []*mty.MultiSigOwner{{Addr: owner.Addr, Weight: owner.Weight}}
+	acc.TxCount++
+
+	execReceipt, err := a.executeIfReady(acc, tx)
+	if err != nil {
+		return nil, err
+	}
+	receipt := a.accountReceipt(prevAcc, acc)
+	mergeReceipt(receipt, a.txReceipt(nil, tx))
+	return mergeReceipt(receipt, execReceipt), nil
+}
+
+func (a *action) confirmTx(confirm *mty.MultiSigConfirmTx) (*types.Receipt, error) {
+	acc, err := getAccount(a.db, confirm.AccountAddr)
+	if err != nil {
+		return nil, err
+	}
+	owner := acc.GetOwner(a.fromaddr)
+	if owner == nil {
+		return nil, mty.ErrNotOwner
+	}
+	tx, err := getTx(a.db, confirm.AccountAddr, confirm.TxID)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Executed {
+		return nil, mty.ErrTxExecuted
+	}
+	prevTx := types.Clone(tx).(*mty.MultiSigTx)
+	confirmed := -1
+	for i, o := range tx.ConfirmedOwners {
+		if o.Addr == owner.Addr {
+			confirmed = i
+			break
+		}
+	}
+	if !confirm.Confirm {
+		if confirmed < 0 {
+			return nil, mty.ErrNotConfirmed
+		}
+		tx.ConfirmedOwners = append(tx.ConfirmedOwners[:confirmed], tx.ConfirmedOwners[confirmed+1:]...)
+		return a.txReceipt(prevTx, tx), nil
+	}
+	if confirmed >= 0 {
+		return nil, mty.ErrAlreadyConfirmed
+	}
+	tx.ConfirmedOwners = append(tx.ConfirmedOwners, &mty.MultiSigOwner{Addr: owner.Addr, Weight: owner.Weight})
+
+	prevAcc := types.Clone(acc).(*mty.MultiSigAccount)
+	execReceipt, err := a.executeIfReady(acc, tx)
+	if err != nil {
+		return nil, err
+	}
+	receipt := a.txReceipt(prevTx, tx)
+	if execReceipt == nil {
+		return receipt, nil
+	}
+	mergeReceipt(receipt, a.accountReceipt(prevAcc, acc))
+	return mergeReceipt(receipt, execReceipt), nil
+}
+
+// confirmedWeight 按照所有者当前的权重计算确认的总权重, 已经被删除的所有者的确认不再计算
+func confirmedWeight(acc *mty.MultiSigAccount, tx *mty.MultiSigTx) uint64 {
+	var weight uint64
+	for _, o := range tx.ConfirmedOwners {
+		if owner := acc.GetOwner(o.Addr); owner != nil {
+			weight += owner.Weight
+		}
+	}
+	return weight
+}
+
+// withinDailyLimit 转出金额是否在当天的剩余限额内
+func (a *action) withinDailyLimit(acc *mty.MultiSigAccount, out *mty.MultiSigTransferOut) bool {
+	limit := acc.GetDailyLimit(out.Execer, out.Symbol)
+	if limit == nil {
+		return false
+	}
+	spent := limit.SpentToday
+	if limit.LastDay != a.blocktime/mty.SecondsPerDay {
+		spent = 0
+	}
+	return spent+out.Amount <= limit.DailyLimit
+}
+
+// executeIfReady 交易达到执行条件时执行, 返回执行产生的回执, 未执行时返回nil
+func (a *action) executeIfReady(acc *mty.MultiSigAccount, tx *mty.MultiSigTx) (*types.Receipt, error) {
+	useLimit := tx.Ty == mty.MultiSigActionTransferOut && a.withinDailyLimit(acc, tx.TransferOut)
+	if !useLimit && confirmedWeight(acc, tx) < acc.RequiredWeight {
+		return nil, nil
+	}
+	receipt, err := a.applyTx(acc, tx, useLimit, false)
+	if err != nil {
+		return nil, err
+	}
+	tx.Executed = true
+	if receipt == nil {
+		receipt = &types.Receipt{Ty: types.ExecOk}
+	}
+	return receipt, nil
+}
+
+// applyTx 在账户上执行交易, 转出交易返回资产转账的回执, checkOnly时转出交易只检查余额
+func (a *action) applyTx(acc *mty.MultiSigAccount, tx *mty.MultiSigTx, useLimit, checkOnly bool) (*types.Receipt, error) {
+	switch tx.Ty {
+	case mty.MultiSigActionOwnerOperate:
+		return nil, a.applyOwnerOperate(acc, tx.OwnerOperate)
+	case mty.MultiSigActionAccountOperate:
+		return nil, a.applyAccountOperate(acc, tx.AccountOperate)
+	case mty.MultiSigActionTransferOut:
+		out := tx.TransferOut
+		accDB, err := a.accountDB(out.Execer, out.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		if useLimit {
+			limit := acc.GetDailyLimit(out.Execer, out.Symbol)
+			day := a.blocktime / mty.SecondsPerDay
+			if limit.LastDay != day {
+				limit.LastDay = day
+				limit.SpentToday = 0
+			}
+			limit.SpentToday += out.Amount
+		}
+		if checkOnly {
+			if accDB.LoadExecAccount(acc.Addr, a.execaddr).GetBalance() < out.Amount {
+				return nil, types.ErrNoBalance
+			}
+			return nil, nil
+		}
+		return accDB.ExecTransfer(acc.Addr, out.To, a.execaddr, out.Amount)
+	}
+	return nil, mty.ErrInvalidOperate
+}
+
+func (a *action) applyOwnerOperate(acc *mty.MultiSigAccount, op *mty.MultiSigOwnerOperate) error {
+	if op == nil {
+		return mty.ErrInvalidOperate
+	}
+	switch op.Operate {
+	case mty.OwnerAdd:
+		if err := address.CheckAddress(op.NewOwner); err != nil {
+			return types.ErrInvalidAddress
+		}
+		if acc.GetOwner(op.NewOwner) != nil {
+			return mty.ErrOwnerExist
+		}
+		if op.NewWeight == 0 {
+			return mty.ErrInvalidWeight
+		}
+		if len(acc.Owners) >= mty.MaxOwnerCount {
+			return mty.ErrOwnerCount
+		}
+		acc.Owners = append(acc.Owners, &mty.MultiSigOwner{Addr: op.NewOwner, Weight: op.NewWeight})
+	case mty.OwnerDel:
+		if len(acc.Owners) <= mty.MinOwnerCount {
+			return mty.ErrOwnerCount
+		}
+		owners := make([]*mty.MultiSigOwner, 0, len(acc.Owners))
+		for _, owner := range acc.Owners {
+			if owner.Addr != op.OldOwner {
+				owners = append(owners, owner)
+			}
+		}
+		if len(owners) == len(acc.Owners) {
+			return mty.ErrOwnerNotExist
+		}
+		acc.Owners = owners
+	case mty.OwnerModify:
+		owner := acc.GetOwner(op.OldOwner)
+		if owner == nil {
+			return mty.ErrOwnerNotExist
+		}
+		if op.NewWeight == 0 {
+			return mty.ErrInvalidWeight
+		}
+		owner.Weight = op.NewWeight
+	case mty.OwnerReplace:
+		owner := acc.GetOwner(op.OldOwner)
+		if owner == nil {
+			return mty.ErrOwnerNotExist
+		}
+		if err := address.CheckAddress(op.NewOwner); err != nil {
+			return types.ErrInvalidAddress
+		}
+		if acc.GetOwner(op.NewOwner) != nil {
+			return mty.ErrOwnerExist
+		}
+		owner.Addr = op.NewOwner
+		if op.NewWeight > 0 {
+			owner.Weight = op.NewWeight
+		}
+	default:
+		return mty.ErrInvalidOperate
+	}
+	//所有者变更后剩余的总权重必须能够达到阈值
+	if acc.TotalWeight() < acc.RequiredWeight {
+		return mty.ErrRequiredWeight
+	}
+	return nil
+}
+
+func (a *action) applyAccountOperate(acc *mty.MultiSigAccount, op *mty.MultiSigAccountOperate) error {
+	if op == nil {
+		return mty.ErrInvalidOperate
+	}
+	if op.NewRequiredWeight > 0 {
+		if op.NewRequiredWeight > acc.TotalWeight() {
+			return mty.ErrRequiredWeight
+		}
+		acc.RequiredWeight = op.NewRequiredWeight
+	}
+	if op.DailyLimit != nil {
+		return a.setDailyLimit(acc, op.DailyLimit)
+	}
+	return nil
+}
@@ -0,0 +1,294 @@
+package executor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	t         *testing.T
+	cfg       *types.Chain33Config
+	db        dbm.KV
+	blocktime int64
+	nonce     int
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	db, err := dbm.NewGoMemDB("multisig", "", 0)
+	require.Nil(t, err)
+	return &testEnv{
+		t:         t,
+		cfg:       types.NewChain33Config(types.GetDefaultCfgstring()),
+		db:        db,
+		blocktime: 10 * mty.SecondsPerDay,
+	}
+}
+
+// action 每次构造不同的交易哈希, 保证创建的多重签名账户地址不同
+func (env *testEnv) action(from string) *action {
+	env.nonce++
+	return &action{
+		db:        env.db,
+		fromaddr:  from,
+		txhash:    common.Sha256([]byte(fmt.Sprintf("%s-%d", from, env.nonce))),
+		blocktime: env.blocktime,
+		execaddr:  dapp.ExecAddress(mty.MultiSigX),
+		cfg:       env.cfg,
+	}
+}
+
+func (env *testEnv) coins() *account.DB {
+	accDB, err := account.NewAccountDB(env.cfg, "coins", env.cfg.GetCoinSymbol(), env.db)
+	require.Nil(env.t, err)
+	return accDB
+}
+
+func (env *testEnv) balance(addr string) int64 {
+	return env.coins().LoadExecAccount(addr, dapp.ExecAddress(mty.MultiSigX)).Balance
+}
+
+func (env *testEnv) createAccount(from string, create *mty.MultiSigAccountCreate, balance int64) *mty.MultiSigAccount {
+	receipt, err := env.action(from).accountCreate(create)
+	require.Nil(env.t, err)
+	var log mty.ReceiptMultiSigAccount
+	require.Nil(env.t, types.Decode(receipt.Logs[0].Log, &log))
+	env.coins().SaveExecAccount(dapp.ExecAddress(mty.MultiSigX), &types.Account{Addr: log.Current.Addr, Balance: balance})
+	return log.Current
+}
+
+func (env *testEnv) getTx(addr string, txID uint64) *mty.MultiSigTx {
+	tx, err := getTx(env.db, addr, txID)
+	require.Nil(env.t, err)
+	return tx
+}
+
+func genOwners(n int, weights ...uint64) []*mty.MultiSigOwner {
+	owners := make([]*mty.MultiSigOwner, n)
+	for i := range owners {
+		addr, _ := util.Genaddress()
+		owners[i] = &mty.MultiSigOwner{Addr: addr, Weight: 1}
+		if i < len(weights) {
+			owners[i].Weight = weights[i]
+		}
+	}
+	return owners
+}
+
+func TestAccountCreateCheck(t *testing.T) {
+	env := newTestEnv(t)
+	creator, _ := util.Genaddress()
+	owners := genOwners(3, 1, 1, 2)
+	create := func(owners []*mty.MultiSigOwner, required uint64) error {
+		_, err := env.action(creator).accountCreate(&mty.MultiSigAccountCreate{Owners: owners, RequiredWeight: required})
+		return err
+	}
+	assert.Nil(t, create(owners, 4))
+	assert.Equal(t, mty.ErrRequiredWeight, create(owners, 0))
+	assert.Equal(t, mty.ErrRequiredWeight, create(owners, 5))
+	assert.Equal(t, mty.ErrOwnerCount, create(nil, 1))
+	assert.Equal(t, mty.ErrOwnerCount, create(genOwners(mty.MaxOwnerCount+1), 1))
+	assert.Equal(t, mty.ErrOwnerExist, create(append(owners, owners[0]), 1))
+	assert.Equal(t, mty.ErrInvalidWeight, create(genOwners(2, 1, 0), 1))
+	assert.Equal(t, types.ErrInvalidAddress, create([]*mty.MultiSigOwner{{Addr: "bad", Weight: 1}}, 1))
+
+	limit := &mty.MultiSigDailyLimit{DailyLimit: -1}
+	_, err := env.action(creator).accountCreate(&mty.MultiSigAccountCreate{Owners: owners, RequiredWeight: 1, DailyLimit: limit})
+	assert.Equal(t, mty.ErrInvalidDailyLimit, err)
+}
+
+func TestTransferOutThreshold(t *testing.T) {
+	env := newTestEnv(t)
+	owners := genOwners(3, 1, 1, 2)
+	acc := env.createAccount(owners[0].Addr, &mty.MultiSigAccountCreate{Owners: owners, RequiredWeight: 3}, 10*types.Coin)
+	to, _ := util.Genaddress()
+	out := &mty.MultiSigTransferOut{AccountAddr: acc.Addr, To: to, Amount: types.Coin}
+
+	outsider, _ := util.Genaddress()
+	_, err := env.action(outsider).transferOut(out)
+	assert.Equal(t, mty.ErrNotOwner, err)
+	_, err = env.action(owners[0].Addr).transferOut(&mty.MultiSigTransferOut{AccountAddr: acc.Addr, To: to, Amount: 11 * types.Coin})
+	assert.Equal(t, types.ErrNoBalance, err)
+
+	//提交者自动确认, 权重1没有达到阈值3
+	_, err = env.action(owners[0].Addr).transferOut(out)
+	require.Nil(t, err)
+	assert.False(t, env.getTx(acc.Addr, 0).Executed)
+	confirm := func(owner string, ok bool) error {
+		_, err := env.action(owner).confirmTx(&mty.MultiSigConfirmTx{AccountAddr: acc.Addr, TxID: 0, Confirm: ok})
+		return err
+	}
+	assert.Equal(t, mty.ErrAlreadyConfirmed, confirm(owners[0].Addr, true))
+	assert.Equal(t, mty.ErrNotConfirmed, confirm(owners[1].Addr, false))
+	assert.Equal(t, mty.ErrNotOwner, confirm(outsider, true))
+	_, err = env.action(owners[0].Addr).confirmTx(&mty.MultiSigConfirmTx{AccountAddr: acc.Addr, TxID: 1, Confirm: true})
+	assert.Equal(t, mty.ErrTxNotExist, err)
+
+	//确认之后撤销, 权重回到1
+	require.Nil(t, confirm(owners[1].Addr, true))
+	require.Nil(t, confirm(owners[1].Addr, false))
+	assert.Equal(t, 1, len(env.getTx(acc.Addr, 0).ConfirmedOwners))
+	require.Nil(t, confirm(owners[1].Addr, true))
+	assert.False(t, env.getTx(acc.Addr, 0).Executed)
+	assert.Equal(t, int64(0), env.balance(to))
+
+	//权重达到3后执行, 执行之后不能再确认
+	require.Nil(t, confirm(owners[2].Addr, true))
+	assert.True(t, env.getTx(acc.Addr, 0).Executed)
+	assert.Equal(t, types.Coin, env.balance(to))
+	assert.Equal(t, 9*types.Coin, env.balance(acc.Addr))
+	assert.Equal(t, mty.ErrTxExecuted, confirm(owners[2].Addr, false))
+}
+
+func TestTransferOutDailyLimit(t *testing.T) {
+	env := newTestEnv(t)
+	owners := genOwners(2)
+	limit := &mty.MultiSigDailyLimit{DailyLimit: types.Coin}
+	acc := env.createAccount(owners[0].Addr, &mty.MultiSigAccountCreate{Owners: owners, RequiredWeight: 2, DailyLimit: limit}, 10*types.Coin)
+	assert.Equal(t, "coins", acc.DailyLimits[0].Execer)
+	assert.Equal(t, env.cfg.GetCoinSymbol(), acc.DailyLimits[0].Symbol)
+	to, _ := util.Genaddress()
+	out := &mty.MultiSigTransferOut{AccountAddr: acc.Addr, To: to, Amount: types.Coin * 6 / 10}
+
+	//限额内只需要一个所有者即可执行
+	_, err := env.action(owners[0].Addr).transferOut(out)
+	require.Nil(t, err)
+	assert.True(t, env.getTx(acc.Addr, 0).Executed)
+	assert.Equal(t, out.Amount, env.balance(to))
+
+	//当天剩余额度不足, 需要达到权重阈值
+	_, err = env.action(owners[0].Addr).transferOut(out)
+	require.Nil(t, err)
+	assert.False(t, env.getTx(acc.Addr, 1).Executed)
+	_, err = env.action(owners[1].Addr).confirmTx(&mty.MultiSigConfirmTx{AccountAddr: acc.Addr, TxID: 1, Confirm: true})
+	require.Nil(t, err)
+	assert.True(t, env.getTx(acc.Addr, 1).Executed)
+
+	//第二天额度重新计算
+	env.blocktime += mty.SecondsPerDay
+	_, err = env.action(owners[1].Addr).transferOut(out)
+	require.Nil(t, err)
+	assert.True(t, env.getTx(acc.Addr, 2).Executed)
+	assert.Equal(t, 3*out.Amount, env.balance(to))
+	stored, err := getAccount(env.db, acc.Addr)
+	require.Nil(t, err)
+	assert.Equal(t, out.Amount, stored.DailyLimits[0].SpentToday)
+	assert.Equal(t, env.blocktime/mty.SecondsPerDay, stored.DailyLimits[0].LastDay)
+
+	//其他资产没有设置限额
+	other, err := account.NewAccountDB(env.cfg, "coins", "OTHER", env.db)
+	require.Nil(t, err)
+	other.SaveExecAccount(dapp.ExecAddress(mty.MultiSigX), &types.Account{Addr: acc.Addr, Balance: types.Coin})
+	_, err = env.action(owners[0].Addr).transferOut(&mty.MultiSigTransferOut{AccountAddr: acc.Addr, To: to, Amount: 1, Execer: "coins", Symbol: "OTHER"})
+	require.Nil(t, err)
+	assert.False(t, env.getTx(acc.Addr, 3).Executed)
+}
+
+func TestOwnerOperate(t *testing.T) {
+	env := newTestEnv(t)
+	owners := genOwners(3, 1, 1, 2)
+	newOwner, _ := util.Genaddress()
+	apply := func(op *mty.MultiSigOwnerOperate) (*mty.MultiSigAccount, error) {
+		acc := &mty.MultiSigAccount{RequiredWeight: 3}
+		for _, o := range owners {
+			acc.Owners = append(acc.Owners, &mty.MultiSigOwner{Addr: o.Addr, Weight: o.Weight})
+		}
+		return acc, env.action(owners[0].Addr).applyOwnerOperate(acc, op)
+	}
+
+	acc, err := apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerAdd, NewOwner: newOwner, NewWeight: 1})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(5), acc.TotalWeight())
+	_, err = apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerAdd, NewOwner: owners[1].Addr, NewWeight: 1})
+	assert.Equal(t, mty.ErrOwnerExist, err)
+	_, err = apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerAdd, NewOwner: newOwner})
+	assert.Equal(t, mty.ErrInvalidWeight, err)
+	full := &mty.MultiSigAccount{Owners: genOwners(mty.MaxOwnerCount), RequiredWeight: 1}
+	err = env.action(owners[0].Addr).applyOwnerOperate(full, &mty.MultiSigOwnerOperate{Operate: mty.OwnerAdd, NewOwner: newOwner, NewWeight: 1})
+	assert.Equal(t, mty.ErrOwnerCount, err)
+
+	//删除之后剩余权重不足阈值
+	acc, err = apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerDel, OldOwner: owners[0].Addr})
+	require.Nil(t, err)
+	assert.Nil(t, acc.GetOwner(owners[0].Addr))
+	_, err = apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerDel, OldOwner: owners[2].Addr})
+	assert.Equal(t, mty.ErrRequiredWeight, err)
+	_, err = apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerDel, OldOwner: newOwner})
+	assert.Equal(t, mty.ErrOwnerNotExist, err)
+	single := &mty.MultiSigAccount{Owners: genOwners(1), RequiredWeight: 1}
+	err = env.action(owners[0].Addr).applyOwnerOperate(single, &mty.MultiSigOwnerOperate{Operate: mty.OwnerDel, OldOwner: single.Owners[0].Addr})
+	assert.Equal(t, mty.ErrOwnerCount, err)
+
+	//修改权重
+	_, err = apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerModify, OldOwner: owners[2].Addr})
+	assert.Equal(t, mty.ErrInvalidWeight, err)
+	acc, err = apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerModify, OldOwner: owners[2].Addr, NewWeight: 1})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(3), acc.TotalWeight())
+	_, err = apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerModify, OldOwner: newOwner, NewWeight: 1})
+	assert.Equal(t, mty.ErrOwnerNotExist, err)
+
+	//替换所有者, 不指定权重时保留原来的权重
+	acc, err = apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerReplace, OldOwner: owners[2].Addr, NewOwner: newOwner})
+	require.Nil(t, err)
+	assert.Nil(t, acc.GetOwner(owners[2].Addr))
+	assert.Equal(t, uint64(2), acc.GetOwner(newOwner).Weight)
+	_, err = apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerReplace, OldOwner: owners[2].Addr, NewOwner: owners[1].Addr})
+	assert.Equal(t, mty.ErrOwnerExist, err)
+	_, err = apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerReplace, OldOwner: newOwner, NewOwner: owners[1].Addr})
+	assert.Equal(t, mty.ErrOwnerNotExist, err)
+	_, err = apply(&mty.MultiSigOwnerOperate{Operate: mty.OwnerReplace, OldOwner: owners[2].Addr, NewOwner: "bad"})
+	assert.Equal(t, types.ErrInvalidAddress, err)
+	_, err = apply(&mty.MultiSigOwnerOperate{Operate: 0})
+	assert.Equal(t, mty.ErrInvalidOperate, err)
+}
+
+func TestOwnerOperateByThreshold(t *testing.T) {
+	env := newTestEnv(t)
+	owners := genOwners(3, 1, 1, 2)
+	acc := env.createAccount(owners[0].Addr, &mty.MultiSigAccountCreate{Owners: owners, RequiredWeight: 2}, 10*types.Coin)
+	to, _ := util.Genaddress()
+
+	//非法的所有者变更在提交时直接拒绝
+	_, err := env.action(owners[0].Addr).ownerOperate(&mty.MultiSigOwnerOperate{AccountAddr: acc.Addr, Operate: mty.OwnerDel, OldOwner: to})
+	assert.Equal(t, mty.ErrOwnerNotExist, err)
+
+	//owners[1]提交的转出还没有达到阈值
+	_, err = env.action(owners[1].Addr).transferOut(&mty.MultiSigTransferOut{AccountAddr: acc.Addr, To: to, Amount: types.Coin})
+	require.Nil(t, err)
+	//owners[2]权重达到阈值, 删除owners[1]之后立即执行
+	_, err = env.action(owners[2].Addr).ownerOperate(&mty.MultiSigOwnerOperate{AccountAddr: acc.Addr, Operate: mty.OwnerDel, OldOwner: owners[1].Addr})
+	require.Nil(t, err)
+	assert.True(t, env.getTx(acc.Addr, 1).Executed)
+	stored, err := getAccount(env.db, acc.Addr)
+	require.Nil(t, err)
+	assert.Nil(t, stored.GetOwner(owners[1].Addr))
+
+	//被删除的所有者的确认不再计算权重
+	_, err = env.action(owners[0].Addr).confirmTx(&mty.MultiSigConfirmTx{AccountAddr: acc.Addr, TxID: 0, Confirm: true})
+	require.Nil(t, err)
+	assert.False(t, env.getTx(acc.Addr, 0).Executed)
+	assert.Equal(t, int64(0), env.balance(to))
+	_, err = env.action(owners[1].Addr).confirmTx(&mty.MultiSigConfirmTx{AccountAddr: acc.Addr, TxID: 0, Confirm: true})
+	assert.Equal(t, mty.ErrNotOwner, err)
+
+	//修改阈值
+	_, err = env.action(owners[2].Addr).accountOperate(&mty.MultiSigAccountOperate{AccountAddr: acc.Addr})
+	assert.Equal(t, mty.ErrInvalidOperate, err)
+	_, err = env.action(owners[2].Addr).accountOperate(&mty.MultiSigAccountOperate{AccountAddr: acc.Addr, NewRequiredWeight: 4})
+	assert.Equal(t, mty.ErrRequiredWeight, err)
+	_, err = env.action(owners[2].Addr).accountOperate(&mty.MultiSigAccountOperate{AccountAddr: acc.Addr, NewRequiredWeight: 3})
+	require.Nil(t, err)
+	stored, err = getAccount(env.db, acc.Addr)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(3), stored.RequiredWeight)
+}
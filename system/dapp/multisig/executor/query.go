@@ -0,0 +1,67 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetAccount 查询多重签名账户
+func (m *MultiSig) Query_GetAccount(in *types.ReqString) (types.Message, error) {
+	return getAccount(m.GetStateDB(), in.GetData())
+}
+
+// Query_GetTx 查询多重签名账户的交易
+func (m *MultiSig) Query_GetTx(in *mty.ReqMultiSigTx) (types.Message, error) {
+	return getTx(m.GetStateDB(), in.GetAccountAddr(), in.GetTxID())
+}
+
+// Query_ListTxs 从fromID开始按照id递减的顺序列出交易, fromID为0时从最新的交易开始
+func (m *MultiSig) Query_ListTxs(in *mty.ReqMultiSigTxList) (types.Message, error) {
+	acc, err := getAccount(m.GetStateDB(), in.GetAccountAddr())
+	if err != nil {
+		return nil, err
+	}
+	count := in.GetCount()
+	if count <= 0 || count > mty.MaxTxListCount {
+		count = mty.MaxTxListCount
+	}
+	reply := &mty.ReplyMultiSigTxList{}
+	if acc.TxCount == 0 {
+		return reply, nil
+	}
+	next := acc.TxCount - 1
+	if in.GetFromID() > 0 && in.GetFromID() < next {
+		next = in.GetFromID()
+	}
+	for {
+		tx, err := getTx(m.GetStateDB(), acc.Addr, next)
+		if err != nil {
+			return nil, err
+		}
+		if !in.GetPendingOnly() || !tx.Executed {
+			reply.Txs = append(reply.Txs, tx)
+		}
+		if int32(len(reply.Txs)) >= count || next == 0 {
+			break
+		}
+		next--
+	}
+	return reply, nil
+}
+
+// Query_GetOwnerAccounts 查询地址作为所有者的多重签名账户
+func (m *MultiSig) Query_GetOwnerAccounts(in *types.ReqString) (types.Message, error) {
+	values, err := m.GetLocalDB().List(ownerPrefix(in.GetData()), nil, 0, 0)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &mty.ReplyMultiSigAccounts{}
+	for _, value := range values {
+		reply.Addrs = append(reply.Addrs, string(value))
+	}
+	return reply, nil
+}
@@ -0,0 +1,141 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sendMultiSigTx(t *testing.T, mocker *testnode.Chain33Mock, priv crypto.PrivKey, execer, action string, payload types.Message) *rpctypes.TransactionDetail {
+	req := &rpctypes.CreateTxIn{
+		Execer:     execer,
+		ActionName: action,
+		Payload:    types.MustPBToJSON(payload),
+	}
+	var txhex string
+	err := mocker.GetJSONC().Call("Chain33.CreateTransaction", req, &txhex)
+	require.Nil(t, err)
+	hash, err := mocker.SendAndSign(priv, txhex)
+	require.Nil(t, err)
+	txinfo, err := mocker.WaitTx(hash)
+	require.Nil(t, err)
+	return txinfo
+}
+
+func TestMultiSigAccount(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	mocker := testnode.NewWithConfig(cfg, nil)
+	defer mocker.Close()
+	mocker.Listen()
+	require.Nil(t, mocker.SendHot())
+
+	hotKey, genesisKey := mocker.GetHotKey(), mocker.GetGenesisKey()
+	hotAddr, genesisAddr := mocker.GetHotAddress(), mocker.GetGenesisAddress()
+	thirdAddr, _ := util.Genaddress()
+	toAddr, _ := util.Genaddress()
+
+	//2/3的多重签名账户, 每日限额1个币
+	create := &mty.MultiSigAccountCreate{
+		Owners: []*mty.MultiSigOwner{
+			{Addr: hotAddr, Weight: 1},
+			{Addr: genesisAddr, Weight: 1},
+			{Addr: thirdAddr, Weight: 1},
+		},
+		RequiredWeight: 2,
+		DailyLimit:     &mty.MultiSigDailyLimit{DailyLimit: types.Coin},
+	}
+	txinfo := sendMultiSigTx(t, mocker, hotKey, mty.MultiSigX, "AccountCreate", create)
+	require.Equal(t, int32(types.ExecOk), txinfo.Receipt.Ty)
+	hash, err := common.FromHex(txinfo.Tx.Hash)
+	require.Nil(t, err)
+	accAddr := address.MultiSignAddress(hash)
+
+	msg, err := mocker.GetAPI().Query(mty.MultiSigX, "GetOwnerAccounts", &types.ReqString{Data: thirdAddr})
+	require.Nil(t, err)
+	assert.Equal(t, []string{accAddr}, msg.(*mty.ReplyMultiSigAccounts).Addrs)
+
+	//钱包返回参与的多重签名账户详情
+	msg, err = mocker.GetAPI().ExecWalletFunc("wallet", "WalletMultiSigAccounts", &types.ReqNil{})
+	require.Nil(t, err)
+	reply := msg.(*mty.ReplyMultiSigAccounts)
+	assert.Equal(t, []string{accAddr}, reply.Addrs)
+	require.Equal(t, 1, len(reply.Accounts))
+	assert.Equal(t, uint64(2), reply.Accounts[0].RequiredWeight)
+
+	//账户不能超过阈值
+	create.RequiredWeight = 4
+	txinfo = sendMultiSigTx(t, mocker, hotKey, mty.MultiSigX, "AccountCreate", create)
+	assert.Equal(t, int32(types.ExecPack), txinfo.Receipt.Ty)
+
+	//先转入合约, 再转入多重签名账户
+	transferToExec := &rpctypes.CreateTx{
+		To:       address.ExecAddress(mty.MultiSigX),
+		Amount:   10 * types.Coin,
+		ExecName: mty.MultiSigX,
+	}
+	var txhex string
+	err = mocker.GetJSONC().Call("Chain33.CreateRawTransaction", transferToExec, &txhex)
+	require.Nil(t, err)
+	hash, err = mocker.SendAndSign(hotKey, txhex)
+	require.Nil(t, err)
+	txinfo, err = mocker.WaitTx(hash)
+	require.Nil(t, err)
+	require.Equal(t, int32(types.ExecOk), txinfo.Receipt.Ty)
+	txinfo = sendMultiSigTx(t, mocker, hotKey, mty.MultiSigX, "TransferIn", &mty.MultiSigTransferIn{AccountAddr: accAddr, Amount: 10 * types.Coin})
+	require.Equal(t, int32(types.ExecOk), txinfo.Receipt.Ty)
+	stateHash := mocker.GetLastBlock().StateHash
+	assert.Equal(t, 10*types.Coin, mocker.GetExecAccount(stateHash, mty.MultiSigX, accAddr).Balance)
+
+	//每日限额内单个所有者直接执行
+	out := &mty.MultiSigTransferOut{AccountAddr: accAddr, Amount: types.Coin / 2, To: toAddr}
+	txinfo = sendMultiSigTx(t, mocker, hotKey, mty.MultiSigX, "TransferOut", out)
+	require.Equal(t, int32(types.ExecOk), txinfo.Receipt.Ty)
+	stateHash = mocker.GetLastBlock().StateHash
+	assert.Equal(t, types.Coin/2, mocker.GetExecAccount(stateHash, mty.MultiSigX, toAddr).Balance)
+
+	//超过每日限额需要其他所有者确认
+	out.Amount = 5 * types.Coin
+	txinfo = sendMultiSigTx(t, mocker, hotKey, mty.MultiSigX, "TransferOut", out)
+	require.Equal(t, int32(types.ExecOk), txinfo.Receipt.Ty)
+	msg, err = mocker.GetAPI().Query(mty.MultiSigX, "GetTx", &mty.ReqMultiSigTx{AccountAddr: accAddr, TxID: 1})
+	require.Nil(t, err)
+	assert.False(t, msg.(*mty.MultiSigTx).Executed)
+
+	//提交者已经确认, 不能重复确认
+	txinfo = sendMultiSigTx(t, mocker, hotKey, mty.MultiSigX, "ConfirmTx", &mty.MultiSigConfirmTx{AccountAddr: accAddr, TxID: 1, Confirm: true})
+	assert.Equal(t, int32(types.ExecPack), txinfo.Receipt.Ty)
+	txinfo = sendMultiSigTx(t, mocker, genesisKey, mty.MultiSigX, "ConfirmTx", &mty.MultiSigConfirmTx{AccountAddr: accAddr, TxID: 1, Confirm: true})
+	require.Equal(t, int32(types.ExecOk), txinfo.Receipt.Ty)
+	stateHash = mocker.GetLastBlock().StateHash
+	assert.Equal(t, 5*types.Coin+types.Coin/2, mocker.GetExecAccount(stateHash, mty.MultiSigX, toAddr).Balance)
+	assert.Equal(t, 4*types.Coin+types.Coin/2, mocker.GetExecAccount(stateHash, mty.MultiSigX, accAddr).Balance)
+
+	//删除所有者
+	del := &mty.MultiSigOwnerOperate{AccountAddr: accAddr, Operate: mty.OwnerDel, OldOwner: thirdAddr}
+	txinfo = sendMultiSigTx(t, mocker, hotKey, mty.MultiSigX, "OwnerOperate", del)
+	require.Equal(t, int32(types.ExecOk), txinfo.Receipt.Ty)
+	txinfo = sendMultiSigTx(t, mocker, genesisKey, mty.MultiSigX, "ConfirmTx", &mty.MultiSigConfirmTx{AccountAddr: accAddr, TxID: 2, Confirm: true})
+	require.Equal(t, int32(types.ExecOk), txinfo.Receipt.Ty)
+	msg, err = mocker.GetAPI().Query(mty.MultiSigX, "GetAccount", &types.ReqString{Data: accAddr})
+	require.Nil(t, err)
+	assert.Equal(t, 2, len(msg.(*mty.MultiSigAccount).Owners))
+	msg, err = mocker.GetAPI().Query(mty.MultiSigX, "GetOwnerAccounts", &types.ReqString{Data: thirdAddr})
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(msg.(*mty.ReplyMultiSigAccounts).Addrs))
+
+	msg, err = mocker.GetAPI().Query(mty.MultiSigX, "ListTxs", &mty.ReqMultiSigTxList{AccountAddr: accAddr})
+	require.Nil(t, err)
+	txs := msg.(*mty.ReplyMultiSigTxList).Txs
+	require.Equal(t, 3, len(txs))
+	assert.Equal(t, uint64(2), txs[0].TxID)
+	assert.True(t, txs[0].Executed)
+}
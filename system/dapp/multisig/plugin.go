@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package multisig 多重签名账户插件
+// 1. 创建由多个所有者按照权重共同管理的账户
+// 2. 所有者变更, 阈值以及每日限额变更需要达到权重阈值后执行
+// 3. 每日限额内的转出可以由单个所有者直接执行
+package multisig
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/multisig/commands"
+	"github.com/33cn/chain33/system/dapp/multisig/executor"
+	"github.com/33cn/chain33/system/dapp/multisig/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.MultiSigX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.MultiSigCmd,
+		RPC:      nil,
+	})
+}
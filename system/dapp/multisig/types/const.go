@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// 多重签名合约的交易类型
+const (
+	MultiSigActionAccountCreate = iota + 1
+	MultiSigActionOwnerOperate
+	MultiSigActionAccountOperate
+	MultiSigActionConfirmTx
+	MultiSigActionTransferIn
+	MultiSigActionTransferOut
+)
+
+// 多重签名合约的日志类型
+const (
+	TyLogMultiSigAccount = 420
+	TyLogMultiSigTx      = 421
+)
+
+// 所有者的操作类型
+const (
+	OwnerAdd = iota + 1
+	OwnerDel
+	OwnerModify
+	OwnerReplace
+)
+
+const (
+	// MaxOwnerCount 多重签名账户最多的所有者数量
+	MaxOwnerCount = 20
+	// MinOwnerCount 多重签名账户最少的所有者数量
+	MinOwnerCount = 1
+	// SecondsPerDay 每日限额按照区块时间计算的周期
+	SecondsPerDay = 24 * 3600
+	// MaxTxListCount 交易列表单次查询的最大数量
+	MaxTxListCount = 100
+)
@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrOwnerCount 所有者数量超出范围
+	ErrOwnerCount = errors.New("ErrOwnerCount")
+	// ErrOwnerExist 所有者已经存在
+	ErrOwnerExist = errors.New("ErrOwnerExist")
+	// ErrOwnerNotExist 所有者不存在
+	ErrOwnerNotExist = errors.New("ErrOwnerNotExist")
+	// ErrNotOwner 交易发起者不是多重签名账户的所有者
+	ErrNotOwner = errors.New("ErrNotOwner")
+	// ErrInvalidWeight 权重必须大于0
+	ErrInvalidWeight = errors.New("ErrInvalidWeight")
+	// ErrRequiredWeight 权重阈值必须大于0且不能超过所有者的总权重
+	ErrRequiredWeight = errors.New("ErrRequiredWeight")
+	// ErrAccountNotExist 多重签名账户不存在
+	ErrAccountNotExist = errors.New("ErrMultiSigAccountNotExist")
+	// ErrAccountExist 多重签名账户已经存在
+	ErrAccountExist = errors.New("ErrMultiSigAccountExist")
+	// ErrTxNotExist 多重签名交易不存在
+	ErrTxNotExist = errors.New("ErrMultiSigTxNotExist")
+	// ErrTxExecuted 多重签名交易已经执行
+	ErrTxExecuted = errors.New("ErrMultiSigTxExecuted")
+	// ErrAlreadyConfirmed 所有者已经确认过交易
+	ErrAlreadyConfirmed = errors.New("ErrAlreadyConfirmed")
+	// ErrNotConfirmed 所有者没有确认过交易, 不能撤销
+	ErrNotConfirmed = errors.New("ErrNotConfirmed")
+	// ErrInvalidOperate 所有者或者账户的操作类型错误
+	ErrInvalidOperate = errors.New("ErrInvalidOperate")
+	// ErrInvalidDailyLimit 每日限额参数错误
+	ErrInvalidDailyLimit = errors.New("ErrInvalidDailyLimit")
+	// ErrInvalidSymbol 资产执行器或者符号错误
+	ErrInvalidSymbol = errors.New("ErrInvalidSymbol")
+)
@@ -0,0 +1,1244 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: multisig.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// 多重签名账户合约的交易
+type MultiSigAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*MultiSigAction_AccountCreate
+	//	*MultiSigAction_OwnerOperate
+	//	*MultiSigAction_AccountOperate
+	//	*MultiSigAction_ConfirmTx
+	//	*MultiSigAction_TransferIn
+	//	*MultiSigAction_TransferOut
+	Value                isMultiSigAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                  `protobuf:"varint,7,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *MultiSigAction) Reset()         { *m = MultiSigAction{} }
+func (m *MultiSigAction) String() string { return proto.CompactTextString(m) }
+func (*MultiSigAction) ProtoMessage()    {}
+func (*MultiSigAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{0}
+}
+
+func (m *MultiSigAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigAction.Unmarshal(m, b)
+}
+func (m *MultiSigAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigAction.Marshal(b, m, deterministic)
+}
+func (m *MultiSigAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAction.Merge(m, src)
+}
+func (m *MultiSigAction) XXX_Size() int {
+	return xxx_messageInfo_MultiSigAction.Size(m)
+}
+func (m *MultiSigAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigAction proto.InternalMessageInfo
+
+type isMultiSigAction_Value interface {
+	isMultiSigAction_Value()
+}
+
+type MultiSigAction_AccountCreate struct {
+	AccountCreate *MultiSigAccountCreate `protobuf:"bytes,1,opt,name=accountCreate,proto3,oneof"`
+}
+
+type MultiSigAction_OwnerOperate struct {
+	OwnerOperate *MultiSigOwnerOperate `protobuf:"bytes,2,opt,name=ownerOperate,proto3,oneof"`
+}
+
+type MultiSigAction_AccountOperate struct {
+	AccountOperate *MultiSigAccountOperate `protobuf:"bytes,3,opt,name=accountOperate,proto3,oneof"`
+}
+
+type MultiSigAction_ConfirmTx struct {
+	ConfirmTx *MultiSigConfirmTx `protobuf:"bytes,4,opt,name=confirmTx,proto3,oneof"`
+}
+
+type MultiSigAction_TransferIn struct {
+	TransferIn *MultiSigTransferIn `protobuf:"bytes,5,opt,name=transferIn,proto3,oneof"`
+}
+
+type MultiSigAction_TransferOut struct {
+	TransferOut *MultiSigTransferOut `protobuf:"bytes,6,opt,name=transferOut,proto3,oneof"`
+}
+
+func (*MultiSigAction_AccountCreate) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_OwnerOperate) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_AccountOperate) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_ConfirmTx) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_TransferIn) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_TransferOut) isMultiSigAction_Value() {}
+
+func (m *MultiSigAction) GetValue() isMultiSigAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetAccountCreate() *MultiSigAccountCreate {
+	if x, ok := m.GetValue().(*MultiSigAction_AccountCreate); ok {
+		return x.AccountCreate
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetOwnerOperate() *MultiSigOwnerOperate {
+	if x, ok := m.GetValue().(*MultiSigAction_OwnerOperate); ok {
+		return x.OwnerOperate
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetAccountOperate() *MultiSigAccountOperate {
+	if x, ok := m.GetValue().(*MultiSigAction_AccountOperate); ok {
+		return x.AccountOperate
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetConfirmTx() *MultiSigConfirmTx {
+	if x, ok := m.GetValue().(*MultiSigAction_ConfirmTx); ok {
+		return x.ConfirmTx
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetTransferIn() *MultiSigTransferIn {
+	if x, ok := m.GetValue().(*MultiSigAction_TransferIn); ok {
+		return x.TransferIn
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetTransferOut() *MultiSigTransferOut {
+	if x, ok := m.GetValue().(*MultiSigAction_TransferOut); ok {
+		return x.TransferOut
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MultiSigAction) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MultiSigAction_AccountCreate)(nil),
+		(*MultiSigAction_OwnerOperate)(nil),
+		(*MultiSigAction_AccountOperate)(nil),
+		(*MultiSigAction_ConfirmTx)(nil),
+		(*MultiSigAction_TransferIn)(nil),
+		(*MultiSigAction_TransferOut)(nil),
+	}
+}
+
+// 多重签名账户的所有者以及权重
+type MultiSigOwner struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Weight               uint64   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigOwner) Reset()         { *m = MultiSigOwner{} }
+func (m *MultiSigOwner) String() string { return proto.CompactTextString(m) }
+func (*MultiSigOwner) ProtoMessage()    {}
+func (*MultiSigOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{1}
+}
+
+func (m *MultiSigOwner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigOwner.Unmarshal(m, b)
+}
+func (m *MultiSigOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigOwner.Marshal(b, m, deterministic)
+}
+func (m *MultiSigOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigOwner.Merge(m, src)
+}
+func (m *MultiSigOwner) XXX_Size() int {
+	return xxx_messageInfo_MultiSigOwner.Size(m)
+}
+func (m *MultiSigOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigOwner proto.InternalMessageInfo
+
+func (m *MultiSigOwner) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *MultiSigOwner) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// 资产的每日限额, 限额内的转出只需要一个所有者即可执行
+type MultiSigDailyLimit struct {
+	Execer               string   `protobuf:"bytes,1,opt,name=execer,proto3" json:"execer,omitempty"`
+	Symbol               string   `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	DailyLimit           int64    `protobuf:"varint,3,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
+	SpentToday           int64    `protobuf:"varint,4,opt,name=spentToday,proto3" json:"spentToday,omitempty"`
+	LastDay              int64    `protobuf:"varint,5,opt,name=lastDay,proto3" json:"lastDay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigDailyLimit) Reset()         { *m = MultiSigDailyLimit{} }
+func (m *MultiSigDailyLimit) String() string { return proto.CompactTextString(m) }
+func (*MultiSigDailyLimit) ProtoMessage()    {}
+func (*MultiSigDailyLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{2}
+}
+
+func (m *MultiSigDailyLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigDailyLimit.Unmarshal(m, b)
+}
+func (m *MultiSigDailyLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigDailyLimit.Marshal(b, m, deterministic)
+}
+func (m *MultiSigDailyLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigDailyLimit.Merge(m, src)
+}
+func (m *MultiSigDailyLimit) XXX_Size() int {
+	return xxx_messageInfo_MultiSigDailyLimit.Size(m)
+}
+func (m *MultiSigDailyLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigDailyLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigDailyLimit proto.InternalMessageInfo
+
+func (m *MultiSigDailyLimit) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *MultiSigDailyLimit) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MultiSigDailyLimit) GetDailyLimit() int64 {
+	if m != nil {
+		return m.DailyLimit
+	}
+	return 0
+}
+
+func (m *MultiSigDailyLimit) GetSpentToday() int64 {
+	if m != nil {
+		return m.SpentToday
+	}
+	return 0
+}
+
+func (m *MultiSigDailyLimit) GetLastDay() int64 {
+	if m != nil {
+		return m.LastDay
+	}
+	return 0
+}
+
+// 多重签名账户
+type MultiSigAccount struct {
+	CreateAddr           string                `protobuf:"bytes,1,opt,name=createAddr,proto3" json:"createAddr,omitempty"`
+	Addr                 string                `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Owners               []*MultiSigOwner      `protobuf:"bytes,3,rep,name=owners,proto3" json:"owners,omitempty"`
+	RequiredWeight       uint64                `protobuf:"varint,4,opt,name=requiredWeight,proto3" json:"requiredWeight,omitempty"`
+	DailyLimits          []*MultiSigDailyLimit `protobuf:"bytes,5,rep,name=dailyLimits,proto3" json:"dailyLimits,omitempty"`
+	TxCount              uint64                `protobuf:"varint,6,opt,name=txCount,proto3" json:"txCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MultiSigAccount) Reset()         { *m = MultiSigAccount{} }
+func (m *MultiSigAccount) String() string { return proto.CompactTextString(m) }
+func (*MultiSigAccount) ProtoMessage()    {}
+func (*MultiSigAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{3}
+}
+
+func (m *MultiSigAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigAccount.Unmarshal(m, b)
+}
+func (m *MultiSigAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigAccount.Marshal(b, m, deterministic)
+}
+func (m *MultiSigAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAccount.Merge(m, src)
+}
+func (m *MultiSigAccount) XXX_Size() int {
+	return xxx_messageInfo_MultiSigAccount.Size(m)
+}
+func (m *MultiSigAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigAccount proto.InternalMessageInfo
+
+func (m *MultiSigAccount) GetCreateAddr() string {
+	if m != nil {
+		return m.CreateAddr
+	}
+	return ""
+}
+
+func (m *MultiSigAccount) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *MultiSigAccount) GetOwners() []*MultiSigOwner {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *MultiSigAccount) GetRequiredWeight() uint64 {
+	if m != nil {
+		return m.RequiredWeight
+	}
+	return 0
+}
+
+func (m *MultiSigAccount) GetDailyLimits() []*MultiSigDailyLimit {
+	if m != nil {
+		return m.DailyLimits
+	}
+	return nil
+}
+
+func (m *MultiSigAccount) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+// 创建多重签名账户
+type MultiSigAccountCreate struct {
+	Owners               []*MultiSigOwner    `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
+	RequiredWeight       uint64              `protobuf:"varint,2,opt,name=requiredWeight,proto3" json:"requiredWeight,omitempty"`
+	DailyLimit           *MultiSigDailyLimit `protobuf:"bytes,3,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *MultiSigAccountCreate) Reset()         { *m = MultiSigAccountCreate{} }
+func (m *MultiSigAccountCreate) String() string { return proto.CompactTextString(m) }
+func (*MultiSigAccountCreate) ProtoMessage()    {}
+func (*MultiSigAccountCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{4}
+}
+
+func (m *MultiSigAccountCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigAccountCreate.Unmarshal(m, b)
+}
+func (m *MultiSigAccountCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigAccountCreate.Marshal(b, m, deterministic)
+}
+func (m *MultiSigAccountCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAccountCreate.Merge(m, src)
+}
+func (m *MultiSigAccountCreate) XXX_Size() int {
+	return xxx_messageInfo_MultiSigAccountCreate.Size(m)
+}
+func (m *MultiSigAccountCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigAccountCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigAccountCreate proto.InternalMessageInfo
+
+func (m *MultiSigAccountCreate) GetOwners() []*MultiSigOwner {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *MultiSigAccountCreate) GetRequiredWeight() uint64 {
+	if m != nil {
+		return m.RequiredWeight
+	}
+	return 0
+}
+
+func (m *MultiSigAccountCreate) GetDailyLimit() *MultiSigDailyLimit {
+	if m != nil {
+		return m.DailyLimit
+	}
+	return nil
+}
+
+// 所有者的添加, 删除, 修改权重以及替换, 需要达到权重阈值才能执行
+type MultiSigOwnerOperate struct {
+	AccountAddr          string   `protobuf:"bytes,1,opt,name=accountAddr,proto3" json:"accountAddr,omitempty"`
+	Operate              int32    `protobuf:"varint,2,opt,name=operate,proto3" json:"operate,omitempty"`
+	OldOwner             string   `protobuf:"bytes,3,opt,name=oldOwner,proto3" json:"oldOwner,omitempty"`
+	NewOwner             string   `protobuf:"bytes,4,opt,name=newOwner,proto3" json:"newOwner,omitempty"`
+	NewWeight            uint64   `protobuf:"varint,5,opt,name=newWeight,proto3" json:"newWeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigOwnerOperate) Reset()         { *m = MultiSigOwnerOperate{} }
+func (m *MultiSigOwnerOperate) String() string { return proto.CompactTextString(m) }
+func (*MultiSigOwnerOperate) ProtoMessage()    {}
+func (*MultiSigOwnerOperate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{5}
+}
+
+func (m *MultiSigOwnerOperate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigOwnerOperate.Unmarshal(m, b)
+}
+func (m *MultiSigOwnerOperate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigOwnerOperate.Marshal(b, m, deterministic)
+}
+func (m *MultiSigOwnerOperate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigOwnerOperate.Merge(m, src)
+}
+func (m *MultiSigOwnerOperate) XXX_Size() int {
+	return xxx_messageInfo_MultiSigOwnerOperate.Size(m)
+}
+func (m *MultiSigOwnerOperate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigOwnerOperate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigOwnerOperate proto.InternalMessageInfo
+
+func (m *MultiSigOwnerOperate) GetAccountAddr() string {
+	if m != nil {
+		return m.AccountAddr
+	}
+	return ""
+}
+
+func (m *MultiSigOwnerOperate) GetOperate() int32 {
+	if m != nil {
+		return m.Operate
+	}
+	return 0
+}
+
+func (m *MultiSigOwnerOperate) GetOldOwner() string {
+	if m != nil {
+		return m.OldOwner
+	}
+	return ""
+}
+
+func (m *MultiSigOwnerOperate) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *MultiSigOwnerOperate) GetNewWeight() uint64 {
+	if m != nil {
+		return m.NewWeight
+	}
+	return 0
+}
+
+// 修改账户的权重阈值或者每日限额, 需要达到权重阈值才能执行
+type MultiSigAccountOperate struct {
+	AccountAddr          string              `protobuf:"bytes,1,opt,name=accountAddr,proto3" json:"accountAddr,omitempty"`
+	NewRequiredWeight    uint64              `protobuf:"varint,2,opt,name=newRequiredWeight,proto3" json:"newRequiredWeight,omitempty"`
+	DailyLimit           *MultiSigDailyLimit `protobuf:"bytes,3,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *MultiSigAccountOperate) Reset()         { *m = MultiSigAccountOperate{} }
+func (m *MultiSigAccountOperate) String() string { return proto.CompactTextString(m) }
+func (*MultiSigAccountOperate) ProtoMessage()    {}
+func (*MultiSigAccountOperate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{6}
+}
+
+func (m *MultiSigAccountOperate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigAccountOperate.Unmarshal(m, b)
+}
+func (m *MultiSigAccountOperate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigAccountOperate.Marshal(b, m, deterministic)
+}
+func (m *MultiSigAccountOperate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAccountOperate.Merge(m, src)
+}
+func (m *MultiSigAccountOperate) XXX_Size() int {
+	return xxx_messageInfo_MultiSigAccountOperate.Size(m)
+}
+func (m *MultiSigAccountOperate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigAccountOperate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigAccountOperate proto.InternalMessageInfo
+
+func (m *MultiSigAccountOperate) GetAccountAddr() string {
+	if m != nil {
+		return m.AccountAddr
+	}
+	return ""
+}
+
+func (m *MultiSigAccountOperate) GetNewRequiredWeight() uint64 {
+	if m != nil {
+		return m.NewRequiredWeight
+	}
+	return 0
+}
+
+func (m *MultiSigAccountOperate) GetDailyLimit() *MultiSigDailyLimit {
+	if m != nil {
+		return m.DailyLimit
+	}
+	return nil
+}
+
+// 所有者确认或者撤销对交易的确认
+type MultiSigConfirmTx struct {
+	AccountAddr          string   `protobuf:"bytes,1,opt,name=accountAddr,proto3" json:"accountAddr,omitempty"`
+	TxID                 uint64   `protobuf:"varint,2,opt,name=txID,proto3" json:"txID,omitempty"`
+	Confirm              bool     `protobuf:"varint,3,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigConfirmTx) Reset()         { *m = MultiSigConfirmTx{} }
+func (m *MultiSigConfirmTx) String() string { return proto.CompactTextString(m) }
+func (*MultiSigConfirmTx) ProtoMessage()    {}
+func (*MultiSigConfirmTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{7}
+}
+
+func (m *MultiSigConfirmTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigConfirmTx.Unmarshal(m, b)
+}
+func (m *MultiSigConfirmTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigConfirmTx.Marshal(b, m, deterministic)
+}
+func (m *MultiSigConfirmTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigConfirmTx.Merge(m, src)
+}
+func (m *MultiSigConfirmTx) XXX_Size() int {
+	return xxx_messageInfo_MultiSigConfirmTx.Size(m)
+}
+func (m *MultiSigConfirmTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigConfirmTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigConfirmTx proto.InternalMessageInfo
+
+func (m *MultiSigConfirmTx) GetAccountAddr() string {
+	if m != nil {
+		return m.AccountAddr
+	}
+	return ""
+}
+
+func (m *MultiSigConfirmTx) GetTxID() uint64 {
+	if m != nil {
+		return m.TxID
+	}
+	return 0
+}
+
+func (m *MultiSigConfirmTx) GetConfirm() bool {
+	if m != nil {
+		return m.Confirm
+	}
+	return false
+}
+
+// 从发起者在合约中的余额转入多重签名账户
+type MultiSigTransferIn struct {
+	AccountAddr          string   `protobuf:"bytes,1,opt,name=accountAddr,proto3" json:"accountAddr,omitempty"`
+	Execer               string   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	Symbol               string   `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note                 string   `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigTransferIn) Reset()         { *m = MultiSigTransferIn{} }
+func (m *MultiSigTransferIn) String() string { return proto.CompactTextString(m) }
+func (*MultiSigTransferIn) ProtoMessage()    {}
+func (*MultiSigTransferIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{8}
+}
+
+func (m *MultiSigTransferIn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigTransferIn.Unmarshal(m, b)
+}
+func (m *MultiSigTransferIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigTransferIn.Marshal(b, m, deterministic)
+}
+func (m *MultiSigTransferIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigTransferIn.Merge(m, src)
+}
+func (m *MultiSigTransferIn) XXX_Size() int {
+	return xxx_messageInfo_MultiSigTransferIn.Size(m)
+}
+func (m *MultiSigTransferIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigTransferIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigTransferIn proto.InternalMessageInfo
+
+func (m *MultiSigTransferIn) GetAccountAddr() string {
+	if m != nil {
+		return m.AccountAddr
+	}
+	return ""
+}
+
+func (m *MultiSigTransferIn) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *MultiSigTransferIn) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MultiSigTransferIn) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MultiSigTransferIn) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// 从多重签名账户转出到指定地址在合约中的余额
+type MultiSigTransferOut struct {
+	AccountAddr          string   `protobuf:"bytes,1,opt,name=accountAddr,proto3" json:"accountAddr,omitempty"`
+	Execer               string   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	Symbol               string   `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	To                   string   `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Note                 string   `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigTransferOut) Reset()         { *m = MultiSigTransferOut{} }
+func (m *MultiSigTransferOut) String() string { return proto.CompactTextString(m) }
+func (*MultiSigTransferOut) ProtoMessage()    {}
+func (*MultiSigTransferOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{9}
+}
+
+func (m *MultiSigTransferOut) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigTransferOut.Unmarshal(m, b)
+}
+func (m *MultiSigTransferOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigTransferOut.Marshal(b, m, deterministic)
+}
+func (m *MultiSigTransferOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigTransferOut.Merge(m, src)
+}
+func (m *MultiSigTransferOut) XXX_Size() int {
+	return xxx_messageInfo_MultiSigTransferOut.Size(m)
+}
+func (m *MultiSigTransferOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigTransferOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigTransferOut proto.InternalMessageInfo
+
+func (m *MultiSigTransferOut) GetAccountAddr() string {
+	if m != nil {
+		return m.AccountAddr
+	}
+	return ""
+}
+
+func (m *MultiSigTransferOut) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *MultiSigTransferOut) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MultiSigTransferOut) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MultiSigTransferOut) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MultiSigTransferOut) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// 多重签名账户提交的交易以及确认情况
+type MultiSigTx struct {
+	AccountAddr          string                  `protobuf:"bytes,1,opt,name=accountAddr,proto3" json:"accountAddr,omitempty"`
+	TxID                 uint64                  `protobuf:"varint,2,opt,name=txID,proto3" json:"txID,omitempty"`
+	TxHash               string                  `protobuf:"bytes,3,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Ty                   int32                   `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
+	Executed             bool                    `protobuf:"varint,5,opt,name=executed,proto3" json:"executed,omitempty"`
+	ConfirmedOwners      []*MultiSigOwner        `protobuf:"bytes,6,rep,name=confirmedOwners,proto3" json:"confirmedOwners,omitempty"`
+	OwnerOperate         *MultiSigOwnerOperate   `protobuf:"bytes,7,opt,name=ownerOperate,proto3" json:"ownerOperate,omitempty"`
+	AccountOperate       *MultiSigAccountOperate `protobuf:"bytes,8,opt,name=accountOperate,proto3" json:"accountOperate,omitempty"`
+	TransferOut          *MultiSigTransferOut    `protobuf:"bytes,9,opt,name=transferOut,proto3" json:"transferOut,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *MultiSigTx) Reset()         { *m = MultiSigTx{} }
+func (m *MultiSigTx) String() string { return proto.CompactTextString(m) }
+func (*MultiSigTx) ProtoMessage()    {}
+func (*MultiSigTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{10}
+}
+
+func (m *MultiSigTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigTx.Unmarshal(m, b)
+}
+func (m *MultiSigTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigTx.Marshal(b, m, deterministic)
+}
+func (m *MultiSigTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigTx.Merge(m, src)
+}
+func (m *MultiSigTx) XXX_Size() int {
+	return xxx_messageInfo_MultiSigTx.Size(m)
+}
+func (m *MultiSigTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigTx proto.InternalMessageInfo
+
+func (m *MultiSigTx) GetAccountAddr() string {
+	if m != nil {
+		return m.AccountAddr
+	}
+	return ""
+}
+
+func (m *MultiSigTx) GetTxID() uint64 {
+	if m != nil {
+		return m.TxID
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *MultiSigTx) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+func (m *MultiSigTx) GetConfirmedOwners() []*MultiSigOwner {
+	if m != nil {
+		return m.ConfirmedOwners
+	}
+	return nil
+}
+
+func (m *MultiSigTx) GetOwnerOperate() *MultiSigOwnerOperate {
+	if m != nil {
+		return m.OwnerOperate
+	}
+	return nil
+}
+
+func (m *MultiSigTx) GetAccountOperate() *MultiSigAccountOperate {
+	if m != nil {
+		return m.AccountOperate
+	}
+	return nil
+}
+
+func (m *MultiSigTx) GetTransferOut() *MultiSigTransferOut {
+	if m != nil {
+		return m.TransferOut
+	}
+	return nil
+}
+
+type ReceiptMultiSigAccount struct {
+	Prev                 *MultiSigAccount `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *MultiSigAccount `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReceiptMultiSigAccount) Reset()         { *m = ReceiptMultiSigAccount{} }
+func (m *ReceiptMultiSigAccount) String() string { return proto.CompactTextString(m) }
+func (*ReceiptMultiSigAccount) ProtoMessage()    {}
+func (*ReceiptMultiSigAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{11}
+}
+
+func (m *ReceiptMultiSigAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptMultiSigAccount.Unmarshal(m, b)
+}
+func (m *ReceiptMultiSigAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptMultiSigAccount.Marshal(b, m, deterministic)
+}
+func (m *ReceiptMultiSigAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptMultiSigAccount.Merge(m, src)
+}
+func (m *ReceiptMultiSigAccount) XXX_Size() int {
+	return xxx_messageInfo_ReceiptMultiSigAccount.Size(m)
+}
+func (m *ReceiptMultiSigAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptMultiSigAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptMultiSigAccount proto.InternalMessageInfo
+
+func (m *ReceiptMultiSigAccount) GetPrev() *MultiSigAccount {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptMultiSigAccount) GetCurrent() *MultiSigAccount {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptMultiSigTx struct {
+	Prev                 *MultiSigTx `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *MultiSigTx `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReceiptMultiSigTx) Reset()         { *m = ReceiptMultiSigTx{} }
+func (m *ReceiptMultiSigTx) String() string { return proto.CompactTextString(m) }
+func (*ReceiptMultiSigTx) ProtoMessage()    {}
+func (*ReceiptMultiSigTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{12}
+}
+
+func (m *ReceiptMultiSigTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptMultiSigTx.Unmarshal(m, b)
+}
+func (m *ReceiptMultiSigTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptMultiSigTx.Marshal(b, m, deterministic)
+}
+func (m *ReceiptMultiSigTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptMultiSigTx.Merge(m, src)
+}
+func (m *ReceiptMultiSigTx) XXX_Size() int {
+	return xxx_messageInfo_ReceiptMultiSigTx.Size(m)
+}
+func (m *ReceiptMultiSigTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptMultiSigTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptMultiSigTx proto.InternalMessageInfo
+
+func (m *ReceiptMultiSigTx) GetPrev() *MultiSigTx {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptMultiSigTx) GetCurrent() *MultiSigTx {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqMultiSigTx struct {
+	AccountAddr          string   `protobuf:"bytes,1,opt,name=accountAddr,proto3" json:"accountAddr,omitempty"`
+	TxID                 uint64   `protobuf:"varint,2,opt,name=txID,proto3" json:"txID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqMultiSigTx) Reset()         { *m = ReqMultiSigTx{} }
+func (m *ReqMultiSigTx) String() string { return proto.CompactTextString(m) }
+func (*ReqMultiSigTx) ProtoMessage()    {}
+func (*ReqMultiSigTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{13}
+}
+
+func (m *ReqMultiSigTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMultiSigTx.Unmarshal(m, b)
+}
+func (m *ReqMultiSigTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMultiSigTx.Marshal(b, m, deterministic)
+}
+func (m *ReqMultiSigTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMultiSigTx.Merge(m, src)
+}
+func (m *ReqMultiSigTx) XXX_Size() int {
+	return xxx_messageInfo_ReqMultiSigTx.Size(m)
+}
+func (m *ReqMultiSigTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMultiSigTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqMultiSigTx proto.InternalMessageInfo
+
+func (m *ReqMultiSigTx) GetAccountAddr() string {
+	if m != nil {
+		return m.AccountAddr
+	}
+	return ""
+}
+
+func (m *ReqMultiSigTx) GetTxID() uint64 {
+	if m != nil {
+		return m.TxID
+	}
+	return 0
+}
+
+type ReplyMultiSigAccounts struct {
+	Addrs []string `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	// 钱包查询时返回账户详情
+	Accounts             []*MultiSigAccount `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ReplyMultiSigAccounts) Reset()         { *m = ReplyMultiSigAccounts{} }
+func (m *ReplyMultiSigAccounts) String() string { return proto.CompactTextString(m) }
+func (*ReplyMultiSigAccounts) ProtoMessage()    {}
+func (*ReplyMultiSigAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{14}
+}
+
+func (m *ReplyMultiSigAccounts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyMultiSigAccounts.Unmarshal(m, b)
+}
+func (m *ReplyMultiSigAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyMultiSigAccounts.Marshal(b, m, deterministic)
+}
+func (m *ReplyMultiSigAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMultiSigAccounts.Merge(m, src)
+}
+func (m *ReplyMultiSigAccounts) XXX_Size() int {
+	return xxx_messageInfo_ReplyMultiSigAccounts.Size(m)
+}
+func (m *ReplyMultiSigAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyMultiSigAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyMultiSigAccounts proto.InternalMessageInfo
+
+func (m *ReplyMultiSigAccounts) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *ReplyMultiSigAccounts) GetAccounts() []*MultiSigAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// 账户的交易列表, 按照txID倒序
+type ReqMultiSigTxList struct {
+	AccountAddr          string   `protobuf:"bytes,1,opt,name=accountAddr,proto3" json:"accountAddr,omitempty"`
+	FromID               uint64   `protobuf:"varint,2,opt,name=fromID,proto3" json:"fromID,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	PendingOnly          bool     `protobuf:"varint,4,opt,name=pendingOnly,proto3" json:"pendingOnly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqMultiSigTxList) Reset()         { *m = ReqMultiSigTxList{} }
+func (m *ReqMultiSigTxList) String() string { return proto.CompactTextString(m) }
+func (*ReqMultiSigTxList) ProtoMessage()    {}
+func (*ReqMultiSigTxList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{15}
+}
+
+func (m *ReqMultiSigTxList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMultiSigTxList.Unmarshal(m, b)
+}
+func (m *ReqMultiSigTxList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMultiSigTxList.Marshal(b, m, deterministic)
+}
+func (m *ReqMultiSigTxList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMultiSigTxList.Merge(m, src)
+}
+func (m *ReqMultiSigTxList) XXX_Size() int {
+	return xxx_messageInfo_ReqMultiSigTxList.Size(m)
+}
+func (m *ReqMultiSigTxList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMultiSigTxList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqMultiSigTxList proto.InternalMessageInfo
+
+func (m *ReqMultiSigTxList) GetAccountAddr() string {
+	if m != nil {
+		return m.AccountAddr
+	}
+	return ""
+}
+
+func (m *ReqMultiSigTxList) GetFromID() uint64 {
+	if m != nil {
+		return m.FromID
+	}
+	return 0
+}
+
+func (m *ReqMultiSigTxList) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqMultiSigTxList) GetPendingOnly() bool {
+	if m != nil {
+		return m.PendingOnly
+	}
+	return false
+}
+
+type ReplyMultiSigTxList struct {
+	Txs                  []*MultiSigTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReplyMultiSigTxList) Reset()         { *m = ReplyMultiSigTxList{} }
+func (m *ReplyMultiSigTxList) String() string { return proto.CompactTextString(m) }
+func (*ReplyMultiSigTxList) ProtoMessage()    {}
+func (*ReplyMultiSigTxList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{16}
+}
+
+func (m *ReplyMultiSigTxList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyMultiSigTxList.Unmarshal(m, b)
+}
+func (m *ReplyMultiSigTxList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyMultiSigTxList.Marshal(b, m, deterministic)
+}
+func (m *ReplyMultiSigTxList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMultiSigTxList.Merge(m, src)
+}
+func (m *ReplyMultiSigTxList) XXX_Size() int {
+	return xxx_messageInfo_ReplyMultiSigTxList.Size(m)
+}
+func (m *ReplyMultiSigTxList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyMultiSigTxList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyMultiSigTxList proto.InternalMessageInfo
+
+func (m *ReplyMultiSigTxList) GetTxs() []*MultiSigTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MultiSigAction)(nil), "types.MultiSigAction")
+	proto.RegisterType((*MultiSigOwner)(nil), "types.MultiSigOwner")
+	proto.RegisterType((*MultiSigDailyLimit)(nil), "types.MultiSigDailyLimit")
+	proto.RegisterType((*MultiSigAccount)(nil), "types.MultiSigAccount")
+	proto.RegisterType((*MultiSigAccountCreate)(nil), "types.MultiSigAccountCreate")
+	proto.RegisterType((*MultiSigOwnerOperate)(nil), "types.MultiSigOwnerOperate")
+	proto.RegisterType((*MultiSigAccountOperate)(nil), "types.MultiSigAccountOperate")
+	proto.RegisterType((*MultiSigConfirmTx)(nil), "types.MultiSigConfirmTx")
+	proto.RegisterType((*MultiSigTransferIn)(nil), "types.MultiSigTransferIn")
+	proto.RegisterType((*MultiSigTransferOut)(nil), "types.MultiSigTransferOut")
+	proto.RegisterType((*MultiSigTx)(nil), "types.MultiSigTx")
+	proto.RegisterType((*ReceiptMultiSigAccount)(nil), "types.ReceiptMultiSigAccount")
+	proto.RegisterType((*ReceiptMultiSigTx)(nil), "types.ReceiptMultiSigTx")
+	proto.RegisterType((*ReqMultiSigTx)(nil), "types.ReqMultiSigTx")
+	proto.RegisterType((*ReplyMultiSigAccounts)(nil), "types.ReplyMultiSigAccounts")
+	proto.RegisterType((*ReqMultiSigTxList)(nil), "types.ReqMultiSigTxList")
+	proto.RegisterType((*ReplyMultiSigTxList)(nil), "types.ReplyMultiSigTxList")
+}
+
+func init() {
+	proto.RegisterFile("multisig.proto", fileDescriptor_62b8b91adf3febfa)
+}
+
+var fileDescriptor_62b8b91adf3febfa = []byte{
+	// 903 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0xff, 0x26, 0x7e, 0x61, 0x53, 0x65, 0xba, 0x8d, 0x4c, 0x29, 0x28, 0x32, 0x02, 0xad,
+	0xa0, 0x5a, 0xa1, 0xe5, 0x02, 0x2c, 0x2a, 0x5a, 0x76, 0x2b, 0x52, 0xa9, 0x28, 0xd2, 0xb0, 0x12,
+	0x67, 0xd7, 0x9e, 0x4d, 0x2d, 0x39, 0xb6, 0x6b, 0x8f, 0x37, 0xf6, 0x07, 0xe0, 0xc6, 0x89, 0x0f,
+	0xc0, 0x85, 0x0b, 0x67, 0x3e, 0x1a, 0x9f, 0x00, 0xcd, 0x78, 0x6c, 0x8f, 0x1d, 0x77, 0x1b, 0x75,
+	0x25, 0x6e, 0x7e, 0x33, 0xbf, 0xf7, 0xe6, 0xf7, 0xfe, 0x1b, 0x66, 0xdb, 0x3c, 0xa4, 0x41, 0x16,
+	0x6c, 0x4e, 0x93, 0x34, 0xa6, 0x31, 0x32, 0x68, 0x99, 0x90, 0xcc, 0xf9, 0x47, 0x83, 0xd9, 0xcf,
+	0xec, 0xe6, 0x97, 0x60, 0x73, 0xe1, 0xd1, 0x20, 0x8e, 0xd0, 0x15, 0x1c, 0xb9, 0x9e, 0x17, 0xe7,
+	0x11, 0xbd, 0x4c, 0x89, 0x4b, 0x89, 0xad, 0x2c, 0x95, 0x93, 0xe9, 0xd9, 0x93, 0x53, 0xae, 0x71,
+	0xda, 0xa2, 0x25, 0xcc, 0x6a, 0x84, 0xbb, 0x4a, 0xe8, 0x02, 0x3e, 0x88, 0x77, 0x11, 0x49, 0xd7,
+	0x09, 0x49, 0x99, 0x11, 0x95, 0x1b, 0xf9, 0xa8, 0x67, 0x64, 0x2d, 0x41, 0x56, 0x23, 0xdc, 0x51,
+	0x41, 0x3f, 0xc1, 0x4c, 0xd8, 0xac, 0x8d, 0x68, 0xdc, 0xc8, 0xc7, 0xc3, 0x4c, 0x5a, 0x33, 0x3d,
+	0x35, 0xf4, 0x0d, 0x58, 0x5e, 0x1c, 0xdd, 0x04, 0xe9, 0xf6, 0xba, 0xb0, 0x75, 0x6e, 0xc3, 0xee,
+	0xd9, 0xb8, 0xac, 0xef, 0x57, 0x23, 0xdc, 0x82, 0xd1, 0x39, 0x00, 0x4d, 0xdd, 0x28, 0xbb, 0x21,
+	0xe9, 0x8b, 0xc8, 0x36, 0xb8, 0xea, 0x87, 0x3d, 0xd5, 0xeb, 0x06, 0xb0, 0x1a, 0x61, 0x09, 0x8e,
+	0x9e, 0xc1, 0xb4, 0x96, 0xd6, 0x39, 0xb5, 0x4d, 0xae, 0xfd, 0xf8, 0x2d, 0xda, 0xeb, 0x9c, 0xae,
+	0x46, 0x58, 0x56, 0x40, 0x33, 0x50, 0x69, 0x69, 0x8f, 0x97, 0xca, 0x89, 0x81, 0x55, 0x5a, 0xfe,
+	0x38, 0x06, 0xe3, 0xd6, 0x0d, 0x73, 0xe2, 0x9c, 0xc3, 0x51, 0x27, 0x80, 0x08, 0x81, 0xee, 0xfa,
+	0x7e, 0xca, 0x33, 0x65, 0x61, 0xfe, 0x8d, 0x16, 0x60, 0xee, 0x48, 0xb0, 0x79, 0x4d, 0x79, 0xe8,
+	0x75, 0x2c, 0x24, 0xe7, 0x4f, 0x05, 0x50, 0xad, 0x7d, 0xe5, 0x06, 0x61, 0xf9, 0x32, 0xd8, 0x06,
+	0x94, 0xc1, 0x49, 0x41, 0x3c, 0x52, 0x1b, 0x11, 0x12, 0x3b, 0xcf, 0xca, 0xed, 0xab, 0x38, 0xe4,
+	0x66, 0x2c, 0x2c, 0x24, 0xf4, 0x09, 0x80, 0xdf, 0x68, 0xf3, 0xc4, 0x68, 0x58, 0x3a, 0x61, 0xf7,
+	0x59, 0x42, 0x22, 0x7a, 0x1d, 0xfb, 0x6e, 0xc9, 0x83, 0xae, 0x61, 0xe9, 0x04, 0xd9, 0x30, 0x0e,
+	0xdd, 0x8c, 0x5e, 0xb9, 0x25, 0x0f, 0xab, 0x86, 0x6b, 0xd1, 0xf9, 0x57, 0x81, 0x07, 0xbd, 0xd4,
+	0x32, 0x6b, 0x1e, 0xaf, 0xab, 0x8b, 0xd6, 0x4d, 0xe9, 0xa4, 0x09, 0x80, 0x2a, 0x05, 0xe0, 0x29,
+	0x98, 0xbc, 0x9c, 0x32, 0x5b, 0x5b, 0x6a, 0x27, 0xd3, 0xb3, 0xe3, 0xa1, 0xda, 0xc3, 0x02, 0x83,
+	0x3e, 0x87, 0x59, 0x4a, 0xde, 0xe4, 0x41, 0x4a, 0xfc, 0x5f, 0xab, 0xb0, 0xe9, 0x3c, 0x6c, 0xbd,
+	0x53, 0x74, 0x0e, 0xd3, 0xd6, 0xcb, 0xcc, 0x36, 0x96, 0xda, 0x40, 0x49, 0xb4, 0x71, 0xc5, 0x32,
+	0x9a, 0x39, 0x4d, 0x8b, 0x4b, 0xe6, 0x11, 0xaf, 0x06, 0x1d, 0xd7, 0xa2, 0xf3, 0xb7, 0x02, 0x8f,
+	0x06, 0x3b, 0x4b, 0x72, 0x43, 0x79, 0x2f, 0x37, 0xd4, 0x41, 0x37, 0xbe, 0xdd, 0x4b, 0xdf, 0x9d,
+	0x5e, 0x48, 0x60, 0x46, 0xf5, 0x78, 0xa8, 0x7f, 0xd1, 0x12, 0xa6, 0xa2, 0xf1, 0xa4, 0x2c, 0xc9,
+	0x47, 0xcc, 0xff, 0x58, 0x9a, 0x07, 0x06, 0xae, 0x45, 0xf4, 0x18, 0x26, 0x71, 0xe8, 0x73, 0x73,
+	0x9c, 0x8d, 0x85, 0x1b, 0x99, 0xdd, 0x45, 0x64, 0x57, 0xdd, 0xe9, 0xd5, 0x5d, 0x2d, 0xa3, 0x27,
+	0x60, 0x45, 0x64, 0x27, 0x5c, 0x35, 0xb8, 0xab, 0xed, 0x81, 0xf3, 0x97, 0x02, 0x8b, 0xe1, 0x29,
+	0x71, 0x00, 0xd9, 0xa7, 0x30, 0x8f, 0xc8, 0x0e, 0x0f, 0x45, 0x73, 0xff, 0xe2, 0x3e, 0x01, 0xf5,
+	0x60, 0xbe, 0x37, 0x86, 0x0e, 0xe0, 0x87, 0x40, 0xa7, 0xc5, 0x8b, 0x2b, 0x41, 0x89, 0x7f, 0xb3,
+	0x00, 0x8b, 0xe1, 0xc5, 0x29, 0x4c, 0x70, 0x2d, 0x3a, 0x7f, 0x48, 0x6d, 0xdf, 0x4e, 0xac, 0x03,
+	0x9e, 0x69, 0x07, 0x83, 0xfa, 0x96, 0xc1, 0xa0, 0x75, 0x06, 0xc3, 0x02, 0x4c, 0x77, 0xcb, 0x4b,
+	0xbc, 0x6a, 0x7a, 0x21, 0x31, 0xba, 0x51, 0x4c, 0x09, 0x4f, 0x92, 0x85, 0xf9, 0x37, 0xcb, 0xcf,
+	0xc3, 0x81, 0x41, 0xf8, 0x3f, 0xb2, 0x62, 0x33, 0x36, 0x16, 0x9c, 0x54, 0x1a, 0x37, 0x2c, 0x4d,
+	0x89, 0xe5, 0xef, 0x1a, 0x40, 0xc3, 0xf2, 0x7d, 0x33, 0xb3, 0x00, 0x93, 0x16, 0x2b, 0x37, 0x7b,
+	0x5d, 0x13, 0xab, 0x24, 0x31, 0xe4, 0xf5, 0x7a, 0xc8, 0xb3, 0x62, 0x67, 0xae, 0xe4, 0x94, 0xf8,
+	0x9c, 0xd6, 0x04, 0x37, 0x32, 0x7a, 0x06, 0x0f, 0x44, 0x3a, 0x49, 0xd5, 0x1a, 0x99, 0x6d, 0xde,
+	0x31, 0x13, 0xfa, 0x60, 0xf4, 0x43, 0x6f, 0x27, 0x8f, 0xdf, 0xb9, 0x93, 0x7b, 0x1b, 0xf9, 0xf9,
+	0xde, 0x46, 0x9e, 0x1c, 0xb0, 0x91, 0xf7, 0xf6, 0xf1, 0xf7, 0xdd, 0xc5, 0x68, 0xbd, 0x6b, 0x31,
+	0x76, 0xd6, 0xa2, 0x73, 0x0b, 0x0b, 0x4c, 0x3c, 0x12, 0x24, 0xb4, 0xbf, 0x25, 0xbe, 0x00, 0x3d,
+	0x49, 0xc9, 0xad, 0xf8, 0x61, 0x59, 0x0c, 0x93, 0xc2, 0x1c, 0x83, 0xbe, 0x82, 0xb1, 0x97, 0xa7,
+	0x29, 0x89, 0xa8, 0xad, 0xde, 0x09, 0xaf, 0x61, 0xce, 0x06, 0xe6, 0xbd, 0x77, 0xaf, 0x0b, 0xf4,
+	0x59, 0xe7, 0xc9, 0x79, 0xdf, 0x87, 0x42, 0xbc, 0xf6, 0x65, 0xff, 0xb5, 0x01, 0x64, 0xf3, 0xd0,
+	0x73, 0x38, 0xc2, 0xe4, 0xcd, 0x7d, 0x2b, 0xce, 0x71, 0xe1, 0x11, 0x26, 0x49, 0x58, 0xf6, 0x1c,
+	0xca, 0xd0, 0x31, 0x18, 0x6c, 0x41, 0x56, 0x0b, 0xc5, 0xc2, 0x95, 0x80, 0xce, 0x60, 0x22, 0x2c,
+	0x66, 0xb6, 0xba, 0xd4, 0xee, 0x88, 0x48, 0x83, 0x73, 0x7e, 0x53, 0x60, 0xde, 0xa1, 0xfa, 0x32,
+	0xc8, 0x0e, 0xec, 0xde, 0x9b, 0x34, 0xde, 0x36, 0x84, 0x85, 0xc4, 0x98, 0x71, 0x10, 0xef, 0x11,
+	0x03, 0x57, 0x02, 0xb3, 0x97, 0x90, 0xc8, 0x0f, 0xa2, 0xcd, 0x3a, 0x0a, 0xab, 0x5e, 0x99, 0x60,
+	0xf9, 0xc8, 0xf9, 0x0e, 0x1e, 0x76, 0x5c, 0x15, 0x44, 0x3e, 0x05, 0x8d, 0x16, 0xf5, 0xde, 0x1c,
+	0x88, 0x38, 0xbb, 0x7d, 0x65, 0xf2, 0xff, 0xe1, 0xaf, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xf6,
+	0xd0, 0x01, 0xac, 0x21, 0x0b, 0x00, 0x00,
+}
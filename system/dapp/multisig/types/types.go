@@ -0,0 +1,107 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types 多重签名账户合约相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// MultiSigX defines a global string
+	MultiSigX  = "sysmultisig"
+	actionName = map[string]int32{
+		"AccountCreate":  MultiSigActionAccountCreate,
+		"OwnerOperate":   MultiSigActionOwnerOperate,
+		"AccountOperate": MultiSigActionAccountOperate,
+		"ConfirmTx":      MultiSigActionConfirmTx,
+		"TransferIn":     MultiSigActionTransferIn,
+		"TransferOut":    MultiSigActionTransferOut,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogMultiSigAccount: {Ty: reflect.TypeOf(ReceiptMultiSigAccount{}), Name: "LogMultiSigAccount"},
+		TyLogMultiSigTx:      {Ty: reflect.TypeOf(ReceiptMultiSigTx{}), Name: "LogMultiSigTx"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(MultiSigX))
+	types.RegFork(MultiSigX, InitFork)
+	types.RegExec(MultiSigX, InitExecutor)
+}
+
+//InitFork init
+func InitFork(cfg *types.Chain33Config) {
+	cfg.RegisterDappFork(MultiSigX, "Enable", types.MaxHeight)
+}
+
+//InitExecutor init Executor
+func InitExecutor(cfg *types.Chain33Config) {
+	types.RegistorExecutor(MultiSigX, NewType(cfg))
+}
+
+// MultiSigType defines multisig exec type
+type MultiSigType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a multisig type object
+func NewType(cfg *types.Chain33Config) *MultiSigType {
+	c := &MultiSigType{}
+	c.SetChild(c)
+	c.SetConfig(cfg)
+	return c
+}
+
+// GetPayload return multisig action
+func (m *MultiSigType) GetPayload() types.Message {
+	return &MultiSigAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (m *MultiSigType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (m *MultiSigType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (m *MultiSigType) GetName() string {
+	return MultiSigX
+}
+
+// GetOwner 获取账户中的所有者, 不存在返回nil
+func (m *MultiSigAccount) GetOwner(addr string) *MultiSigOwner {
+	for _, owner := range m.GetOwners() {
+		if owner.Addr == addr {
+			return owner
+		}
+	}
+	return nil
+}
+
+// TotalWeight 所有者的总权重
+func (m *MultiSigAccount) TotalWeight() uint64 {
+	var total uint64
+	for _, owner := range m.GetOwners() {
+		total += owner.Weight
+	}
+	return total
+}
+
+// GetDailyLimit 获取资产的每日限额, 没有设置返回nil
+func (m *MultiSigAccount) GetDailyLimit(execer, symbol string) *MultiSigDailyLimit {
+	for _, limit := range m.GetDailyLimits() {
+		if limit.Execer == execer && limit.Symbol == symbol {
+			return limit
+		}
+	}
+	return nil
+}
@@ -9,6 +9,15 @@ import (
 type AccountTxIndex struct {
 	maxperaccount int
 	accMap        map[string]*listmap.ListMap
+	seqMap        map[string]*seqQueue
+}
+
+//seqQueue 使用账户序号的交易按照序号排队
+//从next开始连续的交易是可以打包的ready队列, 其余的交易在future队列中等待前面的序号
+type seqQueue struct {
+	//链上下一个可用的序号
+	next int64
+	txs  map[int64]*types.Transaction
 }
 
 //NewAccountTxIndex 创建一个新的索引
@@ -16,6 +25,7 @@ func NewAccountTxIndex(maxperaccount int) *AccountTxIndex {
 	return &AccountTxIndex{
 		maxperaccount: maxperaccount,
 		accMap:        make(map[string]*listmap.ListMap),
+		seqMap:        make(map[string]*seqQueue),
 	}
 }
 
@@ -61,6 +71,22 @@ func (cache *AccountTxIndex) Remove(tx *types.Transaction) {
 			delete(cache.accMap, addr)
 		}
 	}
+	cache.removeSeq(addr, tx)
+}
+
+func (cache *AccountTxIndex) removeSeq(addr string, tx *types.Transaction) {
+	q, ok := cache.seqMap[addr]
+	if !ok || !tx.IsSeqNonce {
+		return
+	}
+	seq := tx.Nonce
+	if item, ok := q.txs[seq]; ok && item == tx {
+		delete(q.txs, seq)
+	}
+	//账户没有排队的交易后不再缓存链上序号, 下次重新从链上获取
+	if len(q.txs) == 0 {
+		delete(cache.seqMap, addr)
+	}
 }
 
 // Push push transaction to AccountTxIndex
@@ -84,3 +110,97 @@ func (cache *AccountTxIndex) CanPush(tx *types.Transaction) bool {
 	}
 	return true
 }
+
+//HasSeqBase 是否已经缓存了账户的链上序号
+func (cache *AccountTxIndex) HasSeqBase(addr string) bool {
+	_, ok := cache.seqMap[addr]
+	return ok
+}
+
+//SetSeqBase 设置账户的链上序号, 已经缓存的序号由区块更新, 不会被覆盖
+func (cache *AccountTxIndex) SetSeqBase(addr string, next int64) {
+	if _, ok := cache.seqMap[addr]; ok {
+		return
+	}
+	cache.seqMap[addr] = &seqQueue{next: next, txs: make(map[int64]*types.Transaction)}
+}
+
+//CheckSeq 检查账户序号是否可以进入mempool, 需要先设置链上序号
+func (cache *AccountTxIndex) CheckSeq(tx *types.Transaction, seq int64) error {
+	q, ok := cache.seqMap[tx.From()]
+	if !ok {
+		return types.ErrTxNonceNotMatch
+	}
+	if seq < q.next {
+		return types.ErrTxNonceTooLow
+	}
+	if seq >= q.next+int64(cache.maxperaccount) {
+		return types.ErrTxNonceTooHigh
+	}
+	if _, ok := q.txs[seq]; ok {
+		return types.ErrTxNonceExist
+	}
+	return nil
+}
+
+//PushSeq 交易加入账户的序号队列, 调用前需要CheckSeq
+func (cache *AccountTxIndex) PushSeq(tx *types.Transaction, seq int64) {
+	if q, ok := cache.seqMap[tx.From()]; ok {
+		q.txs[seq] = tx
+	}
+}
+
+//ReadyTxs 返回账户从链上序号开始连续的交易
+func (cache *AccountTxIndex) ReadyTxs(addr string) []*types.Transaction {
+	q, ok := cache.seqMap[addr]
+	if !ok {
+		return nil
+	}
+	var txs []*types.Transaction
+	for seq := q.next; ; seq++ {
+		tx, ok := q.txs[seq]
+		if !ok {
+			return txs
+		}
+		txs = append(txs, tx)
+	}
+}
+
+//FutureTxs 返回账户中等待前面序号的交易
+func (cache *AccountTxIndex) FutureTxs(addr string) []*types.Transaction {
+	q, ok := cache.seqMap[addr]
+	if !ok {
+		return nil
+	}
+	ready := int64(len(cache.ReadyTxs(addr)))
+	var txs []*types.Transaction
+	for seq, tx := range q.txs {
+		if seq >= q.next+ready {
+			txs = append(txs, tx)
+		}
+	}
+	return txs
+}
+
+//AddBlockSeq 区块中账户的序号已经使用, 返回队列中序号过低需要删除的交易
+func (cache *AccountTxIndex) AddBlockSeq(addr string, seq int64) []*types.Transaction {
+	q, ok := cache.seqMap[addr]
+	if !ok || seq < q.next {
+		return nil
+	}
+	q.next = seq + 1
+	var stale []*types.Transaction
+	for s, tx := range q.txs {
+		if s < q.next {
+			stale = append(stale, tx)
+		}
+	}
+	return stale
+}
+
+//DelBlockSeq 区块回滚后账户的序号重新可用
+func (cache *AccountTxIndex) DelBlockSeq(addr string, seq int64) {
+	if q, ok := cache.seqMap[addr]; ok && seq < q.next {
+		q.next = seq
+	}
+}
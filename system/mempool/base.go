@@ -19,6 +19,8 @@ var mlog = log.New("module", "mempool.base")
 
 //Mempool mempool 基础类
 type Mempool struct {
+	//上次清理超时记录的时间, 纳秒, 原子操作放在开头保证64位对齐
+	recordSweep       int64
 	proxyMtx          sync.RWMutex
 	in                chan *queue.Message
 	out               <-chan *queue.Message
@@ -32,6 +34,8 @@ type Mempool struct {
 	done              chan struct{}
 	removeBlockTicket *time.Ticker
 	cache             *txCache
+	//正在检查的交易记录, 消息id对应记录, 回复时补充检查结果
+	recording sync.Map
 }
 
 //GetSync 判断是否mempool 同步
@@ -53,6 +57,10 @@ func NewMempool(cfg *types.Mempool) *Mempool {
 	if cfg.PoolCacheSize == 0 {
 		cfg.PoolCacheSize = poolCacheSize
 	}
+	if cfg.SigCacheSize == 0 {
+		cfg.SigCacheSize = types.DefaultSigCacheSize
+	}
+	types.SetSigCacheSize(int(cfg.SigCacheSize))
 	pool.in = make(chan *queue.Message)
 	pool.out = make(<-chan *queue.Message)
 	pool.done = make(chan struct{})
@@ -131,8 +139,30 @@ func (mem *Mempool) filterTxList(count int64, dupMap map[string]bool, isAll bool
 	blockTime := mem.header.GetBlockTime()
 	types.AssertConfig(mem.client)
 	cfg := mem.client.GetConfig()
+	//使用账户序号的交易, 第一次遇到账户时按照序号顺序取出ready队列, future队列中的交易不能打包
+	seqAddrs := make(map[string]bool)
 	//由于mempool可能存在过期交易，先遍历所有，满足目标交易数再退出，否则存在无法获取到实际交易情况
 	mem.cache.Walk(0, func(tx *Item) bool {
+		if _, ok := tx.Value.GetSeqNonce(cfg, height); ok && !isAll {
+			from := tx.Value.From()
+			if seqAddrs[from] {
+				return true
+			}
+			seqAddrs[from] = true
+			for _, readyTx := range mem.cache.ReadyTxs(from) {
+				if item, err := mem.cache.qcache.GetItem(string(readyTx.Hash())); err != nil || isExpired(cfg, item, height, blockTime) {
+					break
+				}
+				if dupMap[string(readyTx.Hash())] {
+					continue
+				}
+				txs = append(txs, readyTx)
+				if count > 0 && len(txs) == int(count) {
+					return false
+				}
+			}
+			return true
+		}
 		if len(dupMap) > 0 {
 			if _, ok := dupMap[string(tx.Value.Hash())]; ok {
 				return true
@@ -173,10 +203,52 @@ func (mem *Mempool) removeTxs(hashes [][]byte) {
 func (mem *Mempool) PushTx(tx *types.Transaction) error {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
+	types.AssertConfig(mem.client)
+	seq, isSeq := tx.GetSeqNonce(mem.client.GetConfig(), mem.header.GetHeight()+1)
+	if isSeq {
+		if err := mem.cache.CheckSeq(tx, seq); err != nil {
+			return err
+		}
+	}
 	err := mem.cache.Push(tx)
+	if err == nil && isSeq {
+		mem.cache.PushSeq(tx, seq)
+	}
 	return err
 }
 
+//loadSeqBase 从链上状态获取账户下一个可用的序号, 已经缓存的账户不再获取
+func (mem *Mempool) loadSeqBase(addr string, header *types.Header) error {
+	mem.proxyMtx.RLock()
+	ok := mem.cache.HasSeqBase(addr)
+	mem.proxyMtx.RUnlock()
+	if ok {
+		return nil
+	}
+	query := &types.StoreGet{StateHash: header.GetStateHash(), Keys: [][]byte{types.CalcAccountSeqKey(addr)}}
+	msg := mem.client.NewMessage("store", types.EventStoreGet, query)
+	err := mem.client.Send(msg, true)
+	if err != nil {
+		return err
+	}
+	resp, err := mem.client.Wait(msg)
+	if err != nil {
+		return err
+	}
+	next := &types.Int64{}
+	values := resp.GetData().(*types.StoreReplyValue).GetValues()
+	if len(values) > 0 && values[0] != nil {
+		err = types.Decode(values[0], next)
+		if err != nil {
+			return err
+		}
+	}
+	mem.proxyMtx.Lock()
+	mem.cache.SetSeqBase(addr, next.GetData())
+	mem.proxyMtx.Unlock()
+	return nil
+}
+
 //  setHeader设置mempool.header
 func (mem *Mempool) setHeader(h *types.Header) {
 	mem.proxyMtx.Lock()
@@ -293,6 +365,18 @@ func (mem *Mempool) removeBlockedTxs() {
 func (mem *Mempool) RemoveTxsOfBlock(block *types.Block) bool {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
+	types.AssertConfig(mem.client)
+	cfg := mem.client.GetConfig()
+	for _, tx := range block.Txs {
+		seq, ok := tx.GetSeqNonce(cfg, block.Height)
+		if !ok {
+			continue
+		}
+		//同一账户序号过低的交易已经无法执行
+		for _, stale := range mem.cache.AddBlockSeq(tx.From(), seq) {
+			mem.cache.Remove(string(stale.Hash()))
+		}
+	}
 	for _, tx := range block.Txs {
 		hash := tx.Hash()
 		exist := mem.cache.Exist(string(hash))
@@ -386,6 +470,16 @@ func (mem *Mempool) delBlock(block *types.Block) {
 		if !mem.checkExpireValid(tx) {
 			continue
 		}
+		if seq, ok := tx.GetSeqNonce(cfg, block.Height); ok {
+			mem.proxyMtx.Lock()
+			mem.cache.DelBlockSeq(tx.From(), seq)
+			mem.proxyMtx.Unlock()
+			err = mem.loadSeqBase(tx.From(), mem.GetHeader())
+			if err != nil {
+				mlog.Error("mem", "load seq err", err)
+				continue
+			}
+		}
 		err = mem.PushTx(tx)
 		if err != nil {
 			mlog.Error("mem", "push tx err", err)
@@ -29,22 +29,22 @@ func (mem *Mempool) CheckExpireValid(msg *queue.Message) (bool, error) {
 }
 
 // checkTxListRemote 发送消息给执行模块检查交易
-func (mem *Mempool) checkTxListRemote(txlist *types.ExecTxList) (*types.ReceiptCheckTxList, error) {
-	if mem.client == nil {
+func (mem *Mempool) checkTxListRemote(client queue.Client, txlist *types.ExecTxList) (*types.ReceiptCheckTxList, error) {
+	if client == nil {
 		panic("client not bind message queue.")
 	}
-	msg := mem.client.NewMessage("execs", types.EventCheckTx, txlist)
-	err := mem.client.Send(msg, true)
+	msg := client.NewMessage("execs", types.EventCheckTx, txlist)
+	err := client.Send(msg, true)
 	if err != nil {
 		mlog.Error("execs closed", "err", err.Error())
 		return nil, err
 	}
-	reply, err := mem.client.Wait(msg)
+	reply, err := client.Wait(msg)
 	if err != nil {
 		return nil, err
 	}
 	txList := reply.GetData().(*types.ReceiptCheckTxList)
-	mem.client.FreeMessage(msg, reply)
+	client.FreeMessage(msg, reply)
 	return txList, nil
 }
 
@@ -146,6 +146,8 @@ func (mem *Mempool) checkLevelFee(tx *types.TransactionCache) error {
 
 //checkTxRemote 检查账户余额是否足够，并加入到Mempool，成功则传入goodChan，若加入Mempool失败则传入badChan
 func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
+	//向区块链以及执行模块发送的检查消息延续交易的追踪
+	client := queue.WithTrace(mem.client, msg.Trace())
 	tx := msg.GetData().(types.TxGroup)
 	lastheader := mem.GetHeader()
 
@@ -162,7 +164,7 @@ func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
 		temtxlist.Txs = append(temtxlist.Txs, txGroup.GetTxs()...)
 	}
 	temtxlist.Height = lastheader.Height
-	newtxs, err := util.CheckDupTx(mem.client, temtxlist.Txs, temtxlist.Height)
+	newtxs, err := util.CheckDupTx(client, temtxlist.Txs, temtxlist.Height)
 	if err != nil {
 		msg.Data = err
 		return msg
@@ -183,7 +185,7 @@ func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
 		txlist.Difficulty = uint64(lastheader.Difficulty)
 		txlist.IsMempool = true
 
-		result, err := mem.checkTxListRemote(txlist)
+		result, err := mem.checkTxListRemote(client, txlist)
 
 		if err == nil && result.Errs[0] != "" {
 			err = errors.New(result.Errs[0])
@@ -195,6 +197,13 @@ func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
 		}
 	}
 
+	if _, ok := tx.Tx().GetSeqNonce(mem.client.GetConfig(), lastheader.GetHeight()+1); ok {
+		err = mem.loadSeqBase(tx.Tx().From(), lastheader)
+		if err != nil {
+			msg.Data = err
+			return msg
+		}
+	}
 	err = mem.PushTx(tx.Tx())
 	if err != nil {
 		mlog.Error("checkTxRemote", "push err", err)
@@ -1,15 +1,27 @@
 package mempool
 
 import (
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common/record"
+	"github.com/33cn/chain33/metrics"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
 )
 
+// rejectTx 记录交易被拒绝的原因
+func rejectTx(err error) {
+	metrics.IncCounter(metrics.Name("chain33/mempool/reject", "reason", err.Error()), 1)
+}
+
 func (mem *Mempool) reply() {
 	defer mlog.Info("piple line quit")
 	defer mem.wg.Done()
 	for m := range mem.out {
+		mem.recordReply(m.ID, m.Err())
 		if m.Err() != nil {
+			rejectTx(m.Err())
 			m.Reply(mem.client.NewMessage("rpc", types.EventReply,
 				&types.Reply{IsOk: false, Msg: []byte(m.Err().Error())}))
 		} else { //TODO, rpc和p2p交易发送需要区分， rpc需要消息答复，p2p不需要
@@ -97,15 +109,26 @@ func (mem *Mempool) eventProcess() {
 			mem.eventTxListByHash(msg)
 		case types.EventCheckTxsExist:
 			mem.eventCheckTxsExist(msg)
+		case types.EventConfigReloaded:
+			// 配置热加载，更新交易费相关配置
+			mem.eventConfigReloaded(msg)
 		default:
 		}
 		mlog.Debug("mempool", "cost", types.Since(beg), "msg", msgName)
+		if metrics.Enabled() {
+			metrics.UpdateGauge("chain33/mempool/size", int64(mem.Size()))
+		}
 	}
 }
 
 //EventTx 初步筛选后存入mempool
 func (mem *Mempool) eventTx(msg *queue.Message) {
+	if record.Enabled(record.KindTx) {
+		mem.recordTx(msg)
+	}
 	if !mem.getSync() {
+		mem.recordReply(msg.ID, types.ErrNotSync)
+		rejectTx(types.ErrNotSync)
 		msg.Reply(mem.client.NewMessage("", types.EventReply, &types.Reply{Msg: []byte(types.ErrNotSync.Error())}))
 		mlog.Debug("wrong tx", "err", types.ErrNotSync.Error())
 	} else {
@@ -113,8 +136,51 @@ func (mem *Mempool) eventTx(msg *queue.Message) {
 		select {
 		case mem.in <- checkedMsg:
 		case <-mem.done:
+			mem.recording.Delete(msg.ID)
+		}
+	}
+}
+
+// recordTTL 交易记录等待回复的最长时间, 超时没有回复的记录直接丢弃
+const recordTTL = time.Minute
+
+//recordTx 记录收到的交易, 检查结果在回复时写入
+func (mem *Mempool) recordTx(msg *queue.Message) {
+	tx, ok := msg.GetData().(*types.Transaction)
+	if !ok {
+		return
+	}
+	now := types.Now().UnixNano()
+	entry := &record.Entry{Time: now, Kind: record.KindTx, Data: types.Encode(tx)}
+	mem.recording.Store(msg.ID, entry)
+	mem.expireRecording(now)
+}
+
+//expireRecording 清理超时没有回复的交易记录, 每个recordTTL最多扫描一次
+func (mem *Mempool) expireRecording(now int64) {
+	last := atomic.LoadInt64(&mem.recordSweep)
+	if now-last < int64(recordTTL) || !atomic.CompareAndSwapInt64(&mem.recordSweep, last, now) {
+		return
+	}
+	mem.recording.Range(func(k, v interface{}) bool {
+		if v.(*record.Entry).Time < now-int64(recordTTL) {
+			mem.recording.Delete(k)
 		}
+		return true
+	})
+}
+
+func (mem *Mempool) recordReply(id int64, err error) {
+	v, ok := mem.recording.Load(id)
+	if !ok {
+		return
 	}
+	mem.recording.Delete(id)
+	entry := v.(*record.Entry)
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	record.Record(entry)
 }
 
 // EventGetMempool 获取Mempool内所有交易
@@ -250,3 +316,20 @@ func (mem *Mempool) eventCheckTxsExist(msg *queue.Message) {
 	}
 	msg.Reply(mem.client.NewMessage("", types.EventReply, reply))
 }
+
+// eventConfigReloaded 配置热加载, 新的交易费配置已经在加载时和链上的配置做过校验
+func (mem *Mempool) eventConfigReloaded(msg *queue.Message) {
+	reload, ok := msg.GetData().(*types.ReloadConfig)
+	if !ok || reload.Config.Mempool == nil {
+		return
+	}
+	cfg := reload.Config.Mempool
+	mem.SetMinFee(cfg.MinTxFeeRate)
+	mem.proxyMtx.Lock()
+	mem.cfg.MaxTxFeeRate = cfg.MaxTxFeeRate
+	mem.cfg.MaxTxFee = cfg.MaxTxFee
+	mem.cfg.IsLevelFee = cfg.IsLevelFee
+	mem.proxyMtx.Unlock()
+	mlog.Info("mempool config reloaded", "minTxFeeRate", cfg.MinTxFeeRate, "maxTxFeeRate", cfg.MaxTxFeeRate,
+		"maxTxFee", cfg.MaxTxFee, "isLevelFee", cfg.IsLevelFee)
+}
@@ -7,7 +7,9 @@ package mempool
 import (
 	"errors"
 	"math/rand"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/33cn/chain33/util"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/common/limits"
 	"github.com/33cn/chain33/common/log"
+	"github.com/33cn/chain33/common/record"
 	"github.com/33cn/chain33/executor"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/store"
@@ -1338,3 +1341,113 @@ func TestCheckTxsExist(t *testing.T) {
 	require.Equal(t, 0, len(replyData.ExistFlags))
 	require.Equal(t, 0, int(replyData.ExistCount))
 }
+
+func TestConfigReloaded(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+
+	cfg := *mem.cfg
+	cfg.MinTxFeeRate = mem.cfg.MinTxFeeRate * 2
+	cfg.MaxTxFee = mem.cfg.MaxTxFee / 2
+	cfg.IsLevelFee = true
+	msg := mem.client.NewMessage("mempool", types.EventConfigReloaded, &types.ReloadConfig{Config: &types.Config{Mempool: &cfg}})
+	require.Nil(t, mem.client.Send(msg, false))
+	//mempool 顺序处理消息, 查询返回时配置已经更新
+	msg = mem.client.NewMessage("mempool", types.EventGetMempoolSize, nil)
+	require.Nil(t, mem.client.Send(msg, true))
+	_, err := mem.client.Wait(msg)
+	require.Nil(t, err)
+	mem.proxyMtx.RLock()
+	defer mem.proxyMtx.RUnlock()
+	require.Equal(t, cfg.MinTxFeeRate, mem.cfg.MinTxFeeRate)
+	require.Equal(t, cfg.MaxTxFee, mem.cfg.MaxTxFee)
+	require.True(t, mem.cfg.IsLevelFee)
+}
+
+func TestSeqNonceQueue(t *testing.T) {
+	str := types.ReadFile("../../cmd/chain33/chain33.test.toml")
+	str = strings.Replace(str, "Title=\"chain33\"", "Title=\"local\"\nTxSeqNonce=true", 1)
+	cfg := types.NewChain33Config(str)
+	mcfg := cfg.GetModuleConfig()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	defer q.Close()
+	mem := NewMempool(mcfg.Mempool)
+	mem.SetQueueCache(NewSimpleQueue(SubConfig{100, mcfg.Mempool.MinTxFeeRate}))
+	mem.SetQueueClient(q.Client())
+	defer mem.Close()
+	mem.setHeader(&types.Header{Height: 1})
+
+	from := address.PubKeyToAddress(privKey.PubKey().Bytes()).String()
+	createSeqTx := func(seq, fee int64) *types.Transaction {
+		tx := util.CreateNoneTx(cfg, nil)
+		tx.Fee = fee
+		tx.SetSeqNonce(seq)
+		tx.Sign(types.SECP256K1, privKey)
+		return tx
+	}
+	mem.cache.SetSeqBase(from, 2)
+	tx1 := createSeqTx(1, 1e6)
+	require.Equal(t, types.ErrTxNonceTooLow, mem.PushTx(tx1))
+	tx2 := createSeqTx(2, 1e6)
+	tx3 := createSeqTx(3, 3e6)
+	tx5 := createSeqTx(5, 5e6)
+	require.Nil(t, mem.PushTx(tx5))
+	require.Nil(t, mem.PushTx(tx3))
+	require.Equal(t, types.ErrTxNonceExist, mem.PushTx(createSeqTx(3, 2e6)))
+	require.Equal(t, types.ErrTxNonceTooHigh, mem.PushTx(createSeqTx(1000, 1e6)))
+	require.Equal(t, 0, len(mem.cache.ReadyTxs(from)))
+	require.Equal(t, 2, len(mem.cache.FutureTxs(from)))
+	//缺少序号2时不能打包
+	require.Equal(t, 0, len(mem.getTxList(&types.TxHashList{Count: 10})))
+
+	require.Nil(t, mem.PushTx(tx2))
+	require.Equal(t, 2, len(mem.cache.ReadyTxs(from)))
+	normal := util.CreateNoneTx(cfg, privKey)
+	require.Nil(t, mem.PushTx(normal))
+	//手续费高的交易也要按照序号排序
+	txs := mem.getTxList(&types.TxHashList{Count: 10})
+	require.Equal(t, 3, len(txs))
+	require.Equal(t, tx2.Hash(), txs[0].Hash())
+	require.Equal(t, tx3.Hash(), txs[1].Hash())
+	require.Equal(t, normal.Hash(), txs[2].Hash())
+
+	//序号2, 3打包后序号5仍然等待序号4
+	mem.RemoveTxsOfBlock(&types.Block{Height: 2, Txs: []*types.Transaction{tx2, tx3}})
+	require.Equal(t, 2, mem.Size())
+	require.Equal(t, 1, len(mem.cache.FutureTxs(from)))
+	require.Equal(t, types.ErrTxNonceTooLow, mem.PushTx(createSeqTx(3, 1e6)))
+	tx4 := createSeqTx(4, 1e6)
+	require.Nil(t, mem.PushTx(tx4))
+	require.Equal(t, 2, len(mem.cache.ReadyTxs(from)))
+
+	//其他节点打包了相同序号的交易, 队列中的交易被删除
+	mem.RemoveTxsOfBlock(&types.Block{Height: 3, Txs: []*types.Transaction{createSeqTx(4, 2e6)}})
+	require.Equal(t, 2, mem.Size())
+	require.Equal(t, tx5.Hash(), mem.cache.ReadyTxs(from)[0].Hash())
+
+	mem.cache.DelBlockSeq(from, 3)
+	require.Equal(t, 0, len(mem.cache.ReadyTxs(from)))
+}
+
+func TestExpireRecording(t *testing.T) {
+	mem := &Mempool{}
+	now := types.Now().UnixNano()
+	mem.recording.Store(int64(1), &record.Entry{Time: now - int64(2*recordTTL)})
+	mem.recording.Store(int64(2), &record.Entry{Time: now})
+	mem.expireRecording(now)
+	_, ok := mem.recording.Load(int64(1))
+	require.False(t, ok)
+	_, ok = mem.recording.Load(int64(2))
+	require.True(t, ok)
+
+	//距离上次清理不到recordTTL, 不扫描
+	mem.recording.Store(int64(3), &record.Entry{Time: now - int64(2*recordTTL)})
+	mem.expireRecording(now + int64(time.Second))
+	_, ok = mem.recording.Load(int64(3))
+	require.True(t, ok)
+	mem.expireRecording(now + int64(recordTTL))
+	_, ok = mem.recording.Load(int64(3))
+	require.False(t, ok)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/kevinms/leakybucket-go"
@@ -120,8 +121,9 @@ func (s *Conngater) checkWhitAddr(addr multiaddr.Multiaddr) bool {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (s *Conngater) InterceptSecured(_ network.Direction, _ peer.ID, n network.ConnMultiaddrs) (allow bool) {
-	return true
+func (s *Conngater) InterceptSecured(_ network.Direction, p peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	//被封禁的节点主动连入时拒绝
+	return !s.blacklist.Has(p.Pretty())
 }
 
 // InterceptUpgraded tests whether a fully capable connection is allowed.
@@ -142,16 +144,22 @@ func (s *Conngater) validateDial(addr multiaddr.Multiaddr) bool {
 	return true
 }
 
+//SetMaxConnectNum 配置热加载时调整最大连接数量, 已经建立的连接不会主动断开
+func (s *Conngater) SetMaxConnectNum(limit int32) {
+	atomic.StoreInt32(&s.maxConnectNum, limit)
+}
+
 func (s *Conngater) isPeerAtLimit(direction network.Direction) bool {
-	if s.maxConnectNum == 0 { //不对连接节点数量进行限制
+	maxConnectNum := atomic.LoadInt32(&s.maxConnectNum)
+	if maxConnectNum == 0 { //不对连接节点数量进行限制
 		return false
 	}
 	numOfConns := len((*s.host).Network().Peers())
 	var maxPeers int
 	if direction == network.DirInbound { //inbound connect
-		maxPeers = int(s.maxConnectNum + CacheLimit/2)
+		maxPeers = int(maxConnectNum + CacheLimit/2)
 	} else {
-		maxPeers = int(s.maxConnectNum + CacheLimit)
+		maxPeers = int(maxConnectNum + CacheLimit)
 	}
 	return numOfConns >= maxPeers
 }
@@ -211,19 +219,16 @@ func (tc *TimeCache) checkOvertimekey() {
 	tc.cacheLock.Lock()
 	defer tc.cacheLock.Unlock()
 
-	back := tc.Q.Back()
-	if back == nil {
-		return
-	}
-	v := back.Value.(string)
-	t, ok := tc.M[v]
-	if !ok {
-		return
-	}
-	//if time.Since(t) > tc.span {
-	if time.Now().After(t) {
-		tc.Q.Remove(back)
-		delete(tc.M, v)
+	//各个key的过期时间不同, 需要遍历整个队列清理
+	now := time.Now()
+	for e := tc.Q.Back(); e != nil; {
+		prev := e.Prev()
+		v := e.Value.(string)
+		if t, ok := tc.M[v]; !ok || now.After(t) {
+			tc.Q.Remove(e)
+			delete(tc.M, v)
+		}
+		e = prev
 	}
 }
 
@@ -235,3 +240,20 @@ func (tc *TimeCache) Has(s string) bool {
 	_, ok := tc.M[s]
 	return ok
 }
+
+//Remove remove key
+func (tc *TimeCache) Remove(s string) {
+	tc.cacheLock.Lock()
+	defer tc.cacheLock.Unlock()
+
+	if _, ok := tc.M[s]; !ok {
+		return
+	}
+	delete(tc.M, s)
+	for e := tc.Q.Front(); e != nil; e = e.Next() {
+		if e.Value.(string) == s {
+			tc.Q.Remove(e)
+			break
+		}
+	}
+}
@@ -56,6 +56,13 @@ func Test_MaxLimit(t *testing.T) {
 	//超过上限，会拒绝连接，所以host3连接host1会被拒绝，连接失败
 	err = host3.Connect(context.Background(), h1info)
 	assert.NotNil(t, err)
+
+	//热加载调整上限之后可以连接, host3 拨号失败之后会退避一段时间, 使用新的节点连接
+	gater.SetMaxConnectNum(2)
+	host4, err := newTestHost(12348)
+	require.Nil(t, err)
+	err = host4.Connect(context.Background(), h1info)
+	assert.Nil(t, err)
 }
 
 func Test_InterceptAccept(t *testing.T) {
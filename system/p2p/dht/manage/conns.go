@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/33cn/chain33/common/log/log15"
+	cmetrics "github.com/33cn/chain33/metrics"
 	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
 	"github.com/33cn/chain33/types"
 	core "github.com/libp2p/go-libp2p-core"
@@ -104,6 +105,8 @@ func (s *ConnManager) MonitorAllPeers() {
 	defer ticker1.Stop()
 	ticker2 := time.NewTicker(time.Minute * 2)
 	defer ticker2.Stop()
+	ticker3 := time.NewTicker(time.Second * 10)
+	defer ticker3.Stop()
 
 	for {
 		select {
@@ -113,10 +116,29 @@ func (s *ConnManager) MonitorAllPeers() {
 			s.printMonitorInfo()
 		case <-ticker2.C:
 			s.procConnections()
+		case <-ticker3.C:
+			s.updateBandwidthMetrics()
 		}
 	}
 }
 
+// updateBandwidthMetrics 按协议记录p2p流量
+func (s *ConnManager) updateBandwidthMetrics() {
+	if !cmetrics.Enabled() {
+		return
+	}
+	for id, stat := range s.bandwidthTracker.GetBandwidthByProtocol() {
+		if id == "" {
+			continue
+		}
+		protocol := string(id)
+		cmetrics.UpdateGauge(cmetrics.Name("chain33/p2p/bandwidth/in", "protocol", protocol), stat.TotalIn)
+		cmetrics.UpdateGauge(cmetrics.Name("chain33/p2p/bandwidth/out", "protocol", protocol), stat.TotalOut)
+		cmetrics.UpdateGauge(cmetrics.Name("chain33/p2p/bandwidth/rate_in", "protocol", protocol), int64(stat.RateIn))
+		cmetrics.UpdateGauge(cmetrics.Name("chain33/p2p/bandwidth/rate_out", "protocol", protocol), int64(stat.RateOut))
+	}
+}
+
 func (s *ConnManager) printMonitorInfo() {
 	var LatencyInfo = fmt.Sprintln("--------------时延--------------------")
 	peers := s.FetchConnPeers()
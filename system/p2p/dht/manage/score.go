@@ -0,0 +1,230 @@
+package manage
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/metrics"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+const (
+	defaultDeprioritizeScore = -20
+	defaultDisconnectScore   = -50
+	defaultBanScore          = -100
+	defaultBanSeconds        = 3600
+	defaultRecoverPerMinute  = 1
+
+	//节点状态
+	peerStateNormal       = "normal"
+	peerStateDeprioritize = "deprioritized"
+	peerStateBanned       = "banned"
+)
+
+//各类不良行为的扣分
+var behaviorPenalty = map[int32]int64{
+	types.PeerBehaviorSyncFail:     30,
+	types.PeerBehaviorInvalidBlock: 50,
+	types.PeerBehaviorInvalidTx:    10,
+	types.PeerBehaviorTimeout:      5,
+	types.PeerBehaviorBandwidth:    20,
+}
+
+type peerScore struct {
+	score     int64
+	banUntil  time.Time
+	lastFault string
+}
+
+// ScoreManager 节点评分管理, 统一处理同步,广播,下载以及带宽等方面的节点不良行为
+type ScoreManager struct {
+	ctx       context.Context
+	host      host.Host
+	blacklist *TimeCache
+	tracker   *metrics.BandwidthCounter
+	cfg       p2pty.PeerScoreConfig
+
+	lock   sync.Mutex
+	scores map[peer.ID]*peerScore
+}
+
+// NewScoreManager new score manager, 封禁的节点加入blacklist, 由Conngater拦截连接
+func NewScoreManager(ctx context.Context, host host.Host, blacklist *TimeCache, tracker *metrics.BandwidthCounter, cfg *p2pty.P2PSubConfig) *ScoreManager {
+	s := &ScoreManager{
+		ctx:       ctx,
+		host:      host,
+		blacklist: blacklist,
+		tracker:   tracker,
+		cfg:       cfg.PeerScore,
+		scores:    make(map[peer.ID]*peerScore),
+	}
+	if s.cfg.DeprioritizeScore == 0 {
+		s.cfg.DeprioritizeScore = defaultDeprioritizeScore
+	}
+	if s.cfg.DisconnectScore == 0 {
+		s.cfg.DisconnectScore = defaultDisconnectScore
+	}
+	if s.cfg.BanScore == 0 {
+		s.cfg.BanScore = defaultBanScore
+	}
+	if s.cfg.BanSeconds == 0 {
+		s.cfg.BanSeconds = defaultBanSeconds
+	}
+	if s.cfg.RecoverPerMinute == 0 {
+		s.cfg.RecoverPerMinute = defaultRecoverPerMinute
+	}
+	return s
+}
+
+// Start 定时恢复分数以及检测节点带宽
+func (s *ScoreManager) Start() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.recover()
+			s.checkBandwidth()
+		}
+	}
+}
+
+// Report 记录节点的不良行为并扣分, 分数低于阈值时断开连接或封禁
+func (s *ScoreManager) Report(pid peer.ID, behavior int32, reason string) {
+	penalty, ok := behaviorPenalty[behavior]
+	if !ok || pid == "" || pid == s.host.ID() {
+		return
+	}
+	s.lock.Lock()
+	ps := s.getOrCreate(pid)
+	ps.score -= penalty
+	ps.lastFault = reason
+	score := ps.score
+	s.lock.Unlock()
+	log.Debug("ScoreManager Report", "pid", pid.Pretty(), "behavior", behavior, "reason", reason, "score", score)
+
+	if score <= s.cfg.BanScore {
+		s.Ban(pid, time.Duration(s.cfg.BanSeconds)*time.Second, reason)
+		return
+	}
+	if score <= s.cfg.DisconnectScore {
+		log.Info("ScoreManager disconnect", "pid", pid.Pretty(), "score", score, "reason", reason)
+		_ = s.host.Network().ClosePeer(pid)
+	}
+}
+
+// Ban 封禁节点并断开连接, duration为0时使用配置的封禁时长
+func (s *ScoreManager) Ban(pid peer.ID, duration time.Duration, reason string) {
+	if duration <= 0 {
+		duration = time.Duration(s.cfg.BanSeconds) * time.Second
+	}
+	s.lock.Lock()
+	ps := s.getOrCreate(pid)
+	ps.banUntil = time.Now().Add(duration)
+	if reason != "" {
+		ps.lastFault = reason
+	}
+	s.lock.Unlock()
+	//更新封禁时长
+	s.blacklist.Remove(pid.Pretty())
+	s.blacklist.Add(pid.Pretty(), duration)
+	log.Info("ScoreManager ban", "pid", pid.Pretty(), "duration", duration, "reason", reason)
+	_ = s.host.Network().ClosePeer(pid)
+}
+
+// Unban 解除封禁并清空评分
+func (s *ScoreManager) Unban(pid peer.ID) {
+	s.lock.Lock()
+	delete(s.scores, pid)
+	s.lock.Unlock()
+	s.blacklist.Remove(pid.Pretty())
+}
+
+// IsBanned 节点是否被封禁
+func (s *ScoreManager) IsBanned(pid peer.ID) bool {
+	return s.blacklist.Has(pid.Pretty())
+}
+
+// IsDeprioritized 节点分数过低, 不作为优先节点
+func (s *ScoreManager) IsDeprioritized(pid peer.ID) bool {
+	return s.Score(pid) <= s.cfg.DeprioritizeScore
+}
+
+// Score 获取节点分数
+func (s *ScoreManager) Score(pid peer.ID) int64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if ps, ok := s.scores[pid]; ok {
+		return ps.score
+	}
+	return 0
+}
+
+// List 列出所有有评分记录的节点, 按分数从低到高排序
+func (s *ScoreManager) List() *types.PeerScoreList {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	list := &types.PeerScoreList{}
+	for pid, ps := range s.scores {
+		item := &types.PeerScore{
+			Pid:       pid.Pretty(),
+			Score:     ps.score,
+			State:     peerStateNormal,
+			LastFault: ps.lastFault,
+		}
+		if ps.score <= s.cfg.DeprioritizeScore {
+			item.State = peerStateDeprioritize
+		}
+		if s.blacklist.Has(pid.Pretty()) {
+			item.State = peerStateBanned
+			item.BanUntil = ps.banUntil.Unix()
+		}
+		list.Scores = append(list.Scores, item)
+	}
+	sort.Slice(list.Scores, func(i, j int) bool {
+		return list.Scores[i].Score < list.Scores[j].Score
+	})
+	return list
+}
+
+func (s *ScoreManager) getOrCreate(pid peer.ID) *peerScore {
+	ps, ok := s.scores[pid]
+	if !ok {
+		ps = &peerScore{}
+		s.scores[pid] = ps
+	}
+	return ps
+}
+
+//recover 分数随时间恢复, 恢复到0且未封禁的节点删除记录
+func (s *ScoreManager) recover() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for pid, ps := range s.scores {
+		ps.score += s.cfg.RecoverPerMinute
+		if ps.score >= 0 {
+			ps.score = 0
+			if !s.blacklist.Has(pid.Pretty()) {
+				delete(s.scores, pid)
+			}
+		}
+	}
+}
+
+func (s *ScoreManager) checkBandwidth() {
+	if s.cfg.MaxPeerRateIn <= 0 || s.tracker == nil {
+		return
+	}
+	for pid, stat := range s.tracker.GetBandwidthByPeer() {
+		if stat.RateIn/1024 > float64(s.cfg.MaxPeerRateIn) {
+			s.Report(pid, types.PeerBehaviorBandwidth, "rate in exceeded")
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package manage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScoreManager(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h1, err := newTestHost(13801)
+	require.Nil(t, err)
+	defer h1.Close()
+	h2, err := newTestHost(13802)
+	require.Nil(t, err)
+	defer h2.Close()
+
+	connect := func() {
+		err := h1.Connect(ctx, peer.AddrInfo{ID: h2.ID(), Addrs: h2.Addrs()})
+		require.Nil(t, err)
+		require.Equal(t, network.Connected, h1.Network().Connectedness(h2.ID()))
+	}
+	connect()
+
+	blacklist := NewTimeCache(ctx, time.Minute)
+	subCfg := &p2pty.P2PSubConfig{}
+	subCfg.PeerScore.RecoverPerMinute = 10
+	sm := NewScoreManager(ctx, h1, blacklist, nil, subCfg)
+
+	//未知行为以及自身节点不扣分
+	sm.Report(h2.ID(), 100, "unknown")
+	sm.Report(h1.ID(), types.PeerBehaviorSyncFail, "self")
+	require.Equal(t, 0, len(sm.List().Scores))
+
+	sm.Report(h2.ID(), types.PeerBehaviorTimeout, "timeout")
+	require.Equal(t, int64(-5), sm.Score(h2.ID()))
+	require.False(t, sm.IsDeprioritized(h2.ID()))
+
+	//低于降级阈值
+	sm.Report(h2.ID(), types.PeerBehaviorSyncFail, "exec block fail")
+	require.True(t, sm.IsDeprioritized(h2.ID()))
+	require.Equal(t, network.Connected, h1.Network().Connectedness(h2.ID()))
+	list := sm.List()
+	require.Equal(t, 1, len(list.Scores))
+	require.Equal(t, peerStateDeprioritize, list.Scores[0].State)
+	require.Equal(t, "exec block fail", list.Scores[0].LastFault)
+
+	//低于断开阈值
+	sm.Report(h2.ID(), types.PeerBehaviorInvalidTx, "invalid tx")
+	require.Equal(t, int64(-45), sm.Score(h2.ID()))
+	sm.Report(h2.ID(), types.PeerBehaviorTimeout, "timeout")
+	require.NotEqual(t, network.Connected, h1.Network().Connectedness(h2.ID()))
+	require.False(t, sm.IsBanned(h2.ID()))
+
+	//低于封禁阈值
+	connect()
+	sm.Report(h2.ID(), types.PeerBehaviorInvalidBlock, "invalid block")
+	require.True(t, sm.IsBanned(h2.ID()))
+	require.NotEqual(t, network.Connected, h1.Network().Connectedness(h2.ID()))
+	list = sm.List()
+	require.Equal(t, peerStateBanned, list.Scores[0].State)
+	require.True(t, list.Scores[0].BanUntil > time.Now().Unix())
+
+	//解封后分数清零
+	sm.Unban(h2.ID())
+	require.False(t, sm.IsBanned(h2.ID()))
+	require.Equal(t, int64(0), sm.Score(h2.ID()))
+
+	//手动封禁
+	sm.Ban(h2.ID(), time.Second, "manual")
+	require.True(t, sm.IsBanned(h2.ID()))
+	sm.Unban(h2.ID())
+
+	//分数随时间恢复
+	sm.Report(h2.ID(), types.PeerBehaviorSyncFail, "exec block fail")
+	sm.recover()
+	require.Equal(t, int64(-20), sm.Score(h2.ID()))
+	sm.recover()
+	sm.recover()
+	require.Equal(t, 0, len(sm.List().Scores))
+}
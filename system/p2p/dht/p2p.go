@@ -8,6 +8,7 @@ package dht
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"path/filepath"
@@ -50,8 +51,10 @@ type P2P struct {
 	host            core.Host
 	discovery       *Discovery
 	connManager     *manage.ConnManager
+	connGater       *manage.Conngater
 	peerInfoManager *manage.PeerInfoManager
 	blackCache      *manage.TimeCache
+	scoreManager    *manage.ScoreManager
 	api             client.QueueProtocolAPI
 	client          queue.Client
 	addrBook        *AddrBook
@@ -154,6 +157,7 @@ func initP2P(p *P2P) *P2P {
 	p.discovery = InitDhtDiscovery(p.ctx, p.host, p.addrBook.AddrsInfo(), p.chainCfg, p.subCfg)
 	p.connManager = manage.NewConnManager(p.ctx, p.host, p.discovery.RoutingTable(), bandwidthTracker, p.subCfg)
 	p.peerInfoManager = manage.NewPeerInfoManager(p.ctx, p.host, p.client)
+	p.scoreManager = manage.NewScoreManager(p.ctx, p.host, p.blackCache, bandwidthTracker, p.subCfg)
 	p.taskGroup = &sync.WaitGroup{}
 
 	p.db = newDB("", p.p2pCfg.Driver, filepath.Dir(p.p2pCfg.DbPath), p.subCfg.DHTDataCache)
@@ -185,11 +189,13 @@ func (p *P2P) StartP2P() {
 		PeerInfoManager: p.peerInfoManager,
 		ConnManager:     p.connManager,
 		ConnBlackList:   p.blackCache,
+		PeerScore:       p.scoreManager,
 	}
 	p.env = env
 	protocol.InitAllProtocol(env)
 	p.discovery.Start()
 	go p.managePeers()
+	go p.scoreManager.Start()
 	go p.handleP2PEvent()
 	go p.findLANPeers()
 }
@@ -272,7 +278,8 @@ func (p *P2P) buildHostOptions(priv crypto.PrivKey, bandwidthTracker metrics.Rep
 		//2分钟的宽限期,定期清理
 		options = append(options, libp2p.ConnectionManager(connmgr.NewConnManager(minconnect, maxconnect, time.Minute*2)))
 		//ConnectionGater,处理网络连接的策略
-		options = append(options, libp2p.ConnectionGater(manage.NewConnGater(&p.host, p.subCfg.MaxConnectNum, timeCache, genAddrInfos(p.subCfg.WhitePeerList))))
+		p.connGater = manage.NewConnGater(&p.host, p.subCfg.MaxConnectNum, timeCache, genAddrInfos(p.subCfg.WhitePeerList))
+		options = append(options, libp2p.ConnectionGater(p.connGater))
 	}
 	//关闭ping
 	options = append(options, libp2p.Ping(false))
@@ -345,6 +352,10 @@ func (p *P2P) handleP2PEvent() {
 				continue
 			}
 
+			if msg.Ty == types.EventConfigReloaded {
+				p.configReloaded(msg)
+				continue
+			}
 			p.taskGroup.Add(1)
 			go func(m *queue.Message) {
 				defer p.taskGroup.Done()
@@ -358,6 +369,25 @@ func (p *P2P) handleP2PEvent() {
 	}
 }
 
+// configReloaded 配置热加载, 启动时没有限制连接数量的节点需要重启才能开启限制
+func (p *P2P) configReloaded(msg *queue.Message) {
+	reload, ok := msg.GetData().(*types.ReloadConfig)
+	if !ok || reload.Sub == nil || len(reload.Sub.P2P[p2pty.DHTTypeName]) == 0 {
+		return
+	}
+	subCfg := &p2pty.P2PSubConfig{}
+	if err := json.Unmarshal(reload.Sub.P2P[p2pty.DHTTypeName], subCfg); err != nil {
+		log.Error("configReloaded", "decode sub config err", err)
+		return
+	}
+	if p.connGater == nil || subCfg.MaxConnectNum <= 0 {
+		log.Warn("configReloaded", "maxConnectNum", subCfg.MaxConnectNum, "err", "restart required")
+		return
+	}
+	p.connGater.SetMaxConnectNum(subCfg.MaxConnectNum)
+	log.Info("p2p config reloaded", "maxConnectNum", subCfg.MaxConnectNum)
+}
+
 func (p *P2P) isRestart() bool {
 	return atomic.LoadInt32(&p.restart) == 1
 }
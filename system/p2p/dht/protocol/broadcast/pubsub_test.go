@@ -63,3 +63,27 @@ func TestPubSub(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, blockHash, ps.getMsgHash(psBlockTopic, msg))
 }
+
+func TestCheckTxAndBlock(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	addr, priv := util.Genaddress()
+	tx := util.CreateCoinsTx(cfg, priv, addr, 1)
+	require.Nil(t, checkTx(cfg, tx))
+	block := util.CreateCoinsBlock(cfg, priv, 10)
+	require.Nil(t, checkBlock(cfg, block))
+
+	unsigned := tx.Clone()
+	unsigned.Signature = nil
+	require.Equal(t, types.ErrSign, checkTx(cfg, unsigned))
+	other := tx.Clone()
+	other.ChainID = cfg.GetChainID() + 1
+	require.Equal(t, types.ErrTxChainID, checkTx(cfg, other))
+
+	//交易哈希和区块头不一致
+	bad := types.Clone(block).(*types.Block)
+	bad.Txs = bad.Txs[1:]
+	require.Equal(t, types.ErrCheckTxHash, checkBlock(cfg, bad))
+	bad = types.Clone(block).(*types.Block)
+	bad.Txs[0] = unsigned
+	require.Equal(t, types.ErrSign, checkBlock(cfg, bad))
+}
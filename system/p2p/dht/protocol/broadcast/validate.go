@@ -11,6 +11,7 @@ import (
 	"sync/atomic"
 
 	"github.com/33cn/chain33/common/difficulty"
+	"github.com/33cn/chain33/common/merkle"
 	"github.com/33cn/chain33/types"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -40,6 +41,7 @@ func (p *pubSub) validateBlock(ctx context.Context, id peer.ID, msg *pubsub.Mess
 	err := p.decodeMsg(msg.Data, nil, block)
 	if err != nil {
 		log.Error("validateBlock", "decodeMsg err", err)
+		p.ReportPeer(msg.ReceivedFrom, types.PeerBehaviorInvalidBlock, "decode block err:"+err.Error())
 		return pubsub.ValidationReject
 	}
 
@@ -51,6 +53,12 @@ func (p *pubSub) validateBlock(ctx context.Context, id peer.ID, msg *pubsub.Mess
 		return pubsub.ValidationIgnore
 	}
 
+	if err = checkBlock(p.ChainCfg, block); err != nil {
+		log.Error("validateBlock", "height", block.GetHeight(), "checkBlock err", err)
+		p.ReportPeer(msg.ReceivedFrom, types.PeerBehaviorInvalidBlock, "check block err:"+err.Error())
+		return pubsub.ValidationReject
+	}
+
 	// 丢弃收到高度落后较多的区块
 	maxHeight := atomic.LoadInt64(&p.maxRecvBlkHeight)
 	if block.GetHeight() <= maxHeight-int64(blkHeaderCacheSize) {
@@ -107,6 +115,7 @@ func (p *pubSub) validateTx(ctx context.Context, id peer.ID, msg *pubsub.Message
 	err := p.decodeMsg(msg.Data, nil, tx)
 	if err != nil {
 		log.Error("validateTx", "decodeMsg err", err)
+		p.ReportPeer(msg.ReceivedFrom, types.PeerBehaviorInvalidTx, "decode tx err:"+err.Error())
 		return pubsub.ValidationReject
 	}
 
@@ -115,5 +124,50 @@ func (p *pubSub) validateTx(ctx context.Context, id peer.ID, msg *pubsub.Message
 		return pubsub.ValidationIgnore
 	}
 
+	if err = checkTx(p.ChainCfg, tx); err != nil {
+		log.Error("validateTx", "checkTx err", err)
+		p.ReportPeer(msg.ReceivedFrom, types.PeerBehaviorInvalidTx, "check tx err:"+err.Error())
+		return pubsub.ValidationReject
+	}
+
 	return pubsub.ValidationAccept
 }
+
+// checkTx 交易的基本检查, 不依赖本地状态, 检查失败说明广播节点发送了非法交易
+// 手续费, 过期等和节点状态相关的检查由mempool完成, 不作为惩罚节点的依据
+func checkTx(cfg *types.Chain33Config, tx *types.Transaction) error {
+	if types.Size(tx) > types.MaxTxSize {
+		return types.ErrTxMsgSizeTooBig
+	}
+	if len(tx.Execer) == 0 {
+		return types.ErrExecNameNotAllow
+	}
+	if tx.Signature == nil {
+		return types.ErrSign
+	}
+	if tx.ChainID != cfg.GetChainID() {
+		return types.ErrTxChainID
+	}
+	_, err := tx.GetTxGroup()
+	return err
+}
+
+// checkBlock 区块的基本检查, 包括区块大小, 交易的基本检查以及交易哈希和区块头一致
+func checkBlock(cfg *types.Chain33Config, block *types.Block) error {
+	if types.Size(block) > types.MaxBlockSize {
+		return types.ErrBlockSize
+	}
+	for _, tx := range block.Txs {
+		if err := checkTx(cfg, tx); err != nil {
+			return err
+		}
+	}
+	height := block.Height
+	if cfg.IsPara() {
+		height = block.MainHeight
+	}
+	if !bytes.Equal(block.TxHash, merkle.CalcMerkleRoot(cfg, height, block.Txs)) {
+		return types.ErrCheckTxHash
+	}
+	return nil
+}
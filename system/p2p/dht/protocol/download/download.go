@@ -75,6 +75,7 @@ ReDownload:
 	block, err := p.downloadBlockFromPeerOld(height, task.Pid)
 	if err != nil {
 		log.Error("handleEventDownloadBlock", "SendRecvPeer", err, "pid", task.Pid)
+		p.ReportPeer(task.Pid, types.PeerBehaviorTimeout, "download block err:"+err.Error())
 		p.releaseJob(task)
 		tasks = tasks.Remove(task)
 		goto ReDownload
@@ -17,6 +17,7 @@ type taskInfo struct {
 	Pid     peer.ID       //节点ID
 	Index   int           // 节点在任务列表中索引，方便下载失败后，把该节点从下载列表中删除
 	Latency time.Duration // 任务所在节点的时延
+	Demoted bool          // 节点评分过低, 排在其他节点之后
 	mtx     sync.Mutex
 }
 
@@ -27,6 +28,9 @@ func (t tasks) Len() int {
 
 //Less Sort from low to high
 func (t tasks) Less(a, b int) bool {
+	if t[a].Demoted != t[b].Demoted {
+		return !t[a].Demoted
+	}
 	return t[a].Latency < t[b].Latency
 }
 
@@ -72,7 +76,7 @@ func (p *Protocol) initJob(pids []string, jobID string) tasks {
 	}
 
 	for _, pID := range pIDs {
-		if pID == p.Host.ID() {
+		if pID == p.Host.ID() || p.PeerBanned(pID) {
 			continue
 		}
 		var job taskInfo
@@ -82,6 +86,7 @@ func (p *Protocol) initJob(pids []string, jobID string) tasks {
 		if job.Latency == 0 { //如果查询不到节点对应的时延，就设置非常大
 			job.Latency = time.Second
 		}
+		job.Demoted = p.PeerDeprioritized(pID)
 		job.TaskNum = 0
 		JobPeerIds = append(JobPeerIds, &job)
 	}
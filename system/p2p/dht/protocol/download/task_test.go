@@ -52,6 +52,15 @@ func testJobs(t *testing.T) {
 	require.Equal(t, myjobs[1].Pid, pid1)
 	require.Equal(t, myjobs[2].Pid, pid3)
 
+	//评分过低的节点排在最后
+	t2.Demoted = true
+	myjobs.Sort()
+	require.Equal(t, myjobs[0].Pid, pid1)
+	require.Equal(t, myjobs[1].Pid, pid3)
+	require.Equal(t, myjobs[2].Pid, pid2)
+	t2.Demoted = false
+	myjobs.Sort()
+
 	//test delete
 	myjobs = myjobs.Remove(&taskInfo{Index: 4})
 	require.Equal(t, 3, myjobs.Len())
@@ -0,0 +1,194 @@
+// Package light 为轻节点提供交易证明和状态证明的p2p协议
+package light
+
+import (
+	"context"
+	"time"
+
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	core "github.com/libp2p/go-libp2p-core/protocol"
+)
+
+var (
+	log = log15.New("module", "p2p.light")
+)
+
+func init() {
+	protocol.RegisterProtocolInitializer(InitProtocol)
+}
+
+const (
+	fetchTxProof    = "/chain33/light-tx-proof/1.0.0"
+	fetchStateProof = "/chain33/light-state-proof/1.0.0"
+)
+
+const (
+	// 单个节点的请求超时
+	fetchTimeout = 10 * time.Second
+	// 整个请求的超时, 小于blockchain模块等待回复的60秒
+	fetchTotalTimeout = 50 * time.Second
+	// 同时请求的最大节点数
+	maxFetchPeers = 8
+)
+
+// Protocol ...
+type Protocol struct {
+	*protocol.P2PEnv
+}
+
+// InitProtocol initials protocol
+func InitProtocol(env *protocol.P2PEnv) {
+	p := &Protocol{
+		P2PEnv: env,
+	}
+	//轻节点本身没有完整数据, 不对外提供证明
+	if !env.ChainCfg.GetModuleConfig().BlockChain.LightMode {
+		protocol.RegisterStreamHandler(p.Host, fetchTxProof, p.handleStreamTxProof)
+		protocol.RegisterStreamHandler(p.Host, fetchStateProof, p.handleStreamStateProof)
+	}
+	protocol.RegisterEventHandler(types.EventFetchTxProof, p.handleEventFetchTxProof)
+	protocol.RegisterEventHandler(types.EventFetchStateProof, p.handleEventFetchStateProof)
+}
+
+func (p *Protocol) handleStreamTxProof(stream network.Stream) {
+	defer stream.Close()
+	var req types.ReqHash
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		log.Error("handleStreamTxProof", "ReadStream err", err)
+		return
+	}
+	resp, err := p.QueryModule("blockchain", types.EventQueryTx, &req)
+	if err != nil {
+		return
+	}
+	detail, ok := resp.(*types.TransactionDetail)
+	if !ok {
+		return
+	}
+	err = protocol.WriteStream(detail, stream)
+	if err != nil {
+		log.Error("handleStreamTxProof", "WriteStream err", err, "remote pid", stream.Conn().RemotePeer().String())
+	}
+}
+
+func (p *Protocol) handleStreamStateProof(stream network.Stream) {
+	defer stream.Close()
+	var req types.StoreGet
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		log.Error("handleStreamStateProof", "ReadStream err", err)
+		return
+	}
+	resp, err := p.QueryModule("store", types.EventStoreGetProof, &req)
+	if err != nil {
+		return
+	}
+	reply, ok := resp.(*types.StoreReplyProof)
+	if !ok {
+		return
+	}
+	err = protocol.WriteStream(reply, stream)
+	if err != nil {
+		log.Error("handleStreamStateProof", "WriteStream err", err, "remote pid", stream.Conn().RemotePeer().String())
+	}
+}
+
+func (p *Protocol) handleEventFetchTxProof(msg *queue.Message) {
+	req := msg.GetData().(*types.FetchProof)
+	resp, err := p.fetchFromPeers(fetchTxProof, req, func() types.Message { return &types.TransactionDetail{} })
+	if err != nil {
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchTxProof, err))
+		return
+	}
+	msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchTxProof, resp))
+}
+
+func (p *Protocol) handleEventFetchStateProof(msg *queue.Message) {
+	req := msg.GetData().(*types.FetchProof)
+	resp, err := p.fetchFromPeers(fetchStateProof, req, func() types.Message { return &types.StoreReplyProof{} })
+	if err != nil {
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchStateProof, err))
+		return
+	}
+	msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchStateProof, resp))
+}
+
+//fetchFromPeers 并发向已连接的节点请求, 按返回的先后交给blockchain模块校验, 返回第一个通过校验的结果
+//整个请求在fetchTotalTimeout内结束, 慢节点不会耗尽blockchain模块等待回复的时间
+func (p *Protocol) fetchFromPeers(pid core.ID, req *types.FetchProof, newResp func() types.Message) (types.Message, error) {
+	peers := p.ConnManager.FetchConnPeers()
+	if len(peers) == 0 {
+		return nil, types.ErrNoPeer
+	}
+	ctx, cancel := context.WithTimeout(p.Ctx, fetchTotalTimeout)
+	defer cancel()
+	type result struct {
+		remote peer.ID
+		resp   types.Message
+		err    error
+	}
+	results := make(chan *result, len(peers))
+	go func() {
+		limit := make(chan struct{}, maxFetchPeers)
+		for _, remote := range peers {
+			select {
+			case limit <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func(remote peer.ID) {
+				defer func() { <-limit }()
+				resp := newResp()
+				err := p.fetchFromPeer(ctx, remote, pid, req.Req, resp)
+				results <- &result{remote: remote, resp: resp, err: err}
+			}(remote)
+		}
+	}()
+	lastErr := types.ErrNoPeer
+	for range peers {
+		select {
+		case r := <-results:
+			err := r.err
+			//校验函数不要求并发安全, 在当前协程中依次校验
+			if err == nil && req.Verify != nil {
+				err = req.Verify(r.resp)
+				if err != nil {
+					lastErr = err
+				}
+			}
+			if err == nil {
+				return r.resp, nil
+			}
+			log.Debug("fetchFromPeers", "protocol", pid, "remote", r.remote.Pretty(), "err", err)
+		case <-ctx.Done():
+			if lastErr == types.ErrNoPeer {
+				lastErr = types.ErrTimeout
+			}
+			return nil, lastErr
+		}
+	}
+	return nil, lastErr
+}
+
+func (p *Protocol) fetchFromPeer(ctx context.Context, remote peer.ID, pid core.ID, req, resp types.Message) error {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+	stream, err := p.Host.NewStream(ctx, remote, pid)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	deadline, _ := ctx.Deadline()
+	_ = stream.SetDeadline(deadline)
+	err = protocol.WriteStream(req, stream)
+	if err != nil {
+		return err
+	}
+	return protocol.ReadStream(resp, stream)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/33cn/chain33/system/p2p/dht/protocol"
 	"github.com/33cn/chain33/types"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	kbt "github.com/libp2p/go-libp2p-kbucket"
 	"github.com/multiformats/go-multiaddr"
 )
@@ -132,6 +133,10 @@ func (p *Protocol) handleEventPeerInfo(msg *queue.Message) {
 	peers := p.RoutingTable.NearestPeers(kbt.ConvertPeerID(p.Host.ID()), maxPeers)
 	var peerList types.PeerList
 	for _, pid := range peers {
+		//评分过低的节点不参与区块同步
+		if p.PeerDeprioritized(pid) {
+			continue
+		}
 		if info := p.PeerInfoManager.Fetch(pid); info != nil {
 			peerList.Peers = append(peerList.Peers, info)
 		}
@@ -151,6 +156,55 @@ func (p *Protocol) handleEventNetProtocols(msg *queue.Message) {
 	msg.Reply(p.QueueClient.NewMessage("rpc", types.EventNetProtocols, bandProtocols))
 }
 
+func (p *Protocol) handleEventReportPeer(msg *queue.Message) {
+	req, ok := msg.GetData().(*types.ReportPeer)
+	if !ok {
+		return
+	}
+	pid, err := peer.Decode(req.Pid)
+	if err != nil {
+		log.Error("handleEventReportPeer", "pid", req.Pid, "decode err", err)
+		return
+	}
+	p.ReportPeer(pid, req.Behavior, req.Reason)
+}
+
+func (p *Protocol) handleEventGetPeerScores(msg *queue.Message) {
+	list := &types.PeerScoreList{}
+	if p.PeerScore != nil {
+		list = p.PeerScore.List()
+	}
+	msg.Reply(p.QueueClient.NewMessage("rpc", types.EventGetPeerScores, list))
+}
+
+func (p *Protocol) handleEventBanPeer(msg *queue.Message) {
+	req := msg.GetData().(*types.ReqBanPeer)
+	pid, err := peer.Decode(req.Pid)
+	if err != nil || p.PeerScore == nil {
+		if err == nil {
+			err = types.ErrNotSupport
+		}
+		msg.Reply(p.QueueClient.NewMessage("rpc", types.EventBanPeer, &types.Reply{Msg: []byte(err.Error())}))
+		return
+	}
+	p.PeerScore.Ban(pid, time.Duration(req.Seconds)*time.Second, "manual ban")
+	msg.Reply(p.QueueClient.NewMessage("rpc", types.EventBanPeer, &types.Reply{IsOk: true}))
+}
+
+func (p *Protocol) handleEventUnbanPeer(msg *queue.Message) {
+	req := msg.GetData().(*types.ReqString)
+	pid, err := peer.Decode(req.Data)
+	if err != nil || p.PeerScore == nil {
+		if err == nil {
+			err = types.ErrNotSupport
+		}
+		msg.Reply(p.QueueClient.NewMessage("rpc", types.EventUnbanPeer, &types.Reply{Msg: []byte(err.Error())}))
+		return
+	}
+	p.PeerScore.Unban(pid)
+	msg.Reply(p.QueueClient.NewMessage("rpc", types.EventUnbanPeer, &types.Reply{IsOk: true}))
+}
+
 func (p *Protocol) handleEventNetInfo(msg *queue.Message) {
 	insize, outsize := p.ConnManager.BoundSize()
 	var netinfo types.NodeNetInfo
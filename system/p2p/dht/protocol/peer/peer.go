@@ -75,6 +75,10 @@ func InitProtocol(env *protocol.P2PEnv) {
 	protocol.RegisterEventHandler(types.EventPeerInfo, p.handleEventPeerInfo)
 	protocol.RegisterEventHandler(types.EventGetNetInfo, p.handleEventNetInfo)
 	protocol.RegisterEventHandler(types.EventNetProtocols, p.handleEventNetProtocols)
+	protocol.RegisterEventHandler(types.EventReportPeer, p.handleEventReportPeer)
+	protocol.RegisterEventHandler(types.EventGetPeerScores, p.handleEventGetPeerScores)
+	protocol.RegisterEventHandler(types.EventBanPeer, p.handleEventBanPeer)
+	protocol.RegisterEventHandler(types.EventUnbanPeer, p.handleEventUnbanPeer)
 
 	//绑定订阅事件与相关处理函数
 	protocol.RegisterEventHandler(types.EventSubTopic, p.handleEventSubTopic)
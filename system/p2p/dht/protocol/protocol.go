@@ -35,6 +35,7 @@ type P2PEnv struct {
 	Pubsub          *extension.PubSub
 	RoutingTable    *kbt.RoutingTable
 	Discovery       discovery.Discovery
+	PeerScore       IPeerScore
 }
 
 type iLRU interface {
@@ -60,6 +61,34 @@ type IConnManager interface {
 	RateCalculate(ratebytes float64) string
 }
 
+// IPeerScore is interface of ScoreManager
+type IPeerScore interface {
+	Report(pid peer.ID, behavior int32, reason string)
+	Ban(pid peer.ID, duration time.Duration, reason string)
+	Unban(pid peer.ID)
+	IsBanned(pid peer.ID) bool
+	IsDeprioritized(pid peer.ID) bool
+	List() *types.PeerScoreList
+}
+
+// ReportPeer 上报节点不良行为, 未配置评分管理时忽略
+func (p *P2PEnv) ReportPeer(pid peer.ID, behavior int32, reason string) {
+	if p.PeerScore == nil {
+		return
+	}
+	p.PeerScore.Report(pid, behavior, reason)
+}
+
+// PeerBanned 节点是否已被封禁
+func (p *P2PEnv) PeerBanned(pid peer.ID) bool {
+	return p.PeerScore != nil && p.PeerScore.IsBanned(pid)
+}
+
+// PeerDeprioritized 节点分数过低或已被封禁, 不作为优先节点
+func (p *P2PEnv) PeerDeprioritized(pid peer.ID) bool {
+	return p.PeerScore != nil && (p.PeerScore.IsBanned(pid) || p.PeerScore.IsDeprioritized(pid))
+}
+
 // QueryModule sends message to other module and waits response
 func (p *P2PEnv) QueryModule(topic string, ty int64, data interface{}) (interface{}, error) {
 	msg := p.QueueClient.NewMessage(topic, ty, data)
@@ -57,10 +57,28 @@ type P2PSubConfig struct {
 
 	// pubsub配置
 	PubSub PubSubConfig `json:"pubsub,omitempty"`
+	// 节点评分配置
+	PeerScore PeerScoreConfig `json:"peerScore,omitempty"`
 	//启动私有网络，只有相同配置的节点才能连接，多用于联盟链需求，创建方式 hex.Encode([32]byte),32字节的十六进制编码字符串
 	Psk string `json:"psk"`
 }
 
+// PeerScoreConfig 节点评分配置, 分数从0开始, 不良行为扣分, 随时间逐渐恢复
+type PeerScoreConfig struct {
+	// 低于该分数的节点不作为同步和下载的优先节点, 默认-20
+	DeprioritizeScore int64 `json:"deprioritizeScore,omitempty"`
+	// 低于该分数断开连接, 默认-50
+	DisconnectScore int64 `json:"disconnectScore,omitempty"`
+	// 低于该分数封禁节点, 默认-100
+	BanScore int64 `json:"banScore,omitempty"`
+	// 封禁时长, 单位秒, 默认3600
+	BanSeconds int64 `json:"banSeconds,omitempty"`
+	// 每分钟恢复的分数, 默认1
+	RecoverPerMinute int64 `json:"recoverPerMinute,omitempty"`
+	// 单个节点的最大接收速率, 单位KB/s, 超过后扣分, 0表示不限制
+	MaxPeerRateIn int64 `json:"maxPeerRateIn,omitempty"`
+}
+
 // PubSubConfig pubsub config
 type PubSubConfig struct {
 
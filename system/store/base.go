@@ -6,10 +6,12 @@ package store
 
 import (
 	"sync"
+	"time"
 
 	dbm "github.com/33cn/chain33/common/db"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/metrics"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
@@ -57,6 +59,11 @@ type SubStore interface {
 	CommitUpgrade(hash *types.ReqHash) ([]byte, error)
 }
 
+// ProofStore 支持状态证明的store, 用于向轻节点提供mavl证明
+type ProofStore interface {
+	GetProof(req *types.StoreGet) (*types.StoreReplyProof, error)
+}
+
 // BaseStore 基础的store结构体
 type BaseStore struct {
 	db      dbm.DB
@@ -141,6 +148,7 @@ func (store *BaseStore) processMessage(msg *queue.Message) {
 			req := msg.GetData().(*types.ReqHash)
 			var hash []byte
 			var err error
+			defer metrics.UpdateTimerSince("chain33/store/commit", time.Now())
 			if req.Upgrade {
 				hash, err = store.child.CommitUpgrade(req)
 			} else {
@@ -189,6 +197,22 @@ func (store *BaseStore) processMessage(msg *queue.Message) {
 				msg.Reply(client.NewMessage("", types.EventStoreDel, &types.ReplyHash{Hash: hash}))
 			}
 		}()
+	} else if msg.Ty == types.EventStoreGetProof {
+		store.wg.Add(1)
+		go func() {
+			defer store.wg.Done()
+			ps, ok := store.child.(ProofStore)
+			if !ok {
+				msg.Reply(client.NewMessage("", types.EventStoreGetProof, types.ErrActionNotSupport))
+				return
+			}
+			reply, err := ps.GetProof(msg.GetData().(*types.StoreGet))
+			if err != nil {
+				msg.Reply(client.NewMessage("", types.EventStoreGetProof, err))
+				return
+			}
+			msg.Reply(client.NewMessage("", types.EventStoreGetProof, reply))
+		}()
 	} else if msg.Ty == types.EventStoreList {
 		store.wg.Add(1)
 		go func() {
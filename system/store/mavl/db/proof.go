@@ -66,6 +66,67 @@ func ReadProof(roothash []byte, leafhash []byte, data []byte) (*Proof, error) {
 	return &merkleAvlProof, nil
 }
 
+// VerifyAbsence 校验key不存在的证明, left和right是root下相邻的两个叶子节点并且left.Key < key < right.Key
+// key小于所有叶子节点时left为空, right必须是最左侧的叶子节点, 大于所有叶子节点时相反
+func VerifyAbsence(key []byte, root []byte, left, right *types.StoreProof) bool {
+	var lpath, rpath []bool
+	if left != nil {
+		path, ok := proofPath(root, left)
+		if !ok || bytes.Compare(left.Key, key) >= 0 {
+			return false
+		}
+		lpath = path
+	}
+	if right != nil {
+		path, ok := proofPath(root, right)
+		if !ok || bytes.Compare(right.Key, key) <= 0 {
+			return false
+		}
+		rpath = path
+	}
+	switch {
+	case left == nil && right == nil:
+		return false
+	case left == nil:
+		return allSame(rpath, false)
+	case right == nil:
+		return allSame(lpath, true)
+	}
+	// 从根节点开始路径相同, 分叉之后left进入左子树并一直向右, right进入右子树并一直向左
+	i := 0
+	for i < len(lpath) && i < len(rpath) && lpath[i] == rpath[i] {
+		i++
+	}
+	if i == len(lpath) || i == len(rpath) || lpath[i] || !rpath[i] {
+		return false
+	}
+	return allSame(lpath[i+1:], true) && allSame(rpath[i+1:], false)
+}
+
+// proofPath 校验叶子节点的存在证明, 返回从根节点到叶子节点每一层是否进入右子树
+func proofPath(root []byte, leaf *types.StoreProof) ([]bool, bool) {
+	leafNode := types.LeafNode{Key: leaf.Key, Value: leaf.Value, Height: 0, Size: 1}
+	proof, err := ReadProof(root, leafNode.Hash(), leaf.Proof)
+	if err != nil || !proof.Verify(leaf.Key, leaf.Value, root) {
+		return nil, false
+	}
+	path := make([]bool, len(proof.InnerNodes))
+	for i, branch := range proof.InnerNodes {
+		// 子节点在左侧时LeftHash为空
+		path[len(path)-1-i] = len(branch.LeftHash) != 0
+	}
+	return path, true
+}
+
+func allSame(path []bool, right bool) bool {
+	for _, r := range path {
+		if r != right {
+			return false
+		}
+	}
+	return true
+}
+
 // InnerNodeProofHash 计算inner节点的hash
 func InnerNodeProofHash(childHash []byte, branch *types.InnerNode) []byte {
 	var innernode types.InnerNode
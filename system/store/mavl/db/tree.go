@@ -294,6 +294,29 @@ func (t *Tree) Proof(key []byte) (value []byte, proofBytes []byte, exists bool)
 	return value, proofBytes, true
 }
 
+// ProofAbsence 获取不存在的key左右相邻叶子节点的proof证明, key小于或者大于所有叶子节点时只有一侧
+func (t *Tree) ProofAbsence(key []byte) (left *types.StoreProof, right *types.StoreProof) {
+	index, _, exists := t.Get(key)
+	if exists || t.root == nil {
+		return nil, nil
+	}
+	leafProof := func(index int32) *types.StoreProof {
+		k, _ := t.GetByIndex(index)
+		value, proof, exists := t.Proof(k)
+		if !exists {
+			return nil
+		}
+		return &types.StoreProof{Key: k, Value: value, Proof: proof}
+	}
+	if index > 0 {
+		left = leafProof(index - 1)
+	}
+	if index < t.Size() {
+		right = leafProof(index)
+	}
+	return left, right
+}
+
 // Remove 删除key对应的节点
 func (t *Tree) Remove(key []byte) (value []byte, removed bool) {
 	if t.root == nil {
@@ -535,6 +535,49 @@ func TestIAVLProof(t *testing.T) {
 	db.Close()
 }
 
+func TestProofAbsence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := db.NewDB("mavltree", "leveldb", dir, 100)
+	tree := NewTree(db, true, nil)
+	for i := 0; i < 40; i += 2 {
+		tree.Set([]byte(fmt.Sprintf("key%02d", i)), []byte(fmt.Sprintf("value%02d", i)))
+	}
+	root := tree.Save()
+
+	for i := 1; i < 40; i += 2 {
+		key := []byte(fmt.Sprintf("key%02d", i))
+		left, right := tree.ProofAbsence(key)
+		require.NotNil(t, left)
+		assert.True(t, VerifyAbsence(key, root, left, right), string(key))
+	}
+	//比所有key小或者大时只有一侧
+	left, right := tree.ProofAbsence([]byte("a"))
+	assert.Nil(t, left)
+	assert.True(t, VerifyAbsence([]byte("a"), root, left, right))
+	left, right = tree.ProofAbsence([]byte("z"))
+	assert.Nil(t, right)
+	assert.True(t, VerifyAbsence([]byte("z"), root, left, right))
+	//存在的key没有不存在证明
+	left, right = tree.ProofAbsence([]byte("key02"))
+	assert.Nil(t, left)
+	assert.Nil(t, right)
+
+	//不相邻的叶子节点, 缺少一侧, 顺序颠倒或者篡改的证明都无法通过
+	key := []byte("key05")
+	left, right = tree.ProofAbsence(key)
+	farLeft, _ := tree.ProofAbsence([]byte("key01"))
+	assert.False(t, VerifyAbsence(key, root, farLeft, right))
+	assert.False(t, VerifyAbsence(key, root, left, nil))
+	assert.False(t, VerifyAbsence(key, root, nil, right))
+	assert.False(t, VerifyAbsence(key, root, right, left))
+	assert.False(t, VerifyAbsence([]byte("key04"), root, left, right))
+	bad := *right
+	bad.Value = []byte("bad")
+	assert.False(t, VerifyAbsence(key, root, left, &bad))
+}
+
 func TestSetAndGetKVPair(t *testing.T) {
 	dir, err := ioutil.TempDir("", "datastore")
 	require.NoError(t, err)
@@ -120,6 +120,26 @@ func (mavls *Store) Get(datas *types.StoreGet) [][]byte {
 	return values
 }
 
+// GetProof 获取指定状态下keys的mavl证明, 不存在的key返回左右相邻叶子节点的证明
+func (mavls *Store) GetProof(req *types.StoreGet) (*types.StoreReplyProof, error) {
+	tree := mavl.NewTree(mavls.GetDB(), true, mavls.treeCfg)
+	err := tree.Load(req.StateHash)
+	if err != nil {
+		return nil, err
+	}
+	reply := &types.StoreReplyProof{StateHash: req.StateHash}
+	for _, key := range req.Keys {
+		value, proof, exist := tree.Proof(key)
+		if !exist {
+			left, right := tree.ProofAbsence(key)
+			reply.Proofs = append(reply.Proofs, &types.StoreProof{Key: key, Left: left, Right: right})
+			continue
+		}
+		reply.Proofs = append(reply.Proofs, &types.StoreProof{Key: key, Value: value, Proof: proof})
+	}
+	return reply, nil
+}
+
 // MemSet set keys values to memcory mavl, return root hash and error
 func (mavls *Store) MemSet(datas *types.StoreSet, sync bool) ([]byte, error) {
 	beg := types.Now()
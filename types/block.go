@@ -6,8 +6,6 @@ package types
 
 import (
 	"bytes"
-	"runtime"
-	"sync"
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/crypto"
@@ -93,12 +91,18 @@ func (block *Block) getHeaderHashNew() *Header {
 
 // VerifySignature 验证区块和交易的签名,支持指定需要验证的交易
 func VerifySignature(cfg *Chain33Config, block *Block, txs []*Transaction) bool {
+	return VerifySignatureByState(cfg, block, txs, nil)
+}
+
+// VerifySignatureByState 验证区块和交易的签名, parentState为父区块执行后的状态哈希,
+// 与高度相关的签名(如证书)使用该状态下的链上配置校验, 执行侧链上的区块时也能使用正确的配置
+func VerifySignatureByState(cfg *Chain33Config, block *Block, txs []*Transaction, parentState []byte) bool {
 	//检查区块的签名
-	if !block.verifySignature(cfg) {
+	if !block.verifySignature(cfg, parentState) {
 		return false
 	}
 	//检查交易的签名
-	return verifyTxsSignature(txs, block.GetHeight())
+	return verifyTxsSignature(txs, block.GetHeight(), parentState)
 }
 
 // CheckSign 检测block的签名,以及交易的签名
@@ -106,89 +110,28 @@ func (block *Block) CheckSign(cfg *Chain33Config) bool {
 	return VerifySignature(cfg, block, block.Txs)
 }
 
-func (block *Block) verifySignature(cfg *Chain33Config) bool {
+func (block *Block) verifySignature(cfg *Chain33Config, parentState []byte) bool {
 	if block.GetSignature() == nil {
 		return true
 	}
 	hash := block.Hash(cfg)
-	return CheckSign(hash, "", block.GetSignature(), block.GetHeight())
-}
-
-func verifyTxsSignature(txs []*Transaction, blockHeight int64) bool {
-
-	//没有需要要验签的交易，直接返回
-	if len(txs) == 0 {
-		return true
-	}
-	done := make(chan struct{})
-	defer close(done)
-	taskes := gen(done, txs)
-	cpuNum := runtime.NumCPU()
-	// Start a fixed number of goroutines to read and digest files.
-	c := make(chan result) // HLc
-	var wg sync.WaitGroup
-	wg.Add(cpuNum)
-	for i := 0; i < cpuNum; i++ {
-		go func() {
-			checksign(done, taskes, c, blockHeight) // HLc
-			wg.Done()
-		}()
-	}
-	go func() {
-		wg.Wait()
-		close(c) // HLc
-	}()
-	// End of pipeline. OMIT
-	for r := range c {
-		if !r.isok {
-			return false
-		}
-	}
-	return true
-}
-
-func gen(done <-chan struct{}, task []*Transaction) <-chan *Transaction {
-	ch := make(chan *Transaction)
-	go func() {
-		defer func() {
-			close(ch)
-		}()
-		for i := 0; i < len(task); i++ {
-			select {
-			case ch <- task[i]:
-			case <-done:
-				return
-			}
-		}
-	}()
-	return ch
-}
-
-type result struct {
-	isok bool
-}
-
-func check(data *Transaction, blockHeight int64) bool {
-	return data.CheckSign(blockHeight)
-}
-
-func checksign(done <-chan struct{}, taskes <-chan *Transaction, c chan<- result, blockHeight int64) {
-	for task := range taskes {
-		select {
-		case c <- result{check(task, blockHeight)}:
-		case <-done:
-			return
-		}
-	}
+	return checkSign(hash, "", block.GetSignature(), block.GetHeight(), parentState)
 }
 
 // CheckSign 检测签名
 func CheckSign(data []byte, execer string, sign *Signature, blockHeight int64) bool {
+	return checkSign(data, execer, sign, blockHeight, nil)
+}
+
+func checkSign(data []byte, execer string, sign *Signature, blockHeight int64, parentState []byte) bool {
 	//GetDefaultSign: 系统内置钱包，非插件中的签名
 	c, err := crypto.New(GetSignName(execer, int(sign.Ty)), crypto.WithNewOptionEnableCheck(blockHeight))
 	if err != nil {
 		return false
 	}
+	if hv, ok := c.(crypto.HeightValidator); ok {
+		return hv.ValidateByHeight(data, sign.Pubkey, sign.Signature, blockHeight, parentState) == nil
+	}
 	return c.Validate(data, sign.Pubkey, sign.Signature) == nil
 }
 
@@ -258,6 +201,33 @@ func (header *Header) Size() int {
 	return Size(header)
 }
 
+// CalcHash 根据区块头字段重新计算区块哈希, 与Block.Hash计算方式一致, 交易个数取自header.TxCount
+func (header *Header) CalcHash(cfg *Chain33Config) []byte {
+	head := &Header{}
+	head.Version = header.Version
+	head.ParentHash = header.ParentHash
+	head.TxHash = header.TxHash
+	head.BlockTime = header.BlockTime
+	head.Height = header.Height
+	if cfg.IsFork(header.Height, "ForkBlockHash") {
+		head.Difficulty = header.Difficulty
+		head.StateHash = header.StateHash
+		head.TxCount = header.TxCount
+	}
+	data, err := proto.Marshal(head)
+	if err != nil {
+		panic(err)
+	}
+	return common.Sha256(data)
+}
+
+// FetchProof 轻节点向全节点请求证明, Req为EventFetchTxProof或EventFetchStateProof的请求
+// Verify由blockchain模块提供, 使用本地区块头校验peer返回的证明, 校验失败时p2p继续向下一个peer请求
+type FetchProof struct {
+	Req    Message
+	Verify func(Message) error
+}
+
 // Size 获取paraTxDetail的Size
 func (paraTxDetail *ParaTxDetail) Size() int {
 	return Size(paraTxDetail)
@@ -4,7 +4,11 @@
 
 package types
 
-import "github.com/33cn/chain33/common/crypto"
+import (
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/record"
+	"github.com/33cn/chain33/common/trace"
+)
 
 // Config 配置信息
 type Config struct {
@@ -22,6 +26,7 @@ type Config struct {
 	TestNet          bool           `json:"testNet,omitempty"`
 	FixTime          bool           `json:"fixTime,omitempty"`
 	TxHeight         bool           `json:"txHeight,omitempty"`
+	TxSeqNonce       bool           `json:"txSeqNonce,omitempty"`
 	Pprof            *Pprof         `json:"pprof,omitempty"`
 	Fork             *ForkList      `json:"fork,omitempty"`
 	Health           *HealthCheck   `json:"health,omitempty"`
@@ -29,6 +34,8 @@ type Config struct {
 	DisableForkCheck bool           `json:"disableForkCheck,omitempty"`
 	EnableParaFork   bool           `json:"enableParaFork,omitempty"`
 	Metrics          *Metrics       `json:"metrics,omitempty"`
+	Trace            *trace.Config  `json:"trace,omitempty"`
+	Record           *record.Config `json:"record,omitempty"`
 	ChainID          int32          `json:"chainID,omitempty"`
 	AddrVer          byte           `json:"addrVer,omitempty"`
 	Crypto           *crypto.Config `json:"crypto,omitempty"`
@@ -107,6 +114,8 @@ type Mempool struct {
 	MaxTxFee int64 `json:"maxTxFee,omitempty"`
 	// 目前execCheck效率较低，支持关闭交易execCheck，提升性能
 	DisableExecCheck bool `json:"disableExecCheck,omitempty"`
+	// 已验签交易的缓存数量, 区块校验时跳过mempool中已经验签的交易, 默认102400, 负数表示关闭缓存
+	SigCacheSize int64 `json:"sigCacheSize,omitempty"`
 }
 
 // Consensus 配置
@@ -140,6 +149,8 @@ type Wallet struct {
 	// 钱包发送交易签名方式
 	SignType string `json:"signType,omitempty"`
 	CoinType string `json:"coinType,omitempty"`
+	// 私钥以及种子加密使用的密钥派生算法, 支持scrypt/argon2id, 默认scrypt
+	KeyStoreKDF string `json:"keyStoreKDF,omitempty"`
 }
 
 // Store 配置
@@ -203,6 +214,8 @@ type BlockChain struct {
 	EnableIfDelLocalChunk bool `json:"enableIfDelLocalChunk,omitempty"`
 	// 使能注册推送区块、区块头或交易回执
 	EnablePushSubscribe bool `json:"EnablePushSubscribe,omitempty"`
+	// 推送订阅者的最大数量, 默认100, 支持配置热加载
+	MaxPushSubscriber int `json:"maxPushSubscriber,omitempty"`
 	// 当前活跃区块的缓存数量
 	MaxActiveBlockNum int `json:"maxActiveBlockNum,omitempty"`
 	// 当前活跃区块的缓存大小M为单位
@@ -217,6 +230,14 @@ type BlockChain struct {
 	DisableBlockBroadcast bool `json:"disableBlockBroadcast,omitempty"`
 	//关闭本地和ntp server的时钟偏移检查
 	DisableClockDriftCheck bool `json:"disableClockDriftCheck,omitempty"`
+	//轻节点模式, 只同步和验证区块头, 交易和状态查询通过全节点的证明验证
+	LightMode bool `json:"lightMode,omitempty"`
+	//并行流水线同步, 先同步并校验区块头, 再从多个节点并行下载区块并按顺序执行
+	EnablePipelineSync bool `json:"enablePipelineSync,omitempty"`
+	//流水线同步每个下载窗口的区块数, 默认128
+	PipelineWindowSize int64 `json:"pipelineWindowSize,omitempty"`
+	//流水线同步同时下载的最大窗口数, 默认16
+	PipelineMaxWindows int64 `json:"pipelineMaxWindows,omitempty"`
 }
 
 // P2P 配置
@@ -289,6 +310,19 @@ type HealthCheck struct {
 	ListenAddr     string `json:"listenAddr,omitempty"`
 	CheckInterval  uint32 `json:"checkInterval,omitempty"`
 	UnSyncMaxTimes uint32 `json:"unSyncMaxTimes,omitempty"`
+	// HTTPAddr http存活以及就绪检查的监听地址, 为空时不启用
+	HTTPAddr string `json:"httpAddr,omitempty"`
+	// 以下检查阈值为0时使用默认值, 为负数时不做该项检查
+	// MaxHeightLag 本节点高度落后其他节点最大高度的阈值, 默认10
+	MaxHeightLag int64 `json:"maxHeightLag,omitempty"`
+	// MinPeers 最少连接节点数, 默认1
+	MinPeers int64 `json:"minPeers,omitempty"`
+	// MaxMempoolSize mempool交易数阈值, 默认不检查
+	MaxMempoolSize int64 `json:"maxMempoolSize,omitempty"`
+	// MaxBlockAge 最新区块距今的最大秒数, 默认不检查
+	MaxBlockAge int64 `json:"maxBlockAge,omitempty"`
+	// DisableNtpCheck 不检查ntp时钟同步
+	DisableNtpCheck bool `json:"disableNtpCheck,omitempty"`
 }
 
 // Metrics 相关测量配置信息
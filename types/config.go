@@ -249,6 +249,7 @@ func (c *Chain33Config) chain33CfgInit(cfg *Config) {
 		}
 		//TxHeight
 		c.setChainConfig("TxHeight", cfg.TxHeight)
+		c.setChainConfig("TxSeqNonce", cfg.TxSeqNonce)
 	}
 	if c.needSetForkZero() { //local 只用于单元测试
 		if c.isLocal() {
@@ -0,0 +1,103 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: config.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReplyReloadConfig 配置热加载结果, 配置项为toml 展开之后的key, 如rpc.whitelist
+type ReplyReloadConfig struct {
+	// 已经通知各个模块生效的配置项
+	Applied []string `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	// 已经修改但是需要重启节点才能生效的配置项
+	RestartRequired []string `protobuf:"bytes,2,rep,name=restartRequired,proto3" json:"restartRequired,omitempty"`
+	// 共识相关不允许热加载的配置项, 不为空时整个配置文件都不会生效
+	Refused              []string `protobuf:"bytes,3,rep,name=refused,proto3" json:"refused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyReloadConfig) Reset()         { *m = ReplyReloadConfig{} }
+func (m *ReplyReloadConfig) String() string { return proto.CompactTextString(m) }
+func (*ReplyReloadConfig) ProtoMessage()    {}
+func (*ReplyReloadConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{0}
+}
+
+func (m *ReplyReloadConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyReloadConfig.Unmarshal(m, b)
+}
+func (m *ReplyReloadConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyReloadConfig.Marshal(b, m, deterministic)
+}
+func (m *ReplyReloadConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyReloadConfig.Merge(m, src)
+}
+func (m *ReplyReloadConfig) XXX_Size() int {
+	return xxx_messageInfo_ReplyReloadConfig.Size(m)
+}
+func (m *ReplyReloadConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyReloadConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyReloadConfig proto.InternalMessageInfo
+
+func (m *ReplyReloadConfig) GetApplied() []string {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+func (m *ReplyReloadConfig) GetRestartRequired() []string {
+	if m != nil {
+		return m.RestartRequired
+	}
+	return nil
+}
+
+func (m *ReplyReloadConfig) GetRefused() []string {
+	if m != nil {
+		return m.Refused
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ReplyReloadConfig)(nil), "types.ReplyReloadConfig")
+}
+
+func init() {
+	proto.RegisterFile("config.proto", fileDescriptor_3eaf2c85e69e9ea4)
+}
+
+var fileDescriptor_3eaf2c85e69e9ea4 = []byte{
+	// 158 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0xce, 0xcf, 0x4b,
+	0xcb, 0x4c, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x56,
+	0x2a, 0xe4, 0x12, 0x0c, 0x4a, 0x2d, 0xc8, 0xa9, 0x0c, 0x4a, 0xcd, 0xc9, 0x4f, 0x4c, 0x71, 0x06,
+	0xab, 0x10, 0x92, 0xe0, 0x62, 0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4d, 0x91, 0x60, 0x54, 0x60,
+	0xd6, 0xe0, 0x0c, 0x82, 0x71, 0x85, 0x34, 0xb8, 0xf8, 0x8b, 0x52, 0x8b, 0x4b, 0x12, 0x8b, 0x4a,
+	0x82, 0x52, 0x0b, 0x4b, 0x33, 0x8b, 0x52, 0x53, 0x24, 0x98, 0xc0, 0x2a, 0xd0, 0x85, 0x41, 0x66,
+	0x14, 0xa5, 0xa6, 0x95, 0x16, 0xa7, 0xa6, 0x48, 0x30, 0x43, 0xcc, 0x80, 0x72, 0x9d, 0xe4, 0xa3,
+	0x64, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x8d, 0x8d, 0x93, 0xf3,
+	0xf4, 0x93, 0x33, 0x12, 0x33, 0xf3, 0x8c, 0x8d, 0xf5, 0xc1, 0x6e, 0x4a, 0x62, 0x03, 0xbb, 0xd0,
+	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xd2, 0x9a, 0xe0, 0xb1, 0x00, 0x00, 0x00,
+}
@@ -123,6 +123,15 @@ var SystemLog = map[int64]*LogInfo{
 	TyLogBurn:            {reflect.TypeOf(ReceiptAccountBurn{}), "LogBurn"},
 }
 
+//PeerBehavior 节点不良行为类型, 用于p2p节点评分
+const (
+	PeerBehaviorSyncFail     = 1 //同步的区块执行失败
+	PeerBehaviorInvalidBlock = 2 //广播非法区块
+	PeerBehaviorInvalidTx    = 3 //广播非法交易
+	PeerBehaviorTimeout      = 4 //请求超时
+	PeerBehaviorBandwidth    = 5 //带宽占用过高
+)
+
 //exec type
 const (
 	ExecErr  = 0
@@ -729,6 +729,126 @@ func (m *StoreReplyValue) GetValues() [][]byte {
 	return nil
 }
 
+// 指定状态下key的mavl证明, proof为空表示key不存在
+type StoreProof struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Proof []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// key不存在时为左右相邻叶子节点的存在证明, key小于或者大于所有叶子节点时只有一侧
+	Left                 *StoreProof `protobuf:"bytes,4,opt,name=left,proto3" json:"left,omitempty"`
+	Right                *StoreProof `protobuf:"bytes,5,opt,name=right,proto3" json:"right,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *StoreProof) Reset()         { *m = StoreProof{} }
+func (m *StoreProof) String() string { return proto.CompactTextString(m) }
+func (*StoreProof) ProtoMessage()    {}
+func (*StoreProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{13}
+}
+
+func (m *StoreProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreProof.Unmarshal(m, b)
+}
+func (m *StoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreProof.Marshal(b, m, deterministic)
+}
+func (m *StoreProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreProof.Merge(m, src)
+}
+func (m *StoreProof) XXX_Size() int {
+	return xxx_messageInfo_StoreProof.Size(m)
+}
+func (m *StoreProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreProof proto.InternalMessageInfo
+
+func (m *StoreProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StoreProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StoreProof) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *StoreProof) GetLeft() *StoreProof {
+	if m != nil {
+		return m.Left
+	}
+	return nil
+}
+
+func (m *StoreProof) GetRight() *StoreProof {
+	if m != nil {
+		return m.Right
+	}
+	return nil
+}
+
+type StoreReplyProof struct {
+	StateHash            []byte        `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Proofs               []*StoreProof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StoreReplyProof) Reset()         { *m = StoreReplyProof{} }
+func (m *StoreReplyProof) String() string { return proto.CompactTextString(m) }
+func (*StoreReplyProof) ProtoMessage()    {}
+func (*StoreReplyProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{14}
+}
+
+func (m *StoreReplyProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreReplyProof.Unmarshal(m, b)
+}
+func (m *StoreReplyProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreReplyProof.Marshal(b, m, deterministic)
+}
+func (m *StoreReplyProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreReplyProof.Merge(m, src)
+}
+func (m *StoreReplyProof) XXX_Size() int {
+	return xxx_messageInfo_StoreReplyProof.Size(m)
+}
+func (m *StoreReplyProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreReplyProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreReplyProof proto.InternalMessageInfo
+
+func (m *StoreReplyProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *StoreReplyProof) GetProofs() []*StoreProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 type StoreList struct {
 	StateHash            []byte   `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
 	Start                []byte   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
@@ -745,7 +865,7 @@ func (m *StoreList) Reset()         { *m = StoreList{} }
 func (m *StoreList) String() string { return proto.CompactTextString(m) }
 func (*StoreList) ProtoMessage()    {}
 func (*StoreList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{13}
+	return fileDescriptor_8817812184a13374, []int{15}
 }
 
 func (m *StoreList) XXX_Unmarshal(b []byte) error {
@@ -827,7 +947,7 @@ func (m *StoreListReply) Reset()         { *m = StoreListReply{} }
 func (m *StoreListReply) String() string { return proto.CompactTextString(m) }
 func (*StoreListReply) ProtoMessage()    {}
 func (*StoreListReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{14}
+	return fileDescriptor_8817812184a13374, []int{16}
 }
 
 func (m *StoreListReply) XXX_Unmarshal(b []byte) error {
@@ -923,7 +1043,7 @@ func (m *PruneData) Reset()         { *m = PruneData{} }
 func (m *PruneData) String() string { return proto.CompactTextString(m) }
 func (*PruneData) ProtoMessage()    {}
 func (*PruneData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{15}
+	return fileDescriptor_8817812184a13374, []int{17}
 }
 
 func (m *PruneData) XXX_Unmarshal(b []byte) error {
@@ -963,7 +1083,7 @@ func (m *StoreValuePool) Reset()         { *m = StoreValuePool{} }
 func (m *StoreValuePool) String() string { return proto.CompactTextString(m) }
 func (*StoreValuePool) ProtoMessage()    {}
 func (*StoreValuePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{16}
+	return fileDescriptor_8817812184a13374, []int{18}
 }
 
 func (m *StoreValuePool) XXX_Unmarshal(b []byte) error {
@@ -1005,6 +1125,8 @@ func init() {
 	proto.RegisterType((*StoreSetWithSync)(nil), "types.StoreSetWithSync")
 	proto.RegisterType((*StoreGet)(nil), "types.StoreGet")
 	proto.RegisterType((*StoreReplyValue)(nil), "types.StoreReplyValue")
+	proto.RegisterType((*StoreProof)(nil), "types.StoreProof")
+	proto.RegisterType((*StoreReplyProof)(nil), "types.StoreReplyProof")
 	proto.RegisterType((*StoreList)(nil), "types.StoreList")
 	proto.RegisterType((*StoreListReply)(nil), "types.StoreListReply")
 	proto.RegisterType((*PruneData)(nil), "types.PruneData")
@@ -1016,46 +1138,51 @@ func init() {
 }
 
 var fileDescriptor_8817812184a13374 = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0x45, 0x92, 0x9d, 0x48, 0x93, 0xd0, 0x18, 0x11, 0x8a, 0x08, 0x29, 0x49, 0x75, 0x72, 0x29,
-	0x38, 0xa5, 0xee, 0xb1, 0x87, 0x26, 0x04, 0xd2, 0x62, 0xb7, 0x04, 0x05, 0x5c, 0xe8, 0xa1, 0xb0,
-	0x91, 0xc6, 0x91, 0x88, 0xbd, 0xeb, 0x4a, 0xab, 0x12, 0xf5, 0xd2, 0x8f, 0xe8, 0xa9, 0xbf, 0xd5,
-	0x2f, 0x2a, 0x3b, 0xbb, 0xb2, 0x64, 0x50, 0x93, 0xe6, 0x36, 0x6f, 0xbd, 0x7a, 0xef, 0xcd, 0x9b,
-	0x59, 0x0c, 0x6e, 0x72, 0x3d, 0x5a, 0xe5, 0x42, 0x0a, 0xbf, 0x2f, 0xab, 0x15, 0x16, 0x07, 0xbb,
-	0xb1, 0x58, 0x2e, 0x05, 0xd7, 0x87, 0xe1, 0x57, 0x70, 0xa7, 0xc8, 0xe6, 0x9f, 0x44, 0x82, 0xfe,
-	0x00, 0x9c, 0x5b, 0xac, 0x02, 0xeb, 0xd8, 0x1a, 0xee, 0x46, 0xaa, 0xf4, 0xf7, 0xa1, 0xff, 0x9d,
-	0x2d, 0x4a, 0x0c, 0x6c, 0x3a, 0xd3, 0xc0, 0x7f, 0x0a, 0x5b, 0x29, 0x66, 0x37, 0xa9, 0x0c, 0x9c,
-	0x63, 0x6b, 0xd8, 0x8f, 0x0c, 0xf2, 0x7d, 0xe8, 0x15, 0xd9, 0x0f, 0x0c, 0x7a, 0x74, 0x4a, 0x75,
-	0xf8, 0x0d, 0xbc, 0x0f, 0x9c, 0x63, 0x4e, 0x02, 0x07, 0xe0, 0x2e, 0x70, 0x2e, 0xdf, 0xb3, 0x22,
-	0x35, 0x2a, 0x6b, 0xec, 0x1f, 0x82, 0x97, 0x2b, 0x16, 0xfa, 0x51, 0xcb, 0x35, 0x07, 0x8f, 0x92,
-	0x2c, 0xc1, 0xfb, 0x78, 0x3a, 0x9b, 0x5e, 0xe6, 0x42, 0xcc, 0xb5, 0x24, 0x9b, 0x6f, 0x4a, 0x6a,
-	0xec, 0xbf, 0x02, 0xc8, 0x6a, 0x6f, 0x45, 0x60, 0x1f, 0x3b, 0xc3, 0x9d, 0xd7, 0x83, 0x11, 0xa5,
-	0x34, 0x5a, 0x9b, 0x8e, 0x5a, 0x77, 0x14, 0x5b, 0x2e, 0x84, 0xf6, 0xe8, 0x68, 0xb6, 0x1a, 0x87,
-	0xbf, 0x2d, 0xf0, 0xae, 0xa4, 0xc8, 0xf1, 0x51, 0x59, 0xb6, 0x23, 0x71, 0xee, 0x8b, 0xa4, 0xf7,
-	0xef, 0x48, 0xfa, 0x9d, 0x91, 0x6c, 0xb5, 0x22, 0x39, 0x05, 0x98, 0x8a, 0x98, 0x2d, 0xce, 0xcf,
-	0xae, 0x50, 0xfa, 0x47, 0x60, 0x4f, 0x66, 0xa6, 0xdf, 0x3d, 0xd3, 0xef, 0x04, 0xab, 0x99, 0x32,
-	0x14, 0xd9, 0x93, 0x99, 0xa2, 0x90, 0x77, 0x59, 0x42, 0xc4, 0x4e, 0x44, 0x75, 0xf8, 0x13, 0x76,
-	0x0c, 0xc5, 0x34, 0x2b, 0xa4, 0x52, 0x5f, 0xe5, 0x38, 0xcf, 0xee, 0x4c, 0x8b, 0x06, 0xd5, 0x7d,
-	0xdb, 0x4d, 0xdf, 0x87, 0xe0, 0x25, 0x59, 0x8e, 0xb1, 0xcc, 0x04, 0x37, 0xd3, 0x6b, 0x0e, 0x54,
-	0x2a, 0xb1, 0x28, 0xb9, 0x34, 0x13, 0xd4, 0xa0, 0xd3, 0xc0, 0x9b, 0x75, 0x0f, 0x17, 0x48, 0x37,
-	0x6e, 0xb1, 0xd2, 0x53, 0xdb, 0x8d, 0xa8, 0xee, 0xfc, 0xea, 0x05, 0xec, 0xd1, 0x57, 0x11, 0xae,
-	0x16, 0xba, 0x43, 0x65, 0x9d, 0xb2, 0xaf, 0x3f, 0x36, 0x28, 0x64, 0xe0, 0xd2, 0xfc, 0x54, 0x44,
-	0x87, 0xe0, 0x15, 0x92, 0x49, 0x6c, 0xed, 0x4d, 0x73, 0xf0, 0x70, 0x80, 0x9b, 0xeb, 0xea, 0xd4,
-	0xb3, 0x09, 0xdf, 0x19, 0x89, 0x73, 0x5c, 0x3c, 0x20, 0xd1, 0x30, 0xd8, 0x1b, 0x0c, 0x4b, 0x18,
-	0xd4, 0x26, 0x3f, 0x67, 0x32, 0xbd, 0xaa, 0x78, 0xec, 0xbf, 0x04, 0xb7, 0x50, 0x67, 0x05, 0x4a,
-	0x22, 0x6a, 0x4c, 0xd5, 0x57, 0xa3, 0xf5, 0x05, 0x5a, 0x8f, 0x8a, 0xc7, 0x44, 0xeb, 0x46, 0x54,
-	0xfb, 0x01, 0x6c, 0x97, 0xab, 0x9b, 0x9c, 0x25, 0x48, 0x7e, 0xdd, 0xa8, 0x86, 0xe1, 0x5b, 0x63,
-	0xf8, 0xe2, 0xc1, 0x4c, 0x3a, 0x06, 0xa2, 0xc2, 0xa7, 0xaf, 0xff, 0x23, 0xfc, 0x5f, 0xf5, 0xeb,
-	0xa1, 0xed, 0xba, 0x5f, 0x6a, 0x1f, 0xfa, 0x85, 0x64, 0xb9, 0xac, 0x5f, 0x12, 0x01, 0xb5, 0x79,
-	0xc8, 0x13, 0xf3, 0x88, 0x54, 0xa9, 0xb4, 0x8a, 0x72, 0xae, 0x76, 0x54, 0x3f, 0x1e, 0x83, 0x9a,
-	0x9d, 0xd3, 0x8b, 0xd2, 0xec, 0xdc, 0x52, 0x24, 0xfa, 0xdd, 0x38, 0x11, 0xd5, 0xe1, 0x1f, 0x0b,
-	0x9e, 0xac, 0x5d, 0x51, 0x17, 0x8d, 0xb8, 0xd5, 0x21, 0x6e, 0x77, 0x89, 0x3b, 0xdd, 0xe2, 0xbd,
-	0xb6, 0xf8, 0x00, 0x1c, 0x5e, 0x2e, 0x8d, 0x21, 0x55, 0x76, 0xd9, 0x51, 0x73, 0xe2, 0x78, 0x27,
-	0x27, 0x58, 0x05, 0xdb, 0x44, 0x5a, 0xc3, 0x75, 0xfa, 0x6e, 0xeb, 0x39, 0x34, 0x51, 0x7b, 0x1b,
-	0x51, 0x3f, 0x07, 0xef, 0x32, 0x2f, 0x39, 0x9e, 0x33, 0xc9, 0x94, 0x9d, 0x94, 0x15, 0x69, 0x11,
-	0x58, 0x74, 0x47, 0x83, 0x70, 0x68, 0xda, 0xa6, 0x99, 0x5d, 0x0a, 0xb1, 0x68, 0x91, 0x59, 0x6d,
-	0xb2, 0xb3, 0xa3, 0x2f, 0xcf, 0x6e, 0x32, 0x99, 0x96, 0xd7, 0xa3, 0x58, 0x2c, 0x4f, 0xc6, 0xe3,
-	0x98, 0x9f, 0xc4, 0x29, 0xcb, 0xf8, 0x78, 0x7c, 0x42, 0x2b, 0x78, 0xbd, 0x45, 0xff, 0x33, 0xe3,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xe6, 0x2e, 0x76, 0x88, 0x06, 0x00, 0x00,
+	// 723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5f, 0x6b, 0xd4, 0x40,
+	0x10, 0x27, 0x97, 0x4b, 0x9b, 0x4c, 0x8b, 0x3d, 0x43, 0x91, 0x50, 0x2a, 0xad, 0x01, 0xf1, 0x8a,
+	0x70, 0x95, 0x9e, 0x8f, 0x3e, 0xd8, 0x52, 0xa8, 0x72, 0xa7, 0x94, 0x14, 0x4e, 0xe8, 0x83, 0xb0,
+	0x4d, 0x36, 0x4d, 0xe8, 0xdd, 0xee, 0x99, 0x6c, 0xa4, 0xf1, 0xc5, 0x0f, 0xe1, 0x93, 0x7e, 0x2c,
+	0x3f, 0x91, 0xec, 0xec, 0xe6, 0xcf, 0x41, 0xec, 0x9f, 0xb7, 0x99, 0xcd, 0xec, 0x6f, 0x7e, 0xf3,
+	0x9b, 0x99, 0x0d, 0xd8, 0xd1, 0xd5, 0x68, 0x99, 0x71, 0xc1, 0x5d, 0x4b, 0x94, 0x4b, 0x9a, 0xef,
+	0x6c, 0x86, 0x7c, 0xb1, 0xe0, 0x4c, 0x1d, 0xfa, 0x5f, 0xc1, 0x9e, 0x52, 0x12, 0x7f, 0xe6, 0x11,
+	0x75, 0x07, 0x60, 0xde, 0xd0, 0xd2, 0x33, 0xf6, 0x8d, 0xe1, 0x66, 0x20, 0x4d, 0x77, 0x1b, 0xac,
+	0xef, 0x64, 0x5e, 0x50, 0xaf, 0x87, 0x67, 0xca, 0x71, 0x9f, 0xc1, 0x5a, 0x42, 0xd3, 0xeb, 0x44,
+	0x78, 0xe6, 0xbe, 0x31, 0xb4, 0x02, 0xed, 0xb9, 0x2e, 0xf4, 0xf3, 0xf4, 0x07, 0xf5, 0xfa, 0x78,
+	0x8a, 0xb6, 0xff, 0x0d, 0x9c, 0x8f, 0x8c, 0xd1, 0x0c, 0x13, 0xec, 0x80, 0x3d, 0xa7, 0xb1, 0xf8,
+	0x40, 0xf2, 0x44, 0x67, 0xa9, 0x7d, 0x77, 0x17, 0x9c, 0x4c, 0xa2, 0xe0, 0x47, 0x95, 0xae, 0x39,
+	0x78, 0x54, 0xca, 0x02, 0x9c, 0x4f, 0xc7, 0xb3, 0xe9, 0x79, 0xc6, 0x79, 0xac, 0x52, 0x92, 0x78,
+	0x35, 0xa5, 0xf2, 0xdd, 0x37, 0x00, 0x69, 0xc5, 0x2d, 0xf7, 0x7a, 0xfb, 0xe6, 0x70, 0xe3, 0x68,
+	0x30, 0x42, 0x95, 0x46, 0x35, 0xe9, 0xa0, 0x15, 0x23, 0xd1, 0x32, 0xce, 0x15, 0x47, 0x53, 0xa1,
+	0x55, 0xbe, 0xff, 0xdb, 0x00, 0xe7, 0x42, 0xf0, 0x8c, 0x3e, 0x4a, 0xcb, 0xb6, 0x24, 0xe6, 0x5d,
+	0x92, 0xf4, 0xff, 0x2f, 0x89, 0xd5, 0x29, 0xc9, 0x5a, 0x4b, 0x92, 0x63, 0x80, 0x29, 0x0f, 0xc9,
+	0xfc, 0xf4, 0xe4, 0x82, 0x0a, 0x77, 0x0f, 0x7a, 0x93, 0x99, 0xae, 0x77, 0x4b, 0xd7, 0x3b, 0xa1,
+	0xe5, 0x4c, 0x12, 0x0a, 0x7a, 0x93, 0x99, 0x84, 0x10, 0xb7, 0x69, 0x84, 0xc0, 0x66, 0x80, 0xb6,
+	0xff, 0x13, 0x36, 0x34, 0xc4, 0x34, 0xcd, 0x85, 0xcc, 0xbe, 0xcc, 0x68, 0x9c, 0xde, 0xea, 0x12,
+	0xb5, 0x57, 0xd5, 0xdd, 0x6b, 0xea, 0xde, 0x05, 0x27, 0x4a, 0x33, 0x1a, 0x8a, 0x94, 0x33, 0xdd,
+	0xbd, 0xe6, 0x40, 0xaa, 0x12, 0xf2, 0x82, 0x09, 0xdd, 0x41, 0xe5, 0x74, 0x12, 0x78, 0x5b, 0xd7,
+	0x70, 0x46, 0x31, 0xe2, 0x86, 0x96, 0xaa, 0x6b, 0x9b, 0x01, 0xda, 0x9d, 0xb7, 0x0e, 0x60, 0x0b,
+	0x6f, 0x05, 0x74, 0x39, 0x57, 0x15, 0x4a, 0xea, 0xa8, 0x7d, 0x75, 0x59, 0x7b, 0x3e, 0x01, 0x1b,
+	0xfb, 0x27, 0x25, 0xda, 0x05, 0x27, 0x17, 0x44, 0xd0, 0xd6, 0xdc, 0x34, 0x07, 0xf7, 0x0b, 0xb8,
+	0x3a, 0xae, 0x66, 0xd5, 0x1b, 0xff, 0xbd, 0x4e, 0x71, 0x4a, 0xe7, 0xf7, 0xa4, 0x68, 0x10, 0x7a,
+	0x2b, 0x08, 0x0b, 0x18, 0x54, 0x24, 0xbf, 0xa4, 0x22, 0xb9, 0x28, 0x59, 0xe8, 0xbe, 0x06, 0x3b,
+	0x97, 0x67, 0x39, 0x15, 0x08, 0xd4, 0x90, 0xaa, 0x42, 0x83, 0x3a, 0x00, 0xc7, 0xa3, 0x64, 0x21,
+	0xc2, 0xda, 0x01, 0xda, 0xae, 0x07, 0xeb, 0xc5, 0xf2, 0x3a, 0x23, 0x11, 0x45, 0xbe, 0x76, 0x50,
+	0xb9, 0xfe, 0x3b, 0x4d, 0xf8, 0xec, 0x5e, 0x4d, 0x3a, 0x1a, 0x22, 0xc5, 0xc7, 0xdb, 0x0f, 0x10,
+	0xff, 0x8f, 0x01, 0x80, 0xb1, 0x6a, 0x6d, 0x1f, 0xba, 0x3e, 0xdb, 0x60, 0x2d, 0xe5, 0x05, 0xbd,
+	0x3b, 0xca, 0x71, 0x5f, 0x42, 0x5f, 0x2e, 0x11, 0xce, 0xd4, 0xc6, 0xd1, 0xd3, 0xb6, 0x18, 0x08,
+	0x1f, 0xe0, 0x67, 0xf7, 0x15, 0x58, 0x59, 0xbd, 0x40, 0x9d, 0x71, 0xea, 0xbb, 0x7f, 0xd9, 0xae,
+	0x43, 0x11, 0xbc, 0x5b, 0x8c, 0x03, 0xb9, 0x1d, 0x9c, 0xc7, 0xd5, 0xab, 0xd2, 0x01, 0xad, 0x03,
+	0xfc, 0x5f, 0xd5, 0xb3, 0x81, 0x6b, 0x75, 0x37, 0xec, 0x36, 0x58, 0xb9, 0x20, 0x99, 0xa8, 0x34,
+	0x40, 0x47, 0x6a, 0x45, 0x59, 0xa4, 0x15, 0x90, 0xa6, 0x14, 0x39, 0x2f, 0x62, 0xb9, 0x9c, 0xea,
+	0xd5, 0xd0, 0x5e, 0xb3, 0x6c, 0x6a, 0x43, 0x9a, 0x65, 0x5b, 0xf0, 0x48, 0x3d, 0x18, 0x66, 0x80,
+	0xb6, 0xff, 0xd7, 0x80, 0x27, 0x35, 0x2b, 0x2c, 0xbb, 0x49, 0x6e, 0x74, 0x24, 0xef, 0x75, 0x25,
+	0x37, 0xbb, 0x93, 0xf7, 0xdb, 0xc9, 0x07, 0x60, 0xb2, 0x62, 0xa1, 0x09, 0x49, 0xb3, 0x8b, 0x8e,
+	0x1c, 0x50, 0x46, 0x6f, 0xc5, 0x84, 0x96, 0xde, 0x3a, 0x82, 0x56, 0x6e, 0x3d, 0x76, 0x76, 0xeb,
+	0x1d, 0x68, 0x66, 0xcc, 0x59, 0x99, 0xb1, 0x17, 0xe0, 0x9c, 0x67, 0x05, 0xa3, 0xa7, 0x44, 0x10,
+	0x49, 0x27, 0x21, 0x79, 0x92, 0x7b, 0x06, 0xc6, 0x28, 0xc7, 0x1f, 0xea, 0xb2, 0x71, 0x58, 0xcf,
+	0x39, 0x9f, 0xb7, 0xc0, 0x8c, 0x36, 0xd8, 0xc9, 0xde, 0xe5, 0xf3, 0xeb, 0x54, 0x24, 0xc5, 0xd5,
+	0x28, 0xe4, 0x8b, 0xc3, 0xf1, 0x38, 0x64, 0x87, 0x61, 0x42, 0x52, 0x36, 0x1e, 0x1f, 0x62, 0xaf,
+	0xaf, 0xd6, 0xf0, 0x07, 0x3b, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x17, 0x42, 0x01, 0x82, 0x81,
+	0x07, 0x00, 0x00,
 }
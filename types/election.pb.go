@@ -0,0 +1,110 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: election.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// ElectionProof 基于VRF的出块节点选举证明, 种子由校验方根据本地区块计算, 不在证明中携带
+type ElectionProof struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round  int64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// secp256k1压缩公钥
+	PubKey []byte `protobuf:"bytes,3,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	// vrf证明
+	Proof                []byte   `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ElectionProof) Reset()         { *m = ElectionProof{} }
+func (m *ElectionProof) String() string { return proto.CompactTextString(m) }
+func (*ElectionProof) ProtoMessage()    {}
+func (*ElectionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{0}
+}
+
+func (m *ElectionProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ElectionProof.Unmarshal(m, b)
+}
+func (m *ElectionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ElectionProof.Marshal(b, m, deterministic)
+}
+func (m *ElectionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElectionProof.Merge(m, src)
+}
+func (m *ElectionProof) XXX_Size() int {
+	return xxx_messageInfo_ElectionProof.Size(m)
+}
+func (m *ElectionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElectionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ElectionProof proto.InternalMessageInfo
+
+func (m *ElectionProof) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ElectionProof) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ElectionProof) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *ElectionProof) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ElectionProof)(nil), "types.ElectionProof")
+}
+
+func init() {
+	proto.RegisterFile("election.proto", fileDescriptor_64dbf621b3c93457)
+}
+
+var fileDescriptor_64dbf621b3c93457 = []byte{
+	// 155 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4b, 0xcd, 0x49, 0x4d,
+	0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2d, 0xa9, 0x2c, 0x48,
+	0x2d, 0x56, 0xca, 0xe6, 0xe2, 0x75, 0x85, 0x4a, 0x04, 0x14, 0xe5, 0xe7, 0xa7, 0x09, 0x89, 0x71,
+	0xb1, 0x65, 0xa4, 0x66, 0xa6, 0x67, 0x94, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0x41, 0x79,
+	0x42, 0x22, 0x5c, 0xac, 0x45, 0xf9, 0xa5, 0x79, 0x29, 0x12, 0x4c, 0x60, 0x61, 0x08, 0x07, 0xa4,
+	0xba, 0xa0, 0x34, 0xc9, 0x3b, 0xb5, 0x52, 0x82, 0x59, 0x81, 0x51, 0x83, 0x27, 0x08, 0xca, 0x03,
+	0xa9, 0x2e, 0x00, 0x19, 0x27, 0xc1, 0x02, 0x16, 0x86, 0x70, 0x9c, 0xe4, 0xa3, 0x64, 0xd3, 0x33,
+	0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x8d, 0x8d, 0x93, 0xf3, 0xf4, 0x93, 0x33,
+	0x12, 0x33, 0xf3, 0x8c, 0x8d, 0xf5, 0xc1, 0xae, 0x49, 0x62, 0x03, 0xbb, 0xcd, 0x18, 0x10, 0x00,
+	0x00, 0xff, 0xff, 0xef, 0x92, 0xf4, 0x03, 0xad, 0x00, 0x00, 0x00,
+}
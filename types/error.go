@@ -133,6 +133,10 @@ var (
 	ErrNewWalletFromSeed    = errors.New("ErrNewWalletFromSeed")
 	ErrNewKeyPair           = errors.New("ErrNewKeyPair")
 	ErrPrivkeyToPub         = errors.New("ErrPrivkeyToPub")
+	ErrWatchOnlyExist       = errors.New("ErrWatchOnlyExist")
+	ErrWatchOnlyNotExist    = errors.New("ErrWatchOnlyNotExist")
+	ErrPubKeyAddrNotMatch   = errors.New("ErrPubKeyAddrNotMatch")
+	ErrTxNotSigned          = errors.New("ErrTxNotSigned")
 
 	ErrOnlyTicketUnLocked = errors.New("ErrOnlyTicketUnLocked")
 	ErrNewCrypto          = errors.New("ErrNewCrypto")
@@ -204,4 +208,14 @@ var (
 	ErrPushNotSubscribed  = errors.New("ErrPushNotSubscribed")
 	ErrTxChainID          = errors.New("ErrTxChainID")
 	ErrTimeout            = errors.New("ErrTimeout")
+	ErrTxNonceTooLow      = errors.New("ErrTxNonceTooLow")
+	ErrTxNonceTooHigh     = errors.New("ErrTxNonceTooHigh")
+	ErrTxNonceExist       = errors.New("ErrTxNonceExist")
+	ErrTxNonceNotMatch    = errors.New("ErrTxNonceNotMatch")
+	ErrTxGroupSeqNonce    = errors.New("ErrTxGroupSeqNonce")
+
+	ErrConfigNotReloadable = errors.New("ErrConfigNotReloadable")
+	ErrConfigInvalid       = errors.New("ErrConfigInvalid")
+
+	ErrNoStateProof = errors.New("ErrNoStateProof")
 )
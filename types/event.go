@@ -185,6 +185,22 @@ const (
 	EventCheckTxsExist = 357
 	//delete para blocks
 	EventDeleteParaBlocks = 358
+
+	//获取指定状态下key的mavl证明
+	EventStoreGetProof = 359
+	//轻节点从全节点获取交易证明和状态证明
+	EventFetchTxProof    = 360
+	EventFetchStateProof = 361
+	//节点评分, 上报节点不良行为以及查询评分和手动封禁
+	EventReportPeer    = 362
+	EventGetPeerScores = 363
+	EventBanPeer       = 364
+	EventUnbanPeer     = 365
+	//配置热加载, 请求重新加载配置文件以及通知各个模块新的配置
+	EventReloadConfig   = 366
+	EventConfigReloaded = 367
+	//共识模块按共识规则校验连续的区块头, 用于只同步区块头的轻节点
+	EventCheckBlockHeaders = 368
 )
 
 var eventName = map[int]string{
@@ -343,4 +359,14 @@ var eventName = map[int]string{
 	EventNetProtocols:               "EventNetProtocols",
 	EventCheckTxsExist:              "EventCheckTxsExist",
 	EventDeleteParaBlocks:           "EventDeleteParaBlocks",
+	EventStoreGetProof:              "EventStoreGetProof",
+	EventFetchTxProof:               "EventFetchTxProof",
+	EventFetchStateProof:            "EventFetchStateProof",
+	EventReportPeer:                 "EventReportPeer",
+	EventGetPeerScores:              "EventGetPeerScores",
+	EventBanPeer:                    "EventBanPeer",
+	EventUnbanPeer:                  "EventUnbanPeer",
+	EventReloadConfig:               "EventReloadConfig",
+	EventConfigReloaded:             "EventConfigReloaded",
+	EventCheckBlockHeaders:          "EventCheckBlockHeaders",
 }
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"math/rand"
 	"reflect"
+	"sort"
 	"strings"
 	"unicode"
 
@@ -85,6 +86,16 @@ func LoadExecutorType(execstr string) ExecutorType {
 	return nil
 }
 
+// ListExecutorType 获取所有已注册执行器的名称(按名称排序)
+func ListExecutorType() []string {
+	names := make([]string, 0, len(executorMap))
+	for name := range executorMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // CallExecNewTx 重构完成后删除
 func CallExecNewTx(c *Chain33Config, execName, action string, param interface{}) ([]byte, error) {
 	exec := LoadExecutorType(execName)
@@ -170,7 +181,9 @@ func CreateFormatTx(c *Chain33Config, execName string, payload []byte) (*Transac
 // FormatTx 格式化tx交易
 func FormatTx(c *Chain33Config, execName string, tx *Transaction) (*Transaction, error) {
 	//填写nonce,execer,to, fee 等信息, 后面会增加一个修改transaction的函数，会加上execer fee 等的修改
-	tx.Nonce = rand.Int63()
+	if !tx.IsSeqNonce {
+		tx.Nonce = RandNonce()
+	}
 	tx.ChainID = c.GetChainID()
 	tx.Execer = []byte(execName)
 	//平行链，所有的to地址都是合约地址
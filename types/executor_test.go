@@ -6,6 +6,7 @@ package types
 
 import (
 	"encoding/json"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,6 +24,11 @@ func TestLoadExecutorType(t *testing.T) {
 
 	exec = LoadExecutorType("xxxx")
 	assert.Equal(t, exec, nil)
+
+	names := ListExecutorType()
+	assert.Contains(t, names, "coins")
+	assert.Contains(t, names, "manage")
+	assert.True(t, sort.StringsAreSorted(names))
 }
 
 func TestFormatTx(t *testing.T) {
@@ -139,6 +139,7 @@ func (f *Forks) SetTestNetFork() {
 	f.SetFork("ForkCacheDriver", 2580000)
 	f.SetFork("ForkTicketFundAddrV1", 3350000)
 	f.SetFork("ForkRootHash", 4500000)
+	f.SetFork("ForkTxSeqNonce", MaxHeight)
 }
 
 func (f *Forks) setLocalFork() {
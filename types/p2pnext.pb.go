@@ -2526,6 +2526,224 @@ func (m *ProtocolInfo) GetRatetotal() string {
 	return ""
 }
 
+//*
+//dht protos 节点评分
+type PeerScore struct {
+	Pid   string `protobuf:"bytes,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Score int64  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	// normal, deprioritized, banned
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// 封禁到期时间, unix秒
+	BanUntil             int64    `protobuf:"varint,4,opt,name=banUntil,proto3" json:"banUntil,omitempty"`
+	LastFault            string   `protobuf:"bytes,5,opt,name=lastFault,proto3" json:"lastFault,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerScore) Reset()         { *m = PeerScore{} }
+func (m *PeerScore) String() string { return proto.CompactTextString(m) }
+func (*PeerScore) ProtoMessage()    {}
+func (*PeerScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d81e96199caf00d1, []int{45}
+}
+
+func (m *PeerScore) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerScore.Unmarshal(m, b)
+}
+func (m *PeerScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerScore.Marshal(b, m, deterministic)
+}
+func (m *PeerScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerScore.Merge(m, src)
+}
+func (m *PeerScore) XXX_Size() int {
+	return xxx_messageInfo_PeerScore.Size(m)
+}
+func (m *PeerScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerScore proto.InternalMessageInfo
+
+func (m *PeerScore) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *PeerScore) GetScore() int64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *PeerScore) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *PeerScore) GetBanUntil() int64 {
+	if m != nil {
+		return m.BanUntil
+	}
+	return 0
+}
+
+func (m *PeerScore) GetLastFault() string {
+	if m != nil {
+		return m.LastFault
+	}
+	return ""
+}
+
+type PeerScoreList struct {
+	Scores               []*PeerScore `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PeerScoreList) Reset()         { *m = PeerScoreList{} }
+func (m *PeerScoreList) String() string { return proto.CompactTextString(m) }
+func (*PeerScoreList) ProtoMessage()    {}
+func (*PeerScoreList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d81e96199caf00d1, []int{46}
+}
+
+func (m *PeerScoreList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerScoreList.Unmarshal(m, b)
+}
+func (m *PeerScoreList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerScoreList.Marshal(b, m, deterministic)
+}
+func (m *PeerScoreList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerScoreList.Merge(m, src)
+}
+func (m *PeerScoreList) XXX_Size() int {
+	return xxx_messageInfo_PeerScoreList.Size(m)
+}
+func (m *PeerScoreList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerScoreList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerScoreList proto.InternalMessageInfo
+
+func (m *PeerScoreList) GetScores() []*PeerScore {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+// 其他模块上报节点的不良行为, behavior见types.PeerBehavior*
+type ReportPeer struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Behavior             int32    `protobuf:"varint,2,opt,name=behavior,proto3" json:"behavior,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportPeer) Reset()         { *m = ReportPeer{} }
+func (m *ReportPeer) String() string { return proto.CompactTextString(m) }
+func (*ReportPeer) ProtoMessage()    {}
+func (*ReportPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d81e96199caf00d1, []int{47}
+}
+
+func (m *ReportPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportPeer.Unmarshal(m, b)
+}
+func (m *ReportPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportPeer.Marshal(b, m, deterministic)
+}
+func (m *ReportPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportPeer.Merge(m, src)
+}
+func (m *ReportPeer) XXX_Size() int {
+	return xxx_messageInfo_ReportPeer.Size(m)
+}
+func (m *ReportPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportPeer proto.InternalMessageInfo
+
+func (m *ReportPeer) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *ReportPeer) GetBehavior() int32 {
+	if m != nil {
+		return m.Behavior
+	}
+	return 0
+}
+
+func (m *ReportPeer) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// 手动封禁节点, seconds为0使用默认封禁时长
+type ReqBanPeer struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Seconds              int64    `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqBanPeer) Reset()         { *m = ReqBanPeer{} }
+func (m *ReqBanPeer) String() string { return proto.CompactTextString(m) }
+func (*ReqBanPeer) ProtoMessage()    {}
+func (*ReqBanPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d81e96199caf00d1, []int{48}
+}
+
+func (m *ReqBanPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqBanPeer.Unmarshal(m, b)
+}
+func (m *ReqBanPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqBanPeer.Marshal(b, m, deterministic)
+}
+func (m *ReqBanPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqBanPeer.Merge(m, src)
+}
+func (m *ReqBanPeer) XXX_Size() int {
+	return xxx_messageInfo_ReqBanPeer.Size(m)
+}
+func (m *ReqBanPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqBanPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqBanPeer proto.InternalMessageInfo
+
+func (m *ReqBanPeer) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *ReqBanPeer) GetSeconds() int64 {
+	if m != nil {
+		return m.Seconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MessageComm)(nil), "types.MessageComm")
 	proto.RegisterType((*MessageUtil)(nil), "types.MessageUtil")
@@ -2572,6 +2790,10 @@ func init() {
 	proto.RegisterType((*RemoveTopicReply)(nil), "types.RemoveTopicReply")
 	proto.RegisterType((*NetProtocolInfos)(nil), "types.NetProtocolInfos")
 	proto.RegisterType((*ProtocolInfo)(nil), "types.ProtocolInfo")
+	proto.RegisterType((*PeerScore)(nil), "types.PeerScore")
+	proto.RegisterType((*PeerScoreList)(nil), "types.PeerScoreList")
+	proto.RegisterType((*ReportPeer)(nil), "types.ReportPeer")
+	proto.RegisterType((*ReqBanPeer)(nil), "types.ReqBanPeer")
 }
 
 func init() {
@@ -2579,98 +2801,106 @@ func init() {
 }
 
 var fileDescriptor_d81e96199caf00d1 = []byte{
-	// 1475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x72, 0xdb, 0x36,
-	0x10, 0xd6, 0x4f, 0x64, 0x89, 0x2b, 0xd9, 0x96, 0x11, 0xd7, 0xa3, 0xc9, 0xf4, 0xc7, 0xc3, 0x5e,
-	0x94, 0xb6, 0x76, 0x12, 0x29, 0x87, 0x24, 0x4d, 0x0f, 0x91, 0x93, 0x46, 0x6e, 0x6b, 0x8f, 0x06,
-	0x6d, 0x73, 0xe8, 0xf4, 0x42, 0x93, 0x88, 0xc4, 0xb1, 0x48, 0xd0, 0x04, 0xa8, 0xc4, 0x99, 0x1e,
-	0xfa, 0x5a, 0x7d, 0x87, 0x3e, 0x44, 0x5f, 0xa1, 0x6f, 0xd0, 0x01, 0x08, 0x10, 0x24, 0x25, 0x27,
-	0x13, 0xd5, 0xbe, 0x61, 0x81, 0xdd, 0xfd, 0xf6, 0xe7, 0xc3, 0x0a, 0x14, 0x6c, 0x46, 0x83, 0x28,
-	0x24, 0x6f, 0xf9, 0x61, 0x14, 0x53, 0x4e, 0x51, 0x83, 0x5f, 0x46, 0x84, 0xdd, 0xb1, 0xa2, 0x41,
-	0x94, 0xee, 0xdc, 0xe9, 0x9e, 0xcd, 0xa9, 0x7b, 0xee, 0xce, 0x1c, 0x3f, 0x4c, 0x77, 0xec, 0xbf,
-	0xaa, 0xd0, 0x3e, 0x21, 0x8c, 0x39, 0x53, 0x72, 0x44, 0x83, 0x00, 0xf5, 0xa0, 0xb9, 0x20, 0x31,
-	0xf3, 0x69, 0xd8, 0xab, 0xee, 0x57, 0xfb, 0x16, 0xd6, 0x22, 0xfa, 0x14, 0x2c, 0xee, 0x07, 0x84,
-	0x71, 0x27, 0x88, 0x7a, 0xb5, 0xfd, 0x6a, 0xbf, 0x8e, 0xcd, 0x06, 0xda, 0x82, 0x9a, 0xef, 0xf5,
-	0xea, 0xd2, 0xa4, 0xe6, 0x7b, 0x68, 0x0f, 0x36, 0xa6, 0x94, 0x31, 0x3f, 0xea, 0xdd, 0xda, 0xaf,
-	0xf6, 0x5b, 0x58, 0x49, 0x62, 0x3f, 0xa4, 0x1e, 0x39, 0xf6, 0x7a, 0x0d, 0xa9, 0xab, 0x24, 0xf4,
-	0x39, 0x80, 0x58, 0x4d, 0x92, 0xb3, 0x1f, 0xc9, 0x65, 0x6f, 0x63, 0xbf, 0xda, 0xef, 0xe0, 0xdc,
-	0x0e, 0x42, 0x70, 0x8b, 0xf9, 0xd3, 0xb0, 0xd7, 0x94, 0x27, 0x72, 0x6d, 0xff, 0x5b, 0xcb, 0x62,
-	0xff, 0x95, 0xfb, 0x73, 0xf4, 0x15, 0x6c, 0xb8, 0x34, 0x08, 0x54, 0xe8, 0xed, 0x01, 0x3a, 0x94,
-	0x05, 0x38, 0xcc, 0xe5, 0x87, 0x95, 0x06, 0xba, 0x0f, 0xad, 0x88, 0x90, 0xf8, 0x38, 0x7c, 0x4d,
-	0x65, 0x32, 0x46, 0x7b, 0x32, 0x98, 0x4c, 0xd4, 0xc9, 0xb8, 0x82, 0x33, 0x2d, 0x74, 0x60, 0x2a,
-	0x53, 0x97, 0x06, 0x3b, 0xc6, 0xe0, 0x55, 0x7a, 0x30, 0xae, 0x98, 0x72, 0x0d, 0x00, 0xd4, 0xf2,
-	0x99, 0x7b, 0x2e, 0x8b, 0xd0, 0x1e, 0x74, 0x0b, 0x16, 0xcf, 0xdc, 0xf3, 0x71, 0x05, 0xe7, 0xb4,
-	0xd0, 0x43, 0x68, 0x91, 0xb7, 0x9c, 0xc4, 0xa1, 0x33, 0x97, 0xe5, 0x69, 0x0f, 0xf6, 0x8c, 0xc5,
-	0x0b, 0x75, 0xa2, 0x03, 0xd3, 0x9a, 0x68, 0x08, 0xd6, 0x94, 0x70, 0xd9, 0x59, 0x26, 0x2b, 0xd7,
-	0x1e, 0xdc, 0x36, 0x66, 0x2f, 0x09, 0x1f, 0xc9, 0xa3, 0x71, 0x05, 0x1b, 0x3d, 0x74, 0x00, 0x2d,
-	0x3f, 0x5c, 0x78, 0x0e, 0x77, 0x98, 0xac, 0x69, 0x7b, 0xb0, 0xad, 0x6c, 0x8e, 0xc3, 0xc5, 0x73,
-	0xb1, 0x2d, 0x30, 0xb4, 0xca, 0xa8, 0x09, 0x8d, 0x85, 0x33, 0x4f, 0x88, 0xfd, 0x03, 0x20, 0x55,
-	0x4e, 0x5d, 0x24, 0x4c, 0x2e, 0xd0, 0x43, 0x68, 0x07, 0xe9, 0xae, 0x30, 0x7d, 0x4f, 0xf9, 0xf3,
-	0x6a, 0xf6, 0x25, 0xdc, 0x5e, 0xf2, 0xc5, 0xa2, 0xf5, 0x9c, 0xa1, 0x6f, 0xa0, 0xa9, 0xc4, 0xab,
-	0xfb, 0x89, 0xb5, 0x8a, 0x7d, 0x09, 0xbb, 0x1a, 0x3a, 0xeb, 0xde, 0xda, 0x89, 0xa0, 0xaf, 0xcb,
-	0xd8, 0xcb, 0xd4, 0x30, 0xd0, 0xef, 0xe0, 0x93, 0x15, 0xd0, 0x6b, 0xe7, 0xfd, 0x51, 0xd8, 0x11,
-	0x6c, 0x69, 0x6c, 0x3f, 0x9c, 0xae, 0x9f, 0x70, 0xbf, 0x0c, 0xba, 0x95, 0x2b, 0xb6, 0xf0, 0x9c,
-	0x21, 0x5e, 0xc0, 0x76, 0x01, 0x71, 0xed, 0x3c, 0xdf, 0x0b, 0x49, 0xf3, 0x90, 0x2c, 0x4b, 0xf2,
-	0x99, 0xe7, 0xc5, 0x37, 0xd3, 0xd5, 0x97, 0x84, 0x4b, 0xe7, 0x2b, 0xf2, 0x4c, 0x41, 0x6f, 0x24,
-	0xcf, 0x22, 0x64, 0x52, 0x80, 0xfc, 0xc9, 0x67, 0xfc, 0x06, 0xae, 0x8e, 0x76, 0x6d, 0x60, 0x4f,
-	0x32, 0xfe, 0xea, 0x89, 0x74, 0x4a, 0xf8, 0xfa, 0x43, 0xe0, 0xcf, 0x2a, 0xec, 0xad, 0xf2, 0xb7,
-	0x76, 0x01, 0xef, 0x97, 0xb3, 0xb9, 0x62, 0x86, 0xe6, 0x6f, 0xa4, 0x9e, 0x43, 0xd9, 0xb0, 0x5c,
-	0x9f, 0x35, 0x07, 0x65, 0xf8, 0x55, 0xb3, 0xd8, 0x60, 0xbf, 0xc9, 0x06, 0x51, 0x0e, 0x7b, 0xed,
-	0xdc, 0xef, 0x96, 0xc1, 0xcb, 0x43, 0xdd, 0x00, 0xff, 0x91, 0x07, 0x3e, 0x21, 0x41, 0x44, 0xe9,
-	0x7c, 0xfd, 0xac, 0x0f, 0xcb, 0xc0, 0xbb, 0x85, 0xac, 0xb5, 0xff, 0xdc, 0x75, 0xd1, 0x77, 0x54,
-	0xcd, 0xa8, 0xeb, 0x4e, 0x58, 0xb9, 0xcd, 0x25, 0xfc, 0x16, 0xba, 0xca, 0xcd, 0x98, 0x38, 0x1e,
-	0x89, 0x6f, 0x2c, 0xd9, 0xd4, 0x7d, 0x0e, 0x79, 0x01, 0x3b, 0x25, 0xe4, 0x1b, 0x99, 0xf6, 0x4b,
-	0xb8, 0x2c, 0xc3, 0x55, 0xed, 0xbf, 0x81, 0x81, 0xaf, 0x3d, 0x67, 0xa0, 0xb1, 0x19, 0xf8, 0x84,
-	0xfc, 0x9f, 0xa9, 0x74, 0x65, 0x6b, 0xb5, 0x5f, 0x83, 0xc9, 0x33, 0x36, 0x9d, 0x12, 0x2e, 0x1f,
-	0x6b, 0xd7, 0x3c, 0x08, 0x4f, 0xa9, 0xa7, 0x5d, 0xe7, 0x33, 0xdd, 0xc9, 0x65, 0xca, 0x30, 0x89,
-	0xe6, 0x97, 0x1f, 0xf5, 0x06, 0x7d, 0x00, 0x10, 0x65, 0x96, 0xe5, 0x7e, 0x66, 0x07, 0x38, 0xa7,
-	0x64, 0x87, 0x19, 0x89, 0x47, 0x31, 0x75, 0xbc, 0x23, 0x87, 0xf1, 0x8f, 0x82, 0xbc, 0x92, 0xba,
-	0x99, 0xbb, 0x62, 0x37, 0x29, 0xec, 0x4c, 0x06, 0x93, 0x02, 0x7b, 0xd9, 0x35, 0x7c, 0x23, 0xd4,
-	0xe5, 0x37, 0x82, 0x7e, 0xd3, 0x37, 0x72, 0x6f, 0xfa, 0x7f, 0xea, 0x00, 0x93, 0xc1, 0x04, 0x93,
-	0x8b, 0x84, 0x30, 0x8e, 0x06, 0xd0, 0x9c, 0xa5, 0xa8, 0x2a, 0xb9, 0x9e, 0xe1, 0x7b, 0x31, 0x2a,
-	0xac, 0x15, 0xd1, 0x08, 0xb6, 0x63, 0x72, 0x71, 0x34, 0x4b, 0xc2, 0x73, 0x4c, 0x5c, 0x1a, 0x7b,
-	0xac, 0xf4, 0x43, 0x80, 0x8b, 0xa7, 0xe3, 0x0a, 0x2e, 0x1b, 0xa0, 0xc7, 0xd0, 0x71, 0x85, 0x2c,
-	0x3a, 0x7e, 0xc2, 0xa6, 0xea, 0xc5, 0xaf, 0x47, 0xf9, 0x51, 0xee, 0x68, 0x5c, 0xc1, 0x05, 0x55,
-	0xf4, 0x14, 0x36, 0x33, 0x59, 0xd0, 0x54, 0xbd, 0xfd, 0x77, 0xcb, 0xb6, 0xe2, 0x6c, 0x5c, 0xc1,
-	0x45, 0x65, 0x74, 0x1f, 0xac, 0x98, 0x5c, 0xa4, 0x3f, 0x04, 0xea, 0x1b, 0xa0, 0x6b, 0xc2, 0x36,
-	0x2f, 0xf9, 0x4c, 0x49, 0xbc, 0xe4, 0x63, 0x72, 0x21, 0xf9, 0xa2, 0x5e, 0xff, 0xdb, 0xc6, 0x40,
-	0x6e, 0x8b, 0x97, 0xbc, 0x56, 0x41, 0x08, 0xea, 0x91, 0xef, 0xc9, 0x37, 0xbf, 0x35, 0xae, 0x60,
-	0x21, 0x08, 0x17, 0xd9, 0xc7, 0x50, 0x6b, 0xe9, 0xae, 0x2d, 0x7d, 0x09, 0x0d, 0xa0, 0x15, 0xc5,
-	0x74, 0xe1, 0x7b, 0x24, 0xee, 0x59, 0xcb, 0xc9, 0x4d, 0xd4, 0x99, 0xb4, 0x51, 0xeb, 0x91, 0x05,
-	0xcd, 0x38, 0xed, 0xa9, 0xfd, 0x14, 0x5a, 0x3a, 0x32, 0x74, 0x47, 0x04, 0xff, 0x9a, 0xc4, 0xe2,
-	0xa3, 0xaf, 0x2a, 0x69, 0x90, 0xc9, 0x68, 0x17, 0x1a, 0x2e, 0x4d, 0x42, 0x2e, 0xbb, 0xd7, 0xc0,
-	0xa9, 0x60, 0xdb, 0xd0, 0x1a, 0x3b, 0x6c, 0x26, 0x8b, 0xb5, 0x07, 0x1b, 0x33, 0x87, 0xcd, 0x88,
-	0x20, 0x47, 0xbd, 0xdf, 0xc1, 0x4a, 0xb2, 0x9f, 0xc0, 0x66, 0xa1, 0xcc, 0xe8, 0x2e, 0x34, 0x7c,
-	0x4e, 0x82, 0x54, 0x6f, 0x75, 0x1f, 0x71, 0xaa, 0x61, 0xff, 0x5d, 0x87, 0xb6, 0x24, 0x20, 0x8b,
-	0x68, 0xc8, 0xc8, 0x5a, 0x0c, 0xdc, 0x85, 0x06, 0x89, 0x63, 0x1a, 0xcb, 0xc8, 0x2d, 0x9c, 0x0a,
-	0xe8, 0x01, 0xb4, 0xdd, 0x39, 0x65, 0x24, 0x4e, 0x7b, 0x55, 0x97, 0xa1, 0x94, 0x0b, 0x8d, 0xf3,
-	0x3a, 0x82, 0x0d, 0xf2, 0x7b, 0x6d, 0x44, 0xbd, 0xcb, 0x12, 0x1b, 0x46, 0x7a, 0x5f, 0xb0, 0x21,
-	0x53, 0x42, 0x0f, 0xa1, 0x23, 0x05, 0x15, 0x93, 0x62, 0x84, 0x9e, 0xd6, 0x6a, 0x57, 0x70, 0x36,
-	0xaf, 0x95, 0xd1, 0x5d, 0xdf, 0x97, 0xe6, 0x32, 0xdd, 0xcd, 0x65, 0x29, 0xa8, 0x0a, 0xee, 0xc8,
-	0x4f, 0xf8, 0x65, 0xee, 0x9c, 0xaa, 0x6d, 0xc1, 0x03, 0xad, 0x52, 0xa0, 0x9a, 0xf5, 0x61, 0xaa,
-	0x0d, 0xc1, 0xd2, 0x6b, 0xd6, 0x83, 0xe2, 0x7b, 0x4a, 0xed, 0xab, 0x7b, 0x64, 0xf4, 0x46, 0x20,
-	0x48, 0x95, 0xb6, 0xcf, 0x7e, 0x02, 0x2d, 0x1d, 0x87, 0xa0, 0x8b, 0x13, 0xb2, 0x37, 0x24, 0x96,
-	0x9d, 0x6c, 0x61, 0x25, 0x49, 0x1a, 0x11, 0x7f, 0x3a, 0xe3, 0x6a, 0x64, 0x29, 0xc9, 0x7e, 0x04,
-	0x2d, 0x0d, 0x22, 0x66, 0xd7, 0xf1, 0x73, 0x45, 0xd1, 0xda, 0xf1, 0x73, 0x31, 0xe9, 0x4e, 0x92,
-	0x39, 0xf7, 0xc5, 0xfb, 0xb8, 0x57, 0x93, 0xec, 0x33, 0x1b, 0xf6, 0xef, 0x8a, 0x80, 0xfa, 0x2a,
-	0x08, 0x75, 0x59, 0x35, 0x41, 0x5d, 0xe5, 0xc5, 0x6c, 0xa0, 0x83, 0x7c, 0x96, 0xb5, 0xd5, 0xbc,
-	0x30, 0x1a, 0xf6, 0x77, 0xd0, 0xc9, 0x27, 0x5f, 0x34, 0xaf, 0x7e, 0xd0, 0xfc, 0x11, 0xb4, 0x7e,
-	0x4e, 0xce, 0x7e, 0xa1, 0x91, 0xef, 0x0a, 0xa6, 0x72, 0xb1, 0x50, 0x83, 0x3c, 0x15, 0x44, 0x41,
-	0x02, 0xea, 0x25, 0x73, 0xa2, 0x08, 0xac, 0x24, 0xfb, 0x31, 0x6c, 0x6a, 0xcb, 0xf4, 0xd7, 0x6e,
-	0x0f, 0x36, 0x18, 0x77, 0x78, 0xc2, 0x74, 0x45, 0x53, 0x09, 0x75, 0xa1, 0x1e, 0xb0, 0xa9, 0xb2,
-	0x16, 0x4b, 0xfb, 0x31, 0x6c, 0x4f, 0x92, 0xb3, 0xb9, 0xcf, 0x66, 0xd2, 0x5c, 0x0c, 0xca, 0xd5,
-	0xd8, 0x39, 0xd3, 0x4e, 0x6a, 0xfa, 0x0a, 0x76, 0x4b, 0xa6, 0x29, 0xf8, 0x95, 0xb1, 0xab, 0x90,
-	0x6a, 0xab, 0x42, 0xaa, 0x9b, 0x90, 0x8e, 0xc1, 0x92, 0x0e, 0xe5, 0x4f, 0xff, 0x6a, 0x67, 0x08,
-	0x6e, 0xbd, 0x8e, 0x69, 0xa0, 0x12, 0x91, 0x6b, 0xb1, 0xe7, 0x89, 0x37, 0x45, 0x3d, 0xfd, 0xd5,
-	0x12, 0x6b, 0xbb, 0x0f, 0x5b, 0xdf, 0x13, 0xee, 0xa6, 0x01, 0xea, 0xd1, 0xa4, 0x4a, 0x58, 0x2d,
-	0x94, 0xf0, 0x4b, 0x05, 0xaa, 0x95, 0x24, 0x4e, 0xda, 0x35, 0x0b, 0x2b, 0xc9, 0xfe, 0x16, 0xda,
-	0x98, 0x04, 0x74, 0x41, 0xd6, 0x69, 0x12, 0x86, 0x6e, 0xce, 0xf8, 0x7a, 0x4a, 0xf5, 0x02, 0xba,
-	0xa7, 0x84, 0x4f, 0x62, 0xca, 0xa9, 0x4b, 0xe5, 0xd7, 0x13, 0x43, 0x0f, 0xc0, 0x92, 0x7f, 0x21,
-	0xfa, 0xe2, 0x2a, 0x17, 0xe7, 0x6a, 0x5e, 0x11, 0x1b, 0x2d, 0xfb, 0x1d, 0x74, 0xf2, 0x47, 0x62,
-	0xfa, 0x47, 0x4a, 0x56, 0x91, 0x65, 0xb2, 0x08, 0x2e, 0x76, 0x38, 0xf1, 0x43, 0x9d, 0x5e, 0x2a,
-	0x89, 0xc7, 0x87, 0x58, 0xd1, 0x84, 0xab, 0x00, 0xb5, 0x28, 0xee, 0x98, 0x58, 0x72, 0xca, 0x9d,
-	0xb9, 0xfc, 0xd1, 0xb5, 0xb0, 0xd9, 0x18, 0x7d, 0xf1, 0xdb, 0x67, 0x53, 0x9f, 0xcf, 0x92, 0xb3,
-	0x43, 0x97, 0x06, 0xf7, 0x86, 0x43, 0x37, 0xbc, 0x27, 0xff, 0x07, 0x1d, 0x0e, 0xef, 0xc9, 0xa0,
-	0xcf, 0x36, 0x24, 0xf4, 0xf0, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x90, 0x2e, 0xe2, 0xc0, 0x45,
-	0x15, 0x00, 0x00,
+	// 1604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdb, 0x6e, 0xdb, 0x46,
+	0x13, 0x96, 0x44, 0xeb, 0xc0, 0x91, 0x6c, 0xcb, 0x1b, 0xff, 0x06, 0x11, 0xfc, 0xff, 0x5f, 0x83,
+	0xbd, 0x51, 0xda, 0xda, 0x49, 0xe4, 0x5c, 0x24, 0x69, 0x7a, 0x11, 0x39, 0x07, 0xb9, 0xad, 0x0d,
+	0x61, 0xd3, 0xe4, 0xa2, 0xe8, 0x0d, 0x4d, 0x6e, 0x24, 0xc2, 0x12, 0x97, 0xe2, 0xae, 0x94, 0x38,
+	0xe8, 0x45, 0x81, 0x3e, 0x55, 0xdf, 0xa1, 0x0f, 0xd1, 0x57, 0xe8, 0x1b, 0x14, 0x7b, 0xe2, 0x41,
+	0x92, 0x1b, 0x44, 0xb5, 0x7b, 0xb7, 0x33, 0x3b, 0x33, 0xdf, 0x9c, 0x76, 0xb8, 0x4b, 0xd8, 0x8c,
+	0xbb, 0x71, 0x44, 0xde, 0xf3, 0xc3, 0x38, 0xa1, 0x9c, 0xa2, 0x2a, 0xbf, 0x8c, 0x09, 0xbb, 0x6d,
+	0xc7, 0xdd, 0x58, 0x71, 0x6e, 0xb7, 0xcf, 0xc7, 0xd4, 0xbf, 0xf0, 0x47, 0x5e, 0x18, 0x29, 0x8e,
+	0xfb, 0x5b, 0x19, 0x9a, 0xa7, 0x84, 0x31, 0x6f, 0x48, 0x8e, 0xe9, 0x64, 0x82, 0x1c, 0xa8, 0xcf,
+	0x49, 0xc2, 0x42, 0x1a, 0x39, 0xe5, 0xfd, 0x72, 0xc7, 0xc6, 0x86, 0x44, 0xff, 0x05, 0x9b, 0x87,
+	0x13, 0xc2, 0xb8, 0x37, 0x89, 0x9d, 0xca, 0x7e, 0xb9, 0x63, 0xe1, 0x8c, 0x81, 0xb6, 0xa0, 0x12,
+	0x06, 0x8e, 0x25, 0x55, 0x2a, 0x61, 0x80, 0xf6, 0xa0, 0x36, 0xa4, 0x8c, 0x85, 0xb1, 0xb3, 0xb1,
+	0x5f, 0xee, 0x34, 0xb0, 0xa6, 0x04, 0x3f, 0xa2, 0x01, 0x39, 0x09, 0x9c, 0xaa, 0x94, 0xd5, 0x14,
+	0xfa, 0x3f, 0x80, 0x58, 0x0d, 0x66, 0xe7, 0xdf, 0x91, 0x4b, 0xa7, 0xb6, 0x5f, 0xee, 0xb4, 0x70,
+	0x8e, 0x83, 0x10, 0x6c, 0xb0, 0x70, 0x18, 0x39, 0x75, 0xb9, 0x23, 0xd7, 0xee, 0x9f, 0x95, 0xd4,
+	0xf7, 0xd7, 0x3c, 0x1c, 0xa3, 0x2f, 0xa0, 0xe6, 0xd3, 0xc9, 0x44, 0xbb, 0xde, 0xec, 0xa2, 0x43,
+	0x99, 0x80, 0xc3, 0x5c, 0x7c, 0x58, 0x4b, 0xa0, 0x7b, 0xd0, 0x88, 0x09, 0x49, 0x4e, 0xa2, 0xb7,
+	0xd4, 0xa9, 0x14, 0xa4, 0x07, 0xdd, 0xc1, 0x40, 0xef, 0xf4, 0x4b, 0x38, 0x95, 0x42, 0x07, 0x59,
+	0x66, 0x2c, 0xa9, 0xb0, 0x93, 0x29, 0xbc, 0x51, 0x1b, 0xfd, 0x52, 0x96, 0xae, 0x2e, 0x80, 0x5e,
+	0x3e, 0xf5, 0x2f, 0x64, 0x12, 0x9a, 0xdd, 0x76, 0x41, 0xe3, 0xa9, 0x7f, 0xd1, 0x2f, 0xe1, 0x9c,
+	0x14, 0x7a, 0x00, 0x0d, 0xf2, 0x9e, 0x93, 0x24, 0xf2, 0xc6, 0x32, 0x3d, 0xcd, 0xee, 0x5e, 0xa6,
+	0xf1, 0x5c, 0xef, 0x18, 0xc7, 0x8c, 0x24, 0x3a, 0x02, 0x7b, 0x48, 0xb8, 0xac, 0x2c, 0x93, 0x99,
+	0x6b, 0x76, 0x6f, 0x65, 0x6a, 0x2f, 0x09, 0xef, 0xc9, 0xad, 0x7e, 0x09, 0x67, 0x72, 0xe8, 0x00,
+	0x1a, 0x61, 0x34, 0x0f, 0x3c, 0xee, 0x31, 0x99, 0xd3, 0x66, 0x77, 0x5b, 0xeb, 0x9c, 0x44, 0xf3,
+	0x67, 0x82, 0x2d, 0x30, 0x8c, 0x48, 0xaf, 0x0e, 0xd5, 0xb9, 0x37, 0x9e, 0x11, 0xf7, 0x5b, 0x40,
+	0x3a, 0x9d, 0x26, 0x49, 0x98, 0x4c, 0xd1, 0x03, 0x68, 0x4e, 0x14, 0x57, 0xa8, 0xfe, 0x4d, 0xfa,
+	0xf3, 0x62, 0xee, 0x25, 0xdc, 0x5a, 0xb2, 0xc5, 0xe2, 0xf5, 0x8c, 0xa1, 0xaf, 0xa0, 0xae, 0xc9,
+	0xab, 0xeb, 0x89, 0x8d, 0x88, 0x7b, 0x09, 0xbb, 0x06, 0x3a, 0xad, 0xde, 0xda, 0x81, 0xa0, 0x2f,
+	0x17, 0xb1, 0x97, 0x5b, 0x23, 0x83, 0xfe, 0x00, 0xff, 0x59, 0x01, 0xcd, 0xe2, 0x7f, 0x03, 0x3b,
+	0x86, 0x2d, 0x83, 0x1d, 0x46, 0xc3, 0xf5, 0x03, 0xee, 0x2c, 0x82, 0x6e, 0xe5, 0x92, 0x2d, 0x2c,
+	0xa7, 0x88, 0x53, 0xd8, 0x2e, 0x20, 0xb2, 0xf8, 0x26, 0x20, 0x69, 0x1e, 0x92, 0xa5, 0x41, 0x3e,
+	0x0d, 0x82, 0xe4, 0x66, 0xaa, 0xfa, 0x92, 0x70, 0x69, 0x7c, 0x45, 0x9c, 0x0a, 0xf4, 0x46, 0xe2,
+	0x2c, 0x42, 0xce, 0x0a, 0x90, 0xdf, 0x87, 0x8c, 0xdf, 0xc0, 0xd1, 0x31, 0xa6, 0x33, 0xd8, 0xd3,
+	0xb4, 0x7f, 0xcd, 0x44, 0x3a, 0x23, 0x7c, 0xfd, 0x21, 0xf0, 0x4b, 0x19, 0xf6, 0x56, 0xd9, 0x5b,
+	0x3b, 0x81, 0xf7, 0x16, 0xa3, 0xb9, 0x62, 0x86, 0xe6, 0x4f, 0xa4, 0x99, 0x43, 0xe9, 0xb0, 0x5c,
+	0xbf, 0x6b, 0x0e, 0x16, 0xe1, 0x57, 0xcd, 0xe2, 0x0c, 0xfb, 0x5d, 0x3a, 0x88, 0xb2, 0xcd, 0xf5,
+	0x63, 0xbf, 0xb3, 0x08, 0xbe, 0x38, 0xd4, 0x33, 0xe0, 0x9f, 0xf3, 0xc0, 0xa7, 0x64, 0x12, 0x53,
+	0x3a, 0x5e, 0x3f, 0xea, 0xc3, 0x45, 0xe0, 0xdd, 0x42, 0xd4, 0xc6, 0x7e, 0xee, 0xb8, 0x98, 0x33,
+	0xaa, 0x67, 0xd4, 0x75, 0x07, 0xac, 0xcd, 0xe6, 0x02, 0x7e, 0x0f, 0x6d, 0x6d, 0xa6, 0x4f, 0xbc,
+	0x80, 0x24, 0x37, 0x16, 0xac, 0x32, 0x9f, 0x43, 0x9e, 0xc3, 0xce, 0x02, 0xf2, 0x8d, 0x4c, 0xfb,
+	0x25, 0x5c, 0x96, 0xe2, 0xea, 0xf2, 0xdf, 0xc0, 0xc0, 0x37, 0x96, 0x53, 0xd0, 0x24, 0x1b, 0xf8,
+	0x84, 0xfc, 0x93, 0xa9, 0x74, 0x65, 0x69, 0x8d, 0xdd, 0x0c, 0x93, 0xa7, 0xdd, 0x74, 0x46, 0xb8,
+	0xbc, 0xac, 0x5d, 0xf3, 0x20, 0x3c, 0xa3, 0x81, 0x31, 0x9d, 0x8f, 0x74, 0x27, 0x17, 0x29, 0xc3,
+	0x24, 0x1e, 0x5f, 0x7e, 0xd2, 0x1d, 0xf4, 0x3e, 0x40, 0x9c, 0x6a, 0x2e, 0xd6, 0x33, 0xdd, 0xc0,
+	0x39, 0x21, 0x37, 0x4a, 0x9b, 0xb8, 0x97, 0x50, 0x2f, 0x38, 0xf6, 0x18, 0xff, 0x24, 0xc8, 0x2b,
+	0x5b, 0x37, 0x35, 0x57, 0xac, 0x26, 0x85, 0x9d, 0x41, 0x77, 0x50, 0xe8, 0x5e, 0x76, 0x0d, 0x6f,
+	0x04, 0x4b, 0xbe, 0x11, 0xcc, 0x9d, 0xbe, 0x9a, 0xbb, 0xd3, 0xff, 0x61, 0x01, 0x0c, 0xba, 0x03,
+	0x4c, 0xa6, 0x33, 0xc2, 0x38, 0xea, 0x42, 0x7d, 0xa4, 0x50, 0x75, 0x70, 0x4e, 0xd6, 0xef, 0x45,
+	0xaf, 0xb0, 0x11, 0x44, 0x3d, 0xd8, 0x4e, 0xc8, 0xf4, 0x78, 0x34, 0x8b, 0x2e, 0x30, 0xf1, 0x69,
+	0x12, 0xb0, 0x85, 0x0f, 0x01, 0x2e, 0xee, 0xf6, 0x4b, 0x78, 0x51, 0x01, 0x3d, 0x82, 0x96, 0x2f,
+	0x68, 0x51, 0xf1, 0x53, 0x36, 0x74, 0xac, 0xc2, 0x28, 0x3f, 0xce, 0x6d, 0xf5, 0x4b, 0xb8, 0x20,
+	0x8a, 0x9e, 0xc0, 0x66, 0x4a, 0x8b, 0x36, 0x75, 0x36, 0x0a, 0x89, 0x3e, 0xce, 0xef, 0xf5, 0x4b,
+	0xb8, 0x28, 0x8c, 0xee, 0x81, 0x9d, 0x90, 0xa9, 0xfa, 0x10, 0x38, 0xd5, 0xc2, 0xab, 0x01, 0x93,
+	0x69, 0x76, 0x93, 0x4f, 0x85, 0xc4, 0x4d, 0x3e, 0x21, 0x53, 0xd9, 0x2f, 0x4e, 0xad, 0x70, 0x50,
+	0xb0, 0x66, 0x8b, 0x9b, 0xbc, 0x11, 0x41, 0x08, 0xac, 0x38, 0x0c, 0xe4, 0x9d, 0xdf, 0xee, 0x97,
+	0xb0, 0x20, 0x84, 0x89, 0xf4, 0x31, 0xd4, 0x58, 0x3a, 0x6b, 0x4b, 0x2f, 0xa1, 0x2e, 0x34, 0xe2,
+	0x84, 0xce, 0xc3, 0x80, 0x24, 0x8e, 0xbd, 0x1c, 0xdc, 0x40, 0xef, 0x49, 0x1d, 0xbd, 0xee, 0xd9,
+	0x50, 0x4f, 0x54, 0x4d, 0xdd, 0x27, 0xd0, 0x30, 0x9e, 0xa1, 0xdb, 0xc2, 0xf9, 0xb7, 0x24, 0x11,
+	0x8f, 0xbe, 0xb2, 0x6c, 0x83, 0x94, 0x46, 0xbb, 0x50, 0xf5, 0xe9, 0x2c, 0xe2, 0xb2, 0x7a, 0x55,
+	0xac, 0x08, 0xd7, 0x85, 0x46, 0xdf, 0x63, 0x23, 0x99, 0xac, 0x3d, 0xa8, 0x8d, 0x3c, 0x36, 0x22,
+	0xa2, 0x39, 0xac, 0x4e, 0x0b, 0x6b, 0xca, 0x7d, 0x0c, 0x9b, 0x85, 0x34, 0xa3, 0x3b, 0x50, 0x0d,
+	0x39, 0x99, 0x28, 0xb9, 0xd5, 0x75, 0xc4, 0x4a, 0xc2, 0xfd, 0xdd, 0x82, 0xa6, 0x6c, 0x40, 0x16,
+	0xd3, 0x88, 0x91, 0xb5, 0x3a, 0x70, 0x17, 0xaa, 0x24, 0x49, 0x68, 0x22, 0x3d, 0xb7, 0xb1, 0x22,
+	0xd0, 0x7d, 0x68, 0xfa, 0x63, 0xca, 0x48, 0xa2, 0x6a, 0x65, 0xed, 0x5b, 0x2b, 0x12, 0x8d, 0xf3,
+	0x32, 0xa2, 0x1b, 0xe4, 0x7b, 0xad, 0x47, 0x83, 0xcb, 0x85, 0x6e, 0xe8, 0x19, 0xbe, 0xe8, 0x86,
+	0x54, 0x08, 0x3d, 0x80, 0x96, 0x24, 0xb4, 0x4f, 0x4e, 0xad, 0x30, 0xad, 0x35, 0x57, 0xf4, 0x6c,
+	0x5e, 0x2a, 0x6d, 0x77, 0x73, 0x5e, 0xea, 0xcb, 0xed, 0x9e, 0x1d, 0x96, 0x82, 0xa8, 0xe8, 0x1d,
+	0xf9, 0x84, 0x5f, 0xee, 0x9d, 0x33, 0xcd, 0x16, 0x7d, 0x60, 0x44, 0x0a, 0xad, 0x66, 0x7f, 0xbc,
+	0xd5, 0x8e, 0xc0, 0x36, 0x6b, 0xe6, 0x40, 0xf1, 0x3e, 0xa5, 0xf9, 0xfa, 0x1c, 0x65, 0x72, 0x3d,
+	0x10, 0x4d, 0xa5, 0xca, 0xe7, 0x3e, 0x86, 0x86, 0xf1, 0x43, 0xb4, 0x8b, 0x17, 0xb1, 0x77, 0x24,
+	0x91, 0x95, 0x6c, 0x60, 0x4d, 0xc9, 0x36, 0x22, 0xe1, 0x70, 0xc4, 0xf5, 0xc8, 0xd2, 0x94, 0xfb,
+	0x10, 0x1a, 0x06, 0x44, 0xcc, 0xae, 0x93, 0x67, 0xba, 0x45, 0x2b, 0x27, 0xcf, 0xc4, 0xa4, 0x3b,
+	0x9d, 0x8d, 0x79, 0x28, 0xee, 0xc7, 0x4e, 0x45, 0x76, 0x5f, 0xc6, 0x70, 0x7f, 0x82, 0xcd, 0xc2,
+	0x51, 0x10, 0xe2, 0x32, 0x6b, 0xa2, 0x75, 0xb5, 0x95, 0x8c, 0x81, 0x0e, 0xf2, 0x51, 0x56, 0x56,
+	0xf7, 0x45, 0x26, 0xe1, 0x7e, 0x03, 0xad, 0x7c, 0xf0, 0x45, 0xf5, 0xf2, 0x47, 0xd5, 0x1f, 0x42,
+	0xe3, 0xd5, 0xec, 0xfc, 0x07, 0x1a, 0x87, 0xbe, 0xe8, 0x54, 0x2e, 0x16, 0x7a, 0x90, 0x2b, 0x42,
+	0x24, 0x64, 0x42, 0x83, 0xd9, 0x98, 0xe8, 0x06, 0xd6, 0x94, 0xfb, 0x08, 0x36, 0x8d, 0xa6, 0xfa,
+	0xda, 0xed, 0x41, 0x8d, 0x71, 0x8f, 0xcf, 0x98, 0xc9, 0xa8, 0xa2, 0x50, 0x1b, 0xac, 0x09, 0x1b,
+	0x6a, 0x6d, 0xb1, 0x74, 0x1f, 0xc1, 0xf6, 0x60, 0x76, 0x3e, 0x0e, 0xd9, 0x48, 0xaa, 0x8b, 0x41,
+	0xb9, 0x1a, 0x3b, 0xa7, 0xda, 0x52, 0xaa, 0x6f, 0x60, 0x77, 0x41, 0x55, 0x81, 0x5f, 0xe9, 0xbb,
+	0x76, 0xa9, 0xb2, 0xca, 0x25, 0x2b, 0x73, 0xe9, 0x04, 0x6c, 0x69, 0x50, 0x7e, 0xfa, 0x57, 0x1b,
+	0x43, 0xb0, 0xf1, 0x36, 0xa1, 0x13, 0x1d, 0x88, 0x5c, 0x0b, 0x9e, 0xf8, 0x27, 0x22, 0x2d, 0xb5,
+	0xb0, 0x5c, 0xbb, 0x1d, 0xd8, 0x7a, 0x41, 0xb8, 0xaf, 0x1c, 0x34, 0xa3, 0x49, 0xa7, 0xb0, 0x5c,
+	0x48, 0xe1, 0xe7, 0x60, 0x17, 0x84, 0x24, 0x8e, 0xaa, 0x9a, 0x8d, 0x35, 0xe5, 0x7e, 0x0d, 0x4d,
+	0x4c, 0x26, 0x74, 0x4e, 0xd6, 0x29, 0x12, 0x86, 0x76, 0x4e, 0xf9, 0x7a, 0x52, 0xf5, 0x1c, 0xda,
+	0x67, 0x84, 0x0f, 0xc4, 0x1f, 0x43, 0x9f, 0xca, 0xd7, 0x13, 0x43, 0xf7, 0xc1, 0x96, 0xbf, 0x10,
+	0x43, 0x71, 0x94, 0x8b, 0x73, 0x35, 0x2f, 0x88, 0x33, 0x29, 0xf7, 0x03, 0xb4, 0xf2, 0x5b, 0x62,
+	0xfa, 0xc7, 0x9a, 0xd6, 0x9e, 0xa5, 0xb4, 0x70, 0x2e, 0xf1, 0x38, 0x09, 0x23, 0x13, 0x9e, 0xa2,
+	0xc4, 0xe5, 0x43, 0xac, 0xe8, 0x8c, 0x6b, 0x07, 0x0d, 0x29, 0xce, 0x98, 0x58, 0x72, 0xca, 0xbd,
+	0xb1, 0xfc, 0xe8, 0xda, 0x38, 0x63, 0xb8, 0xbf, 0x96, 0xc1, 0x16, 0xa7, 0xe1, 0x95, 0x4f, 0x13,
+	0x82, 0xda, 0xea, 0x2b, 0xa8, 0x40, 0xc5, 0x52, 0xa4, 0x88, 0x89, 0x2d, 0x3d, 0x03, 0x14, 0x21,
+	0xb9, 0xdc, 0xe3, 0x44, 0x63, 0x29, 0x42, 0xf8, 0x7d, 0xee, 0x45, 0xaf, 0x23, 0x1e, 0x2a, 0x20,
+	0x0b, 0xa7, 0xb4, 0xf0, 0x62, 0xec, 0x31, 0xfe, 0xc2, 0x9b, 0x8d, 0xb9, 0xfe, 0xc7, 0x99, 0x31,
+	0xc4, 0x09, 0x4a, 0x9d, 0x90, 0x2d, 0xd0, 0x81, 0x9a, 0x44, 0x32, 0x07, 0xb7, 0x9d, 0x3b, 0xb8,
+	0x52, 0x0a, 0xeb, 0x7d, 0x17, 0x03, 0x60, 0x12, 0xd3, 0x84, 0x8b, 0xad, 0x15, 0x01, 0x08, 0xa7,
+	0xc8, 0xc8, 0x9b, 0x87, 0xfa, 0xbb, 0x53, 0xc5, 0x29, 0x2d, 0x93, 0x49, 0x3c, 0xa6, 0x7f, 0x5d,
+	0xda, 0x58, 0x53, 0xee, 0x43, 0x61, 0x73, 0xda, 0xf3, 0xa2, 0x2b, 0x6c, 0x3a, 0x50, 0x67, 0xc4,
+	0xa7, 0x91, 0xbe, 0x42, 0x59, 0xd8, 0x90, 0xbd, 0xcf, 0x7e, 0xfc, 0xdf, 0x30, 0xe4, 0xa3, 0xd9,
+	0xf9, 0xa1, 0x4f, 0x27, 0x77, 0x8f, 0x8e, 0xfc, 0xe8, 0xae, 0xfc, 0xad, 0x7c, 0x74, 0x74, 0x57,
+	0x06, 0x70, 0x5e, 0x93, 0x95, 0x3c, 0xfa, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xfa, 0xb8, 0x08, 0xe5,
+	0x94, 0x16, 0x00, 0x00,
 }
@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: queue.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueueLaneStats 消息队列单个优先级通道的统计
+type QueueLaneStats struct {
+	// critical, normal, async, background
+	Lane     string `protobuf:"bytes,1,opt,name=lane,proto3" json:"lane,omitempty"`
+	Depth    int64  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	Capacity int64  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// 成功发送的消息数
+	Sent int64 `protobuf:"varint,4,opt,name=sent,proto3" json:"sent,omitempty"`
+	// 因为过载被拒绝的消息数
+	Rejected int64 `protobuf:"varint,5,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// 消息在通道中等待被订阅者取出的平均以及最大时间, 单位毫秒
+	AvgWaitMs            int64    `protobuf:"varint,6,opt,name=avgWaitMs,proto3" json:"avgWaitMs,omitempty"`
+	MaxWaitMs            int64    `protobuf:"varint,7,opt,name=maxWaitMs,proto3" json:"maxWaitMs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueueLaneStats) Reset()         { *m = QueueLaneStats{} }
+func (m *QueueLaneStats) String() string { return proto.CompactTextString(m) }
+func (*QueueLaneStats) ProtoMessage()    {}
+func (*QueueLaneStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{0}
+}
+
+func (m *QueueLaneStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueLaneStats.Unmarshal(m, b)
+}
+func (m *QueueLaneStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueLaneStats.Marshal(b, m, deterministic)
+}
+func (m *QueueLaneStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueLaneStats.Merge(m, src)
+}
+func (m *QueueLaneStats) XXX_Size() int {
+	return xxx_messageInfo_QueueLaneStats.Size(m)
+}
+func (m *QueueLaneStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueLaneStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueLaneStats proto.InternalMessageInfo
+
+func (m *QueueLaneStats) GetLane() string {
+	if m != nil {
+		return m.Lane
+	}
+	return ""
+}
+
+func (m *QueueLaneStats) GetDepth() int64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *QueueLaneStats) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *QueueLaneStats) GetSent() int64 {
+	if m != nil {
+		return m.Sent
+	}
+	return 0
+}
+
+func (m *QueueLaneStats) GetRejected() int64 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+func (m *QueueLaneStats) GetAvgWaitMs() int64 {
+	if m != nil {
+		return m.AvgWaitMs
+	}
+	return 0
+}
+
+func (m *QueueLaneStats) GetMaxWaitMs() int64 {
+	if m != nil {
+		return m.MaxWaitMs
+	}
+	return 0
+}
+
+// QueueTopicStats 消息队列单个topic的统计
+type QueueTopicStats struct {
+	Topic                string            `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Lanes                []*QueueLaneStats `protobuf:"bytes,2,rep,name=lanes,proto3" json:"lanes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *QueueTopicStats) Reset()         { *m = QueueTopicStats{} }
+func (m *QueueTopicStats) String() string { return proto.CompactTextString(m) }
+func (*QueueTopicStats) ProtoMessage()    {}
+func (*QueueTopicStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{1}
+}
+
+func (m *QueueTopicStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueTopicStats.Unmarshal(m, b)
+}
+func (m *QueueTopicStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueTopicStats.Marshal(b, m, deterministic)
+}
+func (m *QueueTopicStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueTopicStats.Merge(m, src)
+}
+func (m *QueueTopicStats) XXX_Size() int {
+	return xxx_messageInfo_QueueTopicStats.Size(m)
+}
+func (m *QueueTopicStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueTopicStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueTopicStats proto.InternalMessageInfo
+
+func (m *QueueTopicStats) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *QueueTopicStats) GetLanes() []*QueueLaneStats {
+	if m != nil {
+		return m.Lanes
+	}
+	return nil
+}
+
+// QueueStats 消息队列统计
+type QueueStats struct {
+	Topics               []*QueueTopicStats `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueueStats) Reset()         { *m = QueueStats{} }
+func (m *QueueStats) String() string { return proto.CompactTextString(m) }
+func (*QueueStats) ProtoMessage()    {}
+func (*QueueStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{2}
+}
+
+func (m *QueueStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueStats.Unmarshal(m, b)
+}
+func (m *QueueStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueStats.Marshal(b, m, deterministic)
+}
+func (m *QueueStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueStats.Merge(m, src)
+}
+func (m *QueueStats) XXX_Size() int {
+	return xxx_messageInfo_QueueStats.Size(m)
+}
+func (m *QueueStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueStats proto.InternalMessageInfo
+
+func (m *QueueStats) GetTopics() []*QueueTopicStats {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueueLaneStats)(nil), "types.QueueLaneStats")
+	proto.RegisterType((*QueueTopicStats)(nil), "types.QueueTopicStats")
+	proto.RegisterType((*QueueStats)(nil), "types.QueueStats")
+}
+
+func init() {
+	proto.RegisterFile("queue.proto", fileDescriptor_96e4d7d76a734cd8)
+}
+
+var fileDescriptor_96e4d7d76a734cd8 = []byte{
+	// 268 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0x4d, 0x4b, 0xc3, 0x40,
+	0x10, 0x25, 0x6d, 0x13, 0xed, 0x14, 0x14, 0x96, 0x2a, 0x8b, 0x28, 0x86, 0x9c, 0x02, 0x42, 0x02,
+	0xe6, 0xea, 0xc9, 0xb3, 0x1e, 0x8c, 0x05, 0xc1, 0xdb, 0x76, 0x3b, 0x34, 0x11, 0xbb, 0x59, 0xbb,
+	0x13, 0xb1, 0x7f, 0xcf, 0x5f, 0x26, 0x3b, 0x1b, 0x5b, 0xbd, 0xcd, 0xfb, 0x98, 0xb7, 0xbc, 0x59,
+	0x98, 0x7d, 0xf4, 0xd8, 0x63, 0x61, 0xb7, 0x1d, 0x75, 0x22, 0xa6, 0x9d, 0x45, 0x97, 0x7d, 0x47,
+	0x70, 0xf2, 0xe4, 0xe9, 0x07, 0x65, 0xf0, 0x99, 0x14, 0x39, 0x21, 0x60, 0xf2, 0xae, 0x0c, 0xca,
+	0x28, 0x8d, 0xf2, 0x69, 0xcd, 0xb3, 0x98, 0x43, 0xbc, 0x42, 0x4b, 0x8d, 0x1c, 0xa5, 0x51, 0x3e,
+	0xae, 0x03, 0x10, 0x17, 0x70, 0xac, 0x95, 0x55, 0xba, 0xa5, 0x9d, 0x1c, 0xb3, 0xb0, 0xc7, 0x3e,
+	0xc5, 0xa1, 0x21, 0x39, 0x61, 0x9e, 0x67, 0xef, 0xdf, 0xe2, 0x1b, 0x6a, 0xc2, 0x95, 0x8c, 0x83,
+	0xff, 0x17, 0x8b, 0x4b, 0x98, 0xaa, 0xcf, 0xf5, 0x8b, 0x6a, 0xe9, 0xd1, 0xc9, 0x84, 0xc5, 0x03,
+	0xe1, 0xd5, 0x8d, 0xfa, 0x1a, 0xd4, 0xa3, 0xa0, 0xee, 0x89, 0x6c, 0x01, 0xa7, 0xdc, 0x61, 0xd1,
+	0xd9, 0x56, 0x87, 0x12, 0x73, 0x88, 0xc9, 0xa3, 0xa1, 0x45, 0x00, 0xe2, 0x06, 0x62, 0x5f, 0xc7,
+	0xc9, 0x51, 0x3a, 0xce, 0x67, 0xb7, 0x67, 0x05, 0x1f, 0xa1, 0xf8, 0x7f, 0x80, 0x3a, 0x78, 0xb2,
+	0x3b, 0x00, 0x16, 0x42, 0x60, 0x01, 0x09, 0x67, 0x38, 0x19, 0xf1, 0xee, 0xf9, 0xdf, 0xdd, 0xc3,
+	0xc3, 0xf5, 0xe0, 0xba, 0xbf, 0x7e, 0xbd, 0x5a, 0xb7, 0xd4, 0xf4, 0xcb, 0x42, 0x77, 0x9b, 0xb2,
+	0xaa, 0xb4, 0x29, 0x75, 0xa3, 0x5a, 0x53, 0x55, 0x25, 0x2f, 0x2e, 0x13, 0xfe, 0x87, 0xea, 0x27,
+	0x00, 0x00, 0xff, 0xff, 0x85, 0x1c, 0x8f, 0xa7, 0x96, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,142 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	tml "github.com/BurntSushi/toml"
+)
+
+// 配置项规则都是toml 展开之后的小写key, 以.结尾的规则按前缀匹配
+var (
+	// 共识相关的配置, 各个节点必须一致, 不允许热加载
+	consensusConfigKeys = []string{
+		"title", "chainid", "addrver", "coinsymbol", "testnet", "txheight", "txseqnonce",
+		"enableparafork", "disableforkcheck", "store.name", "store.sub.",
+		"fork.", "mver.", "consensus.", "exec.", "crypto.",
+	}
+	// 可以热加载的配置, 修改之后通过EventConfigReloaded 通知各个模块
+	reloadableConfigKeys = []string{
+		"log.",
+		"rpc.whitelist", "rpc.whitlist", "rpc.jrpcfuncwhitelist", "rpc.grpcfuncwhitelist",
+		"rpc.jrpcfuncblacklist", "rpc.grpcfuncblacklist",
+		"mempool.mintxfeerate", "mempool.maxtxfeerate", "mempool.maxtxfee", "mempool.islevelfee",
+		"blockchain.maxpushsubscriber",
+		"p2p.sub.dht.maxconnectnum",
+	}
+)
+
+//ReloadConfig 配置热加载之后通知各个模块的新配置, 各个模块只需要处理自己可以热加载的配置项
+type ReloadConfig struct {
+	Config *Config
+	Sub    *ConfigSubModule
+}
+
+func matchConfigKey(key string, rules []string) bool {
+	key = strings.ToLower(key)
+	for _, rule := range rules {
+		if key == rule || (strings.HasSuffix(rule, ".") && strings.HasPrefix(key, rule)) {
+			return true
+		}
+	}
+	return false
+}
+
+//IsReloadableConfig 配置项是否可以热加载
+func IsReloadableConfig(key string) bool {
+	return matchConfigKey(key, reloadableConfigKeys)
+}
+
+//DiffConfig 比较新旧两个配置文件, 按照是否可以热加载对修改的配置项分类
+//修改了共识相关的配置时返回ErrConfigNotReloadable, 同时在Refused 中返回这些配置项
+func DiffConfig(oldCfg, newCfg string) (*ReplyReloadConfig, error) {
+	oldFlat, err := flatConfigString(oldCfg)
+	if err != nil {
+		return nil, err
+	}
+	newFlat, err := flatConfigString(newCfg)
+	if err != nil {
+		return nil, err
+	}
+	var changed []string
+	for key, value := range newFlat {
+		if old, ok := oldFlat[key]; !ok || !reflect.DeepEqual(old, value) {
+			changed = append(changed, key)
+		}
+	}
+	for key := range oldFlat {
+		if _, ok := newFlat[key]; !ok {
+			changed = append(changed, key)
+		}
+	}
+	sort.Strings(changed)
+	reply := &ReplyReloadConfig{}
+	for _, key := range changed {
+		switch {
+		case matchConfigKey(key, consensusConfigKeys):
+			reply.Refused = append(reply.Refused, key)
+		case matchConfigKey(key, reloadableConfigKeys):
+			reply.Applied = append(reply.Applied, key)
+		default:
+			reply.RestartRequired = append(reply.RestartRequired, key)
+		}
+	}
+	if len(reply.Refused) > 0 {
+		return reply, fmt.Errorf("%w: %s", ErrConfigNotReloadable, strings.Join(reply.Refused, ", "))
+	}
+	return reply, nil
+}
+
+func flatConfigString(cfgstring string) (map[string]interface{}, error) {
+	cfg := make(map[string]interface{})
+	if _, err := tml.Decode(cfgstring, &cfg); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrConfigInvalid, err)
+	}
+	return FlatConfig(cfg), nil
+}
+
+//LoadConfigFile 读取并解析配置文件, 解析流程和启动时一致, 出错时返回ErrConfigInvalid 而不是panic
+func LoadConfigFile(path, defCfg string) (cfgstring string, cfg *Config, sub *ConfigSubModule, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrConfigInvalid, r)
+		}
+	}()
+	cfgstring = MergeCfg(ReadFile(path), defCfg)
+	cfg, sub = InitCfgString(cfgstring)
+	return cfgstring, cfg, sub, nil
+}
+
+//CheckReloadConfig 校验热加载的新配置, 并和启动时一样填充交易费的默认值
+//链上的交易费配置在启动时确定, 区块执行时会用到, 所以mempool 只能提高最小交易费或者降低最大交易费
+func (c *Chain33Config) CheckReloadConfig(cfg *Config) error {
+	if cfg.Mempool == nil || cfg.Wallet == nil {
+		return fmt.Errorf("%w: mempool and wallet config must be set", ErrConfigInvalid)
+	}
+	mem := cfg.Mempool
+	if mem.MaxTxFeeRate == 0 {
+		mem.MaxTxFeeRate = 1e7
+	}
+	if mem.MaxTxFee == 0 {
+		mem.MaxTxFee = 1e9
+	}
+	if mem.MinTxFeeRate < 0 || mem.MinTxFeeRate > mem.MaxTxFeeRate || mem.MaxTxFeeRate > mem.MaxTxFee {
+		return fmt.Errorf("%w: tx fee must meet, 0 <= minTxFeeRate <= maxTxFeeRate <= maxTxFee", ErrConfigInvalid)
+	}
+	if mem.MinTxFeeRate < c.GetMinTxFeeRate() {
+		return fmt.Errorf("%w: mempool.minTxFeeRate less than chain minTxFeeRate %d", ErrConfigInvalid, c.GetMinTxFeeRate())
+	}
+	if mem.MaxTxFee > c.GetMaxTxFee() {
+		return fmt.Errorf("%w: mempool.maxTxFee greater than chain maxTxFee %d", ErrConfigInvalid, c.GetMaxTxFee())
+	}
+	if cfg.Wallet.MinFee < mem.MinTxFeeRate {
+		return fmt.Errorf("%w: config must meet, wallet.minFee >= mempool.minTxFeeRate", ErrConfigInvalid)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffConfig(t *testing.T) {
+	old := GetDefaultCfgstring()
+	reply, err := DiffConfig(old, old)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(reply.Applied)+len(reply.RestartRequired)+len(reply.Refused))
+
+	//可以热加载的配置和需要重启的配置
+	cfg := strings.Replace(old, `whitelist=["127.0.0.1"]`, `whitelist=["127.0.0.1", "192.168.0.1"]`, 1)
+	cfg = strings.Replace(cfg, "minTxFeeRate=100000", "minTxFeeRate=200000", 1)
+	cfg = strings.Replace(cfg, "poolCacheSize=102400", "poolCacheSize=1024", 1)
+	reply, err = DiffConfig(old, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"mempool.minTxFeeRate", "rpc.whitelist"}, reply.Applied)
+	assert.Equal(t, []string{"mempool.poolCacheSize"}, reply.RestartRequired)
+	assert.Nil(t, reply.Refused)
+
+	//修改共识相关的配置
+	cfg = strings.Replace(cfg, `genesis="14KEKbYtKKQm4wMthSK9J4La4nAiidGozt"`, `genesis="1BQXS6TxaYYG5mADaWij4AxhZZUTpw95a5"`, 1)
+	cfg = strings.Replace(cfg, "maxTxNumber = 10000", "maxTxNumber = 20000", 1)
+	reply, err = DiffConfig(old, cfg)
+	assert.True(t, errors.Is(err, ErrConfigNotReloadable))
+	assert.Equal(t, []string{"consensus.genesis", "mver.consensus.maxTxNumber"}, reply.Refused)
+
+	_, err = DiffConfig(old, "title=")
+	assert.True(t, errors.Is(err, ErrConfigInvalid))
+
+	assert.True(t, IsReloadableConfig("log.logConsoleLevel"))
+	assert.True(t, IsReloadableConfig("p2p.sub.dht.maxConnectNum"))
+	assert.False(t, IsReloadableConfig("p2p.sub.dht.port"))
+}
+
+func TestCheckReloadConfig(t *testing.T) {
+	cfgstring := GetDefaultCfgstring()
+	chain33Cfg := NewChain33Config(cfgstring)
+	dir, err := ioutil.TempDir("", "reload")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "chain33.toml")
+
+	_, _, _, err = LoadConfigFile(path, "")
+	assert.True(t, errors.Is(err, ErrConfigInvalid))
+
+	check := func(cfgstring string) error {
+		require.Nil(t, ioutil.WriteFile(path, []byte(cfgstring), 0644))
+		_, cfg, _, err := LoadConfigFile(path, "")
+		require.Nil(t, err)
+		return chain33Cfg.CheckReloadConfig(cfg)
+	}
+	assert.Nil(t, check(cfgstring))
+	//低于链上的最小交易费
+	err = check(strings.Replace(cfgstring, "minTxFeeRate=100000", "minTxFeeRate=10000", 1))
+	assert.True(t, errors.Is(err, ErrConfigInvalid))
+	//钱包的交易费低于mempool
+	err = check(strings.Replace(cfgstring, "minTxFeeRate=100000", "minTxFeeRate=200000", 1))
+	assert.True(t, errors.Is(err, ErrConfigInvalid))
+	cfg := strings.Replace(cfgstring, "minTxFeeRate=100000", "minTxFeeRate=200000", 1)
+	assert.Nil(t, check(strings.Replace(cfg, "minFee=100000", "minFee=200000", 1)))
+}
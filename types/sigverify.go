@@ -0,0 +1,196 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"crypto/sha256"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	// DefaultSigCacheSize 默认缓存的已验签交易数量
+	DefaultSigCacheSize = 102400
+	// sigTaskSize 每个校验任务包含的交易数量, 任务内逐个验签
+	sigTaskSize = 128
+)
+
+var (
+	sigCache       *lru.Cache
+	sigCacheEnable int32 = 1
+)
+
+func init() {
+	var err error
+	sigCache, err = lru.New(DefaultSigCacheSize)
+	if err != nil {
+		panic(err)
+	}
+}
+
+//SetSigCacheSize 设置验签缓存的容量, 0表示关闭缓存
+func SetSigCacheSize(size int) {
+	if size <= 0 {
+		atomic.StoreInt32(&sigCacheEnable, 0)
+		sigCache.Purge()
+		return
+	}
+	sigCache.Resize(size)
+	atomic.StoreInt32(&sigCacheEnable, 1)
+}
+
+// sigCacheKey 由签名数据和签名共同决定, 同一交易更换签名后需要重新校验
+func sigCacheKey(data []byte, sign *Signature) string {
+	h := sha256.New()
+	h.Write(common.Sha256(data))
+	h.Write(Encode(sign))
+	return string(h.Sum(nil))
+}
+
+// sigCacheHit 缓存中记录的是验签通过时的最低区块高度, 低于该高度时插件可能还未启用, 需要重新校验
+func sigCacheHit(key string, blockHeight int64) bool {
+	if atomic.LoadInt32(&sigCacheEnable) == 0 {
+		return false
+	}
+	height, ok := sigCache.Get(key)
+	return ok && blockHeight >= height.(int64)
+}
+
+func sigCacheAdd(key string, blockHeight int64) {
+	if atomic.LoadInt32(&sigCacheEnable) == 0 {
+		return
+	}
+	if height, ok := sigCache.Peek(key); ok && height.(int64) <= blockHeight {
+		return
+	}
+	sigCache.Add(key, blockHeight)
+}
+
+// checkTxSign 校验交易签名, 校验通过的结果缓存后, 区块校验时可以跳过mempool中已经校验过的交易
+// 与区块高度相关的签名(如证书)每次都需要重新校验
+func checkTxSign(data []byte, execer string, sign *Signature, blockHeight int64) bool {
+	c, err := crypto.New(GetSignName(execer, int(sign.Ty)), crypto.WithNewOptionEnableCheck(blockHeight))
+	if err != nil {
+		return false
+	}
+	if hv, ok := c.(crypto.HeightValidator); ok {
+		return hv.ValidateByHeight(data, sign.Pubkey, sign.Signature, blockHeight, nil) == nil
+	}
+	key := sigCacheKey(data, sign)
+	if sigCacheHit(key, blockHeight) {
+		return true
+	}
+	if c.Validate(data, sign.Pubkey, sign.Signature) != nil {
+		return false
+	}
+	sigCacheAdd(key, blockHeight)
+	return true
+}
+
+// sigTask 同一种签名类型的若干交易, 由一个协程逐个校验
+type sigTask struct {
+	c   crypto.Crypto
+	txs []*Transaction
+}
+
+func (task *sigTask) verify(blockHeight int64, parentState []byte) bool {
+	hv, isHeightSign := task.c.(crypto.HeightValidator)
+	for _, tx := range task.txs {
+		data := tx.signData()
+		sign := tx.GetSignature()
+		if isHeightSign {
+			if hv.ValidateByHeight(data, sign.Pubkey, sign.Signature, blockHeight, parentState) != nil {
+				return false
+			}
+			continue
+		}
+		key := sigCacheKey(data, sign)
+		if sigCacheHit(key, blockHeight) {
+			continue
+		}
+		if task.c.Validate(data, sign.Pubkey, sign.Signature) != nil {
+			return false
+		}
+		sigCacheAdd(key, blockHeight)
+	}
+	return true
+}
+
+// splitSigTasks 按照签名类型对交易分组, 每组再按照sigTaskSize拆分成多个任务
+func splitSigTasks(txs []*Transaction, blockHeight int64) ([]*sigTask, bool) {
+	groups := make(map[string]*sigTask)
+	var names []string
+	for _, tx := range txs {
+		sign := tx.GetSignature()
+		if sign == nil {
+			return nil, false
+		}
+		name := GetSignName(string(tx.Execer), int(sign.Ty))
+		group, ok := groups[name]
+		if !ok {
+			c, err := crypto.New(name, crypto.WithNewOptionEnableCheck(blockHeight))
+			if err != nil {
+				return nil, false
+			}
+			group = &sigTask{c: c}
+			groups[name] = group
+			names = append(names, name)
+		}
+		group.txs = append(group.txs, tx)
+	}
+	var tasks []*sigTask
+	for _, name := range names {
+		group := groups[name]
+		for i := 0; i < len(group.txs); i += sigTaskSize {
+			end := i + sigTaskSize
+			if end > len(group.txs) {
+				end = len(group.txs)
+			}
+			tasks = append(tasks, &sigTask{c: group.c, txs: group.txs[i:end]})
+		}
+	}
+	return tasks, true
+}
+
+// verifyTxsSignature 多个协程并行逐个校验交易签名, 已经缓存的签名跳过校验
+func verifyTxsSignature(txs []*Transaction, blockHeight int64, parentState []byte) bool {
+	//没有需要要验签的交易，直接返回
+	if len(txs) == 0 {
+		return true
+	}
+	tasks, ok := splitSigTasks(txs, blockHeight)
+	if !ok {
+		return false
+	}
+	workers := runtime.NumCPU()
+	if workers > len(tasks) {
+		workers = len(tasks)
+	}
+	var failed int32
+	var next int64 = -1
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := atomic.AddInt64(&next, 1)
+				if index >= int64(len(tasks)) {
+					return
+				}
+				if !tasks[index].verify(blockHeight, parentState) {
+					atomic.StoreInt32(&failed, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return atomic.LoadInt32(&failed) == 0
+}
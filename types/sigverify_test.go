@@ -0,0 +1,74 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func genSignedTxs(t *testing.T, signType string, n int) []*Transaction {
+	c, err := crypto.New(signType)
+	require.Nil(t, err)
+	priv, err := c.GenKey()
+	require.Nil(t, err)
+	txs := make([]*Transaction, n)
+	for i := 0; i < n; i++ {
+		txs[i] = &Transaction{Execer: []byte("coins"), Payload: []byte("sigverify"), Nonce: int64(i)}
+		txs[i].Sign(int32(crypto.GetType(signType)), priv)
+	}
+	return txs
+}
+
+func TestVerifyTxsSignature(t *testing.T) {
+	defer SetSigCacheSize(DefaultSigCacheSize)
+	SetSigCacheSize(0)
+	txs := append(genSignedTxs(t, "secp256k1", sigTaskSize+10), genSignedTxs(t, "ed25519", 10)...)
+	tasks, ok := splitSigTasks(txs, 0)
+	require.True(t, ok)
+	assert.Equal(t, 3, len(tasks))
+	assert.True(t, verifyTxsSignature(txs, 0, nil))
+
+	txs[sigTaskSize+15].Signature.Signature[0]++
+	assert.False(t, verifyTxsSignature(txs, 0, nil))
+	txs[sigTaskSize+15].Signature.Signature[0]--
+	txs[0].Signature = nil
+	assert.False(t, verifyTxsSignature(txs, 0, nil))
+}
+
+func TestSigCache(t *testing.T) {
+	defer SetSigCacheSize(DefaultSigCacheSize)
+	SetSigCacheSize(1024)
+	txs := genSignedTxs(t, "secp256k1", 2)
+	key := sigCacheKey(txs[0].signData(), txs[0].Signature)
+	assert.False(t, sigCacheHit(key, 10))
+
+	//mempool中验签后缓存, 区块校验时跳过
+	assert.True(t, txs[0].CheckSign(10))
+	assert.True(t, sigCacheHit(key, 10))
+	assert.True(t, sigCacheHit(key, 11))
+	//低于缓存的验签高度需要重新校验
+	assert.False(t, sigCacheHit(key, 9))
+	assert.True(t, txs[0].CheckSign(9))
+	assert.True(t, sigCacheHit(key, 9))
+
+	//签名变化后缓存不再命中
+	sign := *txs[0].Signature
+	sign.Signature = append([]byte{}, sign.Signature...)
+	sign.Signature[0]++
+	assert.False(t, sigCacheHit(sigCacheKey(txs[0].signData(), &sign), 10))
+	txs[0].Signature = &sign
+	assert.False(t, txs[0].CheckSign(10))
+
+	//区块校验后同样写入缓存
+	assert.True(t, verifyTxsSignature(txs[1:], 10, nil))
+	assert.True(t, sigCacheHit(sigCacheKey(txs[1].signData(), txs[1].Signature), 10))
+
+	SetSigCacheSize(0)
+	assert.False(t, sigCacheHit(sigCacheKey(txs[1].signData(), txs[1].Signature), 10))
+}
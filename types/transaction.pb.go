@@ -719,11 +719,13 @@ type Transaction struct {
 	//随机ID，可以防止payload 相同的时候，交易重复
 	Nonce int64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	//对方地址，如果没有对方地址，可以为空
-	To                   string   `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
-	GroupCount           int32    `protobuf:"varint,8,opt,name=groupCount,proto3" json:"groupCount,omitempty"`
-	Header               []byte   `protobuf:"bytes,9,opt,name=header,proto3" json:"header,omitempty"`
-	Next                 []byte   `protobuf:"bytes,10,opt,name=next,proto3" json:"next,omitempty"`
-	ChainID              int32    `protobuf:"varint,11,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	To         string `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	GroupCount int32  `protobuf:"varint,8,opt,name=groupCount,proto3" json:"groupCount,omitempty"`
+	Header     []byte `protobuf:"bytes,9,opt,name=header,proto3" json:"header,omitempty"`
+	Next       []byte `protobuf:"bytes,10,opt,name=next,proto3" json:"next,omitempty"`
+	ChainID    int32  `protobuf:"varint,11,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// nonce是否为账户序号, 与随机的nonce区分
+	IsSeqNonce           bool     `protobuf:"varint,12,opt,name=isSeqNonce,proto3" json:"isSeqNonce,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -831,6 +833,13 @@ func (m *Transaction) GetChainID() int32 {
 	return 0
 }
 
+func (m *Transaction) GetIsSeqNonce() bool {
+	if m != nil {
+		return m.IsSeqNonce
+	}
+	return false
+}
+
 type Transactions struct {
 	Txs                  []*Transaction `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -2377,100 +2386,100 @@ func init() {
 }
 
 var fileDescriptor_2cc4e03d2c28c490 = []byte{
-	// 1505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0x1b, 0x37,
-	0x17, 0x85, 0x34, 0x92, 0x2d, 0x5d, 0xc9, 0xfe, 0xec, 0x41, 0x90, 0x08, 0x46, 0x3e, 0xc7, 0x25,
-	0x12, 0x20, 0x08, 0x02, 0x19, 0xb0, 0xb3, 0x6b, 0x81, 0x26, 0xb1, 0x93, 0xd8, 0x70, 0x92, 0xa6,
-	0xb4, 0x92, 0x00, 0x6d, 0x37, 0xf4, 0xe8, 0x5a, 0x9a, 0x7a, 0x34, 0x94, 0x67, 0x28, 0x67, 0xd4,
-	0x07, 0xe8, 0xa6, 0xdd, 0xf5, 0x19, 0xfa, 0x3e, 0x7d, 0x8c, 0x3e, 0x46, 0xc1, 0x4b, 0x72, 0x86,
-	0xf2, 0x4f, 0x91, 0x45, 0x80, 0xee, 0x78, 0x2e, 0xa9, 0xfb, 0x7b, 0x78, 0x86, 0x82, 0x75, 0x95,
-	0x89, 0x34, 0x17, 0x91, 0x8a, 0x65, 0xda, 0x9f, 0x66, 0x52, 0xc9, 0xb0, 0xa9, 0xe6, 0x53, 0xcc,
-	0x37, 0xba, 0x91, 0x9c, 0x4c, 0x9c, 0x91, 0xbd, 0x81, 0x95, 0x67, 0x79, 0x8e, 0x2a, 0x7f, 0x85,
-	0x29, 0xe6, 0x71, 0x1e, 0xde, 0x86, 0x25, 0x31, 0x91, 0xb3, 0x54, 0xf5, 0xea, 0x5b, 0xb5, 0x87,
-	0x01, 0xb7, 0x28, 0xbc, 0x0f, 0x2b, 0x19, 0xaa, 0x59, 0x96, 0x3e, 0x1b, 0x0e, 0x33, 0xcc, 0xf3,
-	0x5e, 0xb0, 0x55, 0x7b, 0xd8, 0xe6, 0x8b, 0x46, 0xf6, 0x7b, 0x0d, 0x6e, 0x19, 0x7f, 0x03, 0x1d,
-	0xff, 0x14, 0xb3, 0x81, 0x7c, 0x51, 0x60, 0x14, 0xde, 0x85, 0x76, 0x24, 0xe3, 0x54, 0xc9, 0x33,
-	0x4c, 0x7b, 0x35, 0xfa, 0x69, 0x65, 0xb8, 0x31, 0x68, 0x08, 0x8d, 0x54, 0x2a, 0xa4, 0x58, 0x5d,
-	0x4e, 0xeb, 0x70, 0x03, 0x5a, 0x58, 0x60, 0xf4, 0x56, 0x4c, 0xb0, 0xd7, 0x20, 0x47, 0x25, 0x0e,
-	0x57, 0xa1, 0xae, 0x64, 0xaf, 0x49, 0xd6, 0xba, 0x92, 0xec, 0xd7, 0x1a, 0xac, 0x9a, 0x74, 0x3e,
-	0xc6, 0x6a, 0x3c, 0xcc, 0xc4, 0xa7, 0xff, 0x28, 0x91, 0x9f, 0x5d, 0x1e, 0xae, 0x2d, 0x5f, 0x30,
-	0x0f, 0x13, 0xab, 0x51, 0xc6, 0x3a, 0x82, 0x26, 0xc5, 0xd2, 0x87, 0x75, 0x42, 0xd6, 0x3b, 0xad,
-	0xb5, 0xe3, 0x7c, 0x3e, 0x39, 0x91, 0x09, 0x39, 0x6e, 0x73, 0x8b, 0xbc, 0x80, 0x81, 0x1f, 0x90,
-	0xfd, 0x5d, 0x83, 0xd6, 0x5e, 0x86, 0x42, 0xe1, 0xa0, 0xb0, 0x91, 0x6a, 0x2e, 0xd2, 0x8d, 0x59,
-	0xae, 0x41, 0x70, 0x8a, 0x68, 0x3d, 0xe9, 0x65, 0x99, 0x77, 0xc3, 0xcb, 0x7b, 0x13, 0x20, 0x2e,
-	0xe7, 0x42, 0xbd, 0x6a, 0x71, 0xcf, 0x12, 0xf6, 0x60, 0x39, 0xce, 0x07, 0xd4, 0x9f, 0x25, 0xda,
-	0x74, 0x30, 0xdc, 0x82, 0x0e, 0xb5, 0xe9, 0xd8, 0x54, 0xb2, 0x4c, 0x09, 0xf9, 0xa6, 0x85, 0xd9,
-	0xb4, 0x2e, 0xcd, 0xe6, 0x36, 0x2c, 0xe9, 0x35, 0x66, 0xbd, 0xb6, 0x69, 0x81, 0x41, 0x2c, 0x85,
-	0x2e, 0xc7, 0x8f, 0x59, 0xac, 0x90, 0x8b, 0x4f, 0xb6, 0xda, 0xa2, 0xac, 0xd6, 0x55, 0x1f, 0xf8,
-	0xd5, 0x63, 0x31, 0x8d, 0x33, 0x37, 0x7d, 0x8b, 0x5c, 0xf5, 0xcd, 0xaa, 0xfa, 0x5b, 0xd0, 0x8c,
-	0xd3, 0x21, 0x16, 0x54, 0x47, 0x93, 0x1b, 0xc0, 0x1e, 0xc1, 0x6d, 0xdb, 0xd9, 0xea, 0xaa, 0xbe,
-	0xca, 0xe4, 0x6c, 0xaa, 0x3d, 0xa8, 0x22, 0xef, 0xd5, 0xb6, 0x82, 0x87, 0x6d, 0xae, 0x97, 0x6c,
-	0x13, 0x5a, 0xef, 0xd3, 0x3c, 0x1e, 0xa5, 0x83, 0x42, 0xf7, 0x72, 0x28, 0x94, 0xa0, 0xcc, 0xba,
-	0x9c, 0xd6, 0x2c, 0x83, 0xee, 0x5b, 0xf9, 0x5c, 0x24, 0x22, 0x8d, 0x70, 0x50, 0xd0, 0x2d, 0x56,
-	0xc5, 0x01, 0x96, 0x4e, 0x2c, 0xd2, 0x3d, 0x9d, 0x8a, 0xb9, 0xbe, 0xad, 0x76, 0xfe, 0x0e, 0xd2,
-	0x4e, 0x16, 0x5f, 0x9c, 0xe1, 0xdc, 0x96, 0xe8, 0xe0, 0x4d, 0x75, 0x32, 0x09, 0x1d, 0x2f, 0xa6,
-	0x2e, 0x92, 0x82, 0xd8, 0x8e, 0x19, 0xf0, 0x45, 0x03, 0xfe, 0x59, 0x87, 0x8e, 0xd7, 0x2b, 0x6f,
-	0x90, 0xa6, 0x15, 0x16, 0xd9, 0x98, 0x89, 0x14, 0x43, 0x8a, 0xd9, 0xe5, 0x0e, 0x86, 0x7d, 0x68,
-	0xeb, 0x26, 0x0a, 0x35, 0xcb, 0x0c, 0x3d, 0x3b, 0x3b, 0x6b, 0x7d, 0x92, 0xc5, 0xfe, 0xb1, 0xb3,
-	0xf3, 0xea, 0x88, 0x1b, 0x65, 0xa3, 0x1a, 0x65, 0x95, 0x9b, 0x99, 0xaf, 0x1b, 0xfa, 0x2d, 0x68,
-	0xa6, 0x32, 0x8d, 0x90, 0x46, 0x1c, 0x70, 0x03, 0x2c, 0x65, 0x96, 0x4b, 0xca, 0x6c, 0x02, 0x8c,
-	0xf4, 0x84, 0xf7, 0xe8, 0xd2, 0xb4, 0x88, 0x0d, 0x9e, 0x45, 0x7b, 0x1f, 0xa3, 0x18, 0x5a, 0x6a,
-	0x76, 0xb9, 0x45, 0x74, 0x7d, 0xb0, 0x50, 0x3d, 0xb0, 0xd7, 0x07, 0x0b, 0xa5, 0xab, 0x8c, 0xc6,
-	0x22, 0x4e, 0x0f, 0xf7, 0x7b, 0x1d, 0x72, 0xe4, 0x20, 0x7b, 0x02, 0x5d, 0xaf, 0x4d, 0x79, 0x78,
-	0xbf, 0xa2, 0x53, 0x67, 0x27, 0xb4, 0xf5, 0x7a, 0x27, 0x0c, 0xc5, 0xbe, 0x85, 0x15, 0x1e, 0xa7,
-	0xa3, 0xb2, 0x0f, 0x61, 0x1f, 0x9a, 0xb1, 0xc2, 0x89, 0xfb, 0x61, 0xcf, 0xfe, 0x70, 0xe1, 0xd0,
-	0xa1, 0xc2, 0x09, 0x37, 0xc7, 0xd8, 0x21, 0xac, 0x5f, 0xd9, 0xd3, 0x15, 0x4d, 0x67, 0x27, 0x7a,
-	0xc8, 0xda, 0x4b, 0x97, 0x5b, 0xa4, 0xe5, 0xaf, 0x9a, 0x44, 0x9d, 0xb6, 0x2a, 0x03, 0xfb, 0x1e,
-	0xda, 0x55, 0x1e, 0xba, 0x89, 0x73, 0x1a, 0x71, 0x93, 0xd7, 0xd5, 0xdc, 0x73, 0x69, 0xa6, 0x7b,
-	0xad, 0x4b, 0x23, 0x90, 0x9e, 0xcb, 0x9f, 0xa0, 0xab, 0x69, 0xf7, 0xdd, 0x05, 0x66, 0x17, 0x31,
-	0x92, 0xba, 0x64, 0x18, 0xc5, 0x17, 0x96, 0x3d, 0x01, 0x77, 0x50, 0xef, 0x9c, 0x18, 0x56, 0x5b,
-	0x59, 0x73, 0x50, 0xef, 0xa8, 0x62, 0xcf, 0x53, 0x49, 0x07, 0xd9, 0x1f, 0x35, 0x58, 0xe6, 0x78,
-	0x4e, 0xc4, 0x0e, 0xa1, 0x21, 0x34, 0xdf, 0xad, 0xec, 0x0a, 0x6b, 0x3b, 0x4d, 0xc4, 0x88, 0x1c,
-	0x36, 0x39, 0xad, 0x35, 0x65, 0xa2, 0xd2, 0x57, 0x93, 0x1b, 0xa0, 0xab, 0x18, 0xc6, 0x19, 0xd2,
-	0x60, 0x88, 0x78, 0x4d, 0x5e, 0x19, 0x0c, 0x41, 0xe2, 0xd1, 0x58, 0x39, 0xfa, 0x19, 0xb4, 0xa8,
-	0x30, 0x81, 0x53, 0x98, 0x3b, 0xd0, 0x3c, 0xc0, 0xe2, 0xaa, 0x94, 0xb1, 0x19, 0x74, 0x38, 0x4e,
-	0x93, 0xf9, 0xa0, 0x38, 0x4c, 0x4f, 0xa5, 0xce, 0x6e, 0x2c, 0xf2, 0xb1, 0x53, 0x14, 0xbd, 0xf6,
-	0x22, 0xd5, 0xaf, 0x8f, 0x14, 0x78, 0x91, 0xc2, 0xfb, 0xb0, 0x24, 0xe8, 0xfb, 0xd6, 0x6b, 0x10,
-	0x59, 0xba, 0x96, 0x2c, 0xf4, 0x21, 0xe2, 0x76, 0x8f, 0x7d, 0x05, 0x6d, 0x8e, 0xe7, 0x83, 0xe2,
-	0x75, 0x9c, 0xab, 0xaa, 0x7c, 0xd3, 0x7e, 0x03, 0xd8, 0x6e, 0x99, 0x19, 0x1d, 0xfa, 0x3c, 0xea,
-	0x3e, 0x80, 0x15, 0x8e, 0xe7, 0xaf, 0x50, 0xbd, 0xc1, 0xc9, 0x54, 0xca, 0x84, 0x92, 0xcc, 0x9f,
-	0x25, 0x09, 0xf9, 0x6e, 0x71, 0x03, 0xd8, 0x53, 0x2d, 0xf0, 0xe7, 0xef, 0x32, 0x39, 0xc5, 0xec,
-	0x25, 0x2e, 0x8c, 0xd3, 0xb0, 0xcb, 0x41, 0x23, 0x9f, 0xc7, 0xf1, 0x2f, 0x68, 0x07, 0x66, 0x11,
-	0xeb, 0xc3, 0x2a, 0x65, 0x57, 0xf9, 0xb8, 0x0b, 0xed, 0xa9, 0x03, 0xb6, 0x92, 0xca, 0xc0, 0x38,
-	0xc0, 0xa0, 0x38, 0x10, 0xf9, 0x98, 0x8a, 0xd1, 0x2d, 0x15, 0xf9, 0x18, 0x73, 0x77, 0x17, 0x0c,
-	0xaa, 0x3a, 0x51, 0xf7, 0x3a, 0xe1, 0x29, 0x4d, 0xb0, 0x15, 0x54, 0x4a, 0xc3, 0xbe, 0xd1, 0x55,
-	0x94, 0xb3, 0xcb, 0xc3, 0xc7, 0xba, 0x0a, 0x5a, 0x5e, 0x6a, 0x93, 0x77, 0x8a, 0xbb, 0x23, 0xac,
-	0x0f, 0xc0, 0x31, 0xc2, 0x78, 0xaa, 0x5e, 0xcb, 0xd1, 0x95, 0xab, 0xb5, 0x06, 0x41, 0x22, 0x47,
-	0xf6, 0x5e, 0xe9, 0x25, 0x13, 0x9a, 0xd7, 0x74, 0xfe, 0xca, 0xe1, 0x7b, 0x50, 0x3f, 0xfa, 0x40,
-	0x77, 0xb7, 0xb3, 0xf3, 0x3f, 0x1b, 0xf3, 0x08, 0xe7, 0x1f, 0x44, 0x32, 0x43, 0x5e, 0x3f, 0xfa,
-	0x10, 0x3e, 0x80, 0x46, 0x22, 0x47, 0x39, 0xe5, 0xdf, 0xd9, 0x59, 0x2f, 0xd3, 0x72, 0xe1, 0x39,
-	0x6d, 0xb3, 0x7d, 0x3d, 0x72, 0xb2, 0xed, 0x0b, 0x25, 0xae, 0x84, 0xf9, 0x4c, 0x2f, 0x7f, 0xd5,
-	0xa0, 0x35, 0x28, 0x38, 0xe6, 0xb3, 0x44, 0x79, 0xe4, 0xad, 0x5d, 0x4f, 0xde, 0xba, 0xf7, 0x21,
-	0x0e, 0x19, 0xdd, 0x0e, 0xf3, 0x39, 0xb8, 0x8e, 0x63, 0xfa, 0xe3, 0xff, 0x04, 0x3a, 0x99, 0x09,
-	0x39, 0x14, 0xf6, 0x1d, 0xe3, 0x77, 0xba, 0x4c, 0x9f, 0xfb, 0xc7, 0x34, 0x3b, 0x4e, 0x12, 0x19,
-	0x9d, 0xa9, 0x78, 0xe2, 0x3e, 0x18, 0x95, 0x41, 0x7f, 0x0d, 0x4c, 0x04, 0x7a, 0xa6, 0x2c, 0xd1,
-	0xed, 0xf4, 0x2c, 0xec, 0xb7, 0x00, 0xd6, 0xbd, 0x3c, 0xf6, 0x51, 0x89, 0x38, 0xb1, 0xd9, 0xd6,
-	0xfe, 0x35, 0xdb, 0xc7, 0x24, 0x6e, 0x3a, 0x0d, 0xaa, 0xf4, 0xfa, 0x4c, 0xdd, 0x11, 0x12, 0xd4,
-	0x4c, 0xca, 0x53, 0xd3, 0x63, 0x2d, 0xa8, 0x84, 0xbc, 0x2e, 0x36, 0xae, 0xef, 0x62, 0xd3, 0x97,
-	0x80, 0x85, 0x5a, 0x97, 0x2e, 0xd7, 0x5a, 0x3d, 0x15, 0x97, 0x17, 0x9e, 0x8a, 0x1b, 0xd0, 0x3a,
-	0xcd, 0xe4, 0x84, 0x04, 0xd3, 0x3e, 0xd4, 0x1c, 0xbe, 0xd4, 0x9f, 0xf6, 0xe5, 0xfe, 0x78, 0xa2,
-	0x03, 0x37, 0x8b, 0x4e, 0xf8, 0x08, 0x5a, 0xaa, 0x78, 0x67, 0xea, 0xeb, 0xd0, 0xb9, 0x55, 0xd7,
-	0x35, 0x63, 0xe6, 0xe5, 0x3e, 0x65, 0x33, 0x4b, 0x12, 0x7d, 0x63, 0x7b, 0x5d, 0xba, 0x04, 0x25,
-	0x66, 0x4f, 0x21, 0xbc, 0x32, 0x0c, 0xed, 0xdd, 0x13, 0xa8, 0xde, 0xd5, 0x71, 0x98, 0x73, 0x46,
-	0xa6, 0xb6, 0xa0, 0x65, 0xbf, 0x11, 0x74, 0xe7, 0x75, 0x8d, 0xee, 0x7d, 0x66, 0x00, 0xdb, 0x86,
-	0x3b, 0x1c, 0xcf, 0xf7, 0x31, 0x92, 0x43, 0x7a, 0x84, 0x7a, 0x8f, 0x9d, 0x6b, 0x9f, 0x57, 0xec,
-	0x6b, 0x68, 0xbf, 0xcf, 0x31, 0xa3, 0x57, 0x2b, 0x1d, 0x91, 0xd3, 0x38, 0x2a, 0x8f, 0x68, 0x40,
-	0xef, 0x04, 0x99, 0x2a, 0xb4, 0xfa, 0xd2, 0xe6, 0x0e, 0xb2, 0x1f, 0xa1, 0xf3, 0x7e, 0x3a, 0xca,
-	0xc4, 0x10, 0xdf, 0xa0, 0x12, 0xba, 0xf8, 0x5c, 0x89, 0x4c, 0xc5, 0xe9, 0xc8, 0xea, 0x66, 0x89,
-	0xb5, 0x93, 0x0b, 0xcc, 0x72, 0xfd, 0x4d, 0xb2, 0x4e, 0x2c, 0xf4, 0x48, 0x12, 0xf8, 0x24, 0x61,
-	0x87, 0xa4, 0xc9, 0x37, 0xaa, 0x5f, 0xbb, 0x54, 0xbf, 0x2d, 0xe8, 0xc4, 0xf9, 0xf1, 0x58, 0x66,
-	0x8a, 0xda, 0x5e, 0xa7, 0xc8, 0xbe, 0x89, 0x1d, 0xc3, 0xb2, 0x1d, 0x95, 0x47, 0xd5, 0xda, 0x02,
-	0x55, 0x17, 0x2e, 0xf6, 0x8a, 0xa3, 0xe4, 0x06, 0xb4, 0x32, 0x29, 0x8d, 0x5f, 0xf3, 0x20, 0x28,
-	0x31, 0xeb, 0xc3, 0x1a, 0xc7, 0xf3, 0xbd, 0x31, 0x46, 0x67, 0x83, 0x22, 0x7f, 0x51, 0xe8, 0x14,
-	0x37, 0x34, 0x55, 0x0e, 0x7c, 0x89, 0x2e, 0x31, 0x1b, 0x40, 0x48, 0x82, 0xba, 0xf8, 0x8b, 0x4d,
-	0x00, 0xd4, 0x8b, 0x97, 0x89, 0x18, 0x99, 0xdf, 0xb4, 0xb8, 0x67, 0x29, 0xf7, 0xf7, 0x4a, 0x7d,
-	0x5f, 0xe1, 0x9e, 0xe5, 0xf9, 0xbd, 0x1f, 0xfe, 0x3f, 0x8a, 0xd5, 0x78, 0x76, 0xd2, 0x8f, 0xe4,
-	0x64, 0x7b, 0x77, 0x37, 0x4a, 0xb7, 0xe9, 0x15, 0xb7, 0xbb, 0xbb, 0x4d, 0x54, 0x3a, 0x59, 0xa2,
-	0xbf, 0xe9, 0xbb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x60, 0xa4, 0x2b, 0xa8, 0xd0, 0x0f, 0x00,
-	0x00,
+	// 1518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0x1b, 0x47,
+	0x12, 0x06, 0xff, 0x44, 0xb2, 0x38, 0xd2, 0x4a, 0x03, 0xc3, 0x26, 0x04, 0xaf, 0xac, 0x6d, 0xd8,
+	0x80, 0x61, 0x18, 0x14, 0x20, 0xf9, 0xb6, 0x0b, 0xac, 0x6d, 0xc9, 0xb6, 0x04, 0xd9, 0x5e, 0x6f,
+	0x8b, 0xb6, 0x81, 0xdd, 0x5c, 0x5a, 0xc3, 0x12, 0x39, 0xd1, 0x70, 0x9a, 0x9a, 0x69, 0xca, 0xc3,
+	0x3c, 0x40, 0x2e, 0xc9, 0x2d, 0xcf, 0x94, 0x7b, 0x1e, 0x23, 0x8f, 0x11, 0x74, 0x75, 0xf7, 0x4c,
+	0x53, 0x3f, 0x81, 0x0f, 0x06, 0x72, 0xeb, 0xaf, 0xba, 0x58, 0xbf, 0x5f, 0xd5, 0x34, 0x61, 0x43,
+	0x65, 0x22, 0xcd, 0x45, 0xa4, 0x62, 0x99, 0x0e, 0x66, 0x99, 0x54, 0x32, 0x6c, 0xa9, 0xc5, 0x0c,
+	0xf3, 0xcd, 0x20, 0x92, 0xd3, 0xa9, 0x13, 0xb2, 0x77, 0xb0, 0xfa, 0x22, 0xcf, 0x51, 0xe5, 0x6f,
+	0x30, 0xc5, 0x3c, 0xce, 0xc3, 0xbb, 0xb0, 0x22, 0xa6, 0x72, 0x9e, 0xaa, 0x7e, 0x7d, 0xbb, 0xf6,
+	0xb8, 0xc1, 0x2d, 0x0a, 0x1f, 0xc2, 0x6a, 0x86, 0x6a, 0x9e, 0xa5, 0x2f, 0x46, 0xa3, 0x0c, 0xf3,
+	0xbc, 0xdf, 0xd8, 0xae, 0x3d, 0xee, 0xf2, 0x65, 0x21, 0xfb, 0xb9, 0x06, 0x77, 0x8c, 0xbd, 0xa1,
+	0xf6, 0x7f, 0x86, 0xd9, 0x50, 0xbe, 0x2a, 0x30, 0x0a, 0xef, 0x43, 0x37, 0x92, 0x71, 0xaa, 0xe4,
+	0x39, 0xa6, 0xfd, 0x1a, 0xfd, 0xb4, 0x12, 0xdc, 0xea, 0x34, 0x84, 0x66, 0x2a, 0x15, 0x92, 0xaf,
+	0x80, 0xd3, 0x39, 0xdc, 0x84, 0x0e, 0x16, 0x18, 0xbd, 0x17, 0x53, 0xec, 0x37, 0xc9, 0x50, 0x89,
+	0xc3, 0x35, 0xa8, 0x2b, 0xd9, 0x6f, 0x91, 0xb4, 0xae, 0x24, 0xfb, 0xb1, 0x06, 0x6b, 0x26, 0x9c,
+	0xcf, 0xb1, 0x9a, 0x8c, 0x32, 0xf1, 0xe5, 0x2f, 0x0a, 0xe4, 0x7b, 0x58, 0x5b, 0x2e, 0xcb, 0x37,
+	0x8c, 0xc3, 0xf8, 0x6a, 0x96, 0xbe, 0x8e, 0xa1, 0x45, 0xbe, 0xb4, 0xb2, 0x0e, 0xc8, 0x5a, 0xa7,
+	0xb3, 0x36, 0x9c, 0x2f, 0xa6, 0xa7, 0x32, 0x21, 0xc3, 0x5d, 0x6e, 0x91, 0xe7, 0xb0, 0xe1, 0x3b,
+	0x64, 0xbf, 0xd7, 0xa0, 0xb3, 0x9f, 0xa1, 0x50, 0x38, 0x2c, 0xac, 0xa7, 0x9a, 0xf3, 0x74, 0x6b,
+	0x94, 0xeb, 0xd0, 0x38, 0x43, 0xb4, 0x96, 0xf4, 0xb1, 0x8c, 0xbb, 0xe9, 0xc5, 0xbd, 0x05, 0x10,
+	0x97, 0x7d, 0xa1, 0x5a, 0x75, 0xb8, 0x27, 0x09, 0xfb, 0xd0, 0x8e, 0xf3, 0x21, 0xd5, 0x67, 0x85,
+	0x2e, 0x1d, 0x0c, 0xb7, 0xa1, 0x47, 0x65, 0x3a, 0x31, 0x99, 0xb4, 0x29, 0x20, 0x5f, 0xb4, 0xd4,
+	0x9b, 0xce, 0x95, 0xde, 0xdc, 0x85, 0x15, 0x7d, 0xc6, 0xac, 0xdf, 0x35, 0x25, 0x30, 0x88, 0xa5,
+	0x10, 0x70, 0xfc, 0x9c, 0xc5, 0x0a, 0xb9, 0xf8, 0x62, 0xb3, 0x2d, 0xca, 0x6c, 0x5d, 0xf6, 0x0d,
+	0x3f, 0x7b, 0x2c, 0x66, 0x71, 0xe6, 0xba, 0x6f, 0x91, 0xcb, 0xbe, 0x55, 0x65, 0x7f, 0x07, 0x5a,
+	0x71, 0x3a, 0xc2, 0x82, 0xf2, 0x68, 0x71, 0x03, 0xd8, 0x13, 0xb8, 0x6b, 0x2b, 0x5b, 0x8d, 0xea,
+	0x9b, 0x4c, 0xce, 0x67, 0xda, 0x82, 0x2a, 0xf2, 0x7e, 0x6d, 0xbb, 0xf1, 0xb8, 0xcb, 0xf5, 0x91,
+	0x6d, 0x41, 0xe7, 0x63, 0x9a, 0xc7, 0xe3, 0x74, 0x58, 0xe8, 0x5a, 0x8e, 0x84, 0x12, 0x14, 0x59,
+	0xc0, 0xe9, 0xcc, 0x32, 0x08, 0xde, 0xcb, 0x97, 0x22, 0x11, 0x69, 0x84, 0xc3, 0x82, 0xa6, 0x58,
+	0x15, 0x87, 0x58, 0x1a, 0xb1, 0x48, 0xd7, 0x74, 0x26, 0x16, 0x7a, 0x5a, 0x6d, 0xff, 0x1d, 0xa4,
+	0x9b, 0x2c, 0xbe, 0x3c, 0xc7, 0x85, 0x4d, 0xd1, 0xc1, 0xdb, 0xf2, 0x64, 0x12, 0x7a, 0x9e, 0x4f,
+	0x9d, 0x24, 0x39, 0xb1, 0x15, 0x33, 0xe0, 0x9b, 0x3a, 0xfc, 0xb5, 0x0e, 0x3d, 0xaf, 0x56, 0x5e,
+	0x23, 0x4d, 0x29, 0x2c, 0xb2, 0x3e, 0x13, 0x29, 0x46, 0xe4, 0x33, 0xe0, 0x0e, 0x86, 0x03, 0xe8,
+	0xea, 0x22, 0x0a, 0x35, 0xcf, 0x0c, 0x3d, 0x7b, 0xbb, 0xeb, 0x03, 0x5a, 0x8b, 0x83, 0x13, 0x27,
+	0xe7, 0x95, 0x8a, 0x6b, 0x65, 0xb3, 0x6a, 0x65, 0x15, 0x9b, 0xe9, 0xaf, 0x45, 0x3a, 0xfb, 0x54,
+	0xa6, 0x11, 0x52, 0x8b, 0x1b, 0xdc, 0x00, 0x4b, 0x99, 0x76, 0x49, 0x99, 0x2d, 0x80, 0xb1, 0xee,
+	0xf0, 0x3e, 0x0d, 0x4d, 0x87, 0xd8, 0xe0, 0x49, 0xb4, 0xf5, 0x09, 0x8a, 0x91, 0xa5, 0x66, 0xc0,
+	0x2d, 0xa2, 0xf1, 0xc1, 0x42, 0xf5, 0xc1, 0x8e, 0x0f, 0x16, 0x4a, 0x67, 0x19, 0x4d, 0x44, 0x9c,
+	0x1e, 0x1d, 0xf4, 0x7b, 0x64, 0xc8, 0x41, 0x33, 0x58, 0x27, 0x78, 0xf1, 0x9e, 0x02, 0x0a, 0xdc,
+	0x60, 0x39, 0x09, 0x7b, 0x06, 0x81, 0x57, 0xc6, 0x3c, 0x7c, 0x58, 0xd1, 0xad, 0xb7, 0x1b, 0xda,
+	0x7a, 0x78, 0x1a, 0x86, 0x82, 0xff, 0x86, 0x55, 0x1e, 0xa7, 0xe3, 0xb2, 0x4e, 0xe1, 0x00, 0x5a,
+	0xb1, 0xc2, 0xa9, 0xfb, 0x61, 0xdf, 0xfe, 0x70, 0x49, 0xe9, 0x48, 0xe1, 0x94, 0x1b, 0x35, 0x76,
+	0x04, 0x1b, 0xd7, 0xee, 0x74, 0xc6, 0xb3, 0xf9, 0xa9, 0x26, 0x81, 0xb6, 0x12, 0x70, 0x8b, 0xf4,
+	0x7a, 0xac, 0x3a, 0x55, 0xa7, 0xab, 0x4a, 0xc0, 0xfe, 0x0b, 0xdd, 0x2a, 0x0e, 0x5d, 0xe4, 0x05,
+	0x51, 0xa0, 0xc5, 0xeb, 0x6a, 0xe1, 0x99, 0x34, 0xdd, 0xbf, 0xd1, 0xa4, 0x59, 0xa0, 0x9e, 0xc9,
+	0xef, 0x20, 0xd0, 0xb4, 0xfc, 0xcf, 0x25, 0x66, 0x97, 0x31, 0xd2, 0xf6, 0xc9, 0x30, 0x8a, 0x2f,
+	0x2d, 0xbb, 0x1a, 0xdc, 0x41, 0x7d, 0x73, 0x6a, 0x58, 0x6f, 0xd7, 0x9e, 0x83, 0xfa, 0x46, 0x15,
+	0xfb, 0xde, 0x16, 0x75, 0x90, 0xfd, 0x52, 0x83, 0x36, 0xc7, 0x0b, 0x22, 0x7e, 0x08, 0x4d, 0x31,
+	0x1a, 0x19, 0xb3, 0x5d, 0xde, 0x14, 0x56, 0x76, 0x96, 0x88, 0x31, 0x19, 0x6c, 0x71, 0x3a, 0x6b,
+	0x4a, 0x45, 0xa5, 0xad, 0x16, 0x37, 0x40, 0x67, 0x31, 0x8a, 0x33, 0xa4, 0xc6, 0x10, 0x31, 0x5b,
+	0xbc, 0x12, 0x18, 0x02, 0xc5, 0xe3, 0x89, 0x72, 0xf4, 0x34, 0x68, 0x79, 0x03, 0x35, 0xdc, 0x06,
+	0xba, 0x07, 0xad, 0x43, 0x2c, 0xae, 0xaf, 0x3a, 0x36, 0x87, 0x1e, 0xc7, 0x59, 0xb2, 0x18, 0x16,
+	0x47, 0xe9, 0x99, 0xd4, 0xd1, 0x4d, 0x44, 0x3e, 0x71, 0x1b, 0x47, 0x9f, 0x3d, 0x4f, 0xf5, 0x9b,
+	0x3d, 0x35, 0x3c, 0x4f, 0xe1, 0x43, 0x58, 0x11, 0xf4, 0xfd, 0xeb, 0x37, 0x89, 0x2c, 0x81, 0x25,
+	0x0b, 0x7d, 0xa8, 0xb8, 0xbd, 0x63, 0xff, 0x80, 0x2e, 0xc7, 0x8b, 0x61, 0xf1, 0x36, 0xce, 0x55,
+	0x95, 0xbe, 0x29, 0xbf, 0x01, 0x6c, 0xaf, 0x8c, 0x8c, 0x94, 0xbe, 0x8e, 0xba, 0x8f, 0x60, 0x95,
+	0xe3, 0xc5, 0x1b, 0x54, 0xef, 0x70, 0x3a, 0x93, 0x32, 0xa1, 0x20, 0xf3, 0x17, 0x49, 0x42, 0xb6,
+	0x3b, 0xdc, 0x00, 0xf6, 0x5c, 0x7f, 0x00, 0x2e, 0x3e, 0x64, 0x72, 0x86, 0xd9, 0x6b, 0x5c, 0x6a,
+	0xa7, 0x61, 0x97, 0x83, 0x66, 0xbd, 0x9e, 0xc4, 0x3f, 0xa0, 0x6d, 0x98, 0x45, 0x6c, 0x00, 0x6b,
+	0x14, 0x5d, 0x65, 0xe3, 0x3e, 0x74, 0x67, 0x0e, 0xd8, 0x4c, 0x2a, 0x01, 0xe3, 0x00, 0xc3, 0xe2,
+	0x50, 0xe4, 0x13, 0x4a, 0x46, 0x97, 0x54, 0xe4, 0x13, 0xcc, 0xdd, 0x2c, 0x18, 0x54, 0x55, 0xa2,
+	0xee, 0x55, 0xc2, 0xdb, 0x44, 0x8d, 0xed, 0x46, 0xb5, 0x89, 0xd8, 0xbf, 0x20, 0xb0, 0x15, 0xd2,
+	0xbd, 0xcb, 0xc3, 0xa7, 0x3a, 0x0b, 0x3a, 0x5e, 0x29, 0x93, 0xa7, 0xc5, 0x9d, 0x0a, 0x1b, 0x00,
+	0x70, 0x8c, 0x30, 0x9e, 0xa9, 0xb7, 0x72, 0x7c, 0x6d, 0xb4, 0xd6, 0xa1, 0x91, 0xc8, 0xb1, 0x9d,
+	0x2b, 0x7d, 0x64, 0x02, 0xda, 0x56, 0xff, 0x9a, 0xf2, 0x03, 0xa8, 0x1f, 0x7f, 0xa2, 0xd9, 0xed,
+	0xed, 0xfe, 0xcd, 0xfa, 0x3c, 0xc6, 0xc5, 0x27, 0x91, 0xcc, 0x91, 0xd7, 0x8f, 0x3f, 0x85, 0x8f,
+	0xa0, 0x99, 0xc8, 0x71, 0x4e, 0xf1, 0xf7, 0x76, 0x37, 0xca, 0xb0, 0x9c, 0x7b, 0x4e, 0xd7, 0xec,
+	0x00, 0x7a, 0x56, 0x76, 0x20, 0x94, 0xb8, 0xe6, 0xe6, 0x2b, 0xad, 0xfc, 0x56, 0x83, 0xce, 0xb0,
+	0xe0, 0x98, 0xcf, 0x13, 0xe5, 0x91, 0xb7, 0x76, 0x33, 0x79, 0xeb, 0xde, 0x87, 0x3a, 0x64, 0x34,
+	0x1d, 0xe6, 0x73, 0x71, 0x13, 0xc7, 0xf4, 0xe3, 0xe0, 0x19, 0xf4, 0x32, 0xe3, 0x72, 0x24, 0xec,
+	0x3b, 0xc7, 0xaf, 0x74, 0x19, 0x3e, 0xf7, 0xd5, 0x34, 0x3b, 0x4e, 0x13, 0x19, 0x9d, 0xab, 0x78,
+	0xea, 0x3e, 0x28, 0x95, 0x40, 0xef, 0x71, 0xe3, 0x81, 0x9e, 0x31, 0x2b, 0x34, 0x9d, 0x9e, 0x84,
+	0xfd, 0xd4, 0x80, 0x0d, 0x2f, 0x8e, 0x03, 0x54, 0x22, 0x4e, 0x6c, 0xb4, 0xb5, 0x3f, 0x8d, 0xf6,
+	0x29, 0xb4, 0x6d, 0x18, 0xfd, 0xfa, 0x92, 0xa2, 0x1f, 0xa9, 0x53, 0xa1, 0x85, 0x9a, 0x49, 0x79,
+	0x66, 0x6a, 0x1c, 0x70, 0x8b, 0xbc, 0x2a, 0x36, 0x6f, 0xae, 0x62, 0xcb, 0x5f, 0x01, 0x4b, 0xb9,
+	0xae, 0x5c, 0xcd, 0xb5, 0x7a, 0x4a, 0xb6, 0x97, 0x9e, 0x92, 0x9b, 0xd0, 0x39, 0xcb, 0xe4, 0x94,
+	0x16, 0xa6, 0x7d, 0xc8, 0x39, 0x7c, 0xa5, 0x3e, 0xdd, 0xab, 0xf5, 0xf1, 0x96, 0x0e, 0xdc, 0xbe,
+	0x74, 0xc2, 0x27, 0xd0, 0x51, 0xc5, 0x07, 0x93, 0x5f, 0x8f, 0xf4, 0xd6, 0x5c, 0xd5, 0x8c, 0x98,
+	0x97, 0xf7, 0x14, 0xcd, 0x3c, 0x49, 0xf4, 0xc4, 0xd2, 0x77, 0x35, 0xe0, 0x25, 0x66, 0xcf, 0x21,
+	0xbc, 0xd6, 0x0c, 0x6d, 0xdd, 0x5b, 0x50, 0xfd, 0xeb, 0xed, 0x30, 0x7a, 0x66, 0x4d, 0x6d, 0x43,
+	0xc7, 0x7e, 0x23, 0x68, 0xe6, 0x75, 0x8e, 0xee, 0xfd, 0x66, 0x00, 0xdb, 0x81, 0x7b, 0x1c, 0x2f,
+	0x0e, 0x30, 0x92, 0x23, 0x7a, 0xa4, 0x56, 0x76, 0x6e, 0x7e, 0x7e, 0xb1, 0x7f, 0x42, 0xf7, 0x63,
+	0x8e, 0x19, 0xbd, 0x6a, 0x49, 0x45, 0xce, 0xe2, 0xa8, 0x54, 0xd1, 0x80, 0xde, 0x11, 0x32, 0x55,
+	0x68, 0xf7, 0x4b, 0x97, 0x3b, 0xc8, 0xfe, 0x0f, 0xbd, 0x8f, 0xb3, 0x71, 0x26, 0x46, 0xf8, 0x0e,
+	0x95, 0xd0, 0xc9, 0xe7, 0x4a, 0x64, 0x2a, 0x4e, 0xc7, 0x76, 0x6f, 0x96, 0x58, 0x1b, 0xb9, 0xc4,
+	0x2c, 0xd7, 0xdf, 0x24, 0x6b, 0xc4, 0x42, 0x8f, 0x24, 0x0d, 0x9f, 0x24, 0xec, 0x88, 0x76, 0xf2,
+	0xad, 0xdb, 0xaf, 0x5b, 0x6e, 0xbf, 0x6d, 0xe8, 0xc5, 0xf9, 0xc9, 0x44, 0x66, 0x8a, 0xca, 0x5e,
+	0x27, 0xcf, 0xbe, 0x88, 0x9d, 0x40, 0xdb, 0xb6, 0xca, 0xa3, 0x6a, 0x6d, 0x89, 0xaa, 0x4b, 0x83,
+	0xbd, 0xea, 0x28, 0xb9, 0x09, 0x9d, 0x4c, 0x4a, 0x63, 0xd7, 0x3c, 0x08, 0x4a, 0xcc, 0x06, 0xb0,
+	0xce, 0xf1, 0x62, 0x7f, 0x82, 0xd1, 0xf9, 0xb0, 0xc8, 0x5f, 0x15, 0x3a, 0xc4, 0x4d, 0x4d, 0x95,
+	0x43, 0x7f, 0x45, 0x97, 0x98, 0x0d, 0x21, 0xa4, 0x85, 0xba, 0xfc, 0x8b, 0x2d, 0x00, 0xd4, 0x87,
+	0xd7, 0x89, 0x18, 0x9b, 0xdf, 0x74, 0xb8, 0x27, 0x29, 0xef, 0xf7, 0xcb, 0xfd, 0xbe, 0xca, 0x3d,
+	0xc9, 0xcb, 0x07, 0xff, 0xfb, 0xfb, 0x38, 0x56, 0x93, 0xf9, 0xe9, 0x20, 0x92, 0xd3, 0x9d, 0xbd,
+	0xbd, 0x28, 0xdd, 0xa1, 0x57, 0xde, 0xde, 0xde, 0x0e, 0x51, 0xe9, 0x74, 0x85, 0xfe, 0xc6, 0xef,
+	0xfd, 0x11, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x4f, 0xc5, 0xe7, 0xf0, 0x0f, 0x00, 0x00,
 }
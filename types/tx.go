@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"math/rand"
 	"reflect"
 	"sort"
 	"time"
@@ -173,6 +174,16 @@ func (txgroup *Transactions) IsExpire(cfg *Chain33Config, height, blocktime int6
 	return false
 }
 
+//checkSeqNonce 交易组只允许第一笔交易使用账户序号
+func (txgroup *Transactions) checkSeqNonce(cfg *Chain33Config, height int64) error {
+	for i := 1; i < len(txgroup.Txs); i++ {
+		if _, ok := txgroup.Txs[i].GetSeqNonce(cfg, height); ok {
+			return ErrTxGroupSeqNonce
+		}
+	}
+	return nil
+}
+
 //CheckWithFork 和fork 无关的有个检查函数
 func (txgroup *Transactions) CheckWithFork(cfg *Chain33Config, checkFork, paraFork bool, height, minfee, maxFee int64) error {
 	txs := txgroup.Txs
@@ -214,6 +225,9 @@ func (txgroup *Transactions) CheckWithFork(cfg *Chain33Config, checkFork, paraFo
 			return ErrTxGroupFeeNotZero
 		}
 	}
+	if err := txgroup.checkSeqNonce(cfg, height); err != nil {
+		return err
+	}
 	//检查txs[0] 的费用是否满足要求
 	totalfee := int64(0)
 	for i := 0; i < len(txs); i++ {
@@ -478,15 +492,19 @@ func (tx *Transaction) CheckSign(blockHeight int64) bool {
 	return tx.checkSign(blockHeight)
 }
 
-//txgroup 的情况
-func (tx *Transaction) checkSign(blockHeight int64) bool {
+//signData 交易签名的数据
+func (tx *Transaction) signData() []byte {
 	copytx := *tx
 	copytx.Signature = nil
-	data := Encode(&copytx)
+	return Encode(&copytx)
+}
+
+//txgroup 的情况
+func (tx *Transaction) checkSign(blockHeight int64) bool {
 	if tx.GetSignature() == nil {
 		return false
 	}
-	return CheckSign(data, string(tx.Execer), tx.GetSignature(), blockHeight)
+	return checkTxSign(tx.signData(), string(tx.Execer), tx.GetSignature(), blockHeight)
 }
 
 //Check 交易检测
@@ -629,6 +647,36 @@ func GetTxHeight(cfg *Chain33Config, valid int64, height int64) int64 {
 	return -1
 }
 
+//GetSeqNonce 获取交易的账户序号, 交易没有使用账户序号时返回false
+//账户在链上记录下一个可用的序号, 交易设置IsSeqNonce标记时nonce为账户序号, 按照序号顺序执行,
+//同一个账户的交易在mempool和区块中都按序号排序, 通过开关和ForkTxSeqNonce共同控制是否开启
+//随机的nonce不设置标记, 取值范围不受影响
+func (tx *Transaction) GetSeqNonce(cfg *Chain33Config, height int64) (int64, bool) {
+	if !tx.IsSeqNonce {
+		return 0, false
+	}
+	if !cfg.IsEnableFork(height, "ForkTxSeqNonce", cfg.IsEnable("TxSeqNonce")) {
+		return 0, false
+	}
+	return tx.Nonce, true
+}
+
+//SetSeqNonce 设置交易的账户序号
+func (tx *Transaction) SetSeqNonce(seq int64) {
+	tx.Nonce = seq
+	tx.IsSeqNonce = true
+}
+
+//RandNonce 随机的nonce, 通过IsSeqNonce标记与账户序号区分
+func RandNonce() int64 {
+	return rand.Int63()
+}
+
+//CalcAccountSeqKey 账户序号在statedb中的key
+func CalcAccountSeqKey(addr string) []byte {
+	return []byte("mavl-seq-" + addr)
+}
+
 //JSON Transaction交易信息转成json结构体
 func (tx *Transaction) JSON() string {
 	type transaction struct {
@@ -845,6 +893,7 @@ func cloneTx(tx *Transaction) *Transaction {
 	copytx.Header = tx.Header
 	copytx.Next = tx.Next
 	copytx.ChainID = tx.ChainID
+	copytx.IsSeqNonce = tx.IsSeqNonce
 	return copytx
 }
 
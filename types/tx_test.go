@@ -497,3 +497,38 @@ func modifyTxExec(tx1, tx2, tx3 Transaction, tx1exec, tx2exec, tx3exec string) (
 
 	return tx11, tx12, tx13
 }
+
+func TestSeqNonce(t *testing.T) {
+	cfg := NewChain33Config(GetDefaultCfgstring())
+	tx := &Transaction{Execer: []byte("coins")}
+	tx.SetSeqNonce(10)
+	//没有开启账户序号时作为普通nonce处理
+	_, ok := tx.GetSeqNonce(cfg, 1)
+	assert.False(t, ok)
+
+	str := strings.Replace(GetDefaultCfgstring(), "Title=\"local\"", "Title=\"local\"\nTxSeqNonce=true", 1)
+	cfg = NewChain33Config(str)
+	seq, ok := tx.GetSeqNonce(cfg, 1)
+	assert.True(t, ok)
+	assert.Equal(t, int64(10), seq)
+	assert.True(t, tx.Clone().IsSeqNonce)
+	//随机nonce与账户序号取值相同时也不会被当作账户序号
+	tx2 := &Transaction{Execer: []byte("coins"), Nonce: 10}
+	_, ok = tx2.GetSeqNonce(cfg, 1)
+	assert.False(t, ok)
+	assert.NotEqual(t, tx.Hash(), tx2.Hash())
+
+	//交易组只有第一笔交易可以使用账户序号
+	group := &Transactions{Txs: []*Transaction{tx, tx2}}
+	assert.Nil(t, group.checkSeqNonce(cfg, 1))
+	group = &Transactions{Txs: []*Transaction{tx2, tx}}
+	assert.Equal(t, ErrTxGroupSeqNonce, group.checkSeqNonce(cfg, 1))
+
+	//FormatTx 保留账户序号
+	tx3 := &Transaction{Payload: []byte("x")}
+	tx3.SetSeqNonce(3)
+	tx3, err := FormatTx(cfg, "none", tx3)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), tx3.Nonce)
+	assert.True(t, tx3.IsSeqNonce)
+}
@@ -921,12 +921,14 @@ func (m *ReqWalletImportPrivkey) GetLabel() string {
 // 	 amount : 转账额度
 //	 note :转账备注
 type ReqWalletSendToAddress struct {
-	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Note                 string   `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
-	IsToken              bool     `protobuf:"varint,5,opt,name=isToken,proto3" json:"isToken,omitempty"`
-	TokenSymbol          string   `protobuf:"bytes,6,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	From        string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To          string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount      int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note        string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	IsToken     bool   `protobuf:"varint,5,opt,name=isToken,proto3" json:"isToken,omitempty"`
+	TokenSymbol string `protobuf:"bytes,6,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	// 构造未签名交易时的过期时间, 格式同ReqSignRawTx.expire, 为空时使用默认值
+	Expire               string   `protobuf:"bytes,7,opt,name=expire,proto3" json:"expire,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -999,6 +1001,13 @@ func (m *ReqWalletSendToAddress) GetTokenSymbol() string {
 	return ""
 }
 
+func (m *ReqWalletSendToAddress) GetExpire() string {
+	if m != nil {
+		return m.Expire
+	}
+	return ""
+}
+
 type ReqWalletSetFee struct {
 	Amount               int64    `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1703,6 +1712,254 @@ func (m *ReqPrivkeysFile) GetPasswd() string {
 	return ""
 }
 
+// 只读账户, 只保存地址以及公钥用于跟踪交易, 私钥保存在离线设备中
+type WalletWatchOnlyAccount struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	PubKey               string   `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	TimeStamp            string   `protobuf:"bytes,4,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletWatchOnlyAccount) Reset()         { *m = WalletWatchOnlyAccount{} }
+func (m *WalletWatchOnlyAccount) String() string { return proto.CompactTextString(m) }
+func (*WalletWatchOnlyAccount) ProtoMessage()    {}
+func (*WalletWatchOnlyAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{31}
+}
+
+func (m *WalletWatchOnlyAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletWatchOnlyAccount.Unmarshal(m, b)
+}
+func (m *WalletWatchOnlyAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletWatchOnlyAccount.Marshal(b, m, deterministic)
+}
+func (m *WalletWatchOnlyAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletWatchOnlyAccount.Merge(m, src)
+}
+func (m *WalletWatchOnlyAccount) XXX_Size() int {
+	return xxx_messageInfo_WalletWatchOnlyAccount.Size(m)
+}
+func (m *WalletWatchOnlyAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletWatchOnlyAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletWatchOnlyAccount proto.InternalMessageInfo
+
+func (m *WalletWatchOnlyAccount) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *WalletWatchOnlyAccount) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *WalletWatchOnlyAccount) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *WalletWatchOnlyAccount) GetTimeStamp() string {
+	if m != nil {
+		return m.TimeStamp
+	}
+	return ""
+}
+
+// 通过地址或者公钥导入只读账户, 两者都提供时需要匹配
+type ReqWalletImportWatchOnly struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	PubKey               string   `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqWalletImportWatchOnly) Reset()         { *m = ReqWalletImportWatchOnly{} }
+func (m *ReqWalletImportWatchOnly) String() string { return proto.CompactTextString(m) }
+func (*ReqWalletImportWatchOnly) ProtoMessage()    {}
+func (*ReqWalletImportWatchOnly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{32}
+}
+
+func (m *ReqWalletImportWatchOnly) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqWalletImportWatchOnly.Unmarshal(m, b)
+}
+func (m *ReqWalletImportWatchOnly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqWalletImportWatchOnly.Marshal(b, m, deterministic)
+}
+func (m *ReqWalletImportWatchOnly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqWalletImportWatchOnly.Merge(m, src)
+}
+func (m *ReqWalletImportWatchOnly) XXX_Size() int {
+	return xxx_messageInfo_ReqWalletImportWatchOnly.Size(m)
+}
+func (m *ReqWalletImportWatchOnly) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqWalletImportWatchOnly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqWalletImportWatchOnly proto.InternalMessageInfo
+
+func (m *ReqWalletImportWatchOnly) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqWalletImportWatchOnly) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *ReqWalletImportWatchOnly) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+// 未签名交易包, 导出到离线设备签名
+type UnsignedTxPackage struct {
+	TxHex                string   `protobuf:"bytes,1,opt,name=txHex,proto3" json:"txHex,omitempty"`
+	Hash                 string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	From                 string   `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	PubKey               string   `protobuf:"bytes,4,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	SignType             int32    `protobuf:"varint,5,opt,name=signType,proto3" json:"signType,omitempty"`
+	Fee                  int64    `protobuf:"varint,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	Expire               int64    `protobuf:"varint,7,opt,name=expire,proto3" json:"expire,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsignedTxPackage) Reset()         { *m = UnsignedTxPackage{} }
+func (m *UnsignedTxPackage) String() string { return proto.CompactTextString(m) }
+func (*UnsignedTxPackage) ProtoMessage()    {}
+func (*UnsignedTxPackage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{33}
+}
+
+func (m *UnsignedTxPackage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsignedTxPackage.Unmarshal(m, b)
+}
+func (m *UnsignedTxPackage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsignedTxPackage.Marshal(b, m, deterministic)
+}
+func (m *UnsignedTxPackage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsignedTxPackage.Merge(m, src)
+}
+func (m *UnsignedTxPackage) XXX_Size() int {
+	return xxx_messageInfo_UnsignedTxPackage.Size(m)
+}
+func (m *UnsignedTxPackage) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsignedTxPackage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsignedTxPackage proto.InternalMessageInfo
+
+func (m *UnsignedTxPackage) GetTxHex() string {
+	if m != nil {
+		return m.TxHex
+	}
+	return ""
+}
+
+func (m *UnsignedTxPackage) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *UnsignedTxPackage) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *UnsignedTxPackage) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *UnsignedTxPackage) GetSignType() int32 {
+	if m != nil {
+		return m.SignType
+	}
+	return 0
+}
+
+func (m *UnsignedTxPackage) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *UnsignedTxPackage) GetExpire() int64 {
+	if m != nil {
+		return m.Expire
+	}
+	return 0
+}
+
+// 导入离线签名后的交易并发送
+type ReqWalletSendSignedTx struct {
+	TxHex                string   `protobuf:"bytes,1,opt,name=txHex,proto3" json:"txHex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqWalletSendSignedTx) Reset()         { *m = ReqWalletSendSignedTx{} }
+func (m *ReqWalletSendSignedTx) String() string { return proto.CompactTextString(m) }
+func (*ReqWalletSendSignedTx) ProtoMessage()    {}
+func (*ReqWalletSendSignedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{34}
+}
+
+func (m *ReqWalletSendSignedTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqWalletSendSignedTx.Unmarshal(m, b)
+}
+func (m *ReqWalletSendSignedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqWalletSendSignedTx.Marshal(b, m, deterministic)
+}
+func (m *ReqWalletSendSignedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqWalletSendSignedTx.Merge(m, src)
+}
+func (m *ReqWalletSendSignedTx) XXX_Size() int {
+	return xxx_messageInfo_ReqWalletSendSignedTx.Size(m)
+}
+func (m *ReqWalletSendSignedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqWalletSendSignedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqWalletSendSignedTx proto.InternalMessageInfo
+
+func (m *ReqWalletSendSignedTx) GetTxHex() string {
+	if m != nil {
+		return m.TxHex
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*WalletTxDetail)(nil), "types.WalletTxDetail")
 	proto.RegisterType((*WalletTxDetails)(nil), "types.WalletTxDetails")
@@ -1735,6 +1992,10 @@ func init() {
 	proto.RegisterType((*Int32)(nil), "types.Int32")
 	proto.RegisterType((*ReqAccountList)(nil), "types.ReqAccountList")
 	proto.RegisterType((*ReqPrivkeysFile)(nil), "types.ReqPrivkeysFile")
+	proto.RegisterType((*WalletWatchOnlyAccount)(nil), "types.WalletWatchOnlyAccount")
+	proto.RegisterType((*ReqWalletImportWatchOnly)(nil), "types.ReqWalletImportWatchOnly")
+	proto.RegisterType((*UnsignedTxPackage)(nil), "types.UnsignedTxPackage")
+	proto.RegisterType((*ReqWalletSendSignedTx)(nil), "types.ReqWalletSendSignedTx")
 }
 
 func init() {
@@ -1742,83 +2003,90 @@ func init() {
 }
 
 var fileDescriptor_b88fd140af4deb6f = []byte{
-	// 1234 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5d, 0x6e, 0x1b, 0x37,
-	0x10, 0xc6, 0x4a, 0x96, 0x6d, 0xd1, 0xb2, 0x93, 0x10, 0x49, 0x20, 0xa4, 0x4d, 0xa3, 0xb0, 0x48,
-	0xea, 0x02, 0x85, 0x03, 0x44, 0x2f, 0x45, 0x81, 0x02, 0x71, 0x7e, 0x1c, 0x07, 0x70, 0x52, 0x83,
-	0x52, 0x51, 0xa0, 0x2f, 0x05, 0xbd, 0x3b, 0x92, 0x08, 0xaf, 0xc8, 0x35, 0x97, 0xb2, 0xa4, 0x9b,
-	0xf4, 0x00, 0x3d, 0x42, 0xcf, 0xd0, 0xf7, 0xde, 0xa8, 0xe0, 0x90, 0xd4, 0xee, 0xba, 0xce, 0x43,
-	0xd1, 0x37, 0x7e, 0xc3, 0xe1, 0xfc, 0x7c, 0xc3, 0x19, 0x92, 0xf4, 0x96, 0x22, 0xcf, 0xc1, 0x1e,
-	0x15, 0x46, 0x5b, 0x4d, 0x3b, 0x76, 0x5d, 0x40, 0xf9, 0xe8, 0x9e, 0x35, 0x42, 0x95, 0x22, 0xb5,
-	0x52, 0x2b, 0xbf, 0xf3, 0x68, 0x5f, 0xa4, 0xa9, 0x5e, 0xa8, 0xa0, 0xc8, 0xfe, 0x6c, 0x91, 0x83,
-	0x5f, 0xf0, 0xe4, 0x78, 0xf5, 0x16, 0xac, 0x90, 0x39, 0x65, 0xa4, 0x65, 0x57, 0xfd, 0x64, 0x90,
-	0x1c, 0xee, 0xbd, 0xa4, 0x47, 0x68, 0xe8, 0x68, 0x5c, 0xd9, 0xe1, 0x2d, 0xbb, 0xa2, 0xdf, 0x91,
-	0x1d, 0x03, 0x29, 0xc8, 0xc2, 0xf6, 0x5b, 0x0d, 0x45, 0xee, 0xa5, 0x6f, 0x85, 0x15, 0x3c, 0xaa,
-	0xd0, 0x87, 0x64, 0x7b, 0x06, 0x72, 0x3a, 0xb3, 0xfd, 0xf6, 0x20, 0x39, 0x6c, 0xf3, 0x80, 0xe8,
-	0x7d, 0xd2, 0x91, 0x2a, 0x83, 0x55, 0x7f, 0x0b, 0xc5, 0x1e, 0xd0, 0x2f, 0x49, 0xf7, 0x22, 0xd7,
-	0xe9, 0xa5, 0x95, 0x73, 0xe8, 0x77, 0x70, 0xa7, 0x12, 0x38, 0x5b, 0x62, 0xee, 0x12, 0xe8, 0x6f,
-	0x7b, 0x5b, 0x1e, 0xd1, 0x47, 0x64, 0x77, 0x62, 0xf4, 0x5c, 0x64, 0x99, 0xe9, 0xef, 0x0c, 0x92,
-	0xc3, 0x2e, 0xdf, 0x60, 0x77, 0xc6, 0xae, 0x66, 0xa2, 0x9c, 0xf5, 0x77, 0x07, 0xc9, 0x61, 0x8f,
-	0x07, 0x44, 0xbf, 0x22, 0xc4, 0xe7, 0xf4, 0x49, 0xcc, 0xa1, 0xdf, 0xc5, 0x53, 0x35, 0x09, 0xed,
-	0x93, 0x9d, 0x42, 0xac, 0x73, 0x2d, 0xb2, 0x3e, 0xc1, 0x83, 0x11, 0xb2, 0x13, 0x72, 0xa7, 0xc9,
-	0x5a, 0x49, 0x87, 0xa4, 0x6b, 0x23, 0xe8, 0x27, 0x83, 0xf6, 0xe1, 0xde, 0xcb, 0x07, 0x81, 0x94,
-	0xa6, 0x2a, 0xaf, 0xf4, 0xd8, 0x35, 0xa1, 0x7e, 0xf3, 0xd8, 0x57, 0x65, 0x64, 0xb5, 0xf1, 0x7e,
-	0x8d, 0xbc, 0xbe, 0x84, 0x35, 0x96, 0xa1, 0xcb, 0x23, 0x74, 0x8c, 0xe5, 0xe2, 0x02, 0x72, 0x64,
-	0xbd, 0xcb, 0x3d, 0xa0, 0x94, 0x6c, 0x61, 0xde, 0x6d, 0x14, 0xe2, 0xda, 0xb1, 0xe8, 0xf8, 0x1a,
-	0x59, 0x31, 0x2f, 0x90, 0xdf, 0x2e, 0xaf, 0x04, 0xec, 0x15, 0xe9, 0x79, 0xbf, 0xe7, 0xcb, 0x53,
-	0xc7, 0xc4, 0x43, 0xb2, 0x5d, 0xe0, 0x0a, 0x1d, 0xf6, 0x78, 0x40, 0x2e, 0x12, 0x23, 0x54, 0x56,
-	0x5a, 0x13, 0x3c, 0x46, 0xc8, 0x7e, 0x4f, 0xa2, 0x89, 0x91, 0x15, 0x76, 0x51, 0x52, 0x46, 0x7a,
-	0xb2, 0xf4, 0x92, 0x33, 0x9d, 0x5e, 0xa2, 0xa1, 0x5d, 0xde, 0x90, 0x79, 0x9d, 0xe3, 0x85, 0xd5,
-	0x1f, 0xa5, 0x92, 0x6a, 0x8a, 0x36, 0x51, 0xa7, 0x92, 0xb9, 0xc0, 0x65, 0x79, 0x2a, 0xca, 0x11,
-	0x40, 0x86, 0x19, 0xed, 0xf2, 0x4a, 0xe0, 0x2d, 0x8c, 0x65, 0x7a, 0x19, 0xbc, 0x6c, 0x45, 0x0b,
-	0x95, 0x8c, 0xbd, 0x8a, 0x57, 0x3a, 0x90, 0x5a, 0xd2, 0x23, 0xb2, 0xe3, 0xdb, 0x23, 0x56, 0xe6,
-	0x7e, 0xa3, 0x32, 0x41, 0x8f, 0x47, 0x25, 0xf6, 0x9e, 0xec, 0x37, 0x76, 0xe8, 0x80, 0xb4, 0x45,
-	0x9a, 0x86, 0xa6, 0x38, 0x08, 0x87, 0xe3, 0x31, 0xb7, 0x75, 0x7b, 0x65, 0xd8, 0x2c, 0x92, 0xf4,
-	0xb3, 0x42, 0x02, 0x1c, 0xcf, 0xa2, 0x2c, 0x97, 0x59, 0x28, 0x6c, 0x40, 0x8e, 0x67, 0x57, 0x1c,
-	0xbd, 0xf0, 0xfd, 0xd4, 0xe6, 0x11, 0xd2, 0xe7, 0xe4, 0xc0, 0x47, 0xf5, 0x93, 0xf1, 0x29, 0x06,
-	0x4e, 0x6e, 0x48, 0xd9, 0x53, 0xb2, 0xf7, 0x1e, 0x94, 0xe3, 0xe8, 0x4c, 0xa8, 0xa9, 0xbb, 0x12,
-	0xb9, 0x50, 0x53, 0x74, 0xd3, 0xe1, 0xb8, 0x66, 0xcf, 0x9c, 0x8a, 0x75, 0x2a, 0xaf, 0xd7, 0xe7,
-	0xcb, 0xcf, 0xc5, 0xc2, 0x7e, 0x20, 0xbd, 0x91, 0xb8, 0x86, 0x8d, 0x1e, 0x25, 0x5b, 0xa5, 0xab,
-	0x85, 0xd7, 0xc2, 0x75, 0xed, 0x6c, 0xab, 0x71, 0xf6, 0x09, 0xe9, 0x72, 0x28, 0xf2, 0x35, 0xd6,
-	0xea, 0x96, 0x83, 0xec, 0x94, 0x50, 0x0e, 0x57, 0xe1, 0xe2, 0x80, 0x3d, 0xdf, 0xa4, 0xaf, 0xf3,
-	0xcc, 0x81, 0x78, 0xe1, 0x03, 0x74, 0x3b, 0x0a, 0x96, 0xb8, 0x13, 0x2e, 0x60, 0x80, 0xec, 0x19,
-	0xd9, 0xe7, 0x70, 0xf5, 0x09, 0x96, 0xb1, 0x46, 0x9b, 0x0a, 0x24, 0xf5, 0x0a, 0x78, 0xb5, 0xf7,
-	0x55, 0x29, 0x6f, 0x57, 0x9b, 0x90, 0xfe, 0x26, 0xae, 0xda, 0xb0, 0x3b, 0x93, 0x25, 0x8e, 0x2f,
-	0x37, 0x4a, 0xc6, 0xab, 0xd8, 0x1c, 0x1e, 0x39, 0x4b, 0x68, 0x12, 0x23, 0xeb, 0x70, 0x0f, 0xdc,
-	0xfd, 0xcd, 0xa4, 0x01, 0x3c, 0x8e, 0xb5, 0xea, 0xf0, 0x4a, 0xc0, 0x4e, 0xc9, 0xc3, 0x8d, 0x9f,
-	0x0f, 0xf3, 0x42, 0x1b, 0x7b, 0x1e, 0x5a, 0xfb, 0x3f, 0x36, 0x3d, 0xfb, 0x23, 0xa9, 0x99, 0x1a,
-	0x81, 0xca, 0xc6, 0xfa, 0x38, 0xcb, 0x0c, 0x94, 0xa5, 0x23, 0xde, 0x85, 0x18, 0x89, 0x77, 0x6b,
-	0x7a, 0x40, 0x5a, 0x56, 0x07, 0x0b, 0x2d, 0xab, 0x6b, 0x73, 0xb4, 0xdd, 0x98, 0xa3, 0x94, 0x6c,
-	0x29, 0x6d, 0x21, 0x8c, 0x0c, 0x5c, 0xbb, 0xd0, 0x64, 0x39, 0xd6, 0x97, 0xa0, 0x70, 0x1e, 0xef,
-	0xf2, 0x08, 0xe9, 0x80, 0xec, 0x59, 0xb7, 0x18, 0xad, 0xe7, 0x17, 0x3a, 0xc7, 0x91, 0xdc, 0xe5,
-	0x75, 0x11, 0xfb, 0x96, 0xdc, 0xa9, 0x17, 0xfc, 0x04, 0xea, 0x23, 0x3c, 0xa9, 0xbb, 0x66, 0x3f,
-	0x92, 0x7b, 0x75, 0xd5, 0xb3, 0xc6, 0x6c, 0x4b, 0x6a, 0xb3, 0xed, 0x76, 0x42, 0xbe, 0x21, 0x0f,
-	0x36, 0xc7, 0x3f, 0x82, 0x99, 0xc2, 0x6b, 0x91, 0x0b, 0x95, 0x42, 0x48, 0x3d, 0x89, 0xa9, 0xb3,
-	0xbf, 0x13, 0x74, 0x84, 0x19, 0x9c, 0x1b, 0x78, 0x63, 0x40, 0x58, 0xa0, 0x4f, 0x49, 0x2f, 0x75,
-	0x2b, 0x6d, 0x7e, 0xab, 0x39, 0xdc, 0x0b, 0x32, 0x47, 0x2d, 0x72, 0xe3, 0x5e, 0x8a, 0x56, 0xe0,
-	0x46, 0xf8, 0xf7, 0xa8, 0xf4, 0xc9, 0xfb, 0xe9, 0x1b, 0x10, 0x0e, 0x2a, 0x65, 0x8d, 0xce, 0x16,
-	0xfe, 0x26, 0x78, 0x3e, 0x1b, 0x32, 0xfa, 0x98, 0x10, 0xbd, 0x54, 0x10, 0x1c, 0x76, 0xfc, 0x90,
-	0x46, 0xc9, 0x71, 0x48, 0xd3, 0x6a, 0x2b, 0xf2, 0xf0, 0xd2, 0x79, 0xe0, 0xa4, 0x85, 0x91, 0x29,
-	0xe0, 0x2b, 0xd7, 0xe6, 0x1e, 0x30, 0x43, 0xee, 0xc7, 0x94, 0x4e, 0xa4, 0x92, 0xe5, 0x2c, 0x64,
-	0xf5, 0x35, 0xd9, 0x9f, 0x20, 0x86, 0x46, 0x5a, 0xbd, 0x28, 0x3c, 0x0e, 0xef, 0x63, 0xc8, 0xa1,
-	0xd5, 0xc8, 0xa1, 0x19, 0x5f, 0xfb, 0x46, 0x7c, 0xac, 0xa8, 0x7c, 0x72, 0xb8, 0xd6, 0x97, 0x35,
-	0x26, 0x0d, 0xe2, 0x26, 0x93, 0x41, 0xf6, 0x7f, 0x3c, 0x02, 0x5e, 0xa6, 0x8f, 0x3a, 0x93, 0x93,
-	0xf5, 0x1b, 0xad, 0x26, 0x72, 0x4a, 0xef, 0x92, 0x76, 0xd5, 0x32, 0x6e, 0xe9, 0xca, 0xad, 0x8b,
-	0x78, 0xd3, 0x75, 0xe1, 0x08, 0xbb, 0x16, 0xf9, 0x02, 0x82, 0x39, 0x0f, 0xdc, 0x7f, 0x61, 0xee,
-	0xec, 0x48, 0x30, 0xa1, 0x36, 0x1b, 0xcc, 0xfe, 0x4a, 0x48, 0x8f, 0xc3, 0xd5, 0x48, 0x4e, 0x15,
-	0x17, 0xcb, 0xf1, 0xea, 0xd6, 0x4b, 0x58, 0xeb, 0xd7, 0xd6, 0xbf, 0xfa, 0xd5, 0xae, 0x4e, 0x61,
-	0x15, 0x1d, 0x22, 0x70, 0x29, 0xc3, 0xaa, 0x90, 0x26, 0xb6, 0x56, 0x40, 0xd5, 0x27, 0xa8, 0xe3,
-	0xa7, 0x88, 0xff, 0x04, 0x61, 0xed, 0x5d, 0xc3, 0xed, 0x04, 0x1b, 0xd8, 0x6e, 0x77, 0x49, 0x7b,
-	0x02, 0x80, 0xbf, 0x98, 0x36, 0x77, 0x4b, 0x37, 0x6d, 0x14, 0x2c, 0x7d, 0xeb, 0xe3, 0x27, 0xa5,
-	0xcb, 0x2b, 0x01, 0x7b, 0x4e, 0x0e, 0xfc, 0x38, 0xde, 0x64, 0xb2, 0x89, 0x2d, 0xa9, 0xc5, 0xc6,
-	0x2e, 0x50, 0x4f, 0x1b, 0xfb, 0xce, 0x98, 0x77, 0xd7, 0xa0, 0xac, 0xfb, 0x1a, 0xb9, 0xb1, 0x31,
-	0xd7, 0xd9, 0x22, 0x87, 0xa0, 0x5c, 0x93, 0x38, 0xfa, 0xac, 0x0e, 0xbb, 0x3e, 0xfd, 0x0d, 0x76,
-	0x3e, 0xc0, 0x18, 0x1d, 0xeb, 0xe7, 0x01, 0xfb, 0x82, 0x74, 0x3e, 0x28, 0x3b, 0x7c, 0xe9, 0xc8,
-	0xcc, 0x84, 0x15, 0xf1, 0x69, 0x72, 0x6b, 0xf6, 0xbd, 0x0b, 0xe0, 0x2a, 0x8c, 0x68, 0x1c, 0xba,
-	0xee, 0xdd, 0x93, 0x76, 0xa6, 0x17, 0x36, 0xb4, 0x71, 0xf8, 0x50, 0xdc, 0x90, 0xb2, 0x77, 0x78,
-	0x25, 0xc2, 0x10, 0x2d, 0x4f, 0xa4, 0x8f, 0x6d, 0x22, 0x73, 0xc0, 0x4f, 0x5d, 0x12, 0xbe, 0x82,
-	0x01, 0x7f, 0xee, 0xe1, 0x7a, 0xfd, 0xe4, 0xd7, 0xc7, 0x53, 0x69, 0x67, 0x8b, 0x8b, 0xa3, 0x54,
-	0xcf, 0x5f, 0x0c, 0x87, 0xa9, 0x7a, 0x91, 0xce, 0x84, 0x54, 0xc3, 0xe1, 0x0b, 0x7c, 0xec, 0x2f,
-	0xb6, 0xf1, 0xbf, 0x3c, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x15, 0xd2, 0xd3, 0x68, 0x0b,
-	0x00, 0x00,
+	// 1357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdb, 0x6e, 0x1b, 0x37,
+	0x13, 0xc6, 0x6a, 0x2d, 0xdb, 0xa2, 0x65, 0xe7, 0xcf, 0x22, 0x31, 0x16, 0xfe, 0x9b, 0x46, 0x61,
+	0x91, 0xd4, 0x05, 0x5a, 0x07, 0x88, 0x6e, 0x8a, 0x02, 0x05, 0xe2, 0x1c, 0x1c, 0x07, 0x75, 0x12,
+	0x83, 0x52, 0x10, 0xa0, 0x28, 0x50, 0x50, 0xbb, 0x23, 0x89, 0xd0, 0x8a, 0x5c, 0x73, 0x29, 0x4b,
+	0x7a, 0x93, 0xbe, 0x45, 0x6f, 0x7a, 0xd9, 0xeb, 0xde, 0xf7, 0x8d, 0x0a, 0x9e, 0xf6, 0xe0, 0x2a,
+	0x01, 0x8a, 0xdc, 0xf1, 0x9b, 0x1d, 0xce, 0xe1, 0x1b, 0x72, 0x86, 0x8b, 0xba, 0x4b, 0x9a, 0x65,
+	0xa0, 0x4e, 0x72, 0x29, 0x94, 0x88, 0xda, 0x6a, 0x9d, 0x43, 0x71, 0x74, 0x5b, 0x49, 0xca, 0x0b,
+	0x9a, 0x28, 0x26, 0xb8, 0xfd, 0x72, 0xb4, 0x4f, 0x93, 0x44, 0x2c, 0xb8, 0x53, 0xc4, 0x7f, 0xb4,
+	0xd0, 0xc1, 0x07, 0xb3, 0x73, 0xb8, 0x7a, 0x01, 0x8a, 0xb2, 0x2c, 0xc2, 0xa8, 0xa5, 0x56, 0x71,
+	0xd0, 0x0b, 0x8e, 0xf7, 0x9e, 0x44, 0x27, 0xc6, 0xd0, 0xc9, 0xb0, 0xb2, 0x43, 0x5a, 0x6a, 0x15,
+	0x7d, 0x8b, 0x76, 0x24, 0x24, 0xc0, 0x72, 0x15, 0xb7, 0x1a, 0x8a, 0xc4, 0x4a, 0x5f, 0x50, 0x45,
+	0x89, 0x57, 0x89, 0x0e, 0xd1, 0xf6, 0x14, 0xd8, 0x64, 0xaa, 0xe2, 0xb0, 0x17, 0x1c, 0x87, 0xc4,
+	0xa1, 0xe8, 0x0e, 0x6a, 0x33, 0x9e, 0xc2, 0x2a, 0xde, 0x32, 0x62, 0x0b, 0xa2, 0x2f, 0x50, 0x67,
+	0x94, 0x89, 0x64, 0xa6, 0xd8, 0x1c, 0xe2, 0xb6, 0xf9, 0x52, 0x09, 0xb4, 0x2d, 0x3a, 0xd7, 0x09,
+	0xc4, 0xdb, 0xd6, 0x96, 0x45, 0xd1, 0x11, 0xda, 0x1d, 0x4b, 0x31, 0xa7, 0x69, 0x2a, 0xe3, 0x9d,
+	0x5e, 0x70, 0xdc, 0x21, 0x25, 0xd6, 0x7b, 0xd4, 0x6a, 0x4a, 0x8b, 0x69, 0xbc, 0xdb, 0x0b, 0x8e,
+	0xbb, 0xc4, 0xa1, 0xe8, 0x4b, 0x84, 0x6c, 0x4e, 0x6f, 0xe9, 0x1c, 0xe2, 0x8e, 0xd9, 0x55, 0x93,
+	0x44, 0x31, 0xda, 0xc9, 0xe9, 0x3a, 0x13, 0x34, 0x8d, 0x91, 0xd9, 0xe8, 0x21, 0x3e, 0x43, 0xb7,
+	0x9a, 0xac, 0x15, 0x51, 0x1f, 0x75, 0x94, 0x07, 0x71, 0xd0, 0x0b, 0x8f, 0xf7, 0x9e, 0xdc, 0x75,
+	0xa4, 0x34, 0x55, 0x49, 0xa5, 0x87, 0xaf, 0x51, 0x64, 0x3f, 0x9e, 0xda, 0xaa, 0x0c, 0x94, 0x90,
+	0xd6, 0xaf, 0x64, 0xd7, 0x33, 0x58, 0x9b, 0x32, 0x74, 0x88, 0x87, 0x9a, 0xb1, 0x8c, 0x8e, 0x20,
+	0x33, 0xac, 0x77, 0x88, 0x05, 0x51, 0x84, 0xb6, 0x4c, 0xde, 0xa1, 0x11, 0x9a, 0xb5, 0x66, 0x51,
+	0xf3, 0x35, 0x50, 0x74, 0x9e, 0x1b, 0x7e, 0x3b, 0xa4, 0x12, 0xe0, 0xa7, 0xa8, 0x6b, 0xfd, 0x5e,
+	0x2e, 0xcf, 0x35, 0x13, 0x87, 0x68, 0x3b, 0x37, 0x2b, 0xe3, 0xb0, 0x4b, 0x1c, 0xd2, 0x91, 0x48,
+	0xca, 0xd3, 0x42, 0x49, 0xe7, 0xd1, 0x43, 0xfc, 0x5b, 0xe0, 0x4d, 0x0c, 0x14, 0x55, 0x8b, 0x22,
+	0xc2, 0xa8, 0xcb, 0x0a, 0x2b, 0xb9, 0x10, 0xc9, 0xcc, 0x18, 0xda, 0x25, 0x0d, 0x99, 0xd5, 0x39,
+	0x5d, 0x28, 0xf1, 0x86, 0x71, 0xc6, 0x27, 0x71, 0xcb, 0xeb, 0x54, 0x32, 0x1d, 0x38, 0x2b, 0xce,
+	0x69, 0x31, 0x00, 0x48, 0x4d, 0x46, 0xbb, 0xa4, 0x12, 0x58, 0x0b, 0x43, 0x96, 0xcc, 0x9c, 0x97,
+	0x2d, 0x6f, 0xa1, 0x92, 0xe1, 0xa7, 0xe8, 0xa0, 0x41, 0x6a, 0x11, 0x9d, 0xa0, 0x1d, 0x7b, 0x3d,
+	0x7c, 0x65, 0xee, 0x34, 0x2a, 0xe3, 0xf4, 0x88, 0x57, 0xc2, 0xaf, 0xd0, 0x7e, 0xe3, 0x4b, 0xd4,
+	0x43, 0x21, 0x4d, 0x12, 0x77, 0x29, 0x0e, 0xdc, 0x66, 0xbf, 0x4d, 0x7f, 0xda, 0x5c, 0x19, 0x3c,
+	0xf5, 0x24, 0xbd, 0xe7, 0x86, 0x00, 0xcd, 0x33, 0x2d, 0x8a, 0x65, 0xea, 0x0a, 0xeb, 0x90, 0xe6,
+	0x59, 0x17, 0x47, 0x2c, 0xec, 0x7d, 0x0a, 0x89, 0x87, 0xd1, 0x23, 0x74, 0x60, 0xa3, 0x7a, 0x27,
+	0x6d, 0x8a, 0x8e, 0x93, 0x1b, 0x52, 0xfc, 0x00, 0xed, 0xbd, 0x02, 0xae, 0x39, 0xba, 0xa0, 0x7c,
+	0xa2, 0x8f, 0x44, 0x46, 0xf9, 0xc4, 0xb8, 0x69, 0x13, 0xb3, 0xc6, 0x0f, 0xb5, 0x8a, 0xd2, 0x2a,
+	0xcf, 0xd6, 0x97, 0xcb, 0x8f, 0xc5, 0x82, 0x7f, 0x40, 0xdd, 0x01, 0xbd, 0x86, 0x52, 0x2f, 0x42,
+	0x5b, 0x05, 0x80, 0xd7, 0x32, 0xeb, 0xda, 0xde, 0x56, 0x63, 0xef, 0x7d, 0xd4, 0x21, 0x90, 0x67,
+	0x6b, 0x53, 0xab, 0x0d, 0x1b, 0xf1, 0x39, 0x8a, 0x08, 0x5c, 0xb9, 0x83, 0x03, 0xea, 0xb2, 0x4c,
+	0x5f, 0x64, 0xa9, 0x06, 0xfe, 0xc0, 0x3b, 0xa8, 0xbf, 0x70, 0x58, 0x9a, 0x2f, 0xee, 0x00, 0x3a,
+	0x88, 0x1f, 0xa2, 0x7d, 0x02, 0x57, 0x6f, 0x61, 0xe9, 0x6b, 0x54, 0x56, 0x20, 0xa8, 0x57, 0xc0,
+	0xaa, 0xbd, 0x02, 0xf5, 0x69, 0xb5, 0x31, 0x8a, 0xcb, 0xb8, 0x6a, 0xcd, 0xee, 0x82, 0x15, 0xa6,
+	0x7d, 0xe9, 0x56, 0x32, 0x5c, 0xf9, 0xcb, 0x61, 0x91, 0xb6, 0x64, 0x4c, 0x9a, 0xc8, 0xda, 0xc4,
+	0x02, 0x7d, 0x7e, 0x53, 0x26, 0xc1, 0x6c, 0x37, 0xb5, 0x6a, 0x93, 0x4a, 0x80, 0xcf, 0xd1, 0x61,
+	0xe9, 0xe7, 0xf5, 0x3c, 0x17, 0x52, 0x5d, 0xba, 0xab, 0xfd, 0x1f, 0x2f, 0x3d, 0xfe, 0x33, 0xa8,
+	0x99, 0x1a, 0x00, 0x4f, 0x87, 0xe2, 0x34, 0x4d, 0x25, 0x14, 0x85, 0x26, 0x5e, 0x87, 0xe8, 0x89,
+	0xd7, 0xeb, 0xe8, 0x00, 0xb5, 0x94, 0x70, 0x16, 0x5a, 0x4a, 0xd4, 0xfa, 0x68, 0xd8, 0xe8, 0xa3,
+	0x11, 0xda, 0xe2, 0x42, 0x81, 0x6b, 0x19, 0x66, 0xad, 0x43, 0x63, 0xc5, 0x50, 0xcc, 0x80, 0x9b,
+	0x7e, 0xbc, 0x4b, 0x3c, 0x8c, 0x7a, 0x68, 0x4f, 0xe9, 0xc5, 0x60, 0x3d, 0x1f, 0x89, 0xcc, 0xb4,
+	0xe4, 0x0e, 0xa9, 0x8b, 0xb4, 0x1f, 0x58, 0xe5, 0x4c, 0x82, 0xeb, 0xca, 0x0e, 0xe1, 0x6f, 0xd0,
+	0xad, 0xfa, 0x41, 0x38, 0x83, 0x7a, 0x6b, 0x0f, 0xea, 0x21, 0xe1, 0x1f, 0xd1, 0xed, 0xba, 0xea,
+	0x45, 0xa3, 0xe7, 0x05, 0xb5, 0x9e, 0xb7, 0x99, 0xa8, 0xaf, 0xd1, 0xdd, 0x72, 0xfb, 0x1b, 0x90,
+	0x13, 0x78, 0x46, 0x33, 0xca, 0x13, 0x70, 0x94, 0x04, 0x9e, 0x12, 0xfc, 0x77, 0x60, 0x1c, 0x99,
+	0xcc, 0x2e, 0x25, 0x3c, 0x97, 0x40, 0x15, 0x44, 0x0f, 0x50, 0x37, 0xd1, 0x2b, 0x21, 0x7f, 0xad,
+	0x39, 0xdc, 0x73, 0x32, 0x4d, 0xb9, 0xe1, 0x4c, 0x4f, 0x90, 0x96, 0xe3, 0x8c, 0xda, 0x39, 0x55,
+	0x58, 0x52, 0x6c, 0x57, 0x76, 0xc8, 0x34, 0x30, 0xae, 0xa4, 0x48, 0x17, 0xf6, 0x84, 0x58, 0x9e,
+	0x1b, 0xb2, 0xe8, 0x1e, 0x42, 0x62, 0xc9, 0xc1, 0x39, 0x6c, 0x1b, 0x8d, 0x8e, 0x91, 0x9c, 0xba,
+	0x34, 0x95, 0x50, 0x34, 0x73, 0x13, 0xd0, 0x02, 0x2d, 0xcd, 0x25, 0x4b, 0x2c, 0xcf, 0x21, 0xb1,
+	0x00, 0x4b, 0x74, 0xc7, 0xa7, 0x74, 0xc6, 0x38, 0x2b, 0xa6, 0x2e, 0xab, 0xaf, 0xd0, 0xfe, 0xd8,
+	0x60, 0x68, 0xa4, 0xd5, 0xf5, 0xc2, 0x53, 0x37, 0x37, 0x5d, 0x0e, 0xad, 0x46, 0x0e, 0xcd, 0xf8,
+	0xc2, 0x1b, 0xf1, 0xe1, 0xbc, 0xf2, 0x49, 0xe0, 0x5a, 0xcc, 0x6a, 0x4c, 0x4a, 0x83, 0x9b, 0x4c,
+	0x3a, 0xd9, 0xe7, 0x78, 0x04, 0x73, 0x98, 0xde, 0x88, 0x94, 0x8d, 0xd7, 0xcf, 0x05, 0x1f, 0xb3,
+	0x49, 0xf4, 0x3f, 0x14, 0x56, 0x57, 0x49, 0x2f, 0x75, 0xb9, 0x45, 0xee, 0x6f, 0x80, 0xc8, 0x35,
+	0x61, 0xd7, 0x34, 0x5b, 0x80, 0x33, 0x67, 0x81, 0x7e, 0x47, 0xcc, 0xb5, 0x1d, 0x06, 0xd2, 0xd5,
+	0xa6, 0xc4, 0xf8, 0xaf, 0x00, 0x75, 0x09, 0x5c, 0x0d, 0xd8, 0x84, 0x13, 0xba, 0x1c, 0xae, 0x36,
+	0x1e, 0xc2, 0xda, 0x3d, 0x6e, 0xfd, 0xeb, 0x1e, 0xab, 0xd5, 0x39, 0xac, 0xbc, 0x43, 0x03, 0x6a,
+	0x17, 0x64, 0xab, 0x7e, 0x41, 0xaa, 0xc7, 0x51, 0xdb, 0x76, 0x17, 0x03, 0x6c, 0xed, 0xf5, 0x45,
+	0xdc, 0x71, 0x36, 0x34, 0xd0, 0xc9, 0x8e, 0x01, 0xcc, 0xeb, 0x26, 0x24, 0x7a, 0xa9, 0xbb, 0x10,
+	0x87, 0xa5, 0x6d, 0x09, 0xe6, 0xf1, 0xd2, 0x21, 0x95, 0x00, 0x3f, 0x42, 0x07, 0xb6, 0x4d, 0x97,
+	0x99, 0x94, 0xb1, 0x05, 0xb5, 0xd8, 0xf0, 0xc8, 0xe8, 0x09, 0xa9, 0x5e, 0x4a, 0xf9, 0xf2, 0x1a,
+	0xb8, 0xd2, 0x4f, 0x26, 0xdd, 0x4e, 0xe6, 0x22, 0x5d, 0x64, 0xe0, 0x94, 0x6b, 0x12, 0x4d, 0x9f,
+	0x12, 0xee, 0xab, 0x4d, 0xbf, 0xc4, 0xda, 0x07, 0x48, 0x29, 0x7c, 0xfd, 0x2c, 0xc0, 0xff, 0x47,
+	0xed, 0xd7, 0x5c, 0xf5, 0x9f, 0x68, 0x32, 0x53, 0xaa, 0xa8, 0x1f, 0x59, 0x7a, 0x8d, 0xbf, 0xd7,
+	0x01, 0x5c, 0xb9, 0xd6, 0x6d, 0x9a, 0xb1, 0x9e, 0x87, 0x4c, 0x4d, 0xc5, 0x42, 0xb9, 0x6b, 0xec,
+	0x1e, 0x1a, 0x37, 0xa4, 0xf8, 0xa5, 0x39, 0x12, 0xae, 0xb9, 0x16, 0x67, 0xcc, 0xc6, 0x36, 0x66,
+	0x19, 0x98, 0xc7, 0x5e, 0xe0, 0x9e, 0x88, 0x0e, 0x7f, 0x74, 0xa0, 0xad, 0xd0, 0xa1, 0xed, 0x1c,
+	0x1f, 0xa8, 0x4a, 0xa6, 0xef, 0x78, 0xb6, 0xf6, 0x73, 0x64, 0x53, 0xed, 0xb5, 0x95, 0xc5, 0xe8,
+	0xa7, 0xb2, 0xf4, 0x0e, 0x55, 0x8d, 0x29, 0xac, 0x3f, 0xdb, 0x3e, 0xfd, 0x44, 0xfb, 0x05, 0xc5,
+	0x37, 0x26, 0x45, 0x19, 0xc2, 0xe7, 0xfb, 0xc6, 0xbf, 0x07, 0xe8, 0xf6, 0x7b, 0x5e, 0xb0, 0x09,
+	0x87, 0x74, 0xb8, 0xba, 0xa4, 0xc9, 0x8c, 0x4e, 0x60, 0xf3, 0x29, 0xd0, 0xde, 0xcc, 0xe3, 0xd9,
+	0xb5, 0x37, 0xbd, 0x2e, 0x47, 0x4c, 0x58, 0x1b, 0x31, 0x55, 0x04, 0x5b, 0x8d, 0x08, 0x8e, 0xd0,
+	0xae, 0x76, 0x34, 0x5c, 0xe7, 0xe0, 0x0e, 0x73, 0x89, 0xfd, 0xc9, 0xdd, 0xae, 0x4e, 0x6e, 0x73,
+	0x60, 0x84, 0xe5, 0xc0, 0xf8, 0x0e, 0xdd, 0x6d, 0x8c, 0xbb, 0x81, 0x8b, 0x7d, 0x73, 0xd0, 0xcf,
+	0xee, 0xff, 0x7c, 0x6f, 0xc2, 0xd4, 0x74, 0x31, 0x3a, 0x49, 0xc4, 0xfc, 0x71, 0xbf, 0x9f, 0xf0,
+	0xc7, 0xc9, 0x94, 0x32, 0xde, 0xef, 0x3f, 0x36, 0xaf, 0xb7, 0xd1, 0xb6, 0xf9, 0x01, 0xea, 0xff,
+	0x13, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x86, 0x02, 0x8b, 0x39, 0x0d, 0x00, 0x00,
 }
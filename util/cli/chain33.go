@@ -36,6 +36,8 @@ import (
 	"github.com/33cn/chain33/common/limits"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/record"
+	"github.com/33cn/chain33/common/trace"
 	"github.com/33cn/chain33/common/version"
 	"github.com/33cn/chain33/consensus"
 	"github.com/33cn/chain33/executor"
@@ -96,10 +98,12 @@ func RunChain33(name, defCfg string) {
 		panic(err)
 	}
 	//set config: bityuan 用 bityuan.toml 这个配置文件
-	chain33Cfg := types.NewChain33Config(types.MergeCfg(types.ReadFile(*configPath), defCfg))
+	cfgstring := types.MergeCfg(types.ReadFile(*configPath), defCfg)
+	chain33Cfg := types.NewChain33Config(cfgstring)
 	cfg := chain33Cfg.GetModuleConfig()
+	var dataDir string
 	if *datadir != "" {
-		util.ResetDatadir(cfg, *datadir)
+		dataDir = util.ResetDatadir(cfg, *datadir)
 	}
 	if *fixtime {
 		cfg.FixTime = *fixtime
@@ -211,11 +215,22 @@ func RunChain33(name, defCfg string) {
 
 	health := util.NewHealthCheckServer(q.Client())
 	health.Start(cfg.Health)
+	//配置热加载: SIGHUP 信号或者Chain33.ReloadConfig 接口
+	reloader := util.NewConfigReloader(chain33Cfg, cfgstring, *configPath, defCfg, dataDir)
+	reloader.SetQueueClient(q.Client())
 	metrics.StartMetrics(chain33Cfg)
+	if err := trace.Init(cfg.Trace); err != nil {
+		panic(err)
+	}
+	if err := record.Init(cfg.Record); err != nil {
+		panic(err)
+	}
 	defer func() {
 		//close all module,clean some resource
 		log.Info("begin close health module")
 		health.Close()
+		log.Info("begin close config reloader")
+		reloader.Close()
 		log.Info("begin close blockchain module")
 		chain.Close()
 		log.Info("begin close mempool module")
@@ -234,6 +249,8 @@ func RunChain33(name, defCfg string) {
 		walletm.Close()
 		log.Info("begin close queue module")
 		q.Close()
+		trace.Close()
+		record.Close()
 
 	}()
 	q.Start()
@@ -95,6 +95,8 @@ func Run(RPCAddr, ParaName, name string) {
 		commands.OneStepSendCertTxCmd(),
 		closeCmd,
 		commands.AssetCmd(),
+		commands.SchemaCmd(),
+		commands.ReloadConfigCmd(),
 	)
 
 	//test tls is enable
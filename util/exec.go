@@ -33,6 +33,28 @@ func CheckBlock(client queue.Client, block *types.BlockDetail) error {
 	return errors.New(string(reply.GetMsg()))
 }
 
+//CheckHeaders : To check the consecutive headers by consensus rules, headers[0] is the checked parent header,
+//return types.ErrNotSupport if the consensus can not check headers
+func CheckHeaders(client queue.Client, headers []*types.Header) error {
+	msg := client.NewMessage("consensus", types.EventCheckBlockHeaders, &types.Headers{Items: headers})
+	err := client.Send(msg, true)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Wait(msg)
+	if err != nil {
+		return err
+	}
+	reply := resp.GetData().(*types.Reply)
+	if reply.IsOk {
+		return nil
+	}
+	if string(reply.GetMsg()) == types.ErrNotSupport.Error() {
+		return types.ErrNotSupport
+	}
+	return errors.New(string(reply.GetMsg()))
+}
+
 //ExecTx : To send lists of txs within a block to exector for execution
 func ExecTx(client queue.Client, prevStateRoot []byte, block *types.Block) (*types.Receipts, error) {
 	list := &types.ExecTxList{
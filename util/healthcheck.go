@@ -6,6 +6,7 @@ package util
 
 import (
 	"net"
+	"net/http"
 	"time"
 
 	"sync"
@@ -22,16 +23,29 @@ var (
 	checkInterval  uint32 = 5                // 5s
 )
 
+const (
+	defaultMaxHeightLag int64 = 10
+	defaultMinPeers     int64 = 1
+)
+
 // HealthCheckServer  a node's health check server
 type HealthCheckServer struct {
-	api  client.QueueProtocolAPI
-	l    net.Listener
-	quit chan struct{}
-	wg   sync.WaitGroup
+	api    client.QueueProtocolAPI
+	l      net.Listener
+	quit   chan struct{}
+	wg     sync.WaitGroup
+	cfg    types.HealthCheck
+	server *http.Server
 }
 
 // Close NewHealthCheckServer close
 func (s *HealthCheckServer) Close() {
+	if s.server != nil {
+		err := s.server.Close()
+		if err != nil {
+			log.Error("healthCheck ", "http close err", err)
+		}
+	}
 	close(s.quit)
 	s.wg.Wait()
 	log.Info("healthCheck quit")
@@ -64,11 +78,20 @@ func (s *HealthCheckServer) Start(cfg *types.HealthCheck) {
 		if cfg.UnSyncMaxTimes != 0 {
 			unSyncMaxTimes = cfg.UnSyncMaxTimes
 		}
+		s.cfg = *cfg
 	}
-	log.Info("healthCheck start ", "addr", listenAddr, "inter", checkInterval, "times", unSyncMaxTimes)
+	if s.cfg.MaxHeightLag == 0 {
+		s.cfg.MaxHeightLag = defaultMaxHeightLag
+	}
+	if s.cfg.MinPeers == 0 {
+		s.cfg.MinPeers = defaultMinPeers
+	}
+	log.Info("healthCheck start ", "addr", listenAddr, "inter", checkInterval, "times", unSyncMaxTimes, "httpAddr", s.cfg.HTTPAddr)
 	s.wg.Add(1)
 	go s.healthCheck()
-
+	if s.cfg.HTTPAddr != "" {
+		s.startHTTP()
+	}
 }
 
 func (s *HealthCheckServer) listen(on bool) error {
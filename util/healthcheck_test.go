@@ -5,6 +5,8 @@
 package util
 
 import (
+	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,89 @@ func TestGetHealth(t *testing.T) {
 	assert.Equal(t, false, ret)
 
 }
+
+func newStatusAPI(synced bool, fatal int32) *mocks.QueueProtocolAPI {
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetLastHeader").Return(&types.Header{Height: 100, BlockTime: types.Now().Unix() - 100}, nil)
+	api.On("ExecWalletFunc", "wallet", "FatalFailure", mock.Anything).Return(&types.Int32{Data: fatal}, nil)
+	api.On("IsSync").Return(&types.Reply{IsOk: synced}, nil)
+	peers := &types.PeerList{Peers: []*types.Peer{
+		{Addr: "addr1", Header: &types.Header{Height: 120}},
+		{Addr: "self", Header: &types.Header{Height: 100}},
+	}}
+	api.On("PeerInfo", mock.Anything).Return(peers, nil)
+	api.On("GetMempoolSize").Return(&types.MempoolSize{Size: 50}, nil)
+	api.On("IsNtpClockSync").Return(&types.Reply{IsOk: true}, nil)
+	return api
+}
+
+func findCheck(st *HealthStatus, name string) *CheckResult {
+	for _, r := range st.Checks {
+		if r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+func TestHealthStatus(t *testing.T) {
+	q := queue.New("channel")
+	health := NewHealthCheckServer(q.Client())
+	health.api = newStatusAPI(true, 0)
+	health.cfg = types.HealthCheck{MaxHeightLag: 30, MinPeers: 1, MaxMempoolSize: 100, MaxBlockAge: 200}
+	st := health.Status()
+	assert.True(t, st.Live)
+	assert.True(t, st.Ready)
+	assert.Equal(t, int64(20), st.HeightLag)
+	assert.Equal(t, int64(1), st.Peers)
+	assert.Equal(t, int64(50), st.MempoolSize)
+
+	//阈值收紧后就绪检查失败, 并给出原因
+	health.cfg = types.HealthCheck{MaxHeightLag: 10, MinPeers: 2, MaxMempoolSize: 10, MaxBlockAge: 50}
+	st = health.Status()
+	assert.True(t, st.Live)
+	assert.False(t, st.Ready)
+	for _, name := range []string{CheckHeightLag, CheckPeers, CheckMempool, CheckBlockAge} {
+		r := findCheck(st, name)
+		assert.NotNil(t, r, name)
+		assert.False(t, r.OK, name)
+		assert.NotEmpty(t, r.Reason, name)
+	}
+	assert.True(t, findCheck(st, CheckNtp).OK)
+
+	//负数阈值不检查
+	health.cfg = types.HealthCheck{MaxHeightLag: -1, MinPeers: -1, DisableNtpCheck: true}
+	st = health.Status()
+	assert.True(t, st.Ready)
+	assert.Nil(t, findCheck(st, CheckHeightLag))
+	assert.Nil(t, findCheck(st, CheckNtp))
+
+	health.api = newStatusAPI(false, 1)
+	st = health.Status()
+	assert.False(t, st.Live)
+	assert.False(t, findCheck(st, CheckSync).OK)
+}
+
+func TestHealthHTTP(t *testing.T) {
+	q := queue.New("channel")
+	health := NewHealthCheckServer(q.Client())
+	api := newStatusAPI(false, 0)
+	api.On("Close").Return()
+	health.api = api
+	health.Start(&types.HealthCheck{ListenAddr: "localhost:18805", CheckInterval: 1, HTTPAddr: "localhost:18806"})
+	defer health.Close()
+
+	resp, err := http.Get("http://localhost:18806/health/live")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	resp, err = http.Get("http://localhost:18806/health/ready")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	var st HealthStatus
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&st))
+	resp.Body.Close()
+	assert.False(t, st.IsSync)
+	assert.Equal(t, "block chain is catching up", findCheck(&st, CheckSync).Reason)
+}
@@ -0,0 +1,200 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+)
+
+// 检查项名称
+const (
+	CheckBlockchain   = "blockchain"
+	CheckFatalFailure = "fatalFailure"
+	CheckSync         = "sync"
+	CheckHeightLag    = "heightLag"
+	CheckPeers        = "peers"
+	CheckMempool      = "mempool"
+	CheckNtp          = "ntp"
+	CheckBlockAge     = "blockAge"
+)
+
+// CheckResult 单项检查结果, 不通过时Reason说明原因
+type CheckResult struct {
+	Name   string `json:"name"`
+	OK     bool   `json:"ok"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// HealthStatus 节点健康状态
+type HealthStatus struct {
+	Live          bool           `json:"live"`
+	Ready         bool           `json:"ready"`
+	Height        int64          `json:"height"`
+	PeerMaxHeight int64          `json:"peerMaxHeight"`
+	HeightLag     int64          `json:"heightLag"`
+	Peers         int64          `json:"peers"`
+	MempoolSize   int64          `json:"mempoolSize"`
+	IsSync        bool           `json:"isSync"`
+	NtpSync       bool           `json:"ntpSync"`
+	FatalFailure  int32          `json:"fatalFailure"`
+	BlockAge      int64          `json:"blockAge"`
+	Checks        []*CheckResult `json:"checks"`
+}
+
+func (st *HealthStatus) add(name string, ok bool, format string, args ...interface{}) {
+	r := &CheckResult{Name: name, OK: ok}
+	if !ok {
+		r.Reason = fmt.Sprintf(format, args...)
+	}
+	st.Checks = append(st.Checks, r)
+}
+
+// failed 返回未通过的检查项, names为空时检查全部
+func (st *HealthStatus) failed(names ...string) []*CheckResult {
+	var results []*CheckResult
+	for _, r := range st.Checks {
+		if r.OK {
+			continue
+		}
+		if len(names) == 0 {
+			results = append(results, r)
+			continue
+		}
+		for _, name := range names {
+			if r.Name == name {
+				results = append(results, r)
+			}
+		}
+	}
+	return results
+}
+
+// Status 收集节点当前的健康状态
+// 存活检查只关注区块链模块是否可以响应以及是否出现致命错误, 就绪检查需要全部检查项通过
+func (s *HealthCheckServer) Status() *HealthStatus {
+	st := &HealthStatus{}
+	cfg := &s.cfg
+
+	header, err := s.api.GetLastHeader()
+	if err != nil {
+		st.add(CheckBlockchain, false, "get last header: %v", err)
+	} else {
+		st.add(CheckBlockchain, true, "")
+		st.Height = header.Height
+		st.BlockAge = types.Now().Unix() - header.BlockTime
+	}
+
+	//钱包模块记录区块链等模块上报的致命错误
+	fatal, err := s.api.ExecWalletFunc("wallet", "FatalFailure", &types.ReqNil{})
+	if err != nil {
+		log.Debug("healthCheck", "get fatal failure err", err)
+	} else if reply, ok := fatal.(*types.Int32); ok {
+		st.FatalFailure = reply.Data
+	}
+	st.add(CheckFatalFailure, st.FatalFailure == 0, "fatal failure %d reported", st.FatalFailure)
+	st.Live = len(st.failed(CheckBlockchain, CheckFatalFailure)) == 0
+
+	reply, err := s.api.IsSync()
+	if err != nil {
+		st.add(CheckSync, false, "get sync status: %v", err)
+	} else {
+		st.IsSync = reply.IsOk
+		st.add(CheckSync, reply.IsOk, "block chain is catching up")
+	}
+
+	peerList, err := s.api.PeerInfo(&types.P2PGetPeerReq{})
+	if err != nil {
+		st.add(CheckPeers, false, "get peer info: %v", err)
+	} else {
+		//p2p模块返回的节点列表最后一个为本节点
+		peers := peerList.GetPeers()
+		if len(peers) > 0 {
+			peers = peers[:len(peers)-1]
+		}
+		st.Peers = int64(len(peers))
+		for _, peer := range peers {
+			if peer.GetHeader().GetHeight() > st.PeerMaxHeight {
+				st.PeerMaxHeight = peer.GetHeader().GetHeight()
+			}
+		}
+		if cfg.MinPeers > 0 {
+			st.add(CheckPeers, st.Peers >= cfg.MinPeers, "peers %d less than %d", st.Peers, cfg.MinPeers)
+		}
+	}
+	if st.PeerMaxHeight > st.Height {
+		st.HeightLag = st.PeerMaxHeight - st.Height
+	}
+	if cfg.MaxHeightLag > 0 {
+		st.add(CheckHeightLag, st.HeightLag <= cfg.MaxHeightLag, "height %d behind peers %d more than %d",
+			st.Height, st.PeerMaxHeight, cfg.MaxHeightLag)
+	}
+
+	if cfg.MaxMempoolSize > 0 {
+		size, err := s.api.GetMempoolSize()
+		if err != nil {
+			st.add(CheckMempool, false, "get mempool size: %v", err)
+		} else {
+			st.MempoolSize = size.Size
+			st.add(CheckMempool, size.Size <= cfg.MaxMempoolSize, "mempool size %d more than %d", size.Size, cfg.MaxMempoolSize)
+		}
+	}
+
+	if !cfg.DisableNtpCheck {
+		ntp, err := s.api.IsNtpClockSync()
+		if err != nil {
+			st.add(CheckNtp, false, "get ntp status: %v", err)
+		} else {
+			st.NtpSync = ntp.IsOk
+			st.add(CheckNtp, ntp.IsOk, "ntp clock out of sync")
+		}
+	}
+
+	if cfg.MaxBlockAge > 0 && header != nil {
+		st.add(CheckBlockAge, st.BlockAge <= cfg.MaxBlockAge, "last block %d seconds ago more than %d", st.BlockAge, cfg.MaxBlockAge)
+	}
+	st.Ready = len(st.failed()) == 0
+	return st
+}
+
+func (s *HealthCheckServer) startHTTP() {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health/live", func(w http.ResponseWriter, r *http.Request) {
+		st := s.Status()
+		writeStatus(w, st, st.Live)
+	})
+	mux.HandleFunc("/health/ready", func(w http.ResponseWriter, r *http.Request) {
+		st := s.Status()
+		writeStatus(w, st, st.Ready)
+	})
+	listener, err := net.Listen("tcp", s.cfg.HTTPAddr)
+	if err != nil {
+		log.Error("healthCheck ", "http listen err", err)
+		return
+	}
+	s.server = &http.Server{Handler: mux}
+	go func() {
+		err := s.server.Serve(listener)
+		if err != http.ErrServerClosed {
+			log.Error("healthCheck ", "http serve err", err)
+		}
+	}()
+}
+
+func writeStatus(w http.ResponseWriter, st *HealthStatus, ok bool) {
+	w.Header().Set("Content-Type", "application/json")
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	err := json.NewEncoder(w).Encode(st)
+	if err != nil {
+		log.Error("healthCheck ", "write status err", err)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package util
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	clog "github.com/33cn/chain33/common/log"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+const reloadNotifyTimeout = 5 * time.Second
+
+// reloadTopics 配置热加载之后需要通知的模块
+var reloadTopics = []string{"rpc", "mempool", "blockchain", "p2p"}
+
+// ConfigReloader 配置热加载, 收到SIGHUP 信号或者管理接口的EventReloadConfig 请求时重新读取配置文件,
+// 共识相关的配置不允许修改, 可以热加载的配置通过EventConfigReloaded 通知各个模块
+type ConfigReloader struct {
+	chain33Cfg *types.Chain33Config
+	path       string
+	defCfg     string
+	datadir    string
+	// 启动时的配置, 用于判断哪些修改需要重启
+	boot string
+	// 最近一次生效的配置, 用于判断哪些修改需要通知模块
+	current string
+	mu      sync.Mutex
+	client  queue.Client
+	sig     chan os.Signal
+	wg      sync.WaitGroup
+}
+
+// NewConfigReloader new config reloader, cfgstring 为启动时合并了默认配置的配置, datadir 为启动时重置的数据目录
+func NewConfigReloader(chain33Cfg *types.Chain33Config, cfgstring, path, defCfg, datadir string) *ConfigReloader {
+	return &ConfigReloader{
+		chain33Cfg: chain33Cfg,
+		path:       path,
+		defCfg:     defCfg,
+		datadir:    datadir,
+		boot:       cfgstring,
+		current:    cfgstring,
+	}
+}
+
+// SetQueueClient 订阅config 消息并监听SIGHUP 信号
+func (r *ConfigReloader) SetQueueClient(c queue.Client) {
+	r.client = c
+	r.client.Sub("config")
+	r.sig = make(chan os.Signal, 1)
+	signal.Notify(r.sig, syscall.SIGHUP)
+	r.wg.Add(2)
+	go r.handleEvent()
+	go r.handleSignal()
+}
+
+// Close ConfigReloader close
+func (r *ConfigReloader) Close() {
+	signal.Stop(r.sig)
+	close(r.sig)
+	r.client.Close()
+	r.wg.Wait()
+	log.Info("config reloader quit")
+}
+
+func (r *ConfigReloader) handleEvent() {
+	defer r.wg.Done()
+	for msg := range r.client.Recv() {
+		switch msg.Ty {
+		case types.EventReloadConfig:
+			reply, err := r.Reload()
+			if err != nil {
+				msg.Reply(r.client.NewMessage("", types.EventReloadConfig, err))
+				continue
+			}
+			msg.Reply(r.client.NewMessage("", types.EventReloadConfig, reply))
+		default:
+			msg.Reply(r.client.NewMessage("", msg.Ty, types.ErrNotSupport))
+		}
+	}
+}
+
+func (r *ConfigReloader) handleSignal() {
+	defer r.wg.Done()
+	for range r.sig {
+		log.Info("reload config", "signal", "SIGHUP", "path", r.path)
+		_, err := r.Reload()
+		if err != nil {
+			log.Error("reload config", "err", err)
+		}
+	}
+}
+
+// Reload 重新读取配置文件, 校验通过之后通知各个模块, 任何一步出错都不会有配置生效
+func (r *ConfigReloader) Reload() (*types.ReplyReloadConfig, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cfgstring, cfg, sub, err := types.LoadConfigFile(r.path, r.defCfg)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := types.DiffConfig(r.boot, cfgstring)
+	if err != nil {
+		return nil, err
+	}
+	changed, err := types.DiffConfig(r.current, cfgstring)
+	if err != nil {
+		return nil, err
+	}
+	reply.Applied = changed.Applied
+	if err := r.chain33Cfg.CheckReloadConfig(cfg); err != nil {
+		return nil, err
+	}
+	if err := checkLogLevel(cfg.Log); err != nil {
+		return nil, err
+	}
+	if r.datadir != "" {
+		ResetDatadir(cfg, r.datadir)
+	}
+	r.current = cfgstring
+	log.Info("reload config", "applied", reply.Applied, "restartRequired", reply.RestartRequired)
+	if len(reply.Applied) == 0 {
+		return reply, nil
+	}
+	for _, key := range reply.Applied {
+		if strings.HasPrefix(strings.ToLower(key), "log.") {
+			clog.ReloadLog(cfg.Log)
+			break
+		}
+	}
+	data := &types.ReloadConfig{Config: cfg, Sub: sub}
+	for _, topic := range reloadTopics {
+		msg := r.client.NewMessage(topic, types.EventConfigReloaded, data)
+		if err := r.client.SendTimeout(msg, false, reloadNotifyTimeout); err != nil {
+			log.Error("reload config notify", "topic", topic, "err", err)
+		}
+	}
+	return reply, nil
+}
+
+func checkLogLevel(cfg *types.Log) error {
+	if cfg == nil {
+		return nil
+	}
+	for _, lvl := range []string{cfg.Loglevel, cfg.LogConsoleLevel} {
+		if lvl == "" {
+			continue
+		}
+		if _, err := log.LvlFromString(lvl); err != nil {
+			return fmt.Errorf("%w: %s", types.ErrConfigInvalid, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package util
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigReloader(t *testing.T) {
+	cfgstring := types.GetDefaultCfgstring()
+	chain33Cfg := types.NewChain33Config(cfgstring)
+	q := queue.New("channel")
+	q.SetConfig(chain33Cfg)
+	defer q.Close()
+	rpcClient := q.Client()
+	rpcClient.Sub("rpc")
+
+	dir, err := ioutil.TempDir("", "reload")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "chain33.toml")
+	require.Nil(t, ioutil.WriteFile(path, []byte(cfgstring), 0644))
+	reloader := NewConfigReloader(chain33Cfg, cfgstring, path, "", "")
+	reloader.SetQueueClient(q.Client())
+	defer reloader.Close()
+
+	client := q.Client()
+	reload := func(cfgstring string) (*types.ReplyReloadConfig, error) {
+		require.Nil(t, ioutil.WriteFile(path, []byte(cfgstring), 0644))
+		msg := client.NewMessage("config", types.EventReloadConfig, &types.ReqNil{})
+		require.Nil(t, client.Send(msg, true))
+		resp, err := client.Wait(msg)
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetData().(*types.ReplyReloadConfig), nil
+	}
+
+	newCfg := strings.Replace(cfgstring, `whitelist=["127.0.0.1"]`, `whitelist=["127.0.0.1", "192.168.0.1"]`, 1)
+	newCfg = strings.Replace(newCfg, "poolCacheSize=102400", "poolCacheSize=1024", 1)
+	reply, err := reload(newCfg)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"rpc.whitelist"}, reply.Applied)
+	assert.Equal(t, []string{"mempool.poolCacheSize"}, reply.RestartRequired)
+	msg := <-rpcClient.Recv()
+	assert.Equal(t, int64(types.EventConfigReloaded), msg.Ty)
+	assert.Equal(t, []string{"127.0.0.1", "192.168.0.1"}, msg.GetData().(*types.ReloadConfig).Config.RPC.Whitelist)
+
+	//没有新的修改, 需要重启的配置项依然返回
+	reply, err = reload(newCfg)
+	require.Nil(t, err)
+	assert.Nil(t, reply.Applied)
+	assert.Equal(t, []string{"mempool.poolCacheSize"}, reply.RestartRequired)
+
+	//共识相关的配置以及不合法的配置都不会生效
+	_, err = reload(strings.Replace(newCfg, "maxTxNumber = 10000", "maxTxNumber = 20000", 1))
+	assert.True(t, errors.Is(err, types.ErrConfigNotReloadable))
+	_, err = reload(strings.Replace(newCfg, `loglevel = "debug"`, `loglevel = "verbose"`, 1))
+	assert.True(t, errors.Is(err, types.ErrConfigInvalid))
+	_, err = reload(strings.Replace(newCfg, "minTxFeeRate=100000", "minTxFeeRate=10000", 1))
+	assert.True(t, errors.Is(err, types.ErrConfigInvalid))
+	//恢复原来的白名单
+	reply, err = reload(cfgstring)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"rpc.whitelist"}, reply.Applied)
+	assert.Nil(t, reply.RestartRequired)
+	msg = <-rpcClient.Recv()
+	assert.Equal(t, []string{"127.0.0.1"}, msg.GetData().(*types.ReloadConfig).Config.RPC.Whitelist)
+}
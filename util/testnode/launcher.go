@@ -0,0 +1,161 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testnode
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+//NetworkConfig 启动测试网络的参数
+type NetworkConfig struct {
+	//节点数量
+	Nodes int
+	//出块的节点, 第0个节点总是出块, 负责产生第一个区块
+	Miners []int
+	//生成私钥的种子, 相同的种子生成相同的创世地址和账户
+	Seed string
+	//生成的账户数量, 账户可以通过 Network.Fund 从创世地址获取余额
+	Accounts int
+	//开放每个节点的jsonrpc和grpc服务, 端口随机分配
+	RPC bool
+	//启动前修改节点的配置, 可以为nil
+	Modify func(i int, cfg *types.Chain33Config)
+}
+
+//NetworkKeys 测试网络生成的私钥
+type NetworkKeys struct {
+	Genesis  crypto.PrivKey
+	Accounts []crypto.PrivKey
+}
+
+//GenesisAddress 创世地址
+func (keys *NetworkKeys) GenesisAddress() string {
+	return address.PubKeyToAddress(keys.Genesis.PubKey().Bytes()).String()
+}
+
+//Address 第i个账户的地址
+func (keys *NetworkKeys) Address(i int) string {
+	return address.PubKeyToAddress(keys.Accounts[i].PubKey().Bytes()).String()
+}
+
+//GenKeys 根据种子生成创世私钥和n个账户私钥
+func GenKeys(seed string, n int) *NetworkKeys {
+	keys := &NetworkKeys{Genesis: seedKey(seed, "genesis")}
+	for i := 0; i < n; i++ {
+		keys.Accounts = append(keys.Accounts, seedKey(seed, fmt.Sprint(i)))
+	}
+	return keys
+}
+
+func seedKey(seed, name string) crypto.PrivKey {
+	hash := sha256.Sum256([]byte(seed + "/" + name))
+	return util.HexToPrivkey(common.ToHex(hash[:]))
+}
+
+//NetworkConfigs 生成测试网络每个节点的配置, 所有节点使用相同的创世地址
+func NetworkConfigs(conf *NetworkConfig, keys *NetworkKeys) []*types.Chain33Config {
+	miners := map[int]bool{0: true}
+	for _, i := range conf.Miners {
+		miners[i] = true
+	}
+	genesis := keys.GenesisAddress()
+	var cfgs []*types.Chain33Config
+	for i := 0; i < conf.Nodes; i++ {
+		cfg := GetDefaultConfig()
+		mcfg := cfg.GetModuleConfig()
+		//缩短同步周期, 落后一个区块超过1秒就从其他节点同步
+		mcfg.BlockChain.TimeoutSeconds = 1
+		mcfg.BlockChain.OnChainTimeout = 1
+		mcfg.Consensus.Minerstart = miners[i]
+		mcfg.Consensus.Genesis = genesis
+		sub := cfg.GetSubConfig()
+		if data, ok := sub.Consensus[mcfg.Consensus.Name]; ok {
+			data, err := types.ModifySubConfig(data, "genesis", genesis)
+			if err != nil {
+				panic(err)
+			}
+			sub.Consensus[mcfg.Consensus.Name] = data
+		}
+		if conf.Modify != nil {
+			conf.Modify(i, cfg)
+		}
+		cfgs = append(cfgs, cfg)
+	}
+	return cfgs
+}
+
+//Launch 启动测试网络
+//
+//节点在只有创世区块时看到其他节点会认为自己没有同步完成, 所以先单独启动第0个节点产生第一个区块, 再启动其他节点
+func Launch(conf *NetworkConfig) *Network {
+	seed := conf.Seed
+	if seed == "" {
+		seed = "chain33"
+	}
+	keys := GenKeys(seed, conf.Accounts)
+	n := newNetwork(conf.RPC, keys)
+	for i, cfg := range NetworkConfigs(conf, keys) {
+		mock := n.AddNode(cfg)
+		if i > 0 {
+			continue
+		}
+		_, err := mock.GetAPI().SendTx(util.CreateNoneTx(cfg, keys.Genesis))
+		if err != nil {
+			panic(err)
+		}
+		err = mock.WaitHeight(1)
+		if err != nil {
+			panic(err)
+		}
+	}
+	err := n.WaitSync(time.Minute)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+//Fund 通过节点i从创世地址给每个生成的账户转账, 等待所有交易打包
+func (n *Network) Fund(i int, amount int64, timeout time.Duration) error {
+	if err := n.CheckIndex(i); err != nil {
+		return err
+	}
+	mock := n.Node(i)
+	if mock == nil || n.keys == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := mock.GetClient().GetConfig()
+	var hashes [][]byte
+	for j := range n.keys.Accounts {
+		tx := util.CreateCoinsTx(cfg, n.keys.Genesis, n.keys.Address(j), amount)
+		reply, err := mock.GetAPI().SendTx(tx)
+		if err != nil {
+			return err
+		}
+		hashes = append(hashes, reply.GetMsg())
+	}
+	deadline := time.Now().Add(timeout)
+	for _, hash := range hashes {
+		for {
+			_, err := mock.GetAPI().QueryTx(&types.ReqHash{Hash: hash})
+			if err == nil {
+				break
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("wait tx %s timeout", common.ToHex(hash))
+			}
+			time.Sleep(time.Second / 10)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,594 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testnode
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+//Network 进程内的多节点测试网络, 节点之间通过内存p2p转发交易和区块
+//
+//网络中的链路可以断开和恢复, 节点可以宕机后使用原来的数据重启, 用于在单元测试中稳定的重现分叉, 回滚和同步
+type Network struct {
+	mu       sync.RWMutex
+	nodes    []*Chain33Mock
+	p2ps     []*memP2P
+	cfgs     []*types.Chain33Config
+	datadirs []string
+	// 断开的链路, key为两个节点的序号, 小的在前
+	cuts map[[2]int]bool
+	// 节点作为数据提供方时注入的故障
+	faults map[int]PeerFault
+	// 节点上报的其他节点的不良行为
+	reports map[int][]*types.ReportPeer
+	// 是否开放节点的rpc服务
+	rpc  bool
+	keys *NetworkKeys
+}
+
+//NewNetwork 按配置依次启动节点, 每个配置对应一个节点
+func NewNetwork(cfgs []*types.Chain33Config) *Network {
+	n := newNetwork(false, nil)
+	for _, cfg := range cfgs {
+		n.AddNode(cfg)
+	}
+	return n
+}
+
+func newNetwork(rpc bool, keys *NetworkKeys) *Network {
+	return &Network{
+		cuts:    make(map[[2]int]bool),
+		faults:  make(map[int]PeerFault),
+		reports: make(map[int][]*types.ReportPeer),
+		rpc:     rpc,
+		keys:    keys,
+	}
+}
+
+//AddNode 向运行中的网络加入一个新节点, 模拟落后的节点加入网络后同步
+func (n *Network) AddNode(cfg *types.Chain33Config) *Chain33Mock {
+	n.mu.Lock()
+	i := len(n.nodes)
+	p := newMemP2P(n, i)
+	n.p2ps = append(n.p2ps, p)
+	n.nodes = append(n.nodes, nil)
+	n.cfgs = append(n.cfgs, cfg)
+	n.datadirs = append(n.datadirs, "")
+	n.mu.Unlock()
+	mock := newWithNetwork(cfg, nil, p)
+	n.start(i, mock)
+	return mock
+}
+
+func (n *Network) start(i int, mock *Chain33Mock) {
+	if n.rpc {
+		mock.Listen()
+	}
+	n.mu.Lock()
+	n.nodes[i] = mock
+	n.datadirs[i] = mock.datadir
+	n.mu.Unlock()
+}
+
+//Len 节点数量
+func (n *Network) Len() int {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return len(n.nodes)
+}
+
+//CheckIndex 检查节点序号是否在网络的范围内
+func (n *Network) CheckIndex(i int) error {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.checkIndex(i)
+}
+
+func (n *Network) checkIndex(i int) error {
+	if i < 0 || i >= len(n.nodes) {
+		return fmt.Errorf("node index %d out of range [0, %d)", i, len(n.nodes))
+	}
+	return nil
+}
+
+//Node 获取第i个节点, 序号越界或者节点已停止时返回nil
+func (n *Network) Node(i int) *Chain33Mock {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if n.checkIndex(i) != nil || n.p2ps[i].isStopped() {
+		return nil
+	}
+	return n.nodes[i]
+}
+
+//StopNode 关闭第i个节点, 模拟节点宕机, 节点的数据保留到网络关闭
+func (n *Network) StopNode(i int) error {
+	n.mu.RLock()
+	if err := n.checkIndex(i); err != nil {
+		n.mu.RUnlock()
+		return err
+	}
+	mock := n.nodes[i]
+	p := n.p2ps[i]
+	n.mu.RUnlock()
+	if p.isStopped() {
+		return nil
+	}
+	p.stop()
+	if mock != nil {
+		mock.stop()
+	}
+	return nil
+}
+
+//RestartNode 使用原来的配置和数据重启第i个节点, 节点正在运行时先关闭
+func (n *Network) RestartNode(i int) (*Chain33Mock, error) {
+	if err := n.StopNode(i); err != nil {
+		return nil, err
+	}
+	n.mu.Lock()
+	p := newMemP2P(n, i)
+	n.p2ps[i] = p
+	//启动完成之前其他节点看不到这个节点
+	n.nodes[i] = nil
+	cfg := n.cfgs[i]
+	datadir := n.datadirs[i]
+	n.mu.Unlock()
+	if n.rpc {
+		//重启后重新选择端口, 原来的端口可能还没有释放
+		rpcCfg := cfg.GetModuleConfig().RPC
+		rpcCfg.JrpcBindAddr = "localhost:0"
+		rpcCfg.GrpcBindAddr = "localhost:0"
+	}
+	mock := newWithDatadir(cfg, nil, p, datadir)
+	n.start(i, mock)
+	return mock, nil
+}
+
+//Disconnect 断开节点i和j之间的链路
+func (n *Network) Disconnect(i, j int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.cuts[link(i, j)] = true
+}
+
+//Connect 恢复节点i和j之间的链路
+func (n *Network) Connect(i, j int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.cuts, link(i, j))
+}
+
+//Partition 把网络分成几组, 组和组之间的链路全部断开, 没有列出的节点保持原来的链路
+func (n *Network) Partition(groups ...[]int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for gi, group := range groups {
+		for _, other := range groups[gi+1:] {
+			for _, i := range group {
+				for _, j := range other {
+					n.cuts[link(i, j)] = true
+				}
+			}
+		}
+	}
+}
+
+//Heal 恢复所有断开的链路
+func (n *Network) Heal() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.cuts = make(map[[2]int]bool)
+}
+
+//Connected 节点i和j之间的链路是否连通
+func (n *Network) Connected(i, j int) bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return !n.cuts[link(i, j)]
+}
+
+//PeerFault 节点向其他节点提供区块和区块头时的故障, 用于测试同步时的重试和重新分配
+type PeerFault int
+
+const (
+	//FaultNone 正常提供数据
+	FaultNone PeerFault = iota
+	//FaultStall 不响应区块请求
+	FaultStall
+	//FaultBadHeaders 返回创世区块之后按共识规则无效的区块头, 区块头哈希重新计算, 能通过哈希校验
+	FaultBadHeaders
+)
+
+//SetFault 设置第i个节点作为数据提供方时的故障
+func (n *Network) SetFault(i int, fault PeerFault) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.faults[i] = fault
+}
+
+func (n *Network) fault(i int) PeerFault {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.faults[i]
+}
+
+//Reports 返回第i个节点上报的其他节点的不良行为
+func (n *Network) Reports(i int) []*types.ReportPeer {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return append([]*types.ReportPeer(nil), n.reports[i]...)
+}
+
+func (n *Network) addReport(i int, report *types.ReportPeer) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.reports[i] = append(n.reports[i], report)
+}
+
+func link(i, j int) [2]int {
+	if i > j {
+		i, j = j, i
+	}
+	return [2]int{i, j}
+}
+
+//RPCAddr 节点的jsonrpc和grpc地址, 只有开放rpc的网络才能访问
+func (n *Network) RPCAddr(i int) (jrpc string, grpc string) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	rpcCfg := n.cfgs[i].GetModuleConfig().RPC
+	return "http://" + rpcCfg.JrpcBindAddr + "/", rpcCfg.GrpcBindAddr
+}
+
+//Keys 网络生成的创世地址和账户私钥, 使用NewNetwork创建的网络返回nil
+func (n *Network) Keys() *NetworkKeys {
+	return n.keys
+}
+
+//WaitHeight 等待所有运行中的节点都达到指定高度
+func (n *Network) WaitHeight(height int64, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for i := 0; i < n.Len(); i++ {
+		for {
+			mock := n.Node(i)
+			if mock == nil {
+				break
+			}
+			header, err := mock.GetAPI().GetLastHeader()
+			if err != nil {
+				return err
+			}
+			if header.Height >= height {
+				break
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("node %d height %d wait %d timeout", i, header.Height, height)
+			}
+			time.Sleep(time.Second / 10)
+		}
+	}
+	return nil
+}
+
+//WaitSync 等待所有运行中的节点最新区块相同, 用于分区恢复后等待节点回滚到同一条链
+func (n *Network) WaitSync(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		var hash []byte
+		synced := true
+		for i := 0; i < n.Len() && synced; i++ {
+			mock := n.Node(i)
+			if mock == nil {
+				continue
+			}
+			header, err := mock.GetAPI().GetLastHeader()
+			if err != nil {
+				return err
+			}
+			if hash == nil {
+				hash = header.Hash
+			}
+			synced = bytes.Equal(hash, header.Hash)
+		}
+		if synced {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("wait sync timeout")
+		}
+		time.Sleep(time.Second / 10)
+	}
+}
+
+//Close 关闭所有节点并删除数据
+func (n *Network) Close() {
+	for i := 0; i < n.Len(); i++ {
+		n.StopNode(i)
+	}
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	for _, dir := range n.datadirs {
+		if dir != "" {
+			os.RemoveAll(dir)
+		}
+	}
+}
+
+// peersExcept 返回除index之外所有运行中并且链路连通的节点p2p
+func (n *Network) peersExcept(index int) []*memP2P {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	peers := make([]*memP2P, 0, len(n.p2ps))
+	for i, p := range n.p2ps {
+		if i == index || p.isStopped() || n.nodes[i] == nil || n.cuts[link(i, index)] {
+			continue
+		}
+		peers = append(peers, p)
+	}
+	return peers
+}
+
+func (n *Network) node(index int) *Chain33Mock {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.nodes[index]
+}
+
+// memP2P 内存p2p, 把广播的交易和区块转发给网络中的其他节点
+type memP2P struct {
+	network *Network
+	index   int
+	client  queue.Client
+	mu      sync.Mutex
+	stopped bool
+	// 已经收到或转发过的交易和区块, 避免重复广播
+	filter *lru.Cache
+}
+
+func newMemP2P(network *Network, index int) *memP2P {
+	filter, err := lru.New(10240)
+	if err != nil {
+		panic(err)
+	}
+	return &memP2P{network: network, index: index, filter: filter}
+}
+
+// broadcast 把hash对应的数据转发给其他节点, 每个节点只转发一次
+func (m *memP2P) broadcast(hash string, topic string, ty int64, data interface{}) {
+	if exist, _ := m.filter.ContainsOrAdd(hash, true); exist {
+		return
+	}
+	for _, peer := range m.network.peersExcept(m.index) {
+		if exist, _ := peer.filter.ContainsOrAdd(hash, true); exist {
+			continue
+		}
+		peer.deliver(topic, ty, data)
+	}
+}
+
+func (m *memP2P) pid() string {
+	return fmt.Sprintf("node%d", m.index)
+}
+
+func (m *memP2P) isStopped() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.stopped
+}
+
+func (m *memP2P) stop() {
+	m.mu.Lock()
+	m.stopped = true
+	m.mu.Unlock()
+}
+
+// deliver 向本节点的某个模块投递消息, 不等待回复
+func (m *memP2P) deliver(topic string, ty int64, data interface{}) {
+	if m.isStopped() {
+		return
+	}
+	err := m.client.Send(m.client.NewMessage(topic, ty, data), false)
+	if err != nil {
+		lognode.Error("memP2P deliver", "node", m.index, "event", types.GetEventName(int(ty)), "err", err)
+	}
+}
+
+//SetQueueClient :
+func (m *memP2P) SetQueueClient(client queue.Client) {
+	m.client = client
+	go func() {
+		p2pKey := "p2p"
+		client.Sub(p2pKey)
+		for msg := range client.Recv() {
+			switch msg.Ty {
+			case types.EventPeerInfo:
+				go func(msg *queue.Message) {
+					msg.Reply(client.NewMessage(p2pKey, types.EventPeerList, m.peerList()))
+				}(msg)
+			case types.EventGetNetInfo:
+				msg.Reply(client.NewMessage(p2pKey, types.EventPeerList, &types.NodeNetInfo{}))
+			case types.EventTxBroadcast:
+				tx := msg.GetData().(*types.Transaction)
+				m.broadcast(string(tx.Hash()), "mempool", types.EventTx, tx)
+				client.FreeMessage(msg)
+			case types.EventBlockBroadcast:
+				block := msg.GetData().(*types.Block)
+				hash := string(block.Hash(client.GetConfig()))
+				m.broadcast(hash, "blockchain", types.EventBroadcastAddBlock, &types.BlockPid{Pid: m.pid(), Block: block})
+				client.FreeMessage(msg)
+			case types.EventAddBlock, types.EventIsSync:
+				client.FreeMessage(msg)
+			case types.EventReportPeer:
+				m.network.addReport(m.index, msg.GetData().(*types.ReportPeer))
+				client.FreeMessage(msg)
+			case types.EventFetchBlocks:
+				go m.fetchBlocks(msg.GetData().(*types.ReqBlocks))
+				msg.Reply(client.NewMessage("blockchain", types.EventReply, &types.Reply{IsOk: true}))
+			case types.EventFetchBlockHeaders:
+				go m.fetchHeaders(msg.GetData().(*types.ReqBlocks))
+				msg.Reply(client.NewMessage("blockchain", types.EventReply, &types.Reply{IsOk: true}))
+			case types.EventFetchTxProof:
+				go func(msg *queue.Message) {
+					msg.Reply(client.NewMessage("blockchain", types.EventFetchTxProof, m.fetchTxProof(msg.GetData().(*types.FetchProof))))
+				}(msg)
+			case types.EventFetchStateProof:
+				go func(msg *queue.Message) {
+					msg.Reply(client.NewMessage("blockchain", types.EventFetchStateProof, m.fetchStateProof(msg.GetData().(*types.FetchProof))))
+				}(msg)
+			default:
+				msg.ReplyErr("p2p->Do not support "+types.GetEventName(int(msg.Ty)), types.ErrNotSupport)
+			}
+		}
+	}()
+}
+
+// fetchBlocks 从指定的或任意一个高度足够的节点获取区块, 按同步区块的方式交给本节点
+func (m *memP2P) fetchBlocks(req *types.ReqBlocks) {
+	for _, peer := range m.network.peersExcept(m.index) {
+		if len(req.Pid) == 1 && req.Pid[0] != peer.pid() {
+			continue
+		}
+		if m.network.fault(peer.index) == FaultStall {
+			return
+		}
+		mock := m.network.node(peer.index)
+		header, err := mock.GetAPI().GetLastHeader()
+		if err != nil || header.Height < req.End {
+			continue
+		}
+		details, err := mock.GetAPI().GetBlocks(&types.ReqBlocks{Start: req.Start, End: req.End})
+		if err != nil {
+			continue
+		}
+		for _, item := range details.Items {
+			//真实网络中区块经过序列化传输, 这里复制一份避免节点之间共享区块对象
+			block := types.Clone(item.Block).(*types.Block)
+			m.deliver("blockchain", types.EventSyncBlock, &types.BlockPid{Pid: peer.pid(), Block: block})
+		}
+		return
+	}
+}
+
+// peerList 返回其他运行中节点的最新区块头
+func (m *memP2P) peerList() *types.PeerList {
+	list := &types.PeerList{}
+	for _, peer := range m.network.peersExcept(m.index) {
+		header, err := m.network.node(peer.index).GetAPI().GetLastHeader()
+		if err != nil {
+			continue
+		}
+		list.Peers = append(list.Peers, &types.Peer{Name: peer.pid(), Header: header})
+	}
+	return list
+}
+
+// fetchHeaders 从指定的节点获取区块头, 按p2p的方式交给本节点
+func (m *memP2P) fetchHeaders(req *types.ReqBlocks) {
+	for _, peer := range m.network.peersExcept(m.index) {
+		if len(req.Pid) > 0 && req.Pid[0] != peer.pid() {
+			continue
+		}
+		mock := m.network.node(peer.index)
+		headers, err := mock.GetAPI().GetHeaders(&types.ReqBlocks{Start: req.Start, End: req.End})
+		if err != nil {
+			continue
+		}
+		if m.network.fault(peer.index) == FaultBadHeaders {
+			headers = badHeaders(mock.GetClient().GetConfig(), headers)
+		}
+		m.deliver("blockchain", types.EventAddBlockHeaders, &types.HeadersPid{Pid: peer.pid(), Headers: headers})
+		return
+	}
+}
+
+// badHeaders 修改创世区块之后区块头的难度并重新计算哈希, 保持父哈希链接, 只有按共识规则校验才能发现
+func badHeaders(cfg *types.Chain33Config, headers *types.Headers) *types.Headers {
+	bad := types.Clone(headers).(*types.Headers)
+	var parent []byte
+	for _, header := range bad.Items {
+		if header.Height > 0 {
+			if parent != nil {
+				header.ParentHash = parent
+			}
+			header.Difficulty++
+			header.Hash = header.CalcHash(cfg)
+		}
+		parent = header.Hash
+	}
+	return bad
+}
+
+// fullPeers 返回非轻节点的peer, 轻节点不提供证明
+func (m *memP2P) fullPeers() []*Chain33Mock {
+	var mocks []*Chain33Mock
+	for _, peer := range m.network.peersExcept(m.index) {
+		mock := m.network.node(peer.index)
+		if mock.GetClient().GetConfig().GetModuleConfig().BlockChain.LightMode {
+			continue
+		}
+		mocks = append(mocks, mock)
+	}
+	return mocks
+}
+
+// verifyProof 和p2p一样, 校验失败时继续向下一个节点请求
+func verifyProof(req *types.FetchProof, resp types.Message, lastErr *error) bool {
+	if req.Verify == nil {
+		return true
+	}
+	err := req.Verify(resp)
+	if err != nil {
+		*lastErr = err
+		return false
+	}
+	return true
+}
+
+func (m *memP2P) fetchTxProof(req *types.FetchProof) interface{} {
+	lastErr := types.ErrNoPeer
+	for _, mock := range m.fullPeers() {
+		detail, err := mock.GetAPI().QueryTx(req.Req.(*types.ReqHash))
+		if err == nil && verifyProof(req, detail, &lastErr) {
+			return detail
+		}
+	}
+	return lastErr
+}
+
+func (m *memP2P) fetchStateProof(req *types.FetchProof) interface{} {
+	lastErr := types.ErrNoPeer
+	for _, mock := range m.fullPeers() {
+		client := mock.GetClient()
+		msg := client.NewMessage("store", types.EventStoreGetProof, req.Req)
+		if err := client.Send(msg, true); err != nil {
+			continue
+		}
+		resp, err := client.Wait(msg)
+		if err != nil {
+			continue
+		}
+		if reply, ok := resp.GetData().(*types.StoreReplyProof); ok && verifyProof(req, reply, &lastErr) {
+			return reply
+		}
+	}
+	return lastErr
+}
+
+//Wait for ready
+func (m *memP2P) Wait() {}
+
+//Close :
+func (m *memP2P) Close() {
+	m.stop()
+}
@@ -0,0 +1,210 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testnode
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/33cn/chain33/common/record"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+//maxDiffLen 差异中返回值的最大长度
+const maxDiffLen = 1024
+
+//replaySkipMethods 提交交易的rpc方法, 同时重放mempool记录时交易已经通过mempool提交, 不再重复提交
+var replaySkipMethods = map[string]bool{
+	"Chain33.SendTransaction":     true,
+	"Chain33.SendTransactionSync": true,
+}
+
+//ReplayConfig 重放参数
+type ReplayConfig struct {
+	//重放速度的倍数, 1为按照记录的时间间隔重放, 0表示不等待
+	Speed float64
+	//重放的记录类型, 为空时重放全部
+	Kinds []record.Kind
+	//只重放不比较返回值的rpc方法, 如节点时间, 网络信息等和节点环境相关的接口
+	IgnoreMethods []string
+}
+
+//ReplayDiff 重放的处理结果和记录不一致
+type ReplayDiff struct {
+	//记录的序号
+	Index  int
+	Kind   record.Kind
+	Method string
+	Expect string
+	Actual string
+}
+
+func (d *ReplayDiff) String() string {
+	return fmt.Sprintf("#%d %s %s\n  expect: %s\n  actual: %s", d.Index, d.Kind, d.Method, d.Expect, d.Actual)
+}
+
+//ReplayReport 重放结果
+type ReplayReport struct {
+	Total    int
+	Replayed map[record.Kind]int
+	Skipped  int
+	Diffs    []*ReplayDiff
+	//重放后节点的高度
+	Height int64
+	Time   time.Duration
+}
+
+//NewReplayNode 创建用于重放的节点, 关闭p2p和挖矿, 开放随机端口的rpc服务,
+//datadir不为空时使用节点数据目录的副本作为初始状态, 关闭节点时保留
+func NewReplayNode(cfg *types.Chain33Config, datadir string) *Chain33Mock {
+	mcfg := cfg.GetModuleConfig()
+	mcfg.P2P.Enable = false
+	mcfg.Consensus.Minerstart = false
+	mcfg.RPC.JrpcBindAddr = "localhost:0"
+	mcfg.RPC.GrpcBindAddr = "localhost:0"
+	var mock *Chain33Mock
+	if datadir != "" {
+		util.ResetDatadir(mcfg, datadir)
+		mock = newWithDatadir(cfg, nil, nil, datadir)
+		mock.keepData = true
+	} else {
+		mock = newWithConfig(cfg, nil)
+	}
+	mock.Listen()
+	return mock
+}
+
+//Replay 按照记录的顺序把区块, 交易和rpc请求重放到节点并比较处理结果,
+//节点不能挖矿, 重放rpc请求之前需要调用Listen, 可以使用 NewReplayNode 创建
+func (mock *Chain33Mock) Replay(entries []*record.Entry, cfg *ReplayConfig) *ReplayReport {
+	if cfg == nil {
+		cfg = &ReplayConfig{}
+	}
+	kinds := make(map[record.Kind]bool)
+	for _, k := range cfg.Kinds {
+		kinds[k] = true
+	}
+	if len(kinds) == 0 {
+		kinds = map[record.Kind]bool{record.KindBlock: true, record.KindTx: true, record.KindRPC: true}
+	}
+	ignore := make(map[string]bool)
+	for _, m := range cfg.IgnoreMethods {
+		ignore[m] = true
+	}
+	report := &ReplayReport{Total: len(entries), Replayed: make(map[record.Kind]int)}
+	start := time.Now()
+	for i, e := range entries {
+		if !kinds[e.Kind] || (e.Kind == record.KindRPC && kinds[record.KindTx] && replaySkipMethods[e.Method]) {
+			report.Skipped++
+			continue
+		}
+		if cfg.Speed > 0 {
+			wait := time.Duration(float64(e.Time-entries[0].Time)/cfg.Speed) - time.Since(start)
+			if wait > 0 {
+				time.Sleep(wait)
+			}
+		}
+		var expect, actual string
+		switch e.Kind {
+		case record.KindBlock:
+			var exist bool
+			actual, exist = mock.replayBlock(e)
+			if exist {
+				report.Skipped++
+				continue
+			}
+			expect = e.Error
+		case record.KindTx:
+			expect, actual = e.Error, mock.replayTx(e)
+		case record.KindRPC:
+			expect, actual = mock.replayRPC(e)
+			if ignore[e.Method] {
+				expect = actual
+			}
+		default:
+			report.Skipped++
+			continue
+		}
+		report.Replayed[e.Kind]++
+		if expect != actual {
+			report.Diffs = append(report.Diffs, &ReplayDiff{Index: i, Kind: e.Kind, Method: e.Method,
+				Expect: truncate(expect), Actual: truncate(actual)})
+		}
+	}
+	report.Time = time.Since(start)
+	report.Height = mock.chain.GetBlockHeight()
+	return report
+}
+
+//replayBlock 本节点挖出的区块当作其他节点的区块处理, 节点中已经存在的区块(如创世区块)不重放
+func (mock *Chain33Mock) replayBlock(e *record.Entry) (string, bool) {
+	block := &types.Block{}
+	if err := types.Decode(e.Data, block); err != nil {
+		return err.Error(), false
+	}
+	if _, err := mock.chain.GetStore().GetBlockHeaderByHash(block.Hash(mock.client.GetConfig())); err == nil {
+		return "", true
+	}
+	pid := e.Source
+	if pid == "self" {
+		pid = "replay"
+	}
+	_, err := mock.chain.ProcAddBlockMsg(e.Method == record.MethodBroadcast, &types.BlockDetail{Block: block}, pid)
+	return errString(err), false
+}
+
+func (mock *Chain33Mock) replayTx(e *record.Entry) string {
+	tx := &types.Transaction{}
+	if err := types.Decode(e.Data, tx); err != nil {
+		return err.Error()
+	}
+	_, err := mock.api.SendTx(tx)
+	return errString(err)
+}
+
+//rpcResponse jsonrpc返回值中需要比较的部分, 忽略请求id
+type rpcResponse struct {
+	Result interface{} `json:"result"`
+	Error  interface{} `json:"error"`
+}
+
+//replayRPC 返回记录的和重放的返回值, 内容相同时返回相同的字符串
+func (mock *Chain33Mock) replayRPC(e *record.Entry) (string, string) {
+	resp, err := http.Post("http://"+mock.cfg.RPC.JrpcBindAddr+"/", "application/json", bytes.NewReader(e.Data))
+	if err != nil {
+		return string(e.Result), err.Error()
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return string(e.Result), err.Error()
+	}
+	var expect, actual rpcResponse
+	if json.Unmarshal(e.Result, &expect) == nil && json.Unmarshal(data, &actual) == nil &&
+		reflect.DeepEqual(expect, actual) {
+		return string(data), string(data)
+	}
+	return string(bytes.TrimSpace(e.Result)), string(bytes.TrimSpace(data))
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func truncate(s string) string {
+	if len(s) > maxDiffLen {
+		return s[:maxDiffLen] + "..."
+	}
+	return s
+}
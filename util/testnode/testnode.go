@@ -67,6 +67,7 @@ type Chain33Mock struct {
 	cfg      *types.Config
 	sub      *types.ConfigSubModule
 	datadir  string
+	keepData bool //关闭时保留数据目录
 	lastsend []byte
 	mu       sync.Mutex
 }
@@ -97,13 +98,26 @@ func newWithConfig(cfg *types.Chain33Config, mockapi client.QueueProtocolAPI) *C
 }
 
 func newWithConfigNoLock(cfg *types.Chain33Config, mockapi client.QueueProtocolAPI) *Chain33Mock {
+	return newWithNetwork(cfg, mockapi, nil)
+}
+
+//newWithNetwork network为nil时根据配置选择p2p模块
+func newWithNetwork(cfg *types.Chain33Config, mockapi client.QueueProtocolAPI, network queue.Module) *Chain33Mock {
+	return newWithDatadir(cfg, mockapi, network, "")
+}
+
+//newWithDatadir datadir不为空时使用已有的数据目录, 用于重启节点
+func newWithDatadir(cfg *types.Chain33Config, mockapi client.QueueProtocolAPI, network queue.Module, datadir string) *Chain33Mock {
 	mfg := cfg.GetModuleConfig()
 	sub := cfg.GetSubConfig()
 	crypto.Init(mfg.Crypto, sub.Crypto)
 	q := queue.New("channel")
 	q.SetConfig(cfg)
 	types.Debug = false
-	datadir := util.ResetDatadir(mfg, "$TEMP/")
+	restart := datadir != ""
+	if !restart {
+		datadir = util.ResetDatadir(mfg, "$TEMP/")
+	}
 	mock := &Chain33Mock{cfg: mfg, sub: sub, q: q, datadir: datadir}
 	mock.random = rand.New(rand.NewSource(types.Now().UnixNano()))
 
@@ -125,9 +139,11 @@ func newWithConfigNoLock(cfg *types.Chain33Config, mockapi client.QueueProtocolA
 
 	mock.mem = mempool.New(cfg)
 	mock.mem.SetQueueClient(q.Client())
-	mock.mem.Wait()
 	lognode.Info("init mempool")
-	if mfg.P2P.Enable {
+	if network != nil {
+		mock.network = network
+		mock.network.SetQueueClient(q.Client())
+	} else if mfg.P2P.Enable {
 		mock.network = p2p.NewP2PMgr(cfg)
 		mock.network.SetQueueClient(q.Client())
 	} else {
@@ -135,6 +151,8 @@ func newWithConfigNoLock(cfg *types.Chain33Config, mockapi client.QueueProtocolA
 		mock.network.SetQueueClient(q.Client())
 	}
 	lognode.Info("init P2P")
+	//非单节点模式下mempool需要等待区块同步完成, 必须在网络模块初始化之后等待
+	mock.mem.Wait()
 	cli := q.Client()
 	w := wallet.New(cfg)
 	mock.client = q.Client()
@@ -147,7 +165,10 @@ func newWithConfigNoLock(cfg *types.Chain33Config, mockapi client.QueueProtocolA
 		if err != nil {
 			return nil
 		}
-		newWalletRealize(mockapi)
+		//重启的节点钱包中已经导入了私钥
+		if !restart {
+			newWalletRealize(mockapi)
+		}
 	}
 	mock.api = mockapi
 	server := rpc.New(cfg)
@@ -306,6 +327,11 @@ func (mock *Chain33Mock) GetRPC() *rpc.RPC {
 	return mock.rpc
 }
 
+//GetConsensus :
+func (mock *Chain33Mock) GetConsensus() queue.Module {
+	return mock.cs
+}
+
 //GetCfg :
 func (mock *Chain33Mock) GetCfg() *types.Config {
 	return mock.cfg
@@ -322,6 +348,18 @@ func (mock *Chain33Mock) Close() {
 }
 
 func (mock *Chain33Mock) closeNoLock() {
+	mock.stop()
+	if mock.keepData {
+		return
+	}
+	err := os.RemoveAll(mock.datadir)
+	if err != nil {
+		return
+	}
+}
+
+//stop 关闭所有模块, 保留数据目录
+func (mock *Chain33Mock) stop() {
 	lognode.Info("network close")
 	mock.network.Close()
 	lognode.Info("network close")
@@ -340,10 +378,6 @@ func (mock *Chain33Mock) closeNoLock() {
 	mock.store.Close()
 	lognode.Info("store close")
 	mock.client.Close()
-	err := os.RemoveAll(mock.datadir)
-	if err != nil {
-		return
-	}
 }
 
 //WaitHeight :
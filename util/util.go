@@ -23,6 +23,7 @@ import (
 	"github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/metrics"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
 	"github.com/pkg/errors"
@@ -275,6 +276,8 @@ func ExecBlock(client queue.Client, prevStateRoot []byte, block *types.Block, er
 	if err != nil {
 		return nil, nil, err
 	}
+	metrics.UpdateTimerSince("chain33/block/exec", beg)
+	metrics.UpdateHistogram("chain33/block/txs", int64(len(block.Txs)))
 	return detail, deltx, nil
 }
 
@@ -317,7 +320,8 @@ func PreExecBlock(client queue.Client, prevStateRoot []byte, block *types.Block,
 				}
 			}
 		}
-		signOK := types.VerifySignature(config, block, unverifiedTxs)
+		//与高度相关的签名使用父区块执行后的状态校验
+		signOK := types.VerifySignatureByState(config, block, unverifiedTxs, prevStateRoot)
 		ulog.Debug("PreExecBlock", "height", block.GetHeight(), "checkCount", len(unverifiedTxs), "CheckSign", types.Since(beg))
 		if !signOK {
 			return nil, nil, types.ErrSign
@@ -7,9 +7,13 @@ package common
 import (
 	"crypto/aes"
 	"crypto/cipher"
+
+	chain33common "github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
 )
 
 // CBCEncrypterPrivkey 使用钱包的password对私钥进行aes cbc加密,返回加密后的privkey
+// Deprecated: 直接使用password作为密钥, 新加密的私钥使用EncryptPrivkey
 func CBCEncrypterPrivkey(password []byte, privkey []byte) []byte {
 	key := make([]byte, 32)
 	Encrypted := make([]byte, len(privkey))
@@ -49,3 +53,24 @@ func CBCDecrypterPrivkey(password []byte, privkey []byte) []byte {
 	decrypter.CryptBlocks(decryptered, privkey)
 	return decryptered
 }
+
+// EncryptPrivkey 使用钱包的password对私钥加密, 返回KeyStore格式的字符串, 存储在WalletAccountStore.Privkey中
+func EncryptPrivkey(password []byte, privkey []byte) (string, error) {
+	ks, err := EncryptKeyStore(password, privkey)
+	if err != nil {
+		return "", err
+	}
+	return string(ks), nil
+}
+
+// DecryptPrivkey 使用钱包的password解密存储的私钥, 兼容旧版本aes cbc加密的hex格式私钥
+func DecryptPrivkey(password []byte, stored string) ([]byte, error) {
+	if IsKeyStore([]byte(stored)) {
+		return DecryptKeyStore(password, []byte(stored))
+	}
+	privkey, err := chain33common.FromHex(stored)
+	if err != nil || len(privkey) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	return CBCDecrypterPrivkey(password, privkey), nil
+}
@@ -0,0 +1,285 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package common
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// KeyStoreVersion 钱包加密数据的格式版本
+const KeyStoreVersion = 1
+
+// 支持的密钥派生算法
+const (
+	KDFScrypt   = "scrypt"
+	KDFArgon2id = "argon2id"
+)
+
+const (
+	keyStoreCipher = "aes-256-gcm"
+	// 派生出的密钥, 前32字节用于加密, 后32字节用于计算MAC
+	derivedKeyLen = 64
+	saltLen       = 32
+
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+
+	argon2Time    = 1
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+
+	// 解密导入的数据时密钥派生参数的上限, 防止构造的参数耗尽内存和cpu
+	// scrypt占用内存为128*N*R字节, 上限256MB; argon2id的Memory单位为KB
+	maxScryptNR   = 1 << 21
+	maxScryptP    = 16
+	maxArgon2Time = 16
+	maxArgon2Mem  = 256 * 1024
+	maxArgon2Thr  = 16
+
+	// 派生密钥的缓存数量, 避免每次解密私钥都进行耗时的密钥派生
+	derivedKeyCacheSize = 64
+)
+
+var (
+	kdfLock    sync.RWMutex
+	defaultKDF = KDFScrypt
+
+	// 钱包解锁期间缓存的派生密钥, 钱包锁定时清零并清空
+	derivedKeys, _ = lru.NewWithEvict(derivedKeyCacheSize, func(key, value interface{}) {
+		wipe(value.([]byte))
+	})
+)
+
+// KDFParams 密钥派生参数, scrypt使用N/R/P, argon2id使用Time/Memory/Threads
+type KDFParams struct {
+	Salt    []byte `json:"salt"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// KeyStore 使用口令加密的数据, 口令通过密钥派生得到加密密钥以及MAC密钥
+type KeyStore struct {
+	Version    int       `json:"version"`
+	KDF        string    `json:"kdf"`
+	KDFParams  KDFParams `json:"kdfparams"`
+	Cipher     string    `json:"cipher"`
+	Nonce      []byte    `json:"nonce"`
+	CipherText []byte    `json:"ciphertext"`
+	MAC        []byte    `json:"mac"`
+}
+
+// SetDefaultKDF 设置新加密数据使用的密钥派生算法, 空字符串使用scrypt
+func SetDefaultKDF(kdf string) error {
+	if kdf == "" {
+		kdf = KDFScrypt
+	}
+	if kdf != KDFScrypt && kdf != KDFArgon2id {
+		return types.ErrNotSupport
+	}
+	kdfLock.Lock()
+	defer kdfLock.Unlock()
+	defaultKDF = kdf
+	return nil
+}
+
+func getDefaultKDF() string {
+	kdfLock.RLock()
+	defer kdfLock.RUnlock()
+	return defaultKDF
+}
+
+// KeyStoreEncrypter 使用同一个派生密钥加密多条数据, 每条数据使用随机的nonce, 批量加密时只需要一次密钥派生
+type KeyStoreEncrypter struct {
+	kdf    string
+	params KDFParams
+	key    []byte
+}
+
+// NewKeyStoreEncrypter 使用随机的salt从口令派生密钥
+func NewKeyStoreEncrypter(password []byte) (*KeyStoreEncrypter, error) {
+	kdf := getDefaultKDF()
+	params := KDFParams{Salt: crypto.CRandBytes(saltLen)}
+	if kdf == KDFArgon2id {
+		params.Time, params.Memory, params.Threads = argon2Time, argon2Memory, argon2Threads
+	} else {
+		params.N, params.R, params.P = scryptN, scryptR, scryptP
+	}
+	key, err := deriveKey(password, kdf, &params)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyStoreEncrypter{kdf: kdf, params: params, key: key}, nil
+}
+
+// Encrypt 加密数据, 返回json格式的KeyStore
+func (e *KeyStoreEncrypter) Encrypt(data []byte) ([]byte, error) {
+	aead, err := newAEAD(e.key[:32])
+	if err != nil {
+		return nil, err
+	}
+	ks := &KeyStore{
+		Version:   KeyStoreVersion,
+		KDF:       e.kdf,
+		KDFParams: e.params,
+		Cipher:    keyStoreCipher,
+		Nonce:     crypto.CRandBytes(aead.NonceSize()),
+	}
+	ks.CipherText = aead.Seal(nil, ks.Nonce, data, nil)
+	ks.MAC = ks.calcMAC(e.key[32:])
+	return json.Marshal(ks)
+}
+
+// Wipe 清零派生密钥, 之后不能再使用
+func (e *KeyStoreEncrypter) Wipe() {
+	wipe(e.key)
+}
+
+// EncryptKeyStore 使用口令加密数据
+func EncryptKeyStore(password, data []byte) ([]byte, error) {
+	e, err := NewKeyStoreEncrypter(password)
+	if err != nil {
+		return nil, err
+	}
+	return e.Encrypt(data)
+}
+
+// DecryptKeyStore 使用口令解密json格式的KeyStore, 口令错误时返回ErrInputPassword
+func DecryptKeyStore(password, data []byte) ([]byte, error) {
+	ks, ok := parseKeyStore(data)
+	if !ok {
+		return nil, types.ErrInvalidParam
+	}
+	if ks.Cipher != keyStoreCipher {
+		return nil, types.ErrNotSupport
+	}
+	key, err := deriveKey(password, ks.KDF, &ks.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(key)
+	if !hmac.Equal(ks.MAC, ks.calcMAC(key[32:])) {
+		return nil, types.ErrInputPassword
+	}
+	aead, err := newAEAD(key[:32])
+	if err != nil {
+		return nil, err
+	}
+	if len(ks.Nonce) != aead.NonceSize() {
+		return nil, types.ErrInvalidParam
+	}
+	return aead.Open(nil, ks.Nonce, ks.CipherText, nil)
+}
+
+// IsKeyStore 是否为KeyStore格式的加密数据, 否则为旧版本直接使用口令加密的数据
+func IsKeyStore(data []byte) bool {
+	_, ok := parseKeyStore(data)
+	return ok
+}
+
+func parseKeyStore(data []byte) (*KeyStore, bool) {
+	if len(data) == 0 || data[0] != '{' {
+		return nil, false
+	}
+	var ks KeyStore
+	err := json.Unmarshal(data, &ks)
+	if err != nil || ks.Version < KeyStoreVersion || ks.KDF == "" {
+		return nil, false
+	}
+	return &ks, true
+}
+
+// calcMAC 对加密参数以及密文计算MAC, 用于校验口令以及数据完整性
+func (ks *KeyStore) calcMAC(macKey []byte) []byte {
+	mac := hmac.New(sha256.New, macKey)
+	fmt.Fprintf(mac, "%d:%s:%s:", ks.Version, ks.KDF, ks.Cipher)
+	mac.Write(ks.Nonce)
+	mac.Write(ks.CipherText)
+	return mac.Sum(nil)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// PurgeDerivedKeys 清零并清空缓存的派生密钥, 钱包锁定时调用
+func PurgeDerivedKeys() {
+	derivedKeys.Purge()
+}
+
+func wipe(key []byte) {
+	for i := range key {
+		key[i] = 0
+	}
+}
+
+// checkKDFParams 检查密钥派生参数不超过上限, 导入的数据中参数不可信
+func checkKDFParams(kdf string, params *KDFParams) error {
+	if len(params.Salt) == 0 {
+		return types.ErrInvalidParam
+	}
+	switch kdf {
+	case KDFScrypt:
+		if params.N <= 1 || params.R <= 0 || params.P <= 0 ||
+			params.N > maxScryptNR/params.R || params.P > maxScryptP {
+			return types.ErrInvalidParam
+		}
+	case KDFArgon2id:
+		if params.Time == 0 || params.Memory == 0 || params.Threads == 0 ||
+			params.Time > maxArgon2Time || params.Memory > maxArgon2Mem || params.Threads > maxArgon2Thr {
+			return types.ErrInvalidParam
+		}
+	default:
+		return types.ErrNotSupport
+	}
+	return nil
+}
+
+func deriveKey(password []byte, kdf string, params *KDFParams) ([]byte, error) {
+	err := checkKDFParams(kdf, params)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%s:%x:%d:%d:%d:%d:%d:%d:", kdf, params.Salt, params.N, params.R, params.P, params.Time, params.Memory, params.Threads)
+	h.Write(password)
+	cacheKey := string(h.Sum(nil))
+	if key, ok := derivedKeys.Get(cacheKey); ok {
+		return append([]byte(nil), key.([]byte)...), nil
+	}
+
+	var key []byte
+	if kdf == KDFScrypt {
+		key, err = scrypt.Key(password, params.Salt, params.N, params.R, params.P, derivedKeyLen)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		key = argon2.IDKey(password, params.Salt, params.Time, params.Memory, params.Threads, derivedKeyLen)
+	}
+	//缓存中的密钥在淘汰或者清空时会被清零, 返回副本
+	derivedKeys.Add(cacheKey, key)
+	return append([]byte(nil), key...), nil
+}
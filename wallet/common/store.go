@@ -11,7 +11,6 @@ import (
 	"fmt"
 	"sync"
 
-	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/common/version"
@@ -286,34 +285,28 @@ func (store *Store) GetEncryptionFlag() int64 {
 	return flag
 }
 
-// SetPasswordHash 保存密码哈希
+// SetPasswordHash 使用KeyStore格式保存钱包密码的校验数据, 密码经过密钥派生后计算MAC
 func (store *Store) SetPasswordHash(password string, batch db.Batch) error {
-	var WalletPwHash types.WalletPwHash
-	//获取一个随机字符串
-	randstr := fmt.Sprintf("fuzamei:$@%s", crypto.CRandHex(16))
-	WalletPwHash.Randstr = randstr
-
-	//通过password和随机字符串生成一个hash值
-	pwhashstr := fmt.Sprintf("%s:%s", password, WalletPwHash.Randstr)
-	pwhash := sha256.Sum256([]byte(pwhashstr))
-	WalletPwHash.PwHash = pwhash[:]
-
-	pwhashbytes, err := json.Marshal(WalletPwHash)
+	pwhashbytes, err := EncryptKeyStore([]byte(password), nil)
 	if err != nil {
-		storelog.Error("SetEncryptionFlag marshal flag", "err", err)
-		return types.ErrMarshal
+		storelog.Error("SetPasswordHash encrypt", "err", err)
+		return err
 	}
 	batch.Set(CalcPasswordHash(), pwhashbytes)
 	return nil
 }
 
-// VerifyPasswordHash 检查密码有效性
+// VerifyPasswordHash 检查密码有效性, 兼容旧版本的sha256密码哈希
 func (store *Store) VerifyPasswordHash(password string) bool {
-	var WalletPwHash types.WalletPwHash
 	pwhashbytes, err := store.Get(CalcPasswordHash())
 	if pwhashbytes == nil || err != nil {
 		return false
 	}
+	if IsKeyStore(pwhashbytes) {
+		_, err = DecryptKeyStore([]byte(password), pwhashbytes)
+		return err == nil
+	}
+	var WalletPwHash types.WalletPwHash
 	err = json.Unmarshal(pwhashbytes, &WalletPwHash)
 	if err != nil {
 		storelog.Error("VerifyPasswordHash unmarshal", "err", err)
@@ -326,6 +319,15 @@ func (store *Store) VerifyPasswordHash(password string) bool {
 	return bytes.Equal(WalletPwHash.GetPwHash(), Pwhash)
 }
 
+// IsLegacyPasswordHash 密码校验数据是否为旧版本格式, 需要在解锁时升级
+func (store *Store) IsLegacyPasswordHash() bool {
+	pwhashbytes, err := store.Get(CalcPasswordHash())
+	if pwhashbytes == nil || err != nil {
+		return false
+	}
+	return !IsKeyStore(pwhashbytes)
+}
+
 // DelAccountByLabel 根据标签名称，删除对应的账号信息
 func (store *Store) DelAccountByLabel(label string) {
 	err := store.GetDB().DeleteSync(CalcLabelKey(label))
@@ -4,11 +4,24 @@
 
 package wallet
 
+import "fmt"
+
 const (
 	keyWalletPassKey = "WalletPassKey"
+	keyWatchOnly     = "WatchOnly"
 )
 
 // CalcWalletPassKey 获取钱包密码的数据库字段Key值
 func CalcWalletPassKey() []byte {
 	return []byte(keyWalletPassKey)
 }
+
+// CalcWatchOnlyKey 只读账户的数据库字段Key值
+func CalcWatchOnlyKey(addr string) []byte {
+	return []byte(fmt.Sprintf("%s:%s", keyWatchOnly, addr))
+}
+
+// CalcWatchOnlyPrefix 只读账户的数据库字段前缀
+func CalcWatchOnlyPrefix() []byte {
+	return []byte(keyWatchOnly + ":")
+}
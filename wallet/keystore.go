@@ -0,0 +1,123 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+)
+
+// encryptPrivkey 使用钱包当前的password加密私钥, 同一个password复用派生密钥
+func (wallet *Wallet) encryptPrivkey(privkey []byte) (string, error) {
+	wallet.encLock.Lock()
+	defer wallet.encLock.Unlock()
+	if wallet.encrypter == nil || wallet.encrypterPass != wallet.Password {
+		encrypter, err := wcom.NewKeyStoreEncrypter([]byte(wallet.Password))
+		if err != nil {
+			return "", err
+		}
+		if wallet.encrypter != nil {
+			wallet.encrypter.Wipe()
+		}
+		wallet.encrypter = encrypter
+		wallet.encrypterPass = wallet.Password
+	}
+	data, err := wallet.encrypter.Encrypt(privkey)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// resetEncrypter 钱包密码变更或者锁定后清零并丢弃缓存的加密器
+func (wallet *Wallet) resetEncrypter() {
+	wallet.encLock.Lock()
+	defer wallet.encLock.Unlock()
+	if wallet.encrypter != nil {
+		wallet.encrypter.Wipe()
+	}
+	wallet.encrypter = nil
+	wallet.encrypterPass = ""
+}
+
+// wipeKeys 钱包锁定时清零内存中的派生密钥
+func (wallet *Wallet) wipeKeys() {
+	wallet.resetEncrypter()
+	wcom.PurgeDerivedKeys()
+}
+
+// upgradeKeyStore 将旧版本直接使用密码加密的私钥, 种子以及密码hash升级为KeyStore格式, 调用者需要持有wallet.mtx
+func (wallet *Wallet) upgradeKeyStore(password string) error {
+	accStores, err := wallet.walletStore.GetAccountByPrefix("Account")
+	if err != nil && err != types.ErrAccountNotExist {
+		return err
+	}
+	var legacy []*types.WalletAccountStore
+	for _, accStore := range accStores {
+		if !wcom.IsKeyStore([]byte(accStore.GetPrivkey())) {
+			legacy = append(legacy, accStore)
+		}
+	}
+	legacySeed := IsLegacySeed(wallet.walletStore.GetDB())
+	legacyHash := wallet.walletStore.IsLegacyPasswordHash()
+	if len(legacy) == 0 && !legacySeed && !legacyHash {
+		return nil
+	}
+
+	batch := wallet.walletStore.NewBatch(true)
+	if len(legacy) > 0 {
+		encrypter, err := wcom.NewKeyStoreEncrypter([]byte(password))
+		if err != nil {
+			return err
+		}
+		defer encrypter.Wipe()
+		for _, accStore := range legacy {
+			privkey, err := wcom.DecryptPrivkey([]byte(password), accStore.GetPrivkey())
+			if err != nil {
+				walletlog.Error("upgradeKeyStore", "addr", accStore.Addr, "DecryptPrivkey err", err)
+				return err
+			}
+			data, err := encrypter.Encrypt(privkey)
+			if err != nil {
+				return err
+			}
+			accStore.Privkey = string(data)
+			err = wallet.walletStore.SetWalletAccountInBatch(true, accStore.Addr, accStore, batch)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if legacySeed {
+		seed, err := GetSeed(wallet.walletStore.GetDB(), password)
+		if err != nil {
+			return err
+		}
+		_, err = SaveSeedInBatch(wallet.walletStore.GetDB(), seed, password, batch)
+		if err != nil {
+			return err
+		}
+	}
+	if legacyHash {
+		err = wallet.walletStore.SetPasswordHash(password, batch)
+		if err != nil {
+			return err
+		}
+	}
+	err = batch.Write()
+	if err != nil {
+		return err
+	}
+	walletlog.Info("upgradeKeyStore", "accounts", len(legacy), "seed", legacySeed, "passwordHash", legacyHash)
+	return nil
+}
+
+// decryptPrivkeysFileContent 解密导出文件中的一条私钥, 兼容旧版本直接使用密码加密的格式
+func decryptPrivkeysFileContent(password, content []byte) ([]byte, error) {
+	if wcom.IsKeyStore(content) {
+		return wcom.DecryptKeyStore(password, content)
+	}
+	return AesgcmDecrypter(password, content)
+}
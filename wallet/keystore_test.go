@@ -0,0 +1,124 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	wcom "github.com/33cn/chain33/wallet/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyStore(t *testing.T) {
+	defer wcom.SetDefaultKDF(wcom.KDFScrypt)
+	data := []byte("test keystore data")
+	for _, kdf := range []string{wcom.KDFScrypt, wcom.KDFArgon2id} {
+		require.Nil(t, wcom.SetDefaultKDF(kdf))
+		ks1, err := wcom.EncryptKeyStore([]byte("password123"), data)
+		require.Nil(t, err)
+		ks2, err := wcom.EncryptKeyStore([]byte("password123"), data)
+		require.Nil(t, err)
+		//随机salt以及nonce, 相同数据加密结果不同
+		assert.NotEqual(t, ks1, ks2)
+		assert.True(t, wcom.IsKeyStore(ks1))
+
+		plain, err := wcom.DecryptKeyStore([]byte("password123"), ks1)
+		require.Nil(t, err)
+		assert.Equal(t, data, plain)
+		_, err = wcom.DecryptKeyStore([]byte("wrongpassword"), ks1)
+		assert.Equal(t, types.ErrInputPassword, err)
+	}
+	assert.Equal(t, types.ErrNotSupport, wcom.SetDefaultKDF("md5"))
+
+	//导入数据中的派生参数超过上限时拒绝派生密钥
+	ks, err := wcom.EncryptKeyStore([]byte("password123"), data)
+	require.Nil(t, err)
+	var store wcom.KeyStore
+	require.Nil(t, json.Unmarshal(ks, &store))
+	for _, params := range []wcom.KDFParams{
+		{Salt: store.KDFParams.Salt, N: 1 << 30, R: 8, P: 1},
+		{Salt: store.KDFParams.Salt, N: 1 << 15, R: 1 << 10, P: 1},
+		{Salt: store.KDFParams.Salt, N: 1 << 15, R: 8, P: 1 << 20},
+	} {
+		store.KDFParams = params
+		bad, err := json.Marshal(&store)
+		require.Nil(t, err)
+		_, err = wcom.DecryptKeyStore([]byte("password123"), bad)
+		assert.Equal(t, types.ErrInvalidParam, err)
+	}
+	store.KDF = wcom.KDFArgon2id
+	store.KDFParams = wcom.KDFParams{Salt: store.KDFParams.Salt, Time: 1, Memory: 1 << 30, Threads: 4}
+	bad, err := json.Marshal(&store)
+	require.Nil(t, err)
+	_, err = wcom.DecryptKeyStore([]byte("password123"), bad)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	//锁定钱包清空缓存的派生密钥后重新派生
+	wcom.PurgeDerivedKeys()
+	plain, err := wcom.DecryptKeyStore([]byte("password123"), ks)
+	require.Nil(t, err)
+	assert.Equal(t, data, plain)
+
+	//旧版本私钥格式兼容
+	_, priv := util.Genaddress()
+	legacy := common.ToHex(wcom.CBCEncrypterPrivkey([]byte("password123"), priv.Bytes()))
+	assert.False(t, wcom.IsKeyStore([]byte(legacy)))
+	privkey, err := wcom.DecryptPrivkey([]byte("password123"), legacy)
+	require.Nil(t, err)
+	assert.Equal(t, priv.Bytes(), privkey)
+}
+
+//旧版本加密的私钥, 种子以及密码hash在解锁时升级
+func testKeyStoreUpgrade(t *testing.T, wallet *Wallet) {
+	println("testKeyStoreUpgrade begin")
+	password := wallet.Password
+	addr, priv := util.Genaddress()
+	bpriv := wcom.CBCEncrypterPrivkey([]byte(password), priv.Bytes())
+	was := &types.WalletAccountStore{Privkey: common.ToHex(bpriv), Label: "legacy-keystore", Addr: addr, TimeStamp: time.Now().String()}
+	require.Nil(t, wallet.walletStore.SetWalletAccount(false, addr, was))
+
+	seed, err := GetSeed(wallet.walletStore.GetDB(), password)
+	require.Nil(t, err)
+	encryptedSeed, err := AesgcmEncrypter([]byte(password), []byte(seed))
+	require.Nil(t, err)
+	require.Nil(t, wallet.walletStore.Set(WalletSeed, encryptedSeed))
+
+	randstr := "legacy"
+	pwhash := sha256.Sum256([]byte(fmt.Sprintf("%s:%s", password, randstr)))
+	pwhashbytes, err := json.Marshal(&types.WalletPwHash{PwHash: pwhash[:], Randstr: randstr})
+	require.Nil(t, err)
+	require.Nil(t, wallet.walletStore.Set(wcom.CalcPasswordHash(), pwhashbytes))
+	assert.True(t, IsLegacySeed(wallet.walletStore.GetDB()))
+	assert.True(t, wallet.walletStore.IsLegacyPasswordHash())
+
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletLock", &types.ReqNil{})
+	require.Nil(t, err)
+	wallet.Password = ""
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletUnLock", &types.WalletUnLock{Passwd: password})
+	require.Nil(t, err)
+
+	was, err = wallet.walletStore.GetAccountByAddr(addr)
+	require.Nil(t, err)
+	assert.True(t, wcom.IsKeyStore([]byte(was.Privkey)))
+	assert.False(t, IsLegacySeed(wallet.walletStore.GetDB()))
+	assert.False(t, wallet.walletStore.IsLegacyPasswordHash())
+	assert.True(t, wallet.walletStore.VerifyPasswordHash(password))
+	seed2, err := GetSeed(wallet.walletStore.GetDB(), password)
+	require.Nil(t, err)
+	assert.Equal(t, seed, seed2)
+	priv2, err := wallet.GetPrivKeyByAddr(addr)
+	require.Nil(t, err)
+	assert.Equal(t, priv.Bytes(), priv2.Bytes())
+	println("testKeyStoreUpgrade end")
+	println("--------------------------")
+}
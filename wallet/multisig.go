@@ -0,0 +1,61 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+// ProcGetMultiSigAccounts 获取钱包账户(包括只读账户)作为所有者参与的多重签名账户及其详情
+func (wallet *Wallet) ProcGetMultiSigAccounts() (*mty.ReplyMultiSigAccounts, error) {
+	owners, err := wallet.getMultiSigOwners()
+	if err != nil {
+		return nil, err
+	}
+	reply := &mty.ReplyMultiSigAccounts{}
+	exist := make(map[string]bool)
+	for _, owner := range owners {
+		msg, err := wallet.api.Query(mty.MultiSigX, "GetOwnerAccounts", &types.ReqString{Data: owner})
+		if err != nil {
+			walletlog.Error("ProcGetMultiSigAccounts", "owner", owner, "Query err", err)
+			return nil, err
+		}
+		for _, addr := range msg.(*mty.ReplyMultiSigAccounts).GetAddrs() {
+			if exist[addr] {
+				continue
+			}
+			exist[addr] = true
+			acc, err := wallet.api.Query(mty.MultiSigX, "GetAccount", &types.ReqString{Data: addr})
+			if err != nil {
+				walletlog.Error("ProcGetMultiSigAccounts", "addr", addr, "GetAccount err", err)
+				return nil, err
+			}
+			reply.Addrs = append(reply.Addrs, addr)
+			reply.Accounts = append(reply.Accounts, acc.(*mty.MultiSigAccount))
+		}
+	}
+	return reply, nil
+}
+
+// getMultiSigOwners 钱包中可能作为多重签名账户所有者的地址
+func (wallet *Wallet) getMultiSigOwners() ([]string, error) {
+	accStores, err := wallet.walletStore.GetAccountByPrefix("Account")
+	if err != nil && err != types.ErrAccountNotExist {
+		return nil, err
+	}
+	var owners []string
+	for _, accStore := range accStores {
+		owners = append(owners, accStore.Addr)
+	}
+	watchOnly, err := wallet.walletStore.GetWatchOnlyAccounts()
+	if err != nil {
+		return nil, err
+	}
+	for _, acc := range watchOnly {
+		owners = append(owners, acc.Addr)
+	}
+	return owners, nil
+}
@@ -17,6 +17,7 @@ import (
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/wallet/bipwallet"
+	wcom "github.com/33cn/chain33/wallet/common"
 )
 
 var (
@@ -84,9 +85,9 @@ func SaveSeedInBatch(db dbm.DB, seed string, password string, batch dbm.Batch) (
 		return false, types.ErrInvalidParam
 	}
 
-	Encrypted, err := AesgcmEncrypter([]byte(password), []byte(seed))
+	Encrypted, err := wcom.EncryptKeyStore([]byte(password), []byte(seed))
 	if err != nil {
-		seedlog.Error("SaveSeed", "AesgcmEncrypter err", err)
+		seedlog.Error("SaveSeed", "EncryptKeyStore err", err)
 		return false, err
 	}
 	batch.Set(WalletSeed, Encrypted)
@@ -106,14 +107,28 @@ func GetSeed(db dbm.DB, password string) (string, error) {
 	if len(Encryptedseed) == 0 {
 		return "", types.ErrSeedNotExist
 	}
-	seed, err := AesgcmDecrypter([]byte(password), Encryptedseed)
+	var seed []byte
+	if wcom.IsKeyStore(Encryptedseed) {
+		seed, err = wcom.DecryptKeyStore([]byte(password), Encryptedseed)
+	} else {
+		seed, err = AesgcmDecrypter([]byte(password), Encryptedseed)
+	}
 	if err != nil {
-		seedlog.Error("GetSeed", "AesgcmDecrypter err", err)
+		seedlog.Error("GetSeed", "decrypt err", err)
 		return "", types.ErrInputPassword
 	}
 	return string(seed), nil
 }
 
+//IsLegacySeed 数据库中的seed是否为旧版本直接使用密码加密的格式
+func IsLegacySeed(db dbm.DB) bool {
+	Encryptedseed, err := db.Get(WalletSeed)
+	if err != nil || len(Encryptedseed) == 0 {
+		return false
+	}
+	return !wcom.IsKeyStore(Encryptedseed)
+}
+
 //GetPrivkeyBySeed 通过seed生成子私钥十六进制字符串
 func GetPrivkeyBySeed(db dbm.DB, seed string, specificIndex uint32, SignType int, coinType uint32) (string, error) {
 	var backupindex uint32
@@ -117,7 +117,7 @@ func (wallet *Wallet) sendTransaction(payload types.Message, execer []byte, priv
 		to = address.ExecAddress(string(execer))
 	}
 	tx := &types.Transaction{Execer: execer, Payload: types.Encode(payload), Fee: wallet.minFee, To: to}
-	tx.Nonce = rand.Int63()
+	tx.Nonce = types.RandNonce()
 	tx.ChainID = wallet.client.GetConfig().GetChainID()
 
 	proper, err := wallet.api.GetProperFee(nil)
@@ -209,7 +209,7 @@ func (wallet *Wallet) SendToAddress(priv crypto.PrivKey, addrto string, amount i
 	return wallet.sendToAddress(priv, addrto, amount, note, Istoken, tokenSymbol)
 }
 
-func (wallet *Wallet) createSendToAddress(addrto string, amount int64, note string, Istoken bool, tokenSymbol string) (*types.Transaction, error) {
+func (wallet *Wallet) createSendToAddress(addrto string, amount int64, note string, Istoken bool, tokenSymbol string, expire time.Duration) (*types.Transaction, error) {
 	var tx *types.Transaction
 	var isWithdraw = false
 	if amount < 0 {
@@ -240,7 +240,7 @@ func (wallet *Wallet) createSendToAddress(addrto string, amount int64, note stri
 		return nil, err
 	}
 	cfg := wallet.client.GetConfig()
-	tx.SetExpire(cfg, time.Second*120)
+	tx.SetExpire(cfg, expire)
 	proper, err := wallet.api.GetProperFee(nil)
 	if err != nil {
 		return nil, err
@@ -262,14 +262,14 @@ func (wallet *Wallet) createSendToAddress(addrto string, amount int64, note stri
 		tx.To = address.ExecAddress(string(tx.Execer))
 	}
 
-	tx.Nonce = rand.Int63()
+	tx.Nonce = types.RandNonce()
 	tx.ChainID = cfg.GetChainID()
 
 	return tx, nil
 }
 
 func (wallet *Wallet) sendToAddress(priv crypto.PrivKey, addrto string, amount int64, note string, Istoken bool, tokenSymbol string) (*types.ReplyHash, error) {
-	tx, err := wallet.createSendToAddress(addrto, amount, note, Istoken, tokenSymbol)
+	tx, err := wallet.createSendToAddress(addrto, amount, note, Istoken, tokenSymbol, time.Second*120)
 	if err != nil {
 		return nil, err
 	}
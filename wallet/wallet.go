@@ -15,7 +15,6 @@ import (
 
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client"
-	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 	dbm "github.com/33cn/chain33/common/db"
@@ -79,6 +78,11 @@ type Wallet struct {
 	minFee      int64
 	accountdb   *account.DB
 	accTokenMap map[string]*account.DB
+
+	// 当前钱包密码对应的私钥加密器, 复用派生密钥避免每个私钥都进行密钥派生
+	encLock       sync.Mutex
+	encrypter     *wcom.KeyStoreEncrypter
+	encrypterPass string
 }
 
 // SetLogLevel 设置日志登记
@@ -105,6 +109,10 @@ func New(cfg *types.Chain33Config) *Wallet {
 	if signType <= 0 {
 		signType = types.SECP256K1
 	}
+	err := wcom.SetDefaultKDF(mcfg.KeyStoreKDF)
+	if err != nil {
+		panic("wallet keyStoreKDF not support: " + mcfg.KeyStoreKDF)
+	}
 
 	wallet := &Wallet{
 		walletStore:      walletStore,
@@ -189,7 +197,7 @@ func (wallet *Wallet) GetPassword() string {
 
 // Nonce 获取随机值
 func (wallet *Wallet) Nonce() int64 {
-	return wallet.random.Int63()
+	return types.RandNonce()
 }
 
 // AddWaitGroup 添加一个分组等待事件
@@ -334,13 +342,11 @@ func (wallet *Wallet) getPrivKeyByAddr(addr string) (crypto.PrivKey, error) {
 	}
 
 	//通过password解密存储的私钥
-	prikeybyte, err := common.FromHex(Accountstor.GetPrivkey())
-	if err != nil || len(prikeybyte) == 0 {
-		walletlog.Error("getPrivKeyByAddr", "FromHex err", err)
+	privkey, err := wcom.DecryptPrivkey([]byte(wallet.Password), Accountstor.GetPrivkey())
+	if err != nil {
+		walletlog.Error("getPrivKeyByAddr", "DecryptPrivkey err", err)
 		return nil, err
 	}
-
-	privkey := wcom.CBCDecrypterPrivkey([]byte(wallet.Password), prikeybyte)
 	//通过privkey生成一个pubkey然后换算成对应的addr
 	cr, err := crypto.New(types.GetSignName("", wallet.SignType), crypto.WithNewOptionEnableCheck(wallet.lastHeader.GetHeight()))
 	if err != nil {
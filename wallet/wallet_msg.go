@@ -332,3 +332,62 @@ func (wallet *Wallet) On_ImportPrivkeysFile(req *types.ReqPrivkeysFile) (types.M
 	}
 	return reply, err
 }
+
+// On_WalletImportWatchOnly 响应导入只读账户
+func (wallet *Wallet) On_WalletImportWatchOnly(req *types.ReqWalletImportWatchOnly) (types.Message, error) {
+	reply, err := wallet.ProcImportWatchOnly(req)
+	if err != nil {
+		walletlog.Error("ProcImportWatchOnly", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_WalletWatchOnlyList 响应获取只读账户列表
+func (wallet *Wallet) On_WalletWatchOnlyList(req *types.ReqNil) (types.Message, error) {
+	reply, err := wallet.ProcGetWatchOnlyList()
+	if err != nil {
+		walletlog.Error("ProcGetWatchOnlyList", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_WalletDelWatchOnly 响应删除只读账户
+func (wallet *Wallet) On_WalletDelWatchOnly(req *types.ReqString) (types.Message, error) {
+	reply := &types.Reply{
+		IsOk: true,
+	}
+	err := wallet.ProcDelWatchOnly(req.GetData())
+	if err != nil {
+		walletlog.Error("ProcDelWatchOnly", "err", err.Error())
+		reply.IsOk = false
+		reply.Msg = []byte(err.Error())
+	}
+	return reply, err
+}
+
+// On_WalletCreateUnsignedTx 响应构造只读账户的未签名交易
+func (wallet *Wallet) On_WalletCreateUnsignedTx(req *types.ReqWalletSendToAddress) (types.Message, error) {
+	reply, err := wallet.ProcCreateUnsignedTx(req)
+	if err != nil {
+		walletlog.Error("ProcCreateUnsignedTx", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_WalletSendSignedTx 响应发送离线签名的交易
+func (wallet *Wallet) On_WalletSendSignedTx(req *types.ReqWalletSendSignedTx) (types.Message, error) {
+	reply, err := wallet.ProcSendSignedTx(req)
+	if err != nil {
+		walletlog.Error("ProcSendSignedTx", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_WalletMultiSigAccounts 响应获取钱包账户所属的多重签名账户
+func (wallet *Wallet) On_WalletMultiSigAccounts(req *types.ReqNil) (types.Message, error) {
+	reply, err := wallet.ProcGetMultiSigAccounts()
+	if err != nil {
+		walletlog.Error("ProcGetMultiSigAccounts", "err", err.Error())
+	}
+	return reply, err
+}
@@ -5,6 +5,7 @@
 package wallet
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"io/ioutil"
@@ -337,9 +338,12 @@ func (wallet *Wallet) ProcCreateNewAccount(Label *types.ReqNewAccount) (*types.W
 	walletAccount.Acc = &Account
 	walletAccount.Label = Label.GetLabel()
 
-	//使用钱包的password对私钥加密 aes cbc
-	Encrypted := wcom.CBCEncrypterPrivkey([]byte(wallet.Password), privkeybyte)
-	WalletAccStore.Privkey = common.ToHex(Encrypted)
+	//使用钱包的password对私钥加密
+	WalletAccStore.Privkey, err = wallet.encryptPrivkey(privkeybyte)
+	if err != nil {
+		walletlog.Error("ProcCreateNewAccount", "encryptPrivkey err", err)
+		return nil, err
+	}
 	WalletAccStore.Label = Label.GetLabel()
 	WalletAccStore.Addr = addr
 
@@ -468,13 +472,11 @@ func (wallet *Wallet) procImportPrivKey(PrivKey *types.ReqWalletImportPrivkey) (
 		return nil, types.ErrPrivkeyToPub
 	}
 
-	//对私钥加密
-	Encryptered := wcom.CBCEncrypterPrivkey([]byte(wallet.Password), privkeybyte)
-	Encrypteredstr := common.ToHex(Encryptered)
-	//校验PrivKey对应的addr是否已经存在钱包中
+	//校验PrivKey对应的addr是否已经存在钱包中, 加密数据使用随机salt, 需要解密后比较
 	Account, err = wallet.walletStore.GetAccountByAddr(addr)
 	if Account != nil && err == nil {
-		if Account.Privkey == Encrypteredstr {
+		stored, err := wcom.DecryptPrivkey([]byte(wallet.Password), Account.Privkey)
+		if err == nil && bytes.Equal(stored, privkeybyte) {
 			walletlog.Error("ProcImportPrivKey Privkey is exist in wallet!")
 			return nil, types.ErrPrivkeyExist
 		}
@@ -482,6 +484,12 @@ func (wallet *Wallet) procImportPrivKey(PrivKey *types.ReqWalletImportPrivkey) (
 		return nil, types.ErrPrivkey
 	}
 
+	//对私钥加密
+	Encrypteredstr, err := wallet.encryptPrivkey(privkeybyte)
+	if err != nil {
+		walletlog.Error("ProcImportPrivKey", "encryptPrivkey err", err)
+		return nil, err
+	}
 	var walletaccount types.WalletAccount
 	var WalletAccStore types.WalletAccountStore
 	WalletAccStore.Privkey = Encrypteredstr //存储加密后的私钥
@@ -544,56 +552,63 @@ func (wallet *Wallet) ProcSendToAddress(SendToAddress *types.ReqWalletSendToAddr
 		return nil, err
 	}
 
-	//获取from账户的余额从account模块，校验余额是否充足
+	err = wallet.checkSendBalance(SendToAddress)
+	if err != nil {
+		return nil, err
+	}
+	addrto := SendToAddress.GetTo()
+	note := SendToAddress.GetNote()
+	amount := SendToAddress.GetAmount()
+	priv, err := wallet.getPrivKeyByAddr(SendToAddress.GetFrom())
+	if err != nil {
+		return nil, err
+	}
+	return wallet.sendToAddress(priv, addrto, amount, note, SendToAddress.IsToken, SendToAddress.TokenSymbol)
+}
+
+//checkSendBalance 从account模块获取from账户的余额，校验余额是否充足
+func (wallet *Wallet) checkSendBalance(SendToAddress *types.ReqWalletSendToAddress) error {
 	addrs := make([]string, 1)
 	addrs[0] = SendToAddress.GetFrom()
-	var accounts []*types.Account
-	var tokenAccounts []*types.Account
-	accounts, err = wallet.accountdb.LoadAccounts(wallet.api, addrs)
+	accounts, err := wallet.accountdb.LoadAccounts(wallet.api, addrs)
 	if err != nil || len(accounts) == 0 {
 		walletlog.Error("ProcSendToAddress", "LoadAccounts err", err)
-		return nil, err
+		return err
 	}
 	Balance := accounts[0].Balance
 	amount := SendToAddress.GetAmount()
 	//amount必须大于等于0
 	if amount < 0 {
-		return nil, types.ErrAmount
+		return types.ErrAmount
 	}
 	if !SendToAddress.IsToken {
 		if Balance-amount < wallet.FeeAmount {
-			return nil, types.ErrInsufficientBalance
+			return types.ErrInsufficientBalance
 		}
 	} else {
 		//如果是token转账，一方面需要保证coin的余额满足fee，另一方面则需要保证token的余额满足转账操作
 		if Balance < wallet.FeeAmount {
-			return nil, types.ErrInsufficientBalance
+			return types.ErrInsufficientBalance
 		}
 		if nil == wallet.accTokenMap[SendToAddress.TokenSymbol] {
 			tokenAccDB, err := account.NewAccountDB(wallet.api.GetConfig(), "token", SendToAddress.TokenSymbol, nil)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			wallet.accTokenMap[SendToAddress.TokenSymbol] = tokenAccDB
 		}
 		tokenAccDB := wallet.accTokenMap[SendToAddress.TokenSymbol]
-		tokenAccounts, err = tokenAccDB.LoadAccounts(wallet.api, addrs)
+		tokenAccounts, err := tokenAccDB.LoadAccounts(wallet.api, addrs)
 		if err != nil || len(tokenAccounts) == 0 {
 			walletlog.Error("ProcSendToAddress", "Load Token Accounts err", err)
-			return nil, err
+			return err
 		}
 		tokenBalance := tokenAccounts[0].Balance
 		if tokenBalance < amount {
-			return nil, types.ErrInsufficientTokenBal
+			return types.ErrInsufficientTokenBal
 		}
 	}
-	addrto := SendToAddress.GetTo()
-	note := SendToAddress.GetNote()
-	priv, err := wallet.getPrivKeyByAddr(addrs[0])
-	if err != nil {
-		return nil, err
-	}
-	return wallet.sendToAddress(priv, addrto, amount, note, SendToAddress.IsToken, SendToAddress.TokenSymbol)
+	return nil
 }
 
 // ProcWalletSetFee 处理设置手续费
@@ -729,13 +744,11 @@ func (wallet *Wallet) ProcMergeBalance(MergeBalance *types.ReqWalletMergeBalance
 	for index, Account := range accounts {
 		Privkey := WalletAccStores[index].Privkey
 		//解密存储的私钥
-		prikeybyte, err := common.FromHex(Privkey)
-		if err != nil || len(prikeybyte) == 0 {
-			walletlog.Error("ProcMergeBalance", "FromHex err", err, "index", index)
+		privkey, err := wcom.DecryptPrivkey([]byte(wallet.Password), Privkey)
+		if err != nil {
+			walletlog.Error("ProcMergeBalance", "DecryptPrivkey err", err, "index", index)
 			continue
 		}
-
-		privkey := wcom.CBCDecrypterPrivkey([]byte(wallet.Password), prikeybyte)
 		priv, err := cr.PrivKeyFromBytes(privkey)
 		if err != nil {
 			walletlog.Error("ProcMergeBalance", "PrivKeyFromBytes err", err, "index", index)
@@ -765,7 +778,7 @@ func (wallet *Wallet) ProcMergeBalance(MergeBalance *types.ReqWalletMergeBalance
 			exec = []byte(cfg.GetTitle() + "coins")
 			toAddr = address.ExecAddress(string(exec))
 		}
-		tx := &types.Transaction{Execer: exec, Payload: types.Encode(transfer), Fee: wallet.FeeAmount, To: toAddr, Nonce: wallet.random.Int63()}
+		tx := &types.Transaction{Execer: exec, Payload: types.Encode(transfer), Fee: wallet.FeeAmount, To: toAddr, Nonce: types.RandNonce()}
 		tx.ChainID = cfg.GetChainID()
 
 		tx.SetExpire(cfg, time.Second*120)
@@ -868,18 +881,28 @@ func (wallet *Wallet) ProcWalletSetPasswd(Passwd *types.ReqWalletSetPasswd) erro
 		walletlog.Error("ProcWalletSetPasswd", "GetAccountByPrefix:err", err)
 	}
 
+	//所有私钥使用同一个派生密钥加密, 旧版本格式的私钥在这里一并升级
+	encrypter, err := wcom.NewKeyStoreEncrypter([]byte(Passwd.NewPass))
+	if err != nil {
+		walletlog.Error("ProcWalletSetPasswd", "NewKeyStoreEncrypter err", err)
+		return err
+	}
+	defer encrypter.Wipe()
 	for _, AccStore := range WalletAccStores {
 		//使用old Password解密存储的私钥
-		storekey, err := common.FromHex(AccStore.GetPrivkey())
-		if err != nil || len(storekey) == 0 {
-			walletlog.Info("ProcWalletSetPasswd", "addr", AccStore.Addr, "FromHex err", err)
+		Decrypter, err := wcom.DecryptPrivkey([]byte(Passwd.OldPass), AccStore.GetPrivkey())
+		if err != nil {
+			walletlog.Info("ProcWalletSetPasswd", "addr", AccStore.Addr, "DecryptPrivkey err", err)
 			continue
 		}
-		Decrypter := wcom.CBCDecrypterPrivkey([]byte(Passwd.OldPass), storekey)
 
 		//使用新的密码重新加密私钥
-		Encrypter := wcom.CBCEncrypterPrivkey([]byte(Passwd.NewPass), Decrypter)
-		AccStore.Privkey = common.ToHex(Encrypter)
+		Encrypter, err := encrypter.Encrypt(Decrypter)
+		if err != nil {
+			walletlog.Info("ProcWalletSetPasswd", "addr", AccStore.Addr, "Encrypt err", err)
+			continue
+		}
+		AccStore.Privkey = string(Encrypter)
 		err = wallet.walletStore.SetWalletAccountInBatch(true, AccStore.Addr, AccStore, newBatch)
 		if err != nil {
 			walletlog.Info("ProcWalletSetPasswd", "addr", AccStore.Addr, "SetWalletAccount err", err)
@@ -893,6 +916,7 @@ func (wallet *Wallet) ProcWalletSetPasswd(Passwd *types.ReqWalletSetPasswd) erro
 	}
 	wallet.Password = Passwd.NewPass
 	wallet.EncryptFlag = 1
+	wallet.resetEncrypter()
 	return nil
 }
 
@@ -905,6 +929,7 @@ func (wallet *Wallet) ProcWalletLock() error {
 	}
 
 	atomic.CompareAndSwapInt32(&wallet.isWalletLocked, 0, 1)
+	wallet.wipeKeys()
 	for _, policy := range wcom.PolicyContainer {
 		policy.OnWalletLocked()
 	}
@@ -940,6 +965,13 @@ func (wallet *Wallet) ProcWalletUnLock(WalletUnLock *types.WalletUnLock) error {
 	}
 	//本钱包没有设置密码加密过,只需要解锁不需要记录解锁密码
 	wallet.Password = WalletUnLock.Passwd
+	//旧版本的加密数据在密码校验通过后升级为KeyStore格式, 升级失败不影响解锁
+	if wallet.EncryptFlag == 1 {
+		err = wallet.upgradeKeyStore(WalletUnLock.Passwd)
+		if err != nil {
+			walletlog.Error("ProcWalletUnLock upgradeKeyStore", "err", err)
+		}
+	}
 	//只解锁挖矿转账
 	if !WalletUnLock.WalletOrTicket {
 		//wallet.isTicketLocked = false
@@ -960,7 +992,9 @@ func (wallet *Wallet) resetTimeout(Timeout int64) {
 	if wallet.timeout == nil {
 		wallet.timeout = time.AfterFunc(time.Second*time.Duration(Timeout), func() {
 			//wallet.isWalletLocked = true
-			atomic.CompareAndSwapInt32(&wallet.isWalletLocked, 0, 1)
+			if atomic.CompareAndSwapInt32(&wallet.isWalletLocked, 0, 1) {
+				wallet.wipeKeys()
+			}
 		})
 	} else {
 		wallet.timeout.Reset(time.Second * time.Duration(Timeout))
@@ -1018,7 +1052,7 @@ func (wallet *Wallet) ProcWalletAddBlock(block *types.BlockDetail) {
 			//from addr
 			fromaddress := addr.String()
 			param.senderRecver = fromaddress
-			if len(fromaddress) != 0 && wallet.AddrInWallet(fromaddress) {
+			if len(fromaddress) != 0 && wallet.addrTracked(fromaddress) {
 				param.sendRecvFlag = sendTx
 				wallet.buildAndStoreWalletTxDetail(param)
 				walletlog.Debug("ProcWalletAddBlock", "fromaddress", fromaddress)
@@ -1026,7 +1060,7 @@ func (wallet *Wallet) ProcWalletAddBlock(block *types.BlockDetail) {
 			}
 			//toaddr获取交易中真实的接收地址，主要是针对para
 			toaddr := tx.GetRealToAddr()
-			if len(toaddr) != 0 && wallet.AddrInWallet(toaddr) {
+			if len(toaddr) != 0 && wallet.addrTracked(toaddr) {
 				param.sendRecvFlag = recvTx
 				wallet.buildAndStoreWalletTxDetail(param)
 				walletlog.Debug("ProcWalletAddBlock", "toaddr", toaddr)
@@ -1126,13 +1160,13 @@ func (wallet *Wallet) ProcWalletDelBlock(block *types.BlockDetail) {
 			pubkey := tx.Signature.GetPubkey()
 			addr := address.PubKeyToAddress(pubkey)
 			fromaddress := addr.String()
-			if len(fromaddress) != 0 && wallet.AddrInWallet(fromaddress) {
+			if len(fromaddress) != 0 && wallet.addrTracked(fromaddress) {
 				newbatch.Delete(wcom.CalcTxKey(heightstr))
 				continue
 			}
 			//toaddr
 			toaddr := tx.GetRealToAddr()
-			if len(toaddr) != 0 && wallet.AddrInWallet(toaddr) {
+			if len(toaddr) != 0 && wallet.addrTracked(toaddr) {
 				newbatch.Delete(wcom.CalcTxKey(heightstr))
 			}
 		}
@@ -1468,11 +1502,15 @@ func (wallet *Wallet) createNewAccountByIndex(index uint32) (string, error) {
 			Label: Label,
 		}
 
-		//使用钱包的password对私钥加密 aes cbc
-		Encrypted := wcom.CBCEncrypterPrivkey([]byte(wallet.Password), privkeybyte)
+		//使用钱包的password对私钥加密
+		Encrypted, err := wallet.encryptPrivkey(privkeybyte)
+		if err != nil {
+			walletlog.Error("createNewAccountByIndex", "encryptPrivkey err", err)
+			return "", err
+		}
 
 		var WalletAccStore types.WalletAccountStore
-		WalletAccStore.Privkey = common.ToHex(Encrypted)
+		WalletAccStore.Privkey = Encrypted
 		WalletAccStore.Label = Label
 		WalletAccStore.Addr = addr
 
@@ -1551,6 +1589,13 @@ func (wallet *Wallet) ProcDumpPrivkeysFile(fileName, passwd string) error {
 		return err
 	}
 
+	//导出文件的所有私钥使用同一个派生密钥加密
+	encrypter, err := wcom.NewKeyStoreEncrypter([]byte(passwd))
+	if err != nil {
+		walletlog.Error("ProcDumpPrivkeysFile NewKeyStoreEncrypter error!", "fileName", fileName, "err", err)
+		return err
+	}
+	defer encrypter.Wipe()
 	for i, acc := range accounts {
 		priv, err := wallet.getPrivKeyByAddr(acc.Addr)
 		if err != nil {
@@ -1561,9 +1606,9 @@ func (wallet *Wallet) ProcDumpPrivkeysFile(fileName, passwd string) error {
 		privkey := common.ToHex(priv.Bytes())
 		content := privkey + "& *.prickey.+.label.* &" + acc.Label
 
-		Encrypter, err := AesgcmEncrypter([]byte(passwd), []byte(content))
+		Encrypter, err := encrypter.Encrypt([]byte(content))
 		if err != nil {
-			walletlog.Error("ProcDumpPrivkeysFile AesgcmEncrypter fileContent error!", "fileName", fileName, "err", err)
+			walletlog.Error("ProcDumpPrivkeysFile Encrypt fileContent error!", "fileName", fileName, "err", err)
 			continue
 		}
 
@@ -1611,9 +1656,9 @@ func (wallet *Wallet) ProcImportPrivkeysFile(fileName, passwd string) error {
 	}
 	accounts := strings.Split(string(fileContent), "&ffzm.&**&")
 	for _, value := range accounts {
-		Decrypter, err := AesgcmDecrypter([]byte(passwd), []byte(value))
+		Decrypter, err := decryptPrivkeysFileContent([]byte(passwd), []byte(value))
 		if err != nil {
-			walletlog.Error("ProcImportPrivkeysFile AesgcmDecrypter fileContent error", "fileName", fileName, "err", err)
+			walletlog.Error("ProcImportPrivkeysFile decrypt fileContent error", "fileName", fileName, "err", err)
 			return types.ErrVerifyOldpasswdFail
 		}
 
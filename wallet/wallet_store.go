@@ -73,3 +73,54 @@ func (ws *walletStore) GetWalletPassword() string {
 	}
 	return string(passwordbytes)
 }
+
+// SetWatchOnlyAccount 保存只读账户
+func (ws *walletStore) SetWatchOnlyAccount(acc *types.WalletWatchOnlyAccount) error {
+	err := ws.GetDB().SetSync(CalcWatchOnlyKey(acc.Addr), types.Encode(acc))
+	if err != nil {
+		storelog.Error("SetWatchOnlyAccount", "SetSync error", err)
+		return err
+	}
+	return nil
+}
+
+// GetWatchOnlyAccount 获取地址对应的只读账户
+func (ws *walletStore) GetWatchOnlyAccount(addr string) (*types.WalletWatchOnlyAccount, error) {
+	value, err := ws.Get(CalcWatchOnlyKey(addr))
+	if value == nil || err != nil {
+		return nil, types.ErrWatchOnlyNotExist
+	}
+	var acc types.WalletWatchOnlyAccount
+	err = types.Decode(value, &acc)
+	if err != nil {
+		storelog.Error("GetWatchOnlyAccount", "Decode error", err)
+		return nil, types.ErrUnmarshal
+	}
+	return &acc, nil
+}
+
+// GetWatchOnlyAccounts 获取所有的只读账户
+func (ws *walletStore) GetWatchOnlyAccounts() ([]*types.WalletWatchOnlyAccount, error) {
+	values := ws.NewListHelper().PrefixScan(CalcWatchOnlyPrefix())
+	accs := make([]*types.WalletWatchOnlyAccount, 0, len(values))
+	for _, value := range values {
+		var acc types.WalletWatchOnlyAccount
+		err := types.Decode(value, &acc)
+		if err != nil {
+			storelog.Error("GetWatchOnlyAccounts", "Decode error", err)
+			return nil, types.ErrUnmarshal
+		}
+		accs = append(accs, &acc)
+	}
+	return accs, nil
+}
+
+// DelWatchOnlyAccount 删除只读账户, 已经记录的交易不删除
+func (ws *walletStore) DelWatchOnlyAccount(addr string) error {
+	err := ws.GetDB().DeleteSync(CalcWatchOnlyKey(addr))
+	if err != nil {
+		storelog.Error("DelWatchOnlyAccount", "DeleteSync error", err)
+		return err
+	}
+	return nil
+}
@@ -203,7 +203,9 @@ func testAllWallet(t *testing.T) {
 	testgetFatalFailure(t, wallet)
 
 	testWallet(t, wallet)
+	testKeyStoreUpgrade(t, wallet)
 	testSendTx(t, wallet)
+	testWatchOnly(t, wallet)
 	testCreateNewAccountByIndex(t, wallet)
 
 	t.Log(datapath)
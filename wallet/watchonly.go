@@ -0,0 +1,205 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"errors"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+)
+
+// isWatchOnly 地址是否为本钱包的只读账户
+func (wallet *Wallet) isWatchOnly(addr string) bool {
+	acc, err := wallet.walletStore.GetWatchOnlyAccount(addr)
+	return err == nil && acc != nil
+}
+
+// addrTracked 钱包需要记录交易的地址, 包括钱包账户以及只读账户
+func (wallet *Wallet) addrTracked(addr string) bool {
+	if wallet.AddrInWallet(addr) {
+		return true
+	}
+	return wallet.isInited() && len(addr) > 0 && wallet.isWatchOnly(addr)
+}
+
+// ProcImportWatchOnly 通过地址或者公钥导入只读账户, 只读账户只用于跟踪交易以及构造未签名交易
+func (wallet *Wallet) ProcImportWatchOnly(req *types.ReqWalletImportWatchOnly) (*types.WalletAccount, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil || len(req.GetLabel()) == 0 || (len(req.GetAddr()) == 0 && len(req.GetPubKey()) == 0) {
+		walletlog.Error("ProcImportWatchOnly input parameter is nil!")
+		return nil, types.ErrInvalidParam
+	}
+	addr := req.GetAddr()
+	if len(req.GetPubKey()) > 0 {
+		pub, err := common.FromHex(req.GetPubKey())
+		if err != nil || len(pub) == 0 {
+			walletlog.Error("ProcImportWatchOnly", "FromHex err", err)
+			return nil, types.ErrFromHex
+		}
+		pubAddr := address.PubKeyToAddr(pub)
+		if len(addr) > 0 && addr != pubAddr {
+			return nil, types.ErrPubKeyAddrNotMatch
+		}
+		addr = pubAddr
+	}
+	if err := address.CheckAddress(addr); err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	//私钥已经在钱包中的地址不需要作为只读账户导入
+	if wallet.AddrInWallet(addr) {
+		return nil, types.ErrPrivkeyExist
+	}
+	if wallet.isWatchOnly(addr) {
+		return nil, types.ErrWatchOnlyExist
+	}
+	//只读账户的label同样不能和钱包账户以及其他只读账户重复
+	Account, err := wallet.walletStore.GetAccountByLabel(req.GetLabel())
+	if Account != nil && err == nil {
+		return nil, types.ErrLabelHasUsed
+	}
+	accs, err := wallet.walletStore.GetWatchOnlyAccounts()
+	if err != nil {
+		return nil, err
+	}
+	for _, acc := range accs {
+		if acc.Label == req.GetLabel() {
+			return nil, types.ErrLabelHasUsed
+		}
+	}
+
+	watch := &types.WalletWatchOnlyAccount{
+		Addr:      addr,
+		PubKey:    req.GetPubKey(),
+		Label:     req.GetLabel(),
+		TimeStamp: time.Now().Format(time.RFC3339),
+	}
+	err = wallet.walletStore.SetWatchOnlyAccount(watch)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := wallet.accountdb.LoadAccounts(wallet.api, []string{addr})
+	if err != nil || len(accounts) == 0 {
+		walletlog.Error("ProcImportWatchOnly", "LoadAccounts err", err)
+		return nil, err
+	}
+	return &types.WalletAccount{Acc: accounts[0], Label: watch.Label}, nil
+}
+
+// ProcGetWatchOnlyList 获取只读账户列表以及余额
+func (wallet *Wallet) ProcGetWatchOnlyList() (*types.WalletAccounts, error) {
+	accs, err := wallet.walletStore.GetWatchOnlyAccounts()
+	if err != nil {
+		return nil, err
+	}
+	reply := &types.WalletAccounts{}
+	if len(accs) == 0 {
+		return reply, nil
+	}
+	addrs := make([]string, len(accs))
+	for i, acc := range accs {
+		addrs[i] = acc.Addr
+	}
+	accounts, err := wallet.accountdb.LoadAccounts(wallet.api, addrs)
+	if err != nil || len(accounts) != len(accs) {
+		walletlog.Error("ProcGetWatchOnlyList", "LoadAccounts err", err)
+		return nil, types.ErrAccountNotExist
+	}
+	for i, acc := range accs {
+		reply.Wallets = append(reply.Wallets, &types.WalletAccount{Acc: accounts[i], Label: acc.Label})
+	}
+	return reply, nil
+}
+
+// ProcDelWatchOnly 删除只读账户
+func (wallet *Wallet) ProcDelWatchOnly(addr string) error {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if !wallet.isWatchOnly(addr) {
+		return types.ErrWatchOnlyNotExist
+	}
+	return wallet.walletStore.DelWatchOnlyAccount(addr)
+}
+
+// 未签名交易默认的过期时间, 需要留出离线签名的时间
+const defaultUnsignedTxExpire = time.Hour
+
+// ProcCreateUnsignedTx 为只读账户构造未签名的转账交易, 导出到离线设备签名
+func (wallet *Wallet) ProcCreateUnsignedTx(req *types.ReqWalletSendToAddress) (*types.UnsignedTxPackage, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil || len(req.From) == 0 || len(req.To) == 0 {
+		walletlog.Error("ProcCreateUnsignedTx input para From or To is nil!")
+		return nil, types.ErrInvalidParam
+	}
+	watch, err := wallet.walletStore.GetWatchOnlyAccount(req.GetFrom())
+	if err != nil {
+		return nil, err
+	}
+	err = wallet.checkSendBalance(req)
+	if err != nil {
+		return nil, err
+	}
+	expire := int64(defaultUnsignedTxExpire)
+	if req.GetExpire() != "" {
+		expire, err = types.ParseExpire(req.GetExpire())
+		if err != nil {
+			return nil, err
+		}
+	}
+	tx, err := wallet.createSendToAddress(req.GetTo(), req.GetAmount(), req.GetNote(), req.IsToken, req.TokenSymbol, time.Duration(expire))
+	if err != nil {
+		return nil, err
+	}
+	return &types.UnsignedTxPackage{
+		TxHex:    common.ToHex(types.Encode(tx)),
+		Hash:     common.ToHex(tx.Hash()),
+		From:     watch.Addr,
+		PubKey:   watch.PubKey,
+		SignType: int32(wallet.SignType),
+		Fee:      tx.Fee,
+		Expire:   tx.Expire,
+	}, nil
+}
+
+// ProcSendSignedTx 校验离线签名后的只读账户交易并发送
+func (wallet *Wallet) ProcSendSignedTx(req *types.ReqWalletSendSignedTx) (*types.ReplyHash, error) {
+	if req == nil || len(req.GetTxHex()) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	txByte, err := common.FromHex(req.GetTxHex())
+	if err != nil {
+		return nil, types.ErrFromHex
+	}
+	var tx types.Transaction
+	err = types.Decode(txByte, &tx)
+	if err != nil {
+		return nil, types.ErrDecode
+	}
+	if tx.GetSignature() == nil || len(tx.GetSignature().GetSignature()) == 0 {
+		return nil, types.ErrTxNotSigned
+	}
+	if !wallet.isWatchOnly(tx.From()) {
+		return nil, types.ErrWatchOnlyNotExist
+	}
+	if !tx.CheckSign(wallet.GetHeight() + 1) {
+		return nil, types.ErrSign
+	}
+	reply, err := wallet.sendTx(&tx)
+	if err != nil {
+		return nil, err
+	}
+	if !reply.GetIsOk() {
+		return nil, errors.New(string(reply.GetMsg()))
+	}
+	return &types.ReplyHash{Hash: tx.Hash()}, nil
+}
@@ -0,0 +1,102 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	wcom "github.com/33cn/chain33/wallet/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWatchOnly(t *testing.T, wallet *Wallet) {
+	println("testWatchOnly begin")
+	watchAddr, watchPriv := util.Genaddress()
+	SaveAccountTomavl(wallet, wallet.client, Statehash, []*types.Account{{Addr: watchAddr, Balance: 100 * types.Coin}})
+
+	//公钥和地址不匹配
+	_, err := wallet.GetAPI().ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{
+		Addr:   ToAddr1,
+		PubKey: common.ToHex(watchPriv.PubKey().Bytes()),
+		Label:  "watch-only",
+	})
+	assert.Equal(t, types.ErrPubKeyAddrNotMatch, err)
+
+	resp, err := wallet.GetAPI().ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{
+		PubKey: common.ToHex(watchPriv.PubKey().Bytes()),
+		Label:  "watch-only",
+	})
+	require.Nil(t, err)
+	acc := resp.(*types.WalletAccount)
+	assert.Equal(t, watchAddr, acc.Acc.Addr)
+	assert.Equal(t, 100*types.Coin, acc.Acc.Balance)
+
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{Addr: watchAddr, Label: "watch-only2"})
+	assert.Equal(t, types.ErrWatchOnlyExist, err)
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{Addr: FromAddr, Label: "watch-only3"})
+	assert.Equal(t, types.ErrPrivkeyExist, err)
+
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletWatchOnlyList", &types.ReqNil{})
+	require.Nil(t, err)
+	list := resp.(*types.WalletAccounts)
+	require.Equal(t, 1, len(list.Wallets))
+	assert.Equal(t, "watch-only", list.Wallets[0].Label)
+
+	//构造未签名交易, 离线签名后导入发送
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletCreateUnsignedTx", &types.ReqWalletSendToAddress{
+		From:   watchAddr,
+		To:     ToAddr1,
+		Amount: types.Coin,
+	})
+	require.Nil(t, err)
+	pkg := resp.(*types.UnsignedTxPackage)
+	assert.Equal(t, watchAddr, pkg.From)
+	txByte, err := common.FromHex(pkg.TxHex)
+	require.Nil(t, err)
+	var tx types.Transaction
+	require.Nil(t, types.Decode(txByte, &tx))
+	assert.Equal(t, pkg.Hash, common.ToHex(tx.Hash()))
+	//默认留出一小时离线签名
+	assert.True(t, tx.Expire > types.Now().Unix()+int64(defaultUnsignedTxExpire/time.Second)-60)
+
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletCreateUnsignedTx", &types.ReqWalletSendToAddress{
+		From: watchAddr, To: ToAddr1, Amount: types.Coin, Expire: "300s",
+	})
+	require.Nil(t, err)
+	expire := resp.(*types.UnsignedTxPackage).Expire
+	assert.True(t, expire > types.Now().Unix()+240 && expire <= types.Now().Unix()+300)
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletCreateUnsignedTx", &types.ReqWalletSendToAddress{
+		From: watchAddr, To: ToAddr1, Amount: types.Coin, Expire: "abc",
+	})
+	assert.NotNil(t, err)
+
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletSendSignedTx", &types.ReqWalletSendSignedTx{TxHex: pkg.TxHex})
+	assert.Equal(t, types.ErrTxNotSigned, err)
+	tx.Sign(pkg.SignType, watchPriv)
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletSendSignedTx", &types.ReqWalletSendSignedTx{TxHex: common.ToHex(types.Encode(&tx))})
+	require.Nil(t, err)
+	assert.Equal(t, tx.Hash(), resp.(*types.ReplyHash).Hash)
+
+	//只读账户的交易记录到钱包
+	blk := &types.Block{Height: 5, Txs: []*types.Transaction{&tx}}
+	wallet.ProcWalletAddBlock(&types.BlockDetail{Block: blk, Receipts: []*types.ReceiptData{{Ty: types.ExecOk}}})
+	value, err := wallet.walletStore.Get(wcom.CalcTxKey(fmt.Sprintf("%018d", blk.Height*maxTxNumPerBlock)))
+	require.Nil(t, err)
+	assert.NotNil(t, value)
+
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletDelWatchOnly", &types.ReqString{Data: watchAddr})
+	require.Nil(t, err)
+	assert.True(t, resp.(*types.Reply).IsOk)
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletCreateUnsignedTx", &types.ReqWalletSendToAddress{From: watchAddr, To: ToAddr1, Amount: types.Coin})
+	assert.Equal(t, types.ErrWatchOnlyNotExist, err)
+	println("testWatchOnly end")
+	println("--------------------------")
+}